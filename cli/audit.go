@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/compass/internal/client"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/printer"
+	"github.com/goto/salt/term"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func auditCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Browse the audit log",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ compass audit list
+			$ compass audit list --actor john.doe@example.com --since 24h
+			$ compass audit list --method DeleteAssets --result OK
+		`),
+	}
+
+	cmd.AddCommand(listAuditEventsCommand(cfg))
+
+	return cmd
+}
+
+func listAuditEventsCommand(cfg *Config) *cobra.Command {
+	var (
+		json  string
+		since time.Duration
+		req   compassv1beta1.ListAuditEventsRequest
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists the audit events, the latest first",
+		Long: heredoc.Doc(`
+			List the audit events recorded for the calls of the mutating methods,
+			the latest first.
+		`),
+		Example: heredoc.Doc(`
+			$ compass audit list --target urn:bigquery:project:dataset:table
+			$ compass audit list --actor john.doe@example.com --since 24h -o json
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			if since > 0 {
+				req.Since = timestamppb.New(time.Now().Add(-since))
+			}
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			res, err := clnt.ListAuditEvents(ctx, &req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if json == "json" {
+				fmt.Println(term.Bluef(prettyPrint(res.GetData())))
+				return nil
+			}
+
+			report := [][]string{{"TIME", "ACTOR", "METHOD", "TARGETS", "RESULT", "LATENCY"}}
+			for _, e := range res.GetData() {
+				report = append(report, []string{
+					e.GetCreatedAt().AsTime().Format(time.RFC3339),
+					e.GetActor(),
+					path.Base(e.GetMethod()),
+					strings.Join(e.GetTargetIds(), ","),
+					e.GetResult(),
+					(time.Duration(e.GetLatencyMs()) * time.Millisecond).String(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println(term.Cyanf("To view all the data in JSON format, use flag `-o json`"))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&req.Actor, "actor", "", "email of the user who made the calls")
+	cmd.Flags().StringVar(&req.Method, "method", "", "method called, like UpsertAsset")
+	cmd.Flags().StringVar(&req.TargetId, "target", "", "id or urn targeted by the calls")
+	cmd.Flags().StringVar(&req.Result, "result", "", "grpc status code of the calls, like OK or PermissionDenied")
+	cmd.Flags().DurationVar(&since, "since", 0, "only list the events of the given last duration, like 24h")
+	cmd.Flags().Uint32Var(&req.Size, "size", 0, "number of events to list, 100 if not set")
+	cmd.Flags().Uint32Var(&req.Offset, "offset", 0, "number of events to skip")
+	cmd.Flags().StringVarP(&json, "out", "o", "table", "flag to control output viewing, for json `-o json`")
+
+	return cmd
+}
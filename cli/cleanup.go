@@ -3,18 +3,19 @@ package cli
 import (
 	"context"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/audit"
 	"github.com/goto/compass/internal/cleanup"
 	"github.com/goto/compass/internal/lineageparser"
-	"github.com/goto/compass/internal/store/elasticsearch"
 	"github.com/goto/compass/internal/store/postgres"
 	"github.com/goto/compass/internal/workermanager"
 	"github.com/goto/compass/pkg/telemetry"
 	"github.com/goto/salt/term"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
 )
 
 func cleanupCmd(cfg *Config) *cobra.Command {
@@ -68,11 +69,6 @@ func runCleanUp(ctx context.Context, cfg *Config) (uint32, error) {
 
 	defer otelCleanup()
 
-	esClient, err := initElasticsearch(logger, cfg.Elasticsearch)
-	if err != nil {
-		return 0, err
-	}
-
 	pgClient, err := initPostgres(ctx, logger, cfg)
 	if err != nil {
 		return 0, err
@@ -92,11 +88,10 @@ func runCleanUp(ctx context.Context, cfg *Config) (uint32, error) {
 	if err != nil {
 		return 0, fmt.Errorf("create new asset repository: %w", err)
 	}
-	discoveryRepository := elasticsearch.NewDiscoveryRepository(
-		esClient,
-		logger,
-		cfg.Elasticsearch.RequestTimeout,
-		strings.Split(cfg.ColSearchExclusionKeywords, ","))
+	discoveryRepository, err := initDiscoveryRepository(logger, cfg, pgClient)
+	if err != nil {
+		return 0, err
+	}
 	lineageRepository, err := postgres.NewLineageRepository(pgClient)
 	if err != nil {
 		return 0, fmt.Errorf("create new lineage repository: %w", err)
@@ -118,15 +113,50 @@ func runCleanUp(ctx context.Context, cfg *Config) (uint32, error) {
 		}
 	}()
 
+	assetTypeRepository, err := postgres.NewAssetTypeRepository(pgClient)
+	if err != nil {
+		return 0, fmt.Errorf("create new asset type repository: %w", err)
+	}
 	assetService, cancel := asset.NewService(asset.ServiceDeps{
 		AssetRepo:     assetRepository,
 		DiscoveryRepo: discoveryRepository,
 		LineageRepo:   lineageRepository,
+		TypeRepo:      assetTypeRepository,
 		Worker:        wrkr,
 		Logger:        logger,
 		Config:        cfg.Asset,
 	})
 	defer cancel()
+	if err := assetService.RefreshTypes(ctx); err != nil {
+		return 0, err
+	}
+
+	auditRepository, err := postgres.NewAuditRepository(pgClient)
+	if err != nil {
+		return 0, fmt.Errorf("create new audit repository: %w", err)
+	}
+	auditService := audit.NewService(auditRepository)
+
+	start := time.Now()
+	total, err := cleanup.Run(ctx, cfg.Cleanup, assetService)
+	if cfg.Cleanup.DryRun {
+		return total, err
+	}
+
+	// the assets are deleted outside of the API, the cleanup is audited here
+	e := audit.Event{
+		Actor:   "compass-cleanup",
+		Method:  "cleanup",
+		Request: audit.Summarize(cfg.Cleanup),
+		Result:  status.Code(err).String(),
+		Latency: time.Since(start),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if err := auditService.Record(ctx, &e); err != nil {
+		logger.Error("failed to record audit event", "err", err)
+	}
 
-	return cleanup.Run(ctx, cfg.Cleanup, assetService)
+	return total, err
 }
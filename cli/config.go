@@ -7,8 +7,11 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/core/savedsearch"
 	"github.com/goto/compass/internal/cleanup"
 	"github.com/goto/compass/internal/client"
+	"github.com/goto/compass/internal/embedder"
 	"github.com/goto/compass/internal/server"
 	esStore "github.com/goto/compass/internal/store/elasticsearch"
 	"github.com/goto/compass/internal/store/postgres"
@@ -22,6 +25,11 @@ import (
 
 const configFlag = "config"
 
+const (
+	discoveryStoreElasticsearch = "elasticsearch"
+	discoveryStorePostgres      = "postgres"
+)
+
 func configCommand(cfg *Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config <command>",
@@ -90,6 +98,12 @@ type Config struct {
 	// Elasticsearch
 	Elasticsearch esStore.Config `mapstructure:"elasticsearch"`
 
+	// Store the assets are searched in, either elasticsearch or postgres
+	DiscoveryStore string `yaml:"discovery_store" mapstructure:"discovery_store" default:"elasticsearch"`
+
+	// Embedder for semantic search
+	Embedder embedder.Config `mapstructure:"embedder"`
+
 	// Database
 	DB postgres.Config `mapstructure:"db"`
 
@@ -107,6 +121,11 @@ type Config struct {
 
 	Asset asset.Config `mapstructure:"asset"`
 
+	SavedSearch savedsearch.Config `mapstructure:"saved_search"`
+
+	// Authorization of write operations
+	Authz authz.Config `mapstructure:"authz"`
+
 	// Cleanup jobs
 	Cleanup cleanup.Config `mapstructure:"cleanup"`
 }
@@ -63,6 +63,9 @@ func New(cliConfig *Config) *cobra.Command {
 		discussionsCommand(cliConfig),
 		searchCommand(cliConfig),
 		lineageCommand(cliConfig),
+		serviceAccountsCommand(cliConfig),
+		auditCommand(cliConfig),
+		usersCommand(cliConfig),
 		cleanupCmd(cliConfig),
 		versionCmd(),
 	)
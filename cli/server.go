@@ -8,14 +8,20 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/audit"
+	"github.com/goto/compass/core/authz"
 	"github.com/goto/compass/core/discussion"
+	"github.com/goto/compass/core/savedsearch"
 	"github.com/goto/compass/core/star"
 	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/team"
 	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/embedder"
 	"github.com/goto/compass/internal/lineageparser"
 	compassserver "github.com/goto/compass/internal/server"
 	esStore "github.com/goto/compass/internal/store/elasticsearch"
 	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/webhook"
 	"github.com/goto/compass/internal/workermanager"
 	"github.com/goto/compass/pkg/telemetry"
 	"github.com/goto/salt/log"
@@ -98,11 +104,6 @@ func runServer(ctx context.Context, cfg *Config) error {
 
 	defer cleanUp()
 
-	esClient, err := initElasticsearch(logger, cfg.Elasticsearch)
-	if err != nil {
-		return err
-	}
-
 	pgClient, err := initPostgres(ctx, logger, cfg)
 	if err != nil {
 		return err
@@ -118,14 +119,21 @@ func runServer(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("create new tag template repository: %w", err)
 	}
 	tagTemplateService := tag.NewTemplateService(tagTemplateRepository)
-	tagService := tag.NewService(tagRepository, tagTemplateService)
+	bulkTagJobRepository, err := postgres.NewBulkTagJobRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new bulk tag job repository: %w", err)
+	}
 
 	// init user
 	userRepository, err := postgres.NewUserRepository(pgClient)
 	if err != nil {
 		return fmt.Errorf("create new user repository: %w", err)
 	}
-	userService := user.NewService(logger, userRepository)
+	serviceAccountRepository, err := postgres.NewServiceAccountRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new service account repository: %w", err)
+	}
+	userService := user.NewService(logger, userRepository, user.WithServiceAccountRepository(serviceAccountRepository))
 
 	assetRepository, err := postgres.NewAssetRepository(
 		pgClient, userRepository, postgres.AssetRepositoryConfig{
@@ -136,17 +144,29 @@ func runServer(ctx context.Context, cfg *Config) error {
 	if err != nil {
 		return fmt.Errorf("create new asset repository: %w", err)
 	}
-	discoveryRepository := esStore.NewDiscoveryRepository(esClient, logger, cfg.Elasticsearch.RequestTimeout, strings.Split(cfg.ColSearchExclusionKeywords, ","))
+	discoveryRepository, err := initDiscoveryRepository(logger, cfg, pgClient)
+	if err != nil {
+		return err
+	}
 	lineageRepository, err := postgres.NewLineageRepository(pgClient)
 	if err != nil {
 		return fmt.Errorf("create new lineage repository: %w", err)
 	}
 
+	// the worker reads and migrates tags through a service of its own, as
+	// the tag service enqueues its jobs on the worker
+	workerTagService := tag.NewService(tagRepository, tagTemplateService,
+		tag.WithReferenceRepositories(userRepository, assetRepository), tag.WithLineageRepository(lineageRepository),
+		tag.WithBulkTagJobRepository(bulkTagJobRepository))
 	wrkr, err := initAssetWorker(ctx, workermanager.Deps{
 		Config:        cfg.Worker,
 		DiscoveryRepo: discoveryRepository,
 		AssetRepo:     assetRepository,
 		Logger:        logger,
+		TagMigrator:   workerTagService,
+		TagReader:     workerTagService,
+		BulkTagger:    workerTagService,
+		TagPropagator: workerTagService,
 	})
 	if err != nil {
 		return err
@@ -158,15 +178,9 @@ func runServer(ctx context.Context, cfg *Config) error {
 		}
 	}()
 
-	assetService, cancel := asset.NewService(asset.ServiceDeps{
-		AssetRepo:     assetRepository,
-		DiscoveryRepo: discoveryRepository,
-		LineageRepo:   lineageRepository,
-		Worker:        wrkr,
-		Logger:        logger,
-		Config:        cfg.Asset,
-	})
-	defer cancel()
+	tagService := tag.NewService(tagRepository, tagTemplateService,
+		tag.WithWorker(wrkr), tag.WithReferenceRepositories(userRepository, assetRepository),
+		tag.WithBulkTagJobRepository(bulkTagJobRepository))
 
 	// init discussion
 	discussionRepository, err := postgres.NewDiscussionRepository(pgClient, 0)
@@ -175,6 +189,30 @@ func runServer(ctx context.Context, cfg *Config) error {
 	}
 	discussionService := discussion.NewService(discussionRepository)
 
+	assetTypeSchemaRepository, err := postgres.NewAssetTypeSchemaRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new asset type schema repository: %w", err)
+	}
+	assetTypeRepository, err := postgres.NewAssetTypeRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new asset type repository: %w", err)
+	}
+	assetService, cancel := asset.NewService(asset.ServiceDeps{
+		AssetRepo:      assetRepository,
+		DiscoveryRepo:  discoveryRepository,
+		LineageRepo:    lineageRepository,
+		DiscussionSvc:  discussionService,
+		TypeSchemaRepo: assetTypeSchemaRepository,
+		TypeRepo:       assetTypeRepository,
+		Worker:         wrkr,
+		Logger:         logger,
+		Config:         cfg.Asset,
+	})
+	defer cancel()
+	if err := assetService.RefreshTypes(ctx); err != nil {
+		return err
+	}
+
 	// init star
 	starRepository, err := postgres.NewStarRepository(pgClient)
 	if err != nil {
@@ -182,6 +220,45 @@ func runServer(ctx context.Context, cfg *Config) error {
 	}
 	starService := star.NewService(starRepository)
 
+	// init saved search
+	savedSearchRepository, err := postgres.NewSavedSearchRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new saved search repository: %w", err)
+	}
+	savedSearchService := savedsearch.NewService(savedsearch.ServiceDeps{
+		Repo:     savedSearchRepository,
+		Searcher: discoveryRepository,
+		Notifier: webhook.NewClient(cfg.SavedSearch.WebhookAllowedHosts...),
+		Worker:   wrkr,
+		Logger:   logger,
+		Config:   cfg.SavedSearch,
+	})
+
+	// init authz
+	policyRepository, err := postgres.NewPolicyRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new policy repository: %w", err)
+	}
+	authzService := authz.NewService(authz.ServiceDeps{
+		Repo:   policyRepository,
+		Logger: logger,
+		Config: cfg.Authz,
+	})
+
+	// init team
+	teamRepository, err := postgres.NewTeamRepository(pgClient, cfg.Service.Identity.ProviderDefaultName)
+	if err != nil {
+		return fmt.Errorf("create new team repository: %w", err)
+	}
+	teamService := team.NewService(teamRepository)
+
+	// init audit
+	auditRepository, err := postgres.NewAuditRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new audit repository: %w", err)
+	}
+	auditService := audit.NewService(auditRepository)
+
 	return compassserver.Serve(
 		ctx,
 		cfg.Service,
@@ -194,6 +271,11 @@ func runServer(ctx context.Context, cfg *Config) error {
 		tagService,
 		tagTemplateService,
 		userService,
+		savedSearchService,
+		authzService,
+		teamService,
+		auditService,
+		userRepository,
 	)
 }
 
@@ -218,6 +300,52 @@ func initElasticsearch(logger log.Logger, config esStore.Config) (*esStore.Clien
 	return esClient, nil
 }
 
+// initDiscoveryRepository returns the discovery repository of the
+// configured discovery store
+func initDiscoveryRepository(logger log.Logger, cfg *Config, pgClient *postgres.Client) (asset.DiscoveryRepository, error) {
+	colSearchExclusionList := strings.Split(cfg.ColSearchExclusionKeywords, ",")
+
+	switch cfg.DiscoveryStore {
+	case discoveryStorePostgres:
+		discoveryRepository, err := postgres.NewDiscoveryRepository(pgClient, colSearchExclusionList)
+		if err != nil {
+			return nil, fmt.Errorf("create new discovery repository: %w", err)
+		}
+		return discoveryRepository, nil
+
+	case discoveryStoreElasticsearch, "":
+		esClient, err := initElasticsearch(logger, cfg.Elasticsearch)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := discoveryRepositoryOptions(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return esStore.NewDiscoveryRepository(esClient, logger, cfg.Elasticsearch.RequestTimeout, colSearchExclusionList, opts...), nil
+
+	default:
+		return nil, fmt.Errorf("unknown discovery store %q", cfg.DiscoveryStore)
+	}
+}
+
+func discoveryRepositoryOptions(cfg *Config) ([]esStore.DiscoveryRepositoryOption, error) {
+	var opts []esStore.DiscoveryRepositoryOption
+	if len(cfg.Elasticsearch.TypeIndices) > 0 {
+		opts = append(opts, esStore.WithTypeIndices(cfg.Elasticsearch.TypeIndices))
+	}
+
+	emb, err := embedder.New(cfg.Embedder)
+	if err != nil {
+		return nil, fmt.Errorf("create new embedder: %w", err)
+	}
+	if emb != nil {
+		opts = append(opts, esStore.WithEmbedder(emb, cfg.Elasticsearch.Semantic))
+	}
+
+	return opts, nil
+}
+
 func initPostgres(ctx context.Context, logger log.Logger, config *Config) (*postgres.Client, error) {
 	pgClient, err := postgres.NewClient(ctx, config.DB)
 	if err != nil {
@@ -228,7 +356,13 @@ func initPostgres(ctx context.Context, logger log.Logger, config *Config) (*post
 	return pgClient, nil
 }
 
-func initAssetWorker(ctx context.Context, deps workermanager.Deps) (asset.Worker, error) {
+type serverWorker interface {
+	asset.Worker
+	savedsearch.Worker
+	tag.Worker
+}
+
+func initAssetWorker(ctx context.Context, deps workermanager.Deps) (serverWorker, error) {
 	if !deps.Config.Enabled {
 		return workermanager.NewInSituWorker(deps), nil
 	}
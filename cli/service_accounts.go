@@ -0,0 +1,347 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/compass/internal/client"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/printer"
+	"github.com/goto/salt/term"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func serviceAccountsCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serviceaccount",
+		Aliases: []string{"serviceaccounts", "sa"},
+		Short:   "Manage service accounts and their api tokens",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ compass serviceaccount create ingestion-bq
+			$ compass serviceaccount list
+			$ compass serviceaccount delete <id>
+			$ compass serviceaccount token create <service-account-id> --scope write
+		`),
+	}
+
+	cmd.AddCommand(
+		createServiceAccountCommand(cfg),
+		listServiceAccountsCommand(cfg),
+		deleteServiceAccountCommand(cfg),
+		apiTokensCommand(cfg),
+	)
+
+	return cmd
+}
+
+func createServiceAccountCommand(cfg *Config) *cobra.Command {
+	var description string
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "create a service account",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ compass serviceaccount create ingestion-bq --description "BigQuery ingestion"
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			res, err := clnt.CreateServiceAccount(ctx, &compassv1beta1.CreateServiceAccountRequest{
+				Name:        args[0],
+				Description: description,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Println("ID: \t", term.Greenf(res.GetData().GetId()))
+			fmt.Println("Email: \t", term.Greenf(res.GetData().GetEmail()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&description, "description", "d", "", "description of the service account")
+
+	return cmd
+}
+
+func listServiceAccountsCommand(cfg *Config) *cobra.Command {
+	var json string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "lists all service accounts",
+		Example: heredoc.Doc(`
+			$ compass serviceaccount list
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			res, err := clnt.GetAllServiceAccounts(ctx, &compassv1beta1.GetAllServiceAccountsRequest{})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if json != "json" {
+				report := [][]string{}
+				report = append(report, []string{"ID", "NAME", "EMAIL", "DESCRIPTION"})
+				for _, sa := range res.GetData() {
+					report = append(report, []string{sa.Id, sa.Name, sa.Email, sa.Description})
+				}
+				printer.Table(os.Stdout, report)
+
+				fmt.Println(term.Cyanf("To view all the data in JSON format, use flag `-o json`"))
+			} else {
+				fmt.Println(term.Bluef(prettyPrint(res.GetData())))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&json, "out", "o", "table", "flag to control output viewing, for json `-o json`")
+
+	return cmd
+}
+
+func deleteServiceAccountCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "delete a service account and revoke its api tokens",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ compass serviceaccount delete <id>
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			if _, err := clnt.DeleteServiceAccount(ctx, &compassv1beta1.DeleteServiceAccountRequest{
+				Id: args[0],
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Println("Deleted service account", term.Greenf(args[0]))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func apiTokensCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "token",
+		Aliases: []string{"tokens"},
+		Short:   "Manage api tokens of a service account",
+		Example: heredoc.Doc(`
+			$ compass serviceaccount token create <service-account-id> --scope read --ttl 720h
+			$ compass serviceaccount token list <service-account-id>
+			$ compass serviceaccount token revoke <service-account-id> <id>
+		`),
+	}
+
+	cmd.AddCommand(
+		createAPITokenCommand(cfg),
+		listAPITokensCommand(cfg),
+		revokeAPITokenCommand(cfg),
+	)
+
+	return cmd
+}
+
+func createAPITokenCommand(cfg *Config) *cobra.Command {
+	var (
+		name   string
+		scopes []string
+		ttl    time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <service-account-id>",
+		Short: "create an api token, printed only once",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ compass serviceaccount token create <service-account-id> --name daily --scope read,write --ttl 720h
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			req := &compassv1beta1.CreateAPITokenRequest{
+				ServiceAccountId: args[0],
+				Name:             name,
+				Scopes:           scopes,
+			}
+			if ttl > 0 {
+				req.ExpiresAt = timestamppb.New(time.Now().Add(ttl))
+			}
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			res, err := clnt.CreateAPIToken(ctx, req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Println("ID: \t\t", term.Greenf(res.GetData().GetId()))
+			fmt.Println("Expires at: \t", term.Greenf(res.GetData().GetExpiresAt().AsTime().Format(time.RFC3339)))
+			fmt.Println("Token: \t\t", term.Greenf(res.GetToken()))
+			fmt.Println(term.Cyanf("Store the token now, it can not be retrieved later"))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "name of the token")
+	cmd.Flags().StringSliceVarP(&scopes, "scope", "s", []string{"read"}, "scopes of the token, read or write")
+	cmd.Flags().DurationVar(&ttl, "ttl", 0, "lifetime of the token, 90 days if not set")
+
+	return cmd
+}
+
+func listAPITokensCommand(cfg *Config) *cobra.Command {
+	var json string
+
+	cmd := &cobra.Command{
+		Use:   "list <service-account-id>",
+		Short: "lists the api tokens of a service account",
+		Args:  cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ compass serviceaccount token list <service-account-id>
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			res, err := clnt.GetAllAPITokens(ctx, &compassv1beta1.GetAllAPITokensRequest{
+				ServiceAccountId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if json != "json" {
+				report := [][]string{}
+				report = append(report, []string{"ID", "NAME", "SCOPES", "EXPIRES AT"})
+				for _, t := range res.GetData() {
+					report = append(report, []string{
+						t.Id, t.Name, strings.Join(t.Scopes, ","), t.GetExpiresAt().AsTime().Format(time.RFC3339),
+					})
+				}
+				printer.Table(os.Stdout, report)
+
+				fmt.Println(term.Cyanf("To view all the data in JSON format, use flag `-o json`"))
+			} else {
+				fmt.Println(term.Bluef(prettyPrint(res.GetData())))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&json, "out", "o", "table", "flag to control output viewing, for json `-o json`")
+
+	return cmd
+}
+
+func revokeAPITokenCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <service-account-id> <id>",
+		Short: "revoke an api token",
+		Args:  cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ compass serviceaccount token revoke <service-account-id> <id>
+		`),
+		Annotations: map[string]string{
+			"action:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			clnt, cancel, err := client.Create(cmd.Context(), cfg.Client)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx := client.SetMetadata(cmd.Context(), cfg.Client)
+			if _, err := clnt.RevokeAPIToken(ctx, &compassv1beta1.RevokeAPITokenRequest{
+				ServiceAccountId: args[0],
+				Id:               args[1],
+			}); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Println("Revoked api token", term.Greenf(args[1]))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/lineageparser"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/salt/printer"
+	"github.com/goto/salt/term"
+	"github.com/spf13/cobra"
+)
+
+func usersCommand(cfg *Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "user",
+		Aliases: []string{"users"},
+		Short:   "Manage users",
+		Annotations: map[string]string{
+			"group": "core",
+		},
+		Example: heredoc.Doc(`
+			$ compass user sync ./directory.json
+			$ compass user sync ./directory.csv --reassign
+		`),
+	}
+
+	cmd.AddCommand(syncUsersCommand(cfg))
+
+	return cmd
+}
+
+func syncUsersCommand(cfg *Config) *cobra.Command {
+	var (
+		reassign bool
+		output   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync <file>",
+		Short: "sync the users with a directory export",
+		Long: heredoc.Doc(`
+			Sync the users with a directory export, a SCIM or LDAP shaped json file
+			or a csv file with a header row.
+
+			Users are upserted, the users missing from the export are marked inactive
+			and the assets left without an active owner are reported, along with the
+			closest active manager of their owners. With --reassign, the assets are
+			given to that manager.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ compass user sync ./directory.json
+			$ compass user sync ./directory.csv --reassign -o json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := runUserSync(cmd.Context(), cfg, args[0], reassign)
+			if err != nil {
+				return fmt.Errorf("sync users: %w", err)
+			}
+
+			if output == "json" {
+				fmt.Println(term.Bluef(prettyPrint(report)))
+				return nil
+			}
+
+			fmt.Println("Users synced", term.Greenf("%d", report.Upserted))
+			fmt.Println("Users deactivated", term.Yellowf("%d", len(report.Deactivated)))
+			if len(report.Orphaned) == 0 {
+				return nil
+			}
+
+			reassigned := make(map[string]struct{}, len(report.ReassignedAssetIDs))
+			for _, id := range report.ReassignedAssetIDs {
+				reassigned[id] = struct{}{}
+			}
+			rows := [][]string{{"URN", "TYPE", "SERVICE", "OWNERS", "SUGGESTED OWNER", "REASSIGNED"}}
+			for _, a := range report.Orphaned {
+				owners := make([]string, 0, len(a.Owners))
+				for _, o := range a.Owners {
+					owners = append(owners, o.Email)
+				}
+				_, ok := reassigned[a.ID]
+				rows = append(rows, []string{
+					term.Bluef(a.URN), a.Type, a.Service, strings.Join(owners, ","), a.SuggestedOwner, fmt.Sprintf("%t", ok),
+				})
+			}
+			fmt.Println(term.Redf("Assets without an active owner"))
+			printer.Table(os.Stdout, rows)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&reassign, "reassign", false, "give the assets without an active owner to the manager of their owners")
+	cmd.Flags().StringVarP(&output, "out", "o", "table", "flag to control output viewing, for json `-o json`")
+
+	return cmd
+}
+
+func runUserSync(ctx context.Context, cfg *Config, path string, reassign bool) (user.SyncReport, error) {
+	logger := initLogger(cfg.LogLevel)
+
+	pgClient, err := initPostgres(ctx, logger, cfg)
+	if err != nil {
+		return user.SyncReport{}, err
+	}
+
+	userRepository, err := postgres.NewUserRepository(pgClient)
+	if err != nil {
+		return user.SyncReport{}, fmt.Errorf("create new user repository: %w", err)
+	}
+	assetRepository, err := postgres.NewAssetRepository(
+		pgClient, userRepository, postgres.AssetRepositoryConfig{
+			DefaultUserProvider: cfg.Service.Identity.ProviderDefaultName,
+			Logger:              logger,
+			LineageParserClient: lineageparser.NewHTTPClient(cfg.Asset.ColumnLineageHost),
+		})
+	if err != nil {
+		return user.SyncReport{}, fmt.Errorf("create new asset repository: %w", err)
+	}
+
+	userService := user.NewService(logger, userRepository, user.WithOwnershipRepository(assetRepository))
+	report, err := userService.SyncDirectoryFile(ctx, path, reassign)
+	if err != nil {
+		return report, err
+	}
+	if len(report.ReassignedAssetIDs) == 0 {
+		return report, nil
+	}
+
+	// reassigned assets are reindexed for the search to show their new owners
+	discoveryRepository, err := initDiscoveryRepository(logger, cfg, pgClient)
+	if err != nil {
+		return report, err
+	}
+	wrkr, err := initAssetWorker(ctx, workermanager.Deps{
+		Config:        cfg.Worker,
+		DiscoveryRepo: discoveryRepository,
+		AssetRepo:     assetRepository,
+		Logger:        logger,
+	})
+	if err != nil {
+		return report, err
+	}
+	defer func() {
+		if err := wrkr.Close(); err != nil {
+			logger.Error("Close worker", "err", err)
+		}
+	}()
+
+	for _, id := range report.ReassignedAssetIDs {
+		if err := wrkr.EnqueueReindexAssetJob(ctx, id); err != nil {
+			logger.Error("reassigned asset not reindexed", "asset_id", id, "err", err)
+		}
+	}
+	return report, nil
+}
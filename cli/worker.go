@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
 	"github.com/goto/compass/internal/lineageparser"
-	"github.com/goto/compass/internal/store/elasticsearch"
 	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/webhook"
 	"github.com/goto/compass/internal/workermanager"
 	"github.com/goto/compass/pkg/telemetry"
 	"github.com/spf13/cobra"
@@ -64,11 +67,6 @@ func runWorker(ctx context.Context, cfg *Config) error {
 
 	defer cleanUp()
 
-	esClient, err := initElasticsearch(logger, cfg.Elasticsearch)
-	if err != nil {
-		return err
-	}
-
 	pgClient, err := initPostgres(ctx, logger, cfg)
 	if err != nil {
 		return err
@@ -84,12 +82,85 @@ func runWorker(ctx context.Context, cfg *Config) error {
 		return fmt.Errorf("create new asset repository: %w", err)
 	}
 
-	mgr, err := workermanager.New(ctx, workermanager.Deps{
-		Config: cfg.Worker,
-		DiscoveryRepo: elasticsearch.NewDiscoveryRepository(esClient, logger, cfg.Elasticsearch.RequestTimeout,
-			strings.Split(cfg.ColSearchExclusionKeywords, ",")),
+	discoveryRepository, err := initDiscoveryRepository(logger, cfg, pgClient)
+	if err != nil {
+		return err
+	}
+
+	// the types registered through the server are indexed too, the registry
+	// is loaded now and refreshed periodically
+	assetTypeRepository, err := postgres.NewAssetTypeRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new asset type repository: %w", err)
+	}
+	assetService, cancel := asset.NewService(asset.ServiceDeps{
 		AssetRepo: assetRepository,
+		TypeRepo:  assetTypeRepository,
 		Logger:    logger,
+		Config:    cfg.Asset,
+	})
+	defer cancel()
+	if err := assetService.RefreshTypes(ctx); err != nil {
+		return err
+	}
+
+	userRepository, err := postgres.NewUserRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new user repository: %w", err)
+	}
+	teamRepository, err := postgres.NewTeamRepository(pgClient, cfg.Service.Identity.ProviderDefaultName)
+	if err != nil {
+		return fmt.Errorf("create new team repository: %w", err)
+	}
+	savedSearchRepository, err := postgres.NewSavedSearchRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new saved search repository: %w", err)
+	}
+	// runs of a saved search are scheduled by the worker manager itself
+	savedSearchService := savedsearch.NewService(savedsearch.ServiceDeps{
+		Repo:     savedSearchRepository,
+		Searcher: discoveryRepository,
+		Notifier: webhook.NewClient(cfg.SavedSearch.WebhookAllowedHosts...),
+		UserRepo: userRepository,
+		TeamRepo: teamRepository,
+		Logger:   logger,
+		Config:   cfg.SavedSearch,
+	})
+
+	tagRepository, err := postgres.NewTagRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new tag repository: %w", err)
+	}
+	tagTemplateRepository, err := postgres.NewTagTemplateRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new tag template repository: %w", err)
+	}
+	bulkTagJobRepository, err := postgres.NewBulkTagJobRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new bulk tag job repository: %w", err)
+	}
+	lineageRepository, err := postgres.NewLineageRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("create new lineage repository: %w", err)
+	}
+	// users are synced from the directory export of the worker config
+	userService := user.NewService(logger, userRepository, user.WithOwnershipRepository(assetRepository))
+
+	tagService := tag.NewService(tagRepository, tag.NewTemplateService(tagTemplateRepository),
+		tag.WithReferenceRepositories(userRepository, assetRepository), tag.WithLineageRepository(lineageRepository),
+		tag.WithBulkTagJobRepository(bulkTagJobRepository))
+
+	mgr, err := workermanager.New(ctx, workermanager.Deps{
+		Config:            cfg.Worker,
+		DiscoveryRepo:     discoveryRepository,
+		AssetRepo:         assetRepository,
+		Logger:            logger,
+		SavedSearchRunner: savedSearchService,
+		TagMigrator:       tagService,
+		TagReader:         tagService,
+		BulkTagger:        tagService,
+		TagPropagator:     tagService,
+		UserSyncer:        userService,
 	})
 	if err != nil {
 		return err
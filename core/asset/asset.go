@@ -15,6 +15,7 @@ type Repository interface {
 	GetAll(context.Context, Filter) ([]Asset, error)
 	GetCount(context.Context, Filter) (int, error)
 	GetCountByQueryExpr(ctx context.Context, queryExpr queryexpr.ExprStr) (uint32, error)
+	GetIDsByQueryExpr(ctx context.Context, queryExpr queryexpr.ExprStr) ([]string, error)
 	GetCountByIsDeletedAndServicesAndUpdatedAt(ctx context.Context, isDeleted bool, services []string, thresholdTime time.Time) (uint32, error)
 	GetByID(ctx context.Context, id string) (Asset, error)
 	GetByURN(ctx context.Context, urn string) (Asset, error)
@@ -52,14 +53,21 @@ type Asset struct {
 	URL         string                 `json:"url" diff:"url"`
 	Labels      map[string]string      `json:"labels" diff:"labels"`
 	Owners      []user.User            `json:"owners,omitempty" diff:"owners"`
-	CreatedAt   time.Time              `json:"created_at" diff:"-"`
-	UpdatedAt   time.Time              `json:"updated_at" diff:"-"`
-	RefreshedAt *time.Time             `json:"refreshed_at" diff:"-"`
-	Version     string                 `json:"version" diff:"-"`
-	UpdatedBy   user.User              `json:"updated_by" diff:"-"`
-	IsDeleted   bool                   `json:"is_deleted" diff:"is_deleted"`
-	Changelog   diff.Changelog         `json:"changelog,omitempty" diff:"-"`
-	Probes      []Probe                `json:"probes,omitempty"`
+	// OwnerTeams are the names of the teams owning the asset along with
+	// its owners
+	OwnerTeams  []string       `json:"owner_teams,omitempty" diff:"owner_teams"`
+	Visibility  *Visibility    `json:"visibility,omitempty" diff:"visibility"`
+	CreatedAt   time.Time      `json:"created_at" diff:"-"`
+	UpdatedAt   time.Time      `json:"updated_at" diff:"-"`
+	RefreshedAt *time.Time     `json:"refreshed_at" diff:"-"`
+	Version     string         `json:"version" diff:"-"`
+	UpdatedBy   user.User      `json:"updated_by" diff:"-"`
+	IsDeleted   bool           `json:"is_deleted" diff:"is_deleted"`
+	Changelog   diff.Changelog `json:"changelog,omitempty" diff:"-"`
+	Probes      []Probe        `json:"probes,omitempty"`
+	// Tags are the values of the tags of the asset by template and field urn,
+	// they are only set to be indexed along with the asset
+	Tags map[string]map[string]interface{} `json:"tags,omitempty" diff:"-"`
 }
 
 type SoftDeleteAssetParams struct {
@@ -8,11 +8,24 @@ import (
 var errDeleteAssetsTimeoutIsZero = errors.New("delete assets timeout must greater than 0 second")
 
 type Config struct {
-	AdditionalTypes               []string      `mapstructure:"additional_types"`
+	AdditionalTypes []string `mapstructure:"additional_types"`
+	// TypeRefreshInterval is how often the types registered through the
+	// other replicas are picked up
+	TypeRefreshInterval           time.Duration `mapstructure:"type_refresh_interval" default:"1m"`
 	DeleteAssetsTimeout           time.Duration `mapstructure:"delete_assets_timeout" default:"5m"`
 	ExcludedChangelogPaths        []string      `mapstructure:"excluded_changelog_paths"`
 	ColumnLineageHost             string        `mapstructure:"column_lineage_host"`
 	ColumnLineageChangeIdentifier string        `mapstructure:"column_lineage_change_identifier"`
+	// SchemaChangeGuard flags the upserts removing, renaming or retyping
+	// columns having downstream column lineage
+	SchemaChangeGuard SchemaChangeGuardConfig `mapstructure:"schema_change_guard"`
+}
+
+type SchemaChangeGuardConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Strict rejects the flagged upserts instead of opening issues on the
+	// downstream assets
+	Strict bool `mapstructure:"strict"`
 }
 
 func (c *Config) Validate() error {
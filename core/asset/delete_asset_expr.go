@@ -37,7 +37,7 @@ func (d DeleteAssetExpr) Validate() error {
 		return err
 	}
 
-	return d.isAllIdentifiersExistInStruct(identifiersWithOperator)
+	return isAllIdentifiersExistInStruct(identifiersWithOperator)
 }
 
 func (DeleteAssetExpr) isRequiredIdentifiersExist(identifiersWithOperator map[string]string) error {
@@ -61,7 +61,7 @@ func (DeleteAssetExpr) isUsingRightOperator(identifiersWithOperator map[string]s
 	return nil
 }
 
-func (DeleteAssetExpr) isAllIdentifiersExistInStruct(identifiersWithOperator map[string]string) error {
+func isAllIdentifiersExistInStruct(identifiersWithOperator map[string]string) error {
 	identifiers := generichelper.GetMapKeys(identifiersWithOperator)
 	for _, identifier := range identifiers {
 		isFieldValid := generichelper.Contains(assetJSONTagsSchema, identifier)
@@ -37,6 +37,9 @@ type GroupConfig struct {
 
 	// Number of documents you want in response
 	Size int
+
+	// Viewer filters out the assets it can not see, if set
+	Viewer *Viewer
 }
 
 // SearchFilter is a filter intended to be used as a search
@@ -53,6 +56,14 @@ type SearchFlags struct {
 	DisableFuzzy bool
 
 	IsColumnSearch bool
+
+	// EnableSemantic combines keyword relevance with the similarity of the
+	// search text to the asset embeddings
+	EnableSemantic bool
+
+	// EnableExplain returns a summary of how the score of each result was
+	// computed
+	EnableExplain bool
 }
 
 // SearchConfig represents a search query along
@@ -83,6 +94,14 @@ type SearchConfig struct {
 
 	// IncludeFields specifies the fields to return in response
 	IncludeFields []string
+
+	// TypeBoosts is added to the score of the assets of the types, defaults
+	// to the search boosts of the registered types
+	TypeBoosts map[Type]float64
+
+	// Viewer filters out the assets it can not see, if set, from the results
+	// and the suggestions
+	Viewer *Viewer
 }
 
 // SearchResult represents an item/result in a list of search results
@@ -96,6 +115,28 @@ type SearchResult struct {
 	Labels      map[string]string
 	Data        map[string]interface{}
 	IsDeleted   bool
+
+	// Score is the relevance score of the result
+	Score float64
+
+	// Highlights are the matched fragments keyed by field, populated when
+	// highlighting is enabled
+	Highlights map[string][]string
+
+	// MatchedColumns are the names of the columns in data.columns whose
+	// name or description were highlighted
+	MatchedColumns []string
+
+	// Explanation is populated when explain is enabled
+	Explanation *SearchExplanation
+}
+
+// SearchExplanation summarises how the score of a search result was
+// computed, as a tree of the contributing scores.
+type SearchExplanation struct {
+	Value       float64
+	Description string
+	Details     []SearchExplanation
 }
 
 type GroupResult struct {
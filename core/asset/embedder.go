@@ -0,0 +1,46 @@
+package asset
+
+import (
+	"context"
+	"strings"
+)
+
+//go:generate mockery --name=Embedder -r --case underscore --with-expecter --structname Embedder --filename embedder_mock.go --output=./mocks
+
+// Embedder turns free text into a dense vector so that assets can be
+// matched by meaning rather than by keywords only.
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+	// Dims is the length of every vector returned by Embed.
+	Dims() int
+}
+
+// EmbeddingText returns the text of the asset that is embedded for semantic
+// search: the name, the description and the description of every column.
+func EmbeddingText(ast Asset) string {
+	parts := make([]string, 0, 2)
+	for _, s := range []string{ast.Name, ast.Description} {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+
+	columns, ok := ast.Data["columns"].([]interface{})
+	if !ok {
+		return strings.Join(parts, "\n")
+	}
+
+	for _, c := range columns {
+		col, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		desc, ok := col["description"].(string)
+		if !ok || strings.TrimSpace(desc) == "" {
+			continue
+		}
+		parts = append(parts, strings.TrimSpace(desc))
+	}
+
+	return strings.Join(parts, "\n")
+}
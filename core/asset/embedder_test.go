@@ -0,0 +1,60 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmbeddingText(t *testing.T) {
+	cases := []struct {
+		Description string
+		Asset       asset.Asset
+		Expected    string
+	}{
+		{
+			Description: "should return empty text for empty asset",
+			Asset:       asset.Asset{},
+			Expected:    "",
+		},
+		{
+			Description: "should join name and description",
+			Asset: asset.Asset{
+				Name:        "orders",
+				Description: "  all orders placed by customers ",
+			},
+			Expected: "orders\nall orders placed by customers",
+		},
+		{
+			Description: "should include column descriptions and skip columns without one",
+			Asset: asset.Asset{
+				Name: "orders",
+				Data: map[string]interface{}{
+					"columns": []interface{}{
+						map[string]interface{}{"name": "id", "description": "order identifier"},
+						map[string]interface{}{"name": "total"},
+						map[string]interface{}{"name": "customer_id", "description": "  "},
+						"malformed",
+						map[string]interface{}{"name": "created_at", "description": "time the order was placed"},
+					},
+				},
+			},
+			Expected: "orders\norder identifier\ntime the order was placed",
+		},
+		{
+			Description: "should ignore columns of unexpected type",
+			Asset: asset.Asset{
+				Name: "orders",
+				Data: map[string]interface{}{"columns": "id,total"},
+			},
+			Expected: "orders",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, asset.EmbeddingText(tc.Asset))
+		})
+	}
+}
@@ -19,6 +19,10 @@ var (
 	ErrURNExist                  = errors.New("urn asset is already exist")
 	ErrAssetAlreadyDeleted       = errors.New("asset already deleted")
 	ErrExpiryThresholdTimeIsZero = errors.New("expiry threshold time is zero")
+	ErrInvalidVisibility         = errors.New("visibility must be public, or restricted to at least one group")
+
+	errNoTypeSchemaRepository = errors.New("schemas of types are not supported")
+	errNoTypeRepository       = errors.New("type registry is not supported")
 )
 
 type NotFoundError struct {
@@ -44,6 +48,90 @@ func (err InvalidError) Error() string {
 	return fmt.Sprintf("invalid asset id: %q", err.AssetID)
 }
 
+// BreakingSchemaChangeError is returned when an upsert is rejected for
+// breaking columns read by downstream assets.
+type BreakingSchemaChangeError struct {
+	URN         string
+	Changes     []ColumnChange
+	Downstreams []string
+}
+
+func (err BreakingSchemaChangeError) Error() string {
+	return fmt.Sprintf("breaking schema change of asset %s: %s: read by %s",
+		err.URN, SchemaDiff{Changes: err.Changes}, strings.Join(err.Downstreams, ", "))
+}
+
+type TypeNotFoundError struct {
+	Type Type
+}
+
+func (err TypeNotFoundError) Error() string {
+	return fmt.Sprintf("type %q is not registered", err.Type)
+}
+
+type TypeExistsError struct {
+	Type Type
+}
+
+func (err TypeExistsError) Error() string {
+	return fmt.Sprintf("type %q is already registered", err.Type)
+}
+
+type InvalidTypeError struct {
+	Type Type
+	Err  error
+}
+
+func (err InvalidTypeError) Error() string {
+	return fmt.Sprintf("invalid type %q: %s", err.Type, err.Err)
+}
+
+func (err InvalidTypeError) Unwrap() error {
+	return err.Err
+}
+
+// TypeInUseError is returned when deleting a type still having assets.
+type TypeInUseError struct {
+	Type  Type
+	Count int
+}
+
+func (err TypeInUseError) Error() string {
+	return fmt.Sprintf("type %q is used by %d assets", err.Type, err.Count)
+}
+
+type TypeSchemaNotFoundError struct {
+	Type Type
+}
+
+func (err TypeSchemaNotFoundError) Error() string {
+	return fmt.Sprintf("no schema registered for type %q", err.Type)
+}
+
+type InvalidTypeSchemaError struct {
+	Type Type
+	Err  error
+}
+
+func (err InvalidTypeSchemaError) Error() string {
+	return fmt.Sprintf("invalid schema for type %q: %s", err.Type, err.Err)
+}
+
+func (err InvalidTypeSchemaError) Unwrap() error {
+	return err.Err
+}
+
+// DataValidationError is returned when the data of an upserted asset violates
+// the schema of its type.
+type DataValidationError struct {
+	Type   Type
+	Errors []string
+}
+
+func (err DataValidationError) Error() string {
+	return fmt.Sprintf("data violates schema of type %q: %s", err.Type, strings.Join(err.Errors, "; "))
+}
+
 type DiscoveryError struct {
 	Op     string
 	ID     string
@@ -17,6 +17,10 @@ type Filter struct {
 	Query         string
 	Data          map[string][]string
 	IsDeleted     bool
+	// OwnerTeams only keeps the assets owned by one of the teams, if set
+	OwnerTeams []string
+	// Viewer filters out the assets it can not see, if set
+	Viewer *Viewer
 }
 
 func (f *Filter) Validate() error {
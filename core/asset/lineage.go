@@ -46,6 +46,8 @@ type LineageQuery struct {
 	IncludeDeleted bool
 	AssetDetail    Asset
 	TargetColumn   string
+	// Viewer is not given the attributes of the nodes it can not see, if set
+	Viewer *Viewer
 }
 
 //go:generate mockery --name=LineageRepository -r --case underscore --with-expecter --structname=LineageRepository --filename=lineage_repository.go --output=./mocks
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
@@ -761,6 +761,65 @@ func (_c *AssetRepository_GetCountByQueryExpr_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetIDsByQueryExpr provides a mock function with given fields: ctx, queryExpr
+func (_m *AssetRepository) GetIDsByQueryExpr(ctx context.Context, queryExpr queryexpr.ExprStr) ([]string, error) {
+	ret := _m.Called(ctx, queryExpr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIDsByQueryExpr")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, queryexpr.ExprStr) ([]string, error)); ok {
+		return rf(ctx, queryExpr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, queryexpr.ExprStr) []string); ok {
+		r0 = rf(ctx, queryExpr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, queryexpr.ExprStr) error); ok {
+		r1 = rf(ctx, queryExpr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssetRepository_GetIDsByQueryExpr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIDsByQueryExpr'
+type AssetRepository_GetIDsByQueryExpr_Call struct {
+	*mock.Call
+}
+
+// GetIDsByQueryExpr is a helper method to define mock.On call
+//   - ctx context.Context
+//   - queryExpr queryexpr.ExprStr
+func (_e *AssetRepository_Expecter) GetIDsByQueryExpr(ctx interface{}, queryExpr interface{}) *AssetRepository_GetIDsByQueryExpr_Call {
+	return &AssetRepository_GetIDsByQueryExpr_Call{Call: _e.mock.On("GetIDsByQueryExpr", ctx, queryExpr)}
+}
+
+func (_c *AssetRepository_GetIDsByQueryExpr_Call) Run(run func(ctx context.Context, queryExpr queryexpr.ExprStr)) *AssetRepository_GetIDsByQueryExpr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(queryexpr.ExprStr))
+	})
+	return _c
+}
+
+func (_c *AssetRepository_GetIDsByQueryExpr_Call) Return(_a0 []string, _a1 error) *AssetRepository_GetIDsByQueryExpr_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssetRepository_GetIDsByQueryExpr_Call) RunAndReturn(run func(context.Context, queryexpr.ExprStr) ([]string, error)) *AssetRepository_GetIDsByQueryExpr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProbes provides a mock function with given fields: ctx, assetURN
 func (_m *AssetRepository) GetProbes(ctx context.Context, assetURN string) ([]asset.Probe, error) {
 	ret := _m.Called(ctx, assetURN)
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	discussion "github.com/goto/compass/core/discussion"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DiscussionService is an autogenerated mock type for the DiscussionService type
+type DiscussionService struct {
+	mock.Mock
+}
+
+type DiscussionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiscussionService) EXPECT() *DiscussionService_Expecter {
+	return &DiscussionService_Expecter{mock: &_m.Mock}
+}
+
+// CreateDiscussion provides a mock function with given fields: ctx, dsc
+func (_m *DiscussionService) CreateDiscussion(ctx context.Context, dsc *discussion.Discussion) (string, error) {
+	ret := _m.Called(ctx, dsc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDiscussion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *discussion.Discussion) (string, error)); ok {
+		return rf(ctx, dsc)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *discussion.Discussion) string); ok {
+		r0 = rf(ctx, dsc)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *discussion.Discussion) error); ok {
+		r1 = rf(ctx, dsc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiscussionService_CreateDiscussion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDiscussion'
+type DiscussionService_CreateDiscussion_Call struct {
+	*mock.Call
+}
+
+// CreateDiscussion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dsc *discussion.Discussion
+func (_e *DiscussionService_Expecter) CreateDiscussion(ctx interface{}, dsc interface{}) *DiscussionService_CreateDiscussion_Call {
+	return &DiscussionService_CreateDiscussion_Call{Call: _e.mock.On("CreateDiscussion", ctx, dsc)}
+}
+
+func (_c *DiscussionService_CreateDiscussion_Call) Run(run func(ctx context.Context, dsc *discussion.Discussion)) *DiscussionService_CreateDiscussion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*discussion.Discussion))
+	})
+	return _c
+}
+
+func (_c *DiscussionService_CreateDiscussion_Call) Return(_a0 string, _a1 error) *DiscussionService_CreateDiscussion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiscussionService_CreateDiscussion_Call) RunAndReturn(run func(context.Context, *discussion.Discussion) (string, error)) *DiscussionService_CreateDiscussion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiscussionService creates a new instance of DiscussionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiscussionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiscussionService {
+	mock := &DiscussionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
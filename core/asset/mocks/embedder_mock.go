@@ -0,0 +1,140 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Embedder is an autogenerated mock type for the Embedder type
+type Embedder struct {
+	mock.Mock
+}
+
+type Embedder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Embedder) EXPECT() *Embedder_Expecter {
+	return &Embedder_Expecter{mock: &_m.Mock}
+}
+
+// Dims provides a mock function with given fields:
+func (_m *Embedder) Dims() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dims")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Embedder_Dims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dims'
+type Embedder_Dims_Call struct {
+	*mock.Call
+}
+
+// Dims is a helper method to define mock.On call
+func (_e *Embedder_Expecter) Dims() *Embedder_Dims_Call {
+	return &Embedder_Dims_Call{Call: _e.mock.On("Dims")}
+}
+
+func (_c *Embedder_Dims_Call) Run(run func()) *Embedder_Dims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Embedder_Dims_Call) Return(_a0 int) *Embedder_Dims_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Embedder_Dims_Call) RunAndReturn(run func() int) *Embedder_Dims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Embed provides a mock function with given fields: ctx, text
+func (_m *Embedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	ret := _m.Called(ctx, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Embed")
+	}
+
+	var r0 []float32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]float32, error)); ok {
+		return rf(ctx, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []float32); ok {
+		r0 = rf(ctx, text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]float32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Embedder_Embed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Embed'
+type Embedder_Embed_Call struct {
+	*mock.Call
+}
+
+// Embed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - text string
+func (_e *Embedder_Expecter) Embed(ctx interface{}, text interface{}) *Embedder_Embed_Call {
+	return &Embedder_Embed_Call{Call: _e.mock.On("Embed", ctx, text)}
+}
+
+func (_c *Embedder_Embed_Call) Run(run func(ctx context.Context, text string)) *Embedder_Embed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Embedder_Embed_Call) Return(_a0 []float32, _a1 error) *Embedder_Embed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Embedder_Embed_Call) RunAndReturn(run func(context.Context, string) ([]float32, error)) *Embedder_Embed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEmbedder creates a new instance of Embedder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmbedder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Embedder {
+	mock := &Embedder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,294 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	asset "github.com/goto/compass/core/asset"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TypeRepository is an autogenerated mock type for the TypeRepository type
+type TypeRepository struct {
+	mock.Mock
+}
+
+type TypeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TypeRepository) EXPECT() *TypeRepository_Expecter {
+	return &TypeRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, def
+func (_m *TypeRepository) Create(ctx context.Context, def *asset.TypeDefinition) error {
+	ret := _m.Called(ctx, def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeDefinition) error); ok {
+		r0 = rf(ctx, def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypeRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TypeRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - def *asset.TypeDefinition
+func (_e *TypeRepository_Expecter) Create(ctx interface{}, def interface{}) *TypeRepository_Create_Call {
+	return &TypeRepository_Create_Call{Call: _e.mock.On("Create", ctx, def)}
+}
+
+func (_c *TypeRepository_Create_Call) Run(run func(ctx context.Context, def *asset.TypeDefinition)) *TypeRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeDefinition))
+	})
+	return _c
+}
+
+func (_c *TypeRepository_Create_Call) Return(_a0 error) *TypeRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypeRepository_Create_Call) RunAndReturn(run func(context.Context, *asset.TypeDefinition) error) *TypeRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, name
+func (_m *TypeRepository) Delete(ctx context.Context, name asset.Type) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypeRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TypeRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name asset.Type
+func (_e *TypeRepository_Expecter) Delete(ctx interface{}, name interface{}) *TypeRepository_Delete_Call {
+	return &TypeRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, name)}
+}
+
+func (_c *TypeRepository_Delete_Call) Run(run func(ctx context.Context, name asset.Type)) *TypeRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *TypeRepository_Delete_Call) Return(_a0 error) *TypeRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypeRepository_Delete_Call) RunAndReturn(run func(context.Context, asset.Type) error) *TypeRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *TypeRepository) Get(ctx context.Context, name asset.Type) (asset.TypeDefinition, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 asset.TypeDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) (asset.TypeDefinition, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) asset.TypeDefinition); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(asset.TypeDefinition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.Type) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TypeRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name asset.Type
+func (_e *TypeRepository_Expecter) Get(ctx interface{}, name interface{}) *TypeRepository_Get_Call {
+	return &TypeRepository_Get_Call{Call: _e.mock.On("Get", ctx, name)}
+}
+
+func (_c *TypeRepository_Get_Call) Run(run func(ctx context.Context, name asset.Type)) *TypeRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *TypeRepository_Get_Call) Return(_a0 asset.TypeDefinition, _a1 error) *TypeRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeRepository_Get_Call) RunAndReturn(run func(context.Context, asset.Type) (asset.TypeDefinition, error)) *TypeRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *TypeRepository) GetAll(ctx context.Context) ([]asset.TypeDefinition, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []asset.TypeDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]asset.TypeDefinition, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []asset.TypeDefinition); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]asset.TypeDefinition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TypeRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TypeRepository_Expecter) GetAll(ctx interface{}) *TypeRepository_GetAll_Call {
+	return &TypeRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *TypeRepository_GetAll_Call) Run(run func(ctx context.Context)) *TypeRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TypeRepository_GetAll_Call) Return(_a0 []asset.TypeDefinition, _a1 error) *TypeRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]asset.TypeDefinition, error)) *TypeRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, def
+func (_m *TypeRepository) Update(ctx context.Context, def *asset.TypeDefinition) error {
+	ret := _m.Called(ctx, def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeDefinition) error); ok {
+		r0 = rf(ctx, def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypeRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TypeRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - def *asset.TypeDefinition
+func (_e *TypeRepository_Expecter) Update(ctx interface{}, def interface{}) *TypeRepository_Update_Call {
+	return &TypeRepository_Update_Call{Call: _e.mock.On("Update", ctx, def)}
+}
+
+func (_c *TypeRepository_Update_Call) Run(run func(ctx context.Context, def *asset.TypeDefinition)) *TypeRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeDefinition))
+	})
+	return _c
+}
+
+func (_c *TypeRepository_Update_Call) Return(_a0 error) *TypeRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypeRepository_Update_Call) RunAndReturn(run func(context.Context, *asset.TypeDefinition) error) *TypeRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTypeRepository creates a new instance of TypeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTypeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TypeRepository {
+	mock := &TypeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
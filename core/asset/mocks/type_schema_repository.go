@@ -0,0 +1,189 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	asset "github.com/goto/compass/core/asset"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TypeSchemaRepository is an autogenerated mock type for the TypeSchemaRepository type
+type TypeSchemaRepository struct {
+	mock.Mock
+}
+
+type TypeSchemaRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TypeSchemaRepository) EXPECT() *TypeSchemaRepository_Expecter {
+	return &TypeSchemaRepository_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, typ
+func (_m *TypeSchemaRepository) Delete(ctx context.Context, typ asset.Type) error {
+	ret := _m.Called(ctx, typ)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) error); ok {
+		r0 = rf(ctx, typ)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypeSchemaRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TypeSchemaRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - typ asset.Type
+func (_e *TypeSchemaRepository_Expecter) Delete(ctx interface{}, typ interface{}) *TypeSchemaRepository_Delete_Call {
+	return &TypeSchemaRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, typ)}
+}
+
+func (_c *TypeSchemaRepository_Delete_Call) Run(run func(ctx context.Context, typ asset.Type)) *TypeSchemaRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Delete_Call) Return(_a0 error) *TypeSchemaRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Delete_Call) RunAndReturn(run func(context.Context, asset.Type) error) *TypeSchemaRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, typ
+func (_m *TypeSchemaRepository) Get(ctx context.Context, typ asset.Type) (asset.TypeSchema, error) {
+	ret := _m.Called(ctx, typ)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 asset.TypeSchema
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) (asset.TypeSchema, error)); ok {
+		return rf(ctx, typ)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) asset.TypeSchema); ok {
+		r0 = rf(ctx, typ)
+	} else {
+		r0 = ret.Get(0).(asset.TypeSchema)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.Type) error); ok {
+		r1 = rf(ctx, typ)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TypeSchemaRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TypeSchemaRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - typ asset.Type
+func (_e *TypeSchemaRepository_Expecter) Get(ctx interface{}, typ interface{}) *TypeSchemaRepository_Get_Call {
+	return &TypeSchemaRepository_Get_Call{Call: _e.mock.On("Get", ctx, typ)}
+}
+
+func (_c *TypeSchemaRepository_Get_Call) Run(run func(ctx context.Context, typ asset.Type)) *TypeSchemaRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Get_Call) Return(_a0 asset.TypeSchema, _a1 error) *TypeSchemaRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Get_Call) RunAndReturn(run func(context.Context, asset.Type) (asset.TypeSchema, error)) *TypeSchemaRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, ts
+func (_m *TypeSchemaRepository) Upsert(ctx context.Context, ts *asset.TypeSchema) error {
+	ret := _m.Called(ctx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeSchema) error); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TypeSchemaRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type TypeSchemaRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ts *asset.TypeSchema
+func (_e *TypeSchemaRepository_Expecter) Upsert(ctx interface{}, ts interface{}) *TypeSchemaRepository_Upsert_Call {
+	return &TypeSchemaRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, ts)}
+}
+
+func (_c *TypeSchemaRepository_Upsert_Call) Run(run func(ctx context.Context, ts *asset.TypeSchema)) *TypeSchemaRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeSchema))
+	})
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Upsert_Call) Return(_a0 error) *TypeSchemaRepository_Upsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TypeSchemaRepository_Upsert_Call) RunAndReturn(run func(context.Context, *asset.TypeSchema) error) *TypeSchemaRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTypeSchemaRepository creates a new instance of TypeSchemaRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTypeSchemaRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TypeSchemaRepository {
+	mock := &TypeSchemaRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -23,6 +23,14 @@ func patchAsset(a *Asset, patchData map[string]interface{}) {
 	if exists {
 		a.Owners = buildOwners(owners)
 	}
+	ownerTeams, exists := patchData["owner_teams"]
+	if exists {
+		a.OwnerTeams = buildStrings(ownerTeams)
+	}
+	visibility, exists := patchData["visibility"]
+	if exists {
+		a.Visibility = buildVisibility(visibility)
+	}
 	data, exists := patchData["data"]
 	if exists {
 		patchAssetData(a, data)
@@ -50,6 +58,56 @@ func buildLabels(data interface{}) map[string]string {
 	return labels
 }
 
+// buildVisibility builds visibility from interface{}, nil being public
+func buildVisibility(data interface{}) *Visibility {
+	var vis Visibility
+	switch d := data.(type) {
+	case map[string]interface{}:
+		vis.Kind = VisibilityKind(getString("kind", d))
+		switch groups := d["groups"].(type) {
+		case []interface{}:
+			for _, g := range groups {
+				if s, ok := g.(string); ok {
+					vis.Groups = append(vis.Groups, s)
+				}
+			}
+		case []string:
+			vis.Groups = groups
+		}
+	case *Visibility:
+		if d == nil {
+			return nil
+		}
+		vis = *d
+	case Visibility:
+		vis = d
+	default:
+		return nil
+	}
+
+	if !vis.IsRestricted() {
+		return nil
+	}
+	return &vis
+}
+
+// buildStrings builds a list of strings from interface{}
+func buildStrings(data interface{}) []string {
+	switch d := data.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(d))
+		for _, v := range d {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	case []string:
+		return d
+	}
+	return nil
+}
+
 // buildOwners builds owners from interface{}
 func buildOwners(data interface{}) []user.User {
 	buildOwner := func(data map[string]interface{}) user.User {
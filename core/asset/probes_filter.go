@@ -7,4 +7,6 @@ type ProbesFilter struct {
 	MaxRows   int
 	NewerThan time.Time
 	OlderThan time.Time
+	// Viewer filters out the probes of the assets it can not see, if set
+	Viewer *Viewer
 }
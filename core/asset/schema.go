@@ -0,0 +1,178 @@
+package asset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColumnChangeType is the type of change of a column of an asset.
+type ColumnChangeType string
+
+const (
+	ColumnAdded       ColumnChangeType = "added"
+	ColumnRemoved     ColumnChangeType = "removed"
+	ColumnRenamed     ColumnChangeType = "renamed"
+	ColumnTypeChanged ColumnChangeType = "type_changed"
+)
+
+// IsBreaking reports whether the change can break the readers of the
+// column, which is the case for all of them but adding a column.
+func (t ColumnChangeType) IsBreaking() bool {
+	return t != ColumnAdded
+}
+
+// ColumnChange is a change of a column in data.columns of an asset. The data
+// type is the one of the column after the change, the old data type is set
+// when it changed or the column was removed.
+type ColumnChange struct {
+	Type        ColumnChangeType `json:"type"`
+	Column      string           `json:"column"`
+	OldColumn   string           `json:"old_column,omitempty"`
+	DataType    string           `json:"data_type,omitempty"`
+	OldDataType string           `json:"old_data_type,omitempty"`
+}
+
+func (c ColumnChange) String() string {
+	switch c.Type {
+	case ColumnRenamed:
+		return fmt.Sprintf("column %q renamed to %q", c.OldColumn, c.Column)
+	case ColumnTypeChanged:
+		return fmt.Sprintf("column %q changed from %q to %q", c.Column, c.OldDataType, c.DataType)
+	default:
+		return fmt.Sprintf("column %q %s", c.Column, c.Type)
+	}
+}
+
+// SchemaDiff is the difference between the columns of two versions of an
+// asset.
+type SchemaDiff struct {
+	URN         string         `json:"urn"`
+	FromVersion string         `json:"from_version"`
+	ToVersion   string         `json:"to_version"`
+	Changes     []ColumnChange `json:"changes"`
+}
+
+// BreakingChanges returns the changes which can break the readers of the
+// columns.
+func (d SchemaDiff) BreakingChanges() []ColumnChange {
+	var changes []ColumnChange
+	for _, c := range d.Changes {
+		if c.Type.IsBreaking() {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+func (d SchemaDiff) String() string {
+	changes := make([]string, len(d.Changes))
+	for i, c := range d.Changes {
+		changes[i] = c.String()
+	}
+	return strings.Join(changes, ", ")
+}
+
+// DiffSchema returns the difference between the columns of the two versions
+// of the asset, read by name and data_type from data.columns. Columns are
+// matched by name, a column removed and another one added at the same
+// position with the same data type are reported as renamed.
+func DiffSchema(from, to Asset) SchemaDiff {
+	diff := SchemaDiff{
+		URN:         to.URN,
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+	}
+
+	oldColumns, newColumns := schemaColumns(from.Data), schemaColumns(to.Data)
+	oldByName := make(map[string]schemaColumn, len(oldColumns))
+	for _, c := range oldColumns {
+		oldByName[c.name] = c
+	}
+	newByName := make(map[string]schemaColumn, len(newColumns))
+	for _, c := range newColumns {
+		newByName[c.name] = c
+	}
+
+	removedAt := make(map[int]schemaColumn)
+	for _, c := range oldColumns {
+		if _, ok := newByName[c.name]; !ok {
+			removedAt[c.position] = c
+		}
+	}
+
+	renamed := make(map[string]bool)
+	for _, c := range newColumns {
+		old, ok := oldByName[c.name]
+		if ok {
+			if old.dataType != c.dataType {
+				diff.Changes = append(diff.Changes, ColumnChange{
+					Type:        ColumnTypeChanged,
+					Column:      c.name,
+					DataType:    c.dataType,
+					OldDataType: old.dataType,
+				})
+			}
+			continue
+		}
+
+		if removed, ok := removedAt[c.position]; ok && removed.dataType == c.dataType {
+			renamed[removed.name] = true
+			diff.Changes = append(diff.Changes, ColumnChange{
+				Type:      ColumnRenamed,
+				Column:    c.name,
+				OldColumn: removed.name,
+				DataType:  c.dataType,
+			})
+			continue
+		}
+
+		diff.Changes = append(diff.Changes, ColumnChange{
+			Type:     ColumnAdded,
+			Column:   c.name,
+			DataType: c.dataType,
+		})
+	}
+
+	for _, c := range oldColumns {
+		if _, ok := newByName[c.name]; ok || renamed[c.name] {
+			continue
+		}
+		diff.Changes = append(diff.Changes, ColumnChange{
+			Type:        ColumnRemoved,
+			Column:      c.name,
+			OldDataType: c.dataType,
+		})
+	}
+
+	return diff
+}
+
+// HasSchema reports whether the data has columns.
+func HasSchema(data map[string]interface{}) bool {
+	_, ok := data["columns"].([]interface{})
+	return ok
+}
+
+type schemaColumn struct {
+	name     string
+	dataType string
+	position int
+}
+
+func schemaColumns(data map[string]interface{}) []schemaColumn {
+	columns, _ := data["columns"].([]interface{})
+	schema := make([]schemaColumn, 0, len(columns))
+	for i, c := range columns {
+		column, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := column["name"].(string)
+		if name == "" {
+			continue
+		}
+		dataType, _ := column["data_type"].(string)
+		schema = append(schema, schemaColumn{name: name, dataType: dataType, position: i})
+	}
+	return schema
+}
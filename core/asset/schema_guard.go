@@ -0,0 +1,166 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goto/compass/core/discussion"
+	"github.com/goto/compass/core/user"
+)
+
+//go:generate mockery --name=DiscussionService -r --case underscore --with-expecter --structname DiscussionService --filename discussion_service.go --output=./mocks
+
+// DiscussionService opens the issues on the downstream assets of breaking
+// schema changes.
+type DiscussionService interface {
+	CreateDiscussion(ctx context.Context, dsc *discussion.Discussion) (string, error)
+}
+
+const breakingSchemaChangeLabel = "breaking-schema-change"
+
+// downstreamImpact is a downstream asset reading the columns broken by a
+// schema change, through column lineage.
+type downstreamImpact struct {
+	URN     string
+	Columns []string
+	Changes []ColumnChange
+}
+
+// guardSchemaChange returns the downstream assets reading the columns the
+// upsert of the asset removes, renames or retypes. The upsert is rejected
+// instead under strict mode.
+func (s *Service) guardSchemaChange(ctx context.Context, previous, ast *Asset) ([]downstreamImpact, error) {
+	guard := s.config.SchemaChangeGuard
+	if !guard.Enabled || previous == nil {
+		return nil, nil
+	}
+
+	breaking := DiffSchema(*previous, *ast).BreakingChanges()
+	if len(breaking) == 0 {
+		return nil, nil
+	}
+
+	impacts, err := s.downstreamImpacts(ctx, ast.URN, breaking)
+	if err != nil {
+		if guard.Strict {
+			return nil, fmt.Errorf("guard schema change: %w", err)
+		}
+		s.logger.Warn("failed to get downstreams of breaking schema change", "urn", ast.URN, "err", err)
+		return nil, nil
+	}
+	if len(impacts) == 0 || !guard.Strict {
+		return impacts, nil
+	}
+
+	var changes []ColumnChange
+	downstreams := make([]string, len(impacts))
+	for i, impact := range impacts {
+		downstreams[i] = impact.URN
+		for _, c := range impact.Changes {
+			if !slices.Contains(changes, c) {
+				changes = append(changes, c)
+			}
+		}
+	}
+	return nil, BreakingSchemaChangeError{
+		URN:         ast.URN,
+		Changes:     changes,
+		Downstreams: downstreams,
+	}
+}
+
+// downstreamImpacts returns the assets directly downstream of the columns
+// of the changes, in the order they are found.
+func (s *Service) downstreamImpacts(ctx context.Context, urn string, changes []ColumnChange) ([]downstreamImpact, error) {
+	var impacts []downstreamImpact
+	indexes := make(map[string]int)
+	for _, c := range changes {
+		column := c.Column
+		if c.Type == ColumnRenamed {
+			column = c.OldColumn
+		}
+
+		graph, err := s.lineageRepository.GetColumnGraph(ctx, urn, LineageQuery{
+			Level:        1,
+			Direction:    LineageDirectionDownstream,
+			TargetColumn: column,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get downstreams of column %q: %w", column, err)
+		}
+
+		for _, edge := range graph {
+			if edge.Source != urn || edge.Target == urn {
+				continue
+			}
+			i, ok := indexes[edge.Target]
+			if !ok {
+				i = len(impacts)
+				indexes[edge.Target] = i
+				impacts = append(impacts, downstreamImpact{URN: edge.Target})
+			}
+			if !slices.Contains(impacts[i].Changes, c) {
+				impacts[i].Changes = append(impacts[i].Changes, c)
+			}
+			if edge.TargetColumn != "" && !slices.Contains(impacts[i].Columns, edge.TargetColumn) {
+				impacts[i].Columns = append(impacts[i].Columns, edge.TargetColumn)
+			}
+		}
+	}
+	return impacts, nil
+}
+
+// openDownstreamIssues opens an issue on each of the downstream assets,
+// assigned to their owners. The upsert is done already, so failures are only
+// logged.
+func (s *Service) openDownstreamIssues(ctx context.Context, upserted Asset, impacts []downstreamImpact) {
+	if s.discussionService == nil {
+		return
+	}
+
+	for _, impact := range impacts {
+		downstream, err := s.assetRepository.GetByURN(ctx, impact.URN)
+		if err != nil {
+			s.logger.Warn("failed to get downstream asset of breaking schema change",
+				"urn", upserted.URN, "downstream", impact.URN, "err", err)
+			continue
+		}
+
+		var assignees []string
+		for _, o := range downstream.Owners {
+			if o.ID != "" && len(assignees) < discussion.MAX_ARRAY_FIELD_NUM {
+				assignees = append(assignees, o.ID)
+			}
+		}
+
+		dsc := discussion.Discussion{
+			Title:     fmt.Sprintf("Breaking schema change in upstream %s", upserted.URN),
+			Body:      downstreamIssueBody(upserted, impact),
+			Type:      discussion.TypeIssues,
+			State:     discussion.StateOpen,
+			Labels:    []string{breakingSchemaChangeLabel},
+			Assets:    []string{downstream.ID},
+			Assignees: assignees,
+			Owner:     user.User{ID: upserted.UpdatedBy.ID},
+		}
+		if _, err := s.discussionService.CreateDiscussion(ctx, &dsc); err != nil {
+			s.logger.Warn("failed to open issue of breaking schema change",
+				"urn", upserted.URN, "downstream", impact.URN, "err", err)
+		}
+	}
+}
+
+func downstreamIssueBody(upserted Asset, impact downstreamImpact) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "Version %s of %s makes breaking changes to the columns read by %s", upserted.Version, upserted.URN, impact.URN)
+	if len(impact.Columns) > 0 {
+		fmt.Fprintf(&body, " into %s", strings.Join(impact.Columns, ", "))
+	}
+	body.WriteString(":\n")
+	for _, c := range impact.Changes {
+		fmt.Fprintf(&body, "- %s\n", c)
+	}
+	return body.String()
+}
@@ -0,0 +1,270 @@
+package asset_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/compass/core/discussion"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_UpsertAssetWithSchemaChangeGuard(t *testing.T) {
+	columns := func(dataType string) map[string]interface{} {
+		return map[string]interface{}{
+			"columns": []interface{}{
+				map[string]interface{}{"name": "id", "data_type": "STRING"},
+				map[string]interface{}{"name": "amount", "data_type": dataType},
+			},
+		}
+	}
+	storedAsset := asset.Asset{ID: "some-id", URN: "some-urn", Type: asset.Type("table"), Service: "bigquery", Data: columns("NUMERIC")}
+	newAsset := func() *asset.Asset {
+		return &asset.Asset{
+			URN: "some-urn", Type: asset.Type("table"), Service: "bigquery", Data: columns("STRING"),
+			UpdatedBy: user.User{ID: "updater-id"},
+		}
+	}
+	retyped := asset.ColumnChange{Type: asset.ColumnTypeChanged, Column: "amount", DataType: "STRING", OldDataType: "NUMERIC"}
+	columnDownstreams := func(lr *mocks.LineageRepository, graph asset.LineageGraph, err error) {
+		lr.EXPECT().GetColumnGraph(mock.Anything, "some-urn", asset.LineageQuery{
+			Level:        1,
+			Direction:    asset.LineageDirectionDownstream,
+			TargetColumn: "amount",
+		}).Return(graph, err)
+	}
+
+	testCases := []struct {
+		Description string
+		Strict      bool
+		Data        map[string]interface{}
+		StoredErr   error
+		Err         error
+		Setup       func(*mocks.AssetRepository, *mocks.DiscoveryRepository, *mocks.LineageRepository, *mocks.DiscussionService)
+	}{
+		{
+			Description: `should upsert without opening issues if the changed columns have no downstreams`,
+			Setup: func(ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository, lr *mocks.LineageRepository, _ *mocks.DiscussionService) {
+				columnDownstreams(lr, nil, nil)
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&storedAsset, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+		{
+			Description: `should open an issue on the downstream assets assigned to their owners`,
+			Setup: func(ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository, lr *mocks.LineageRepository, ds *mocks.DiscussionService) {
+				columnDownstreams(lr, asset.LineageGraph{
+					{Source: "some-urn", SourceColumn: "amount", Target: "downstream-urn", TargetColumn: "total"},
+				}, nil)
+				upserted := *newAsset()
+				upserted.ID, upserted.Version = "some-id", "0.2"
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&upserted, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+				ar.EXPECT().GetByURN(mock.Anything, "downstream-urn").Return(asset.Asset{
+					ID:     "downstream-id",
+					URN:    "downstream-urn",
+					Owners: []user.User{{ID: "owner-id", Email: "owner@example.com"}},
+				}, nil)
+				ds.EXPECT().CreateDiscussion(mock.Anything, &discussion.Discussion{
+					Title:     "Breaking schema change in upstream some-urn",
+					Body:      "Version 0.2 of some-urn makes breaking changes to the columns read by downstream-urn into total:\n- column \"amount\" changed from \"NUMERIC\" to \"STRING\"\n",
+					Type:      discussion.TypeIssues,
+					State:     discussion.StateOpen,
+					Labels:    []string{"breaking-schema-change"},
+					Assets:    []string{"downstream-id"},
+					Assignees: []string{"owner-id"},
+					Owner:     user.User{ID: "updater-id"},
+				}).Return("discussion-id", nil)
+			},
+		},
+		{
+			Description: `should reject the upsert under strict mode if the changed columns have downstreams`,
+			Strict:      true,
+			Setup: func(_ *mocks.AssetRepository, _ *mocks.DiscoveryRepository, lr *mocks.LineageRepository, _ *mocks.DiscussionService) {
+				columnDownstreams(lr, asset.LineageGraph{
+					{Source: "some-urn", SourceColumn: "amount", Target: "downstream-urn", TargetColumn: "total"},
+				}, nil)
+			},
+			Err: asset.BreakingSchemaChangeError{
+				URN:         "some-urn",
+				Changes:     []asset.ColumnChange{retyped},
+				Downstreams: []string{"downstream-urn"},
+			},
+		},
+		{
+			Description: `should reject the upsert under strict mode if the downstreams can not be read`,
+			Strict:      true,
+			Setup: func(_ *mocks.AssetRepository, _ *mocks.DiscoveryRepository, lr *mocks.LineageRepository, _ *mocks.DiscussionService) {
+				columnDownstreams(lr, nil, errors.New("unknown error"))
+			},
+			Err: errors.New(`guard schema change: get downstreams of column "amount": unknown error`),
+		},
+		{
+			Description: `should reject the upsert under strict mode if the upserted asset has no columns`,
+			Strict:      true,
+			Data:        map[string]interface{}{},
+			Setup: func(_ *mocks.AssetRepository, _ *mocks.DiscoveryRepository, lr *mocks.LineageRepository, _ *mocks.DiscussionService) {
+				lr.EXPECT().GetColumnGraph(mock.Anything, "some-urn", asset.LineageQuery{
+					Level:        1,
+					Direction:    asset.LineageDirectionDownstream,
+					TargetColumn: "id",
+				}).Return(nil, nil)
+				lr.EXPECT().GetColumnGraph(mock.Anything, "some-urn", asset.LineageQuery{
+					Level:        1,
+					Direction:    asset.LineageDirectionDownstream,
+					TargetColumn: "amount",
+				}).Return(asset.LineageGraph{
+					{Source: "some-urn", SourceColumn: "amount", Target: "downstream-urn", TargetColumn: "total"},
+				}, nil)
+			},
+			Err: asset.BreakingSchemaChangeError{
+				URN:         "some-urn",
+				Changes:     []asset.ColumnChange{{Type: asset.ColumnRemoved, Column: "amount", OldDataType: "NUMERIC"}},
+				Downstreams: []string{"downstream-urn"},
+			},
+		},
+		{
+			Description: `should reject the upsert under strict mode if the stored asset can not be read`,
+			Strict:      true,
+			StoredErr:   errors.New("unknown error"),
+			Setup: func(*mocks.AssetRepository, *mocks.DiscoveryRepository, *mocks.LineageRepository, *mocks.DiscussionService) {
+			},
+			Err: errors.New(`guard schema change: get asset: unknown error`),
+		},
+		{
+			Description: `should upsert without comparing the schema if the stored asset can not be read`,
+			StoredErr:   errors.New("unknown error"),
+			Setup: func(ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository, _ *mocks.LineageRepository, _ *mocks.DiscussionService) {
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&storedAsset, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := context.Background()
+
+			assetRepo := mocks.NewAssetRepository(t)
+			discoveryRepo := mocks.NewDiscoveryRepository(t)
+			lineageRepo := mocks.NewLineageRepository(t)
+			discussionSvc := mocks.NewDiscussionService(t)
+			assetRepo.EXPECT().GetByURN(ctx, "some-urn").Return(storedAsset, tc.StoredErr)
+			tc.Setup(assetRepo, discoveryRepo, lineageRepo, discussionSvc)
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{
+				AssetRepo:     assetRepo,
+				DiscoveryRepo: discoveryRepo,
+				LineageRepo:   lineageRepo,
+				DiscussionSvc: discussionSvc,
+				Worker:        workermanager.NewInSituWorker(workermanager.Deps{DiscoveryRepo: discoveryRepo}),
+				Logger:        log.NewNoop(),
+				Config: asset.Config{
+					SchemaChangeGuard: asset.SchemaChangeGuardConfig{Enabled: true, Strict: tc.Strict},
+				},
+			})
+			defer cancel()
+
+			ast := newAsset()
+			if tc.Data != nil {
+				ast.Data = tc.Data
+			}
+			_, err := svc.UpsertAssetWithoutLineage(ctx, ast, false)
+			if tc.Err != nil {
+				assert.EqualError(t, err, tc.Err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_UpsertPatchAssetWithSchemaChangeGuard(t *testing.T) {
+	storedAsset := asset.Asset{
+		ID: "some-id", URN: "some-urn", Type: asset.Type("table"), Service: "bigquery",
+		Data: map[string]interface{}{
+			"columns": []interface{}{
+				map[string]interface{}{"name": "id", "data_type": "STRING"},
+				map[string]interface{}{"name": "amount", "data_type": "NUMERIC"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Description string
+		PatchData   map[string]interface{}
+		Err         error
+		Setup       func(*mocks.AssetRepository, *mocks.DiscoveryRepository, *mocks.LineageRepository)
+	}{
+		{
+			Description: `should upsert if the patch does not change the columns`,
+			PatchData:   map[string]interface{}{"description": "some description"},
+			Setup: func(ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository, _ *mocks.LineageRepository) {
+				ar.EXPECT().UpsertPatch(mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(&storedAsset, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+		{
+			Description: `should reject the upsert if the patch retypes a column having downstreams`,
+			PatchData: map[string]interface{}{
+				"data": map[string]interface{}{
+					"columns": []interface{}{
+						map[string]interface{}{"name": "id", "data_type": "STRING"},
+						map[string]interface{}{"name": "amount", "data_type": "STRING"},
+					},
+				},
+			},
+			Setup: func(_ *mocks.AssetRepository, _ *mocks.DiscoveryRepository, lr *mocks.LineageRepository) {
+				lr.EXPECT().GetColumnGraph(mock.Anything, "some-urn", asset.LineageQuery{
+					Level:        1,
+					Direction:    asset.LineageDirectionDownstream,
+					TargetColumn: "amount",
+				}).Return(asset.LineageGraph{
+					{Source: "some-urn", SourceColumn: "amount", Target: "downstream-urn", TargetColumn: "total"},
+				}, nil)
+			},
+			Err: asset.BreakingSchemaChangeError{
+				URN:         "some-urn",
+				Changes:     []asset.ColumnChange{{Type: asset.ColumnTypeChanged, Column: "amount", DataType: "STRING", OldDataType: "NUMERIC"}},
+				Downstreams: []string{"downstream-urn"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := context.Background()
+
+			assetRepo := mocks.NewAssetRepository(t)
+			discoveryRepo := mocks.NewDiscoveryRepository(t)
+			lineageRepo := mocks.NewLineageRepository(t)
+			assetRepo.EXPECT().GetByURN(ctx, "some-urn").Return(storedAsset, nil)
+			tc.Setup(assetRepo, discoveryRepo, lineageRepo)
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{
+				AssetRepo:     assetRepo,
+				DiscoveryRepo: discoveryRepo,
+				LineageRepo:   lineageRepo,
+				Worker:        workermanager.NewInSituWorker(workermanager.Deps{DiscoveryRepo: discoveryRepo}),
+				Logger:        log.NewNoop(),
+				Config: asset.Config{
+					SchemaChangeGuard: asset.SchemaChangeGuardConfig{Enabled: true, Strict: true},
+				},
+			})
+			defer cancel()
+
+			ast := &asset.Asset{URN: "some-urn", Type: asset.Type("table"), Service: "bigquery"}
+			_, err := svc.UpsertPatchAssetWithoutLineage(ctx, ast, tc.PatchData, false)
+			assert.Equal(t, "NUMERIC", storedAsset.Data["columns"].([]interface{})[1].(map[string]interface{})["data_type"])
+			if tc.Err != nil {
+				assert.EqualError(t, err, tc.Err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
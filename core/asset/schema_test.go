@@ -0,0 +1,81 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSchema(t *testing.T) {
+	columns := func(cols ...[2]string) map[string]interface{} {
+		var list []interface{}
+		for _, c := range cols {
+			list = append(list, map[string]interface{}{"name": c[0], "data_type": c[1]})
+		}
+		return map[string]interface{}{"columns": list}
+	}
+
+	cases := []struct {
+		name     string
+		from, to map[string]interface{}
+		expected []ColumnChange
+	}{
+		{
+			name: "same columns",
+			from: columns([2]string{"id", "INT64"}, [2]string{"email", "STRING"}),
+			to:   columns([2]string{"id", "INT64"}, [2]string{"email", "STRING"}),
+		},
+		{
+			name: "added, removed and retyped columns",
+			from: columns([2]string{"id", "INT64"}, [2]string{"email", "STRING"}, [2]string{"age", "INT64"}),
+			to:   columns([2]string{"id", "STRING"}, [2]string{"email", "STRING"}, [2]string{"created_at", "TIMESTAMP"}),
+			expected: []ColumnChange{
+				{Type: ColumnTypeChanged, Column: "id", DataType: "STRING", OldDataType: "INT64"},
+				{Type: ColumnAdded, Column: "created_at", DataType: "TIMESTAMP"},
+				{Type: ColumnRemoved, Column: "age", OldDataType: "INT64"},
+			},
+		},
+		{
+			name: "column replaced at the same position with the same data type is renamed",
+			from: columns([2]string{"id", "INT64"}, [2]string{"mail", "STRING"}),
+			to:   columns([2]string{"id", "INT64"}, [2]string{"email", "STRING"}),
+			expected: []ColumnChange{
+				{Type: ColumnRenamed, Column: "email", OldColumn: "mail", DataType: "STRING"},
+			},
+		},
+		{
+			name: "all columns removed",
+			from: columns([2]string{"id", "INT64"}),
+			to:   map[string]interface{}{},
+			expected: []ColumnChange{
+				{Type: ColumnRemoved, Column: "id", OldDataType: "INT64"},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff := DiffSchema(
+				Asset{URN: "some-urn", Version: "0.1", Data: tc.from},
+				Asset{URN: "some-urn", Version: "0.2", Data: tc.to},
+			)
+			assert.Equal(t, SchemaDiff{
+				URN:         "some-urn",
+				FromVersion: "0.1",
+				ToVersion:   "0.2",
+				Changes:     tc.expected,
+			}, diff)
+		})
+	}
+}
+
+func TestSchemaDiffBreakingChanges(t *testing.T) {
+	diff := SchemaDiff{Changes: []ColumnChange{
+		{Type: ColumnAdded, Column: "created_at"},
+		{Type: ColumnRemoved, Column: "age"},
+		{Type: ColumnRenamed, Column: "email", OldColumn: "mail"},
+	}}
+
+	breaking := diff.BreakingChanges()
+	assert.Equal(t, diff.Changes[1:], breaking)
+	assert.Equal(t, `column "age" removed, column "mail" renamed to "email"`, SchemaDiff{Changes: breaking}.String())
+}
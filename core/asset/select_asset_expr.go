@@ -0,0 +1,24 @@
+package asset
+
+import (
+	"github.com/goto/compass/pkg/queryexpr"
+)
+
+// SelectAssetExpr is a query expression selecting assets on any of their
+// fields
+type SelectAssetExpr struct {
+	queryexpr.ExprStr
+}
+
+func (s SelectAssetExpr) ToQuery() (string, error) {
+	return s.ExprStr.ToQuery()
+}
+
+func (s SelectAssetExpr) Validate() error {
+	identifiersWithOperator, err := queryexpr.GetIdentifiersMap(s.ExprStr.String())
+	if err != nil {
+		return err
+	}
+
+	return isAllIdentifiersExistInStruct(identifiersWithOperator)
+}
@@ -0,0 +1,42 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/pkg/queryexpr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectAssetExpr_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{
+			name: "any identifier of asset",
+			expr: `service == "bigquery" && name in ["orders", "payments"]`,
+		},
+		{
+			name:    "identifier not part of asset",
+			expr:    `service == "bigquery" && owner == "john"`,
+			wantErr: "owner is not a valid identifier",
+		},
+		{
+			name:    "invalid expression",
+			expr:    "findLast(",
+			wantErr: "error parsing expression",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := asset.SelectAssetExpr{ExprStr: queryexpr.SQLExpr(tt.expr)}.Validate()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"time"
@@ -17,17 +18,22 @@ import (
 )
 
 type Service struct {
-	assetRepository     Repository
-	discoveryRepository DiscoveryRepository
-	lineageRepository   LineageRepository
-	worker              Worker
-	logger              log.Logger
-	config              Config
-	cancelFnMap         *sync.Map
-	assetOpCounter      metric.Int64Counter
-	shutdownCtx         context.Context
-	shutdownCancel      context.CancelFunc
-	goroutineWg         sync.WaitGroup
+	assetRepository      Repository
+	discoveryRepository  DiscoveryRepository
+	lineageRepository    LineageRepository
+	discussionService    DiscussionService
+	typeSchemaRepository TypeSchemaRepository
+	typeRepository       TypeRepository
+	worker               Worker
+	logger               log.Logger
+	config               Config
+	cancelFnMap          *sync.Map
+	assetOpCounter       metric.Int64Counter
+	schemaChangeCounter  metric.Int64Counter
+	typeSchemas          sync.Map
+	shutdownCtx          context.Context
+	shutdownCancel       context.CancelFunc
+	goroutineWg          sync.WaitGroup
 }
 
 //go:generate mockery --name=Worker -r --case underscore --with-expecter --structname Worker --filename worker_mock.go --output=./mocks
@@ -44,12 +50,15 @@ type Worker interface {
 }
 
 type ServiceDeps struct {
-	AssetRepo     Repository
-	DiscoveryRepo DiscoveryRepository
-	LineageRepo   LineageRepository
-	Worker        Worker
-	Logger        log.Logger
-	Config        Config
+	AssetRepo      Repository
+	DiscoveryRepo  DiscoveryRepository
+	LineageRepo    LineageRepository
+	DiscussionSvc  DiscussionService
+	TypeSchemaRepo TypeSchemaRepository
+	TypeRepo       TypeRepository
+	Worker         Worker
+	Logger         log.Logger
+	Config         Config
 }
 
 func NewService(deps ServiceDeps) (service *Service, cancel func()) {
@@ -58,23 +67,36 @@ func NewService(deps ServiceDeps) (service *Service, cancel func()) {
 	if err != nil {
 		otel.Handle(err)
 	}
+	schemaChangeCounter, err := otel.Meter("github.com/goto/compass/core/asset").
+		Int64Counter("compass.asset.breaking_schema_change")
+	if err != nil {
+		otel.Handle(err)
+	}
 
 	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
 	newService := &Service{
-		assetRepository:     deps.AssetRepo,
-		discoveryRepository: deps.DiscoveryRepo,
-		lineageRepository:   deps.LineageRepo,
-		worker:              deps.Worker,
-		logger:              deps.Logger,
-		config:              deps.Config,
-		cancelFnMap:         new(sync.Map),
-		assetOpCounter:      assetOpCounter,
-		shutdownCtx:         shutdownCtx,
-		shutdownCancel:      shutdownCancel,
+		assetRepository:      deps.AssetRepo,
+		discoveryRepository:  deps.DiscoveryRepo,
+		lineageRepository:    deps.LineageRepo,
+		discussionService:    deps.DiscussionSvc,
+		typeSchemaRepository: deps.TypeSchemaRepo,
+		typeRepository:       deps.TypeRepo,
+		worker:               deps.Worker,
+		logger:               deps.Logger,
+		config:               deps.Config,
+		cancelFnMap:          new(sync.Map),
+		assetOpCounter:       assetOpCounter,
+		schemaChangeCounter:  schemaChangeCounter,
+		shutdownCtx:          shutdownCtx,
+		shutdownCancel:       shutdownCancel,
 	}
 	if newService.config.ExcludedChangelogPaths == nil {
 		newService.config.ExcludedChangelogPaths = []string{}
 	}
+	if newService.typeRepository != nil && newService.config.TypeRefreshInterval > 0 {
+		newService.goroutineWg.Add(1)
+		go newService.refreshTypesPeriodically(newService.config.TypeRefreshInterval)
+	}
 
 	return newService, func() {
 		shutdownCancel()
@@ -122,6 +144,17 @@ func (s *Service) UpsertAssetWithoutLineage(ctx context.Context, ast *Asset, isU
 	currentTime := time.Now()
 	ast.RefreshedAt = &currentTime
 
+	if err := s.validateAssetData(ctx, *ast); err != nil {
+		return "", err
+	}
+	previous, err := s.previousSchema(ctx, ast)
+	if err != nil {
+		return "", err
+	}
+	impacts, err := s.guardSchemaChange(ctx, previous, ast)
+	if err != nil {
+		return "", err
+	}
 	upsertedAsset, columnLineageProducer, err := s.assetRepository.Upsert(ctx, ast, isUpdateOnly, s.config)
 	if errors.Is(err, ErrURNExist) {
 		upsertedAsset, columnLineageProducer, err = s.assetRepository.Upsert(ctx, ast, isUpdateOnly, s.config)
@@ -130,6 +163,8 @@ func (s *Service) UpsertAssetWithoutLineage(ctx context.Context, ast *Asset, isU
 	if err != nil {
 		return "", err
 	}
+	s.emitSchemaChange(ctx, previous, *upsertedAsset)
+	s.openDownstreamIssues(ctx, *upsertedAsset, impacts)
 
 	if err := s.worker.EnqueueIndexAssetJob(ctx, *upsertedAsset); err != nil {
 		return "", err
@@ -165,6 +200,17 @@ func (s *Service) UpsertPatchAssetWithoutLineage(ctx context.Context, ast *Asset
 	currentTime := time.Now()
 	ast.RefreshedAt = &currentTime
 
+	if err := s.validatePatchedAssetData(ctx, *ast, patchData); err != nil {
+		return "", err
+	}
+	previous, err := s.previousSchema(ctx, ast)
+	if err != nil {
+		return "", err
+	}
+	impacts, err := s.guardSchemaChange(ctx, previous, patchedSchema(previous, patchData))
+	if err != nil {
+		return "", err
+	}
 	upsertedAsset, columnLineageProducer, err := s.assetRepository.UpsertPatch(ctx, ast, patchData, isUpdateOnly, s.config)
 	if errors.Is(err, ErrURNExist) {
 		upsertedAsset, columnLineageProducer, err = s.assetRepository.UpsertPatch(ctx, ast, patchData, isUpdateOnly, s.config)
@@ -173,6 +219,8 @@ func (s *Service) UpsertPatchAssetWithoutLineage(ctx context.Context, ast *Asset
 	if err != nil {
 		return "", err
 	}
+	s.emitSchemaChange(ctx, previous, *upsertedAsset)
+	s.openDownstreamIssues(ctx, *upsertedAsset, impacts)
 
 	if err := s.worker.EnqueueIndexAssetJob(ctx, *upsertedAsset); err != nil {
 		return "", err
@@ -425,6 +473,101 @@ func (s *Service) GetAssetByVersion(ctx context.Context, id, version string) (as
 	return s.assetRepository.GetByVersionWithURN(ctx, id, version)
 }
 
+// GetSchemaDiff returns the difference between the columns of the two
+// versions of the asset.
+func (s *Service) GetSchemaDiff(ctx context.Context, urn, fromVersion, toVersion string) (diff SchemaDiff, err error) {
+	defer func() {
+		s.instrumentAssetOp(ctx, "GetSchemaDiff", urn, err)
+	}()
+
+	from, err := s.assetRepository.GetByVersionWithURN(ctx, urn, fromVersion)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	to, err := s.assetRepository.GetByVersionWithURN(ctx, urn, toVersion)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+
+	return DiffSchema(from, to), nil
+}
+
+// previousSchema returns the stored asset being upserted if it has columns,
+// for its schema to be compared to the upserted one. Under the schema change
+// guard it is compared even if the upserted one has no columns, as they are
+// all removed then. Failing to get it skips the comparison, unless the guard
+// is strict.
+func (s *Service) previousSchema(ctx context.Context, ast *Asset) (*Asset, error) {
+	guard := s.config.SchemaChangeGuard
+	if !guard.Enabled && !HasSchema(ast.Data) {
+		return nil, nil
+	}
+
+	previous, err := s.assetRepository.GetByURN(ctx, ast.URN)
+	if errors.As(err, new(NotFoundError)) {
+		return nil, nil
+	}
+	if err != nil {
+		if guard.Enabled && guard.Strict {
+			return nil, fmt.Errorf("guard schema change: get asset: %w", err)
+		}
+		s.logger.Warn("failed to get asset to compare its schema", "urn", ast.URN, "err", err)
+		return nil, nil
+	}
+
+	if !HasSchema(previous.Data) {
+		return nil, nil
+	}
+	return &previous, nil
+}
+
+// patchedSchema returns the columns of the stored asset once patched, as the
+// patch only carries the columns it changes. The stored asset is left as is.
+func patchedSchema(previous *Asset, patchData map[string]interface{}) *Asset {
+	if previous == nil {
+		return nil
+	}
+
+	columns, _ := previous.Data["columns"].([]interface{})
+	cloned := make([]interface{}, len(columns))
+	for i, c := range columns {
+		if column, ok := c.(map[string]interface{}); ok {
+			c = maps.Clone(column)
+		}
+		cloned[i] = c
+	}
+
+	patched := *previous
+	patched.Data = map[string]interface{}{"columns": cloned}
+	patched.Patch(patchData)
+	return &patched
+}
+
+// emitSchemaChange reports the breaking changes of the schema of the upserted
+// asset, if any.
+func (s *Service) emitSchemaChange(ctx context.Context, previous *Asset, upserted Asset) {
+	if previous == nil {
+		return
+	}
+
+	diff := DiffSchema(*previous, upserted)
+	breaking := diff.BreakingChanges()
+	if len(breaking) == 0 {
+		return
+	}
+
+	s.logger.Warn("breaking schema change",
+		"urn", diff.URN,
+		"from_version", diff.FromVersion,
+		"to_version", diff.ToVersion,
+		"changes", SchemaDiff{Changes: breaking}.String(),
+	)
+	s.schemaChangeCounter.Add(ctx, int64(len(breaking)), metric.WithAttributes(
+		attribute.String("asset.type", upserted.Type.String()),
+		attribute.String("asset.service", upserted.Service),
+	))
+}
+
 func (s *Service) GetAssetVersionHistory(ctx context.Context, flt Filter, id string) ([]Asset, error) {
 	return s.assetRepository.GetVersionHistory(ctx, flt, id, s.config.ExcludedChangelogPaths)
 }
@@ -454,6 +597,7 @@ func (s *Service) GetLineage(ctx context.Context, urn string, query LineageQuery
 	assetProbes, err := s.assetRepository.GetProbesWithFilter(ctx, ProbesFilter{
 		AssetURNs: urns.list(),
 		MaxRows:   1,
+		Viewer:    query.Viewer,
 	})
 	if err != nil {
 		return Lineage{}, fmt.Errorf("get lineage: get latest probes: %w", err)
@@ -486,6 +630,7 @@ func (s *Service) GetColumnLineage(ctx context.Context, urn string, query Lineag
 	assetProbes, err := s.assetRepository.GetProbesWithFilter(ctx, ProbesFilter{
 		AssetURNs: urns.list(),
 		MaxRows:   1,
+		Viewer:    query.Viewer,
 	})
 	if err != nil {
 		return Lineage{}, fmt.Errorf("get lineage: get latest probes: %w", err)
@@ -506,6 +651,9 @@ func (s *Service) GetTypes(ctx context.Context, flt Filter) (map[Type]int, error
 }
 
 func (s *Service) SearchAssets(ctx context.Context, cfg SearchConfig) (results []SearchResult, err error) {
+	if cfg.TypeBoosts == nil {
+		cfg.TypeBoosts = TypeSearchBoosts()
+	}
 	return s.discoveryRepository.Search(ctx, cfg)
 }
 
@@ -272,6 +272,12 @@ func TestService_UpsertAsset(t *testing.T) {
 
 func TestService_UpsertAssetWithoutLineage(t *testing.T) {
 	sampleAsset := &asset.Asset{ID: "some-id", URN: "some-urn", Type: asset.Type("dashboard"), Service: "some-service"}
+	tableAsset := &asset.Asset{
+		ID: "some-id", URN: "some-urn", Type: asset.Type("table"), Service: "some-service",
+		Data: map[string]interface{}{
+			"columns": []interface{}{map[string]interface{}{"name": "id", "data_type": "STRING"}},
+		},
+	}
 	sampleEdges := asset.LineageGraph{
 		{Source: "upstream-urn", SourceColumn: "upstream-col", Target: sampleAsset.URN, TargetColumn: "target-col"},
 	}
@@ -354,6 +360,21 @@ func TestService_UpsertAssetWithoutLineage(t *testing.T) {
 			},
 			ReturnedID: sampleAsset.ID,
 		},
+		{
+			Description: `should compare the schema of the stored asset if the upserted asset has columns`,
+			Asset:       tableAsset,
+			Setup: func(ctx context.Context, ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository, _ *mocks.LineageRepository, _ chan struct{}) {
+				ar.EXPECT().GetByURN(ctx, tableAsset.URN).Return(asset.Asset{
+					ID: "some-id", URN: "some-urn", Type: asset.Type("table"), Service: "some-service",
+					Data: map[string]interface{}{
+						"columns": []interface{}{map[string]interface{}{"name": "id", "data_type": "INT64"}},
+					},
+				}, nil)
+				ar.EXPECT().Upsert(ctx, tableAsset, false, asset.Config{ExcludedChangelogPaths: []string{}}).Return(tableAsset, nil, nil)
+				dr.EXPECT().Upsert(ctx, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+			ReturnedID: tableAsset.ID,
+		},
 		{
 			Description: `should log warning when upsert column lineage returns error`,
 			Asset:       sampleAsset,
@@ -1467,6 +1488,72 @@ func TestService_GetAssetByVersion(t *testing.T) {
 	}
 }
 
+func TestService_GetSchemaDiff(t *testing.T) {
+	urn := "my-test-urn"
+	columns := func(dataType string) map[string]interface{} {
+		return map[string]interface{}{
+			"columns": []interface{}{map[string]interface{}{"name": "id", "data_type": dataType}},
+		}
+	}
+	type testCase struct {
+		Description string
+		Expected    asset.SchemaDiff
+		ExpectedErr error
+		Setup       func(context.Context, *mocks.AssetRepository)
+	}
+
+	testCases := []testCase{
+		{
+			Description: `should return error if a version is not found`,
+			Setup: func(ctx context.Context, ar *mocks.AssetRepository) {
+				ar.EXPECT().GetByVersionWithURN(ctx, urn, "0.1").Return(asset.Asset{URN: urn, Version: "0.1"}, nil)
+				ar.EXPECT().GetByVersionWithURN(ctx, urn, "0.2").Return(asset.Asset{}, asset.NotFoundError{URN: urn})
+			},
+			ExpectedErr: asset.NotFoundError{URN: urn},
+		},
+		{
+			Description: `should return the changes of the columns between the versions`,
+			Setup: func(ctx context.Context, ar *mocks.AssetRepository) {
+				ar.EXPECT().GetByVersionWithURN(ctx, urn, "0.1").Return(asset.Asset{URN: urn, Version: "0.1", Data: columns("INT64")}, nil)
+				ar.EXPECT().GetByVersionWithURN(ctx, urn, "0.2").Return(asset.Asset{URN: urn, Version: "0.2", Data: columns("STRING")}, nil)
+			},
+			Expected: asset.SchemaDiff{
+				URN:         urn,
+				FromVersion: "0.1",
+				ToVersion:   "0.2",
+				Changes: []asset.ColumnChange{
+					{Type: asset.ColumnTypeChanged, Column: "id", DataType: "STRING", OldDataType: "INT64"},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockAssetRepo := mocks.NewAssetRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAssetRepo)
+			}
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{
+				AssetRepo:     mockAssetRepo,
+				DiscoveryRepo: mocks.NewDiscoveryRepository(t),
+				LineageRepo:   mocks.NewLineageRepository(t),
+			})
+			defer cancel()
+
+			diff, err := svc.GetSchemaDiff(ctx, urn, "0.1", "0.2")
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, diff)
+		})
+	}
+}
+
 func TestService_GetAssetVersionHistory(t *testing.T) {
 	assetID := "some-id"
 	type testCase struct {
@@ -3,6 +3,7 @@ package asset
 import (
 	"errors"
 	"regexp"
+	"sync"
 )
 
 var (
@@ -30,6 +31,8 @@ const (
 	typeExperiment   Type = "experiment"
 )
 
+var typesMu sync.RWMutex
+
 var supportedTypeMap = map[Type]bool{
 	typeTable:        true,
 	typeJob:          true,
@@ -43,14 +46,72 @@ var supportedTypeMap = map[Type]bool{
 	typeExperiment:   true,
 }
 
+// registeredTypeMap is the cache of the types registered in the type
+// registry, refreshed by the asset service.
+var registeredTypeMap = map[Type]TypeDefinition{}
+
 func GetSupportedTypes() []Type {
-	output := make([]Type, 0, len(supportedTypeMap))
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	output := make([]Type, 0, len(supportedTypeMap)+len(registeredTypeMap))
 	for _type := range supportedTypeMap {
 		output = append(output, _type)
 	}
+	for _type := range registeredTypeMap {
+		if !supportedTypeMap[_type] {
+			output = append(output, _type)
+		}
+	}
 	return output
 }
 
+// GetTypeDefinition returns the definition of the type from the type
+// registry, if the type is registered.
+func GetTypeDefinition(t Type) (TypeDefinition, bool) {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	def, ok := registeredTypeMap[t]
+	return def, ok
+}
+
+// TypeSearchBoosts returns the default search boosts of the registered
+// types having one, nil if none has.
+func TypeSearchBoosts() map[Type]float64 {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	var boosts map[Type]float64
+	for t, def := range registeredTypeMap {
+		if def.SearchBoost != 0 {
+			if boosts == nil {
+				boosts = make(map[Type]float64)
+			}
+			boosts[t] = def.SearchBoost
+		}
+	}
+	return boosts
+}
+
+func isBuiltinType(t Type) bool {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	return supportedTypeMap[t]
+}
+
+func setRegisteredTypes(defs []TypeDefinition) {
+	registered := make(map[Type]TypeDefinition, len(defs))
+	for _, def := range defs {
+		registered[def.Name] = def
+	}
+
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	registeredTypeMap = registered
+}
+
 func RegisterSupportedTypes(types ...Type) error {
 	for _, t := range types {
 		if err := t.validate(); err != nil {
@@ -58,6 +119,8 @@ func RegisterSupportedTypes(types ...Type) error {
 		}
 	}
 
+	typesMu.Lock()
+	defer typesMu.Unlock()
 	for _, t := range types {
 		if supported := supportedTypeMap[t]; !supported {
 			supportedTypeMap[t] = true
@@ -77,7 +140,14 @@ func (t Type) String() string {
 
 // IsValid will validate whether the typename is valid or not
 func (t Type) IsValid() bool {
-	return supportedTypeMap[t]
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	if supportedTypeMap[t] {
+		return true
+	}
+	_, ok := registeredTypeMap[t]
+	return ok
 }
 
 func (t Type) validate() error {
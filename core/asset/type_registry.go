@@ -0,0 +1,189 @@
+package asset
+
+//go:generate mockery --name=TypeRepository -r --case underscore --with-expecter --structname TypeRepository --filename type_repository.go --output=./mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type TypeRepository interface {
+	GetAll(ctx context.Context) ([]TypeDefinition, error)
+	Get(ctx context.Context, name Type) (TypeDefinition, error)
+	Create(ctx context.Context, def *TypeDefinition) error
+	Update(ctx context.Context, def *TypeDefinition) error
+	Delete(ctx context.Context, name Type) error
+}
+
+// TypeDefinition is a type registered in the type registry. Registering a
+// built-in type only describes it.
+type TypeDefinition struct {
+	Name        Type   `json:"name"`
+	DisplayName string `json:"display_name"`
+	Icon        string `json:"icon"`
+	Description string `json:"description"`
+	// RequiredFields are the paths of the fields of data, separated by dots,
+	// the assets of the type must have
+	RequiredFields []string `json:"required_fields"`
+	// SearchBoost is added to the search score of the assets of the type
+	SearchBoost float64   `json:"search_boost"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (d TypeDefinition) validate() error {
+	if err := d.Name.validate(); err != nil {
+		return InvalidTypeError{Type: d.Name, Err: err}
+	}
+	for _, f := range d.RequiredFields {
+		if strings.TrimSpace(f) == "" {
+			return InvalidTypeError{Type: d.Name, Err: errors.New("required field is empty")}
+		}
+	}
+	if d.SearchBoost < 0 {
+		return InvalidTypeError{Type: d.Name, Err: errors.New("search boost must not be negative")}
+	}
+	return nil
+}
+
+func (s *Service) GetTypeDefinitions(ctx context.Context) ([]TypeDefinition, error) {
+	if s.typeRepository == nil {
+		return nil, nil
+	}
+	return s.typeRepository.GetAll(ctx)
+}
+
+func (s *Service) GetTypeDefinition(ctx context.Context, name Type) (TypeDefinition, error) {
+	if s.typeRepository == nil {
+		return TypeDefinition{}, TypeNotFoundError{Type: name}
+	}
+	return s.typeRepository.Get(ctx, name)
+}
+
+// CreateType registers the type, it is then supported by all the replicas
+// once their cache of the registry is refreshed.
+func (s *Service) CreateType(ctx context.Context, def *TypeDefinition) error {
+	if s.typeRepository == nil {
+		return errNoTypeRepository
+	}
+	if err := def.validate(); err != nil {
+		return err
+	}
+
+	if err := s.typeRepository.Create(ctx, def); err != nil {
+		return err
+	}
+	return s.RefreshTypes(ctx)
+}
+
+func (s *Service) UpdateType(ctx context.Context, def *TypeDefinition) error {
+	if s.typeRepository == nil {
+		return TypeNotFoundError{Type: def.Name}
+	}
+	if err := def.validate(); err != nil {
+		return err
+	}
+
+	if err := s.typeRepository.Update(ctx, def); err != nil {
+		return err
+	}
+	return s.RefreshTypes(ctx)
+}
+
+// DeleteType removes the type from the registry. A type still having assets
+// can not be deleted, unless it is built-in.
+func (s *Service) DeleteType(ctx context.Context, name Type) error {
+	if s.typeRepository == nil {
+		return TypeNotFoundError{Type: name}
+	}
+
+	if !isBuiltinType(name) {
+		count, err := s.assetRepository.GetCount(ctx, Filter{Types: []Type{name}})
+		if err != nil {
+			return fmt.Errorf("count assets of type %s: %w", name, err)
+		}
+		if count > 0 {
+			return TypeInUseError{Type: name, Count: count}
+		}
+	}
+
+	if err := s.typeRepository.Delete(ctx, name); err != nil {
+		return err
+	}
+	return s.RefreshTypes(ctx)
+}
+
+// RefreshTypes reloads the cache of the type registry used by Type.IsValid.
+func (s *Service) RefreshTypes(ctx context.Context) error {
+	if s.typeRepository == nil {
+		return nil
+	}
+
+	defs, err := s.typeRepository.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh types: %w", err)
+	}
+	setRegisteredTypes(defs)
+	return nil
+}
+
+// refreshTypesPeriodically picks up the types registered through the other
+// replicas, until the service is shut down.
+func (s *Service) refreshTypesPeriodically(interval time.Duration) {
+	defer s.goroutineWg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownCtx.Done():
+			return
+		case <-ticker.C:
+			if err := s.RefreshTypes(s.shutdownCtx); err != nil {
+				s.logger.Warn("failed to refresh types", "err", err)
+			}
+		}
+	}
+}
+
+// validateRequiredFields checks the data of the asset has the fields
+// required by the registered type.
+func validateRequiredFields(ast Asset) error {
+	def, ok := GetTypeDefinition(ast.Type)
+	if !ok {
+		return nil
+	}
+
+	var errs []string
+	for _, f := range def.RequiredFields {
+		if !hasDataField(ast.Data, f) {
+			errs = append(errs, fmt.Sprintf("%s is required", f))
+		}
+	}
+	if len(errs) > 0 {
+		return DataValidationError{Type: ast.Type, Errors: errs}
+	}
+	return nil
+}
+
+func hasRequiredFields(t Type) bool {
+	def, ok := GetTypeDefinition(t)
+	return ok && len(def.RequiredFields) > 0
+}
+
+func hasDataField(data map[string]interface{}, path string) bool {
+	var v interface{} = data
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if v, ok = m[key]; !ok {
+			return false
+		}
+	}
+	return v != nil
+}
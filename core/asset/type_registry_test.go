@@ -0,0 +1,238 @@
+package asset_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// registerTypes fills the cache of the type registry with the types, emptied
+// once the test is done.
+func registerTypes(t *testing.T, defs ...asset.TypeDefinition) {
+	t.Helper()
+
+	typeRepo := mocks.NewTypeRepository(t)
+	typeRepo.EXPECT().GetAll(mock.Anything).Return(defs, nil).Once()
+	typeRepo.EXPECT().GetAll(mock.Anything).Return(nil, nil).Once()
+	svc, cancel := asset.NewService(asset.ServiceDeps{TypeRepo: typeRepo, Logger: log.NewNoop()})
+	t.Cleanup(func() {
+		defer cancel()
+		assert.NoError(t, svc.RefreshTypes(context.Background()))
+	})
+
+	require.NoError(t, svc.RefreshTypes(context.Background()))
+}
+
+func TestService_CreateType(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Definition  asset.TypeDefinition
+		Setup       func(*mocks.TypeRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: `should return error if name is invalid`,
+			Definition:  asset.TypeDefinition{Name: "Pipeline"},
+			ExpectedErr: asset.InvalidTypeError{Type: "Pipeline", Err: errors.New("type must be combination of alphanumeric and underscores")},
+		},
+		{
+			Description: `should return error if a required field is empty`,
+			Definition:  asset.TypeDefinition{Name: "pipeline", RequiredFields: []string{" "}},
+			ExpectedErr: asset.InvalidTypeError{Type: "pipeline", Err: errors.New("required field is empty")},
+		},
+		{
+			Description: `should return error if search boost is negative`,
+			Definition:  asset.TypeDefinition{Name: "pipeline", SearchBoost: -1},
+			ExpectedErr: asset.InvalidTypeError{Type: "pipeline", Err: errors.New("search boost must not be negative")},
+		},
+		{
+			Description: `should return error if type is already registered`,
+			Definition:  asset.TypeDefinition{Name: "pipeline"},
+			Setup: func(tr *mocks.TypeRepository) {
+				tr.EXPECT().Create(mock.Anything, &asset.TypeDefinition{Name: "pipeline"}).Return(asset.TypeExistsError{Type: "pipeline"})
+			},
+			ExpectedErr: asset.TypeExistsError{Type: "pipeline"},
+		},
+		{
+			Description: `should return error if registry can not be refreshed`,
+			Definition:  asset.TypeDefinition{Name: "pipeline"},
+			Setup: func(tr *mocks.TypeRepository) {
+				tr.EXPECT().Create(mock.Anything, &asset.TypeDefinition{Name: "pipeline"}).Return(nil)
+				tr.EXPECT().GetAll(mock.Anything).Return(nil, errors.New("unknown error"))
+			},
+			ExpectedErr: errors.New("refresh types: unknown error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			typeRepo := mocks.NewTypeRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(typeRepo)
+			}
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{TypeRepo: typeRepo, Logger: log.NewNoop()})
+			defer cancel()
+
+			err := svc.CreateType(context.Background(), &tc.Definition)
+			assert.EqualError(t, err, tc.ExpectedErr.Error())
+		})
+	}
+
+	t.Run("should support the type once created", func(t *testing.T) {
+		def := asset.TypeDefinition{Name: "pipeline", DisplayName: "Pipeline"}
+		typeRepo := mocks.NewTypeRepository(t)
+		typeRepo.EXPECT().Create(mock.Anything, &def).Return(nil)
+		typeRepo.EXPECT().GetAll(mock.Anything).Return([]asset.TypeDefinition{def}, nil).Once()
+		typeRepo.EXPECT().GetAll(mock.Anything).Return(nil, nil).Once()
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{TypeRepo: typeRepo, Logger: log.NewNoop()})
+		defer cancel()
+		defer func() {
+			assert.NoError(t, svc.RefreshTypes(context.Background()))
+			assert.False(t, asset.Type("pipeline").IsValid())
+		}()
+
+		assert.False(t, asset.Type("pipeline").IsValid())
+		require.NoError(t, svc.CreateType(context.Background(), &def))
+
+		assert.True(t, asset.Type("pipeline").IsValid())
+		assert.Contains(t, asset.GetSupportedTypes(), asset.Type("pipeline"))
+		actual, ok := asset.GetTypeDefinition("pipeline")
+		assert.True(t, ok)
+		assert.Equal(t, def, actual)
+	})
+}
+
+func TestService_DeleteType(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Type        asset.Type
+		Setup       func(*mocks.TypeRepository, *mocks.AssetRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: `should return error if type still has assets`,
+			Type:        "pipeline",
+			Setup: func(_ *mocks.TypeRepository, ar *mocks.AssetRepository) {
+				ar.EXPECT().GetCount(mock.Anything, asset.Filter{Types: []asset.Type{"pipeline"}}).Return(3, nil)
+			},
+			ExpectedErr: asset.TypeInUseError{Type: "pipeline", Count: 3},
+		},
+		{
+			Description: `should return error if type is not registered`,
+			Type:        "pipeline",
+			Setup: func(tr *mocks.TypeRepository, ar *mocks.AssetRepository) {
+				ar.EXPECT().GetCount(mock.Anything, asset.Filter{Types: []asset.Type{"pipeline"}}).Return(0, nil)
+				tr.EXPECT().Delete(mock.Anything, asset.Type("pipeline")).Return(asset.TypeNotFoundError{Type: "pipeline"})
+			},
+			ExpectedErr: asset.TypeNotFoundError{Type: "pipeline"},
+		},
+		{
+			Description: `should delete type without assets`,
+			Type:        "pipeline",
+			Setup: func(tr *mocks.TypeRepository, ar *mocks.AssetRepository) {
+				ar.EXPECT().GetCount(mock.Anything, asset.Filter{Types: []asset.Type{"pipeline"}}).Return(0, nil)
+				tr.EXPECT().Delete(mock.Anything, asset.Type("pipeline")).Return(nil)
+				tr.EXPECT().GetAll(mock.Anything).Return(nil, nil)
+			},
+		},
+		{
+			Description: `should delete definition of built-in type having assets`,
+			Type:        "table",
+			Setup: func(tr *mocks.TypeRepository, _ *mocks.AssetRepository) {
+				tr.EXPECT().Delete(mock.Anything, asset.Type("table")).Return(nil)
+				tr.EXPECT().GetAll(mock.Anything).Return(nil, nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			typeRepo := mocks.NewTypeRepository(t)
+			assetRepo := mocks.NewAssetRepository(t)
+			tc.Setup(typeRepo, assetRepo)
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{AssetRepo: assetRepo, TypeRepo: typeRepo, Logger: log.NewNoop()})
+			defer cancel()
+
+			err := svc.DeleteType(context.Background(), tc.Type)
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_UpsertAssetWithRequiredFields(t *testing.T) {
+	registerTypes(t, asset.TypeDefinition{
+		Name:           "pipeline",
+		RequiredFields: []string{"owner_team", "schedule.interval"},
+	})
+
+	t.Run("should reject asset missing required fields", func(t *testing.T) {
+		svc, cancel := asset.NewService(asset.ServiceDeps{Logger: log.NewNoop()})
+		defer cancel()
+
+		_, err := svc.UpsertAssetWithoutLineage(context.Background(), &asset.Asset{
+			URN:     "some-urn",
+			Type:    "pipeline",
+			Service: "airflow",
+			Data:    map[string]interface{}{"schedule": map[string]interface{}{"cron": "@daily"}},
+		}, false)
+		assert.EqualError(t, err, `data violates schema of type "pipeline": owner_team is required; schedule.interval is required`)
+	})
+
+	t.Run("should upsert asset having required fields", func(t *testing.T) {
+		assetRepo := mocks.NewAssetRepository(t)
+		worker := mocks.NewWorker(t)
+		assetRepo.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&asset.Asset{ID: "some-id"}, nil, nil)
+		worker.EXPECT().EnqueueIndexAssetJob(mock.Anything, mock.Anything).Return(nil)
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{
+			AssetRepo: assetRepo,
+			Worker:    worker,
+			Logger:    log.NewNoop(),
+		})
+		defer cancel()
+
+		ast := &asset.Asset{
+			URN:     "some-urn",
+			Type:    "pipeline",
+			Service: "airflow",
+			Data: map[string]interface{}{
+				"owner_team": "data-platform",
+				"schedule":   map[string]interface{}{"interval": "@daily"},
+			},
+		}
+		id, err := svc.UpsertAssetWithoutLineage(context.Background(), ast, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "some-id", id)
+	})
+}
+
+func TestService_SearchAssetsWithTypeBoosts(t *testing.T) {
+	registerTypes(t,
+		asset.TypeDefinition{Name: "pipeline", SearchBoost: 2},
+		asset.TypeDefinition{Name: "table"},
+	)
+
+	discoveryRepo := mocks.NewDiscoveryRepository(t)
+	discoveryRepo.EXPECT().Search(mock.Anything, asset.SearchConfig{
+		Text:       "orders",
+		TypeBoosts: map[asset.Type]float64{"pipeline": 2},
+	}).Return(nil, nil)
+
+	svc, cancel := asset.NewService(asset.ServiceDeps{DiscoveryRepo: discoveryRepo, Logger: log.NewNoop()})
+	defer cancel()
+
+	_, err := svc.SearchAssets(context.Background(), asset.SearchConfig{Text: "orders"})
+	assert.NoError(t, err)
+}
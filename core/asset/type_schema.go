@@ -0,0 +1,262 @@
+package asset
+
+//go:generate mockery --name=TypeSchemaRepository -r --case underscore --with-expecter --structname TypeSchemaRepository --filename type_schema_repository.go --output=./mocks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// maxSchemaViolations is the maximum number of assets violating a schema
+// reported by a validation of the existing assets.
+const maxSchemaViolations = 100
+
+const validateAssetsPageSize = 1000
+
+type TypeSchemaRepository interface {
+	Upsert(ctx context.Context, ts *TypeSchema) error
+	Get(ctx context.Context, typ Type) (TypeSchema, error)
+	Delete(ctx context.Context, typ Type) error
+}
+
+// SchemaMode is how the schema of a type is enforced on the upserted assets.
+type SchemaMode string
+
+const (
+	// SchemaModeEnforce rejects the assets violating the schema.
+	SchemaModeEnforce SchemaMode = "enforce"
+	// SchemaModeWarn only logs the assets violating the schema.
+	SchemaModeWarn SchemaMode = "warn"
+)
+
+func (m SchemaMode) IsValid() bool {
+	return m == SchemaModeEnforce || m == SchemaModeWarn
+}
+
+// TypeSchema is the JSON Schema the data of the assets of a type is
+// validated against.
+type TypeSchema struct {
+	Type      Type            `json:"type"`
+	Schema    json.RawMessage `json:"schema"`
+	Mode      SchemaMode      `json:"mode"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// SchemaViolation is an asset whose data violates a schema.
+type SchemaViolation struct {
+	URN    string   `json:"urn"`
+	Errors []string `json:"errors"`
+}
+
+// SchemaValidationReport is the result of the validation of the existing
+// assets of a type against a schema. Only the first violations are listed.
+type SchemaValidationReport struct {
+	Checked    int               `json:"checked"`
+	Invalid    int               `json:"invalid"`
+	Violations []SchemaViolation `json:"violations"`
+}
+
+type compiledTypeSchema struct {
+	updatedAt time.Time
+	schema    *gojsonschema.Schema
+}
+
+// UpsertTypeSchema registers the schema of the type, replacing the existing
+// one. The schema is enforced unless the mode is warn.
+func (s *Service) UpsertTypeSchema(ctx context.Context, ts *TypeSchema) error {
+	if s.typeSchemaRepository == nil {
+		return errNoTypeSchemaRepository
+	}
+	if !ts.Type.IsValid() {
+		return ErrUnknownType
+	}
+	if ts.Mode == "" {
+		ts.Mode = SchemaModeEnforce
+	}
+	if !ts.Mode.IsValid() {
+		return InvalidTypeSchemaError{Type: ts.Type, Err: fmt.Errorf("unknown mode %q", ts.Mode)}
+	}
+	if _, err := compileSchema(ts.Type, ts.Schema); err != nil {
+		return err
+	}
+
+	if err := s.typeSchemaRepository.Upsert(ctx, ts); err != nil {
+		return err
+	}
+	s.typeSchemas.Delete(ts.Type)
+	return nil
+}
+
+func (s *Service) GetTypeSchema(ctx context.Context, typ Type) (TypeSchema, error) {
+	if s.typeSchemaRepository == nil {
+		return TypeSchema{}, TypeSchemaNotFoundError{Type: typ}
+	}
+	return s.typeSchemaRepository.Get(ctx, typ)
+}
+
+func (s *Service) DeleteTypeSchema(ctx context.Context, typ Type) error {
+	if s.typeSchemaRepository == nil {
+		return TypeSchemaNotFoundError{Type: typ}
+	}
+	if err := s.typeSchemaRepository.Delete(ctx, typ); err != nil {
+		return err
+	}
+	s.typeSchemas.Delete(typ)
+	return nil
+}
+
+// ValidateAssetsAgainstSchema validates the data of the existing assets of
+// the type against the schema, for the schema to be checked before it is
+// registered.
+func (s *Service) ValidateAssetsAgainstSchema(ctx context.Context, ts TypeSchema) (SchemaValidationReport, error) {
+	typ := ts.Type
+	if !typ.IsValid() {
+		return SchemaValidationReport{}, ErrUnknownType
+	}
+	compiled, err := compileSchema(typ, ts.Schema)
+	if err != nil {
+		return SchemaValidationReport{}, err
+	}
+
+	var report SchemaValidationReport
+	for offset := 0; ; offset += validateAssetsPageSize {
+		assets, err := s.assetRepository.GetAll(ctx, Filter{
+			Types:  []Type{typ},
+			Size:   validateAssetsPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return SchemaValidationReport{}, fmt.Errorf("get assets of type %s: %w", typ, err)
+		}
+
+		for _, ast := range assets {
+			report.Checked++
+			errs, err := validateData(compiled, ast.Data)
+			if err != nil {
+				return SchemaValidationReport{}, fmt.Errorf("validate asset %s: %w", ast.URN, err)
+			}
+			if len(errs) == 0 {
+				continue
+			}
+			report.Invalid++
+			if len(report.Violations) < maxSchemaViolations {
+				report.Violations = append(report.Violations, SchemaViolation{URN: ast.URN, Errors: errs})
+			}
+		}
+		if len(assets) < validateAssetsPageSize {
+			return report, nil
+		}
+	}
+}
+
+// validateAssetData validates the data of the upserted asset against the
+// required fields and the schema of its type, if any. Violations of the
+// schema are only logged under warn mode.
+func (s *Service) validateAssetData(ctx context.Context, ast Asset) error {
+	if err := validateRequiredFields(ast); err != nil {
+		return err
+	}
+	if s.typeSchemaRepository == nil {
+		return nil
+	}
+
+	ts, err := s.typeSchemaRepository.Get(ctx, ast.Type)
+	if errors.As(err, new(TypeSchemaNotFoundError)) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get schema of type %s: %w", ast.Type, err)
+	}
+
+	compiled, err := s.compiledTypeSchema(ts)
+	if err != nil {
+		return err
+	}
+	errs, err := validateData(compiled, ast.Data)
+	if err != nil {
+		return fmt.Errorf("validate data of asset %s: %w", ast.URN, err)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	if ts.Mode == SchemaModeWarn {
+		s.logger.Warn("asset data violates schema of its type", "urn", ast.URN, "type", ast.Type, "errors", errs)
+		return nil
+	}
+	return DataValidationError{Type: ast.Type, Errors: errs}
+}
+
+// validatePatchedAssetData validates the data of the stored asset once
+// patched, as it is upserted.
+func (s *Service) validatePatchedAssetData(ctx context.Context, ast Asset, patchData map[string]interface{}) error {
+	if s.typeSchemaRepository == nil && !hasRequiredFields(ast.Type) {
+		return nil
+	}
+
+	stored, err := s.assetRepository.GetByURN(ctx, ast.URN)
+	if errors.As(err, new(NotFoundError)) {
+		return s.validateAssetData(ctx, ast)
+	}
+	if err != nil {
+		return fmt.Errorf("get asset to validate its data: %w", err)
+	}
+
+	stored.Patch(patchData)
+	return s.validateAssetData(ctx, stored)
+}
+
+// compiledTypeSchema returns the compiled schema, compiled again only once
+// the schema is updated.
+func (s *Service) compiledTypeSchema(ts TypeSchema) (*gojsonschema.Schema, error) {
+	if v, ok := s.typeSchemas.Load(ts.Type); ok {
+		if cached := v.(compiledTypeSchema); cached.updatedAt.Equal(ts.UpdatedAt) {
+			return cached.schema, nil
+		}
+	}
+
+	compiled, err := compileSchema(ts.Type, ts.Schema)
+	if err != nil {
+		return nil, err
+	}
+	s.typeSchemas.Store(ts.Type, compiledTypeSchema{updatedAt: ts.UpdatedAt, schema: compiled})
+	return compiled, nil
+}
+
+func compileSchema(typ Type, schema json.RawMessage) (*gojsonschema.Schema, error) {
+	if len(schema) == 0 {
+		return nil, InvalidTypeSchemaError{Type: typ, Err: errors.New("schema is empty")}
+	}
+
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema))
+	if err != nil {
+		return nil, InvalidTypeSchemaError{Type: typ, Err: err}
+	}
+	return compiled, nil
+}
+
+func validateData(schema *gojsonschema.Schema, data map[string]interface{}) ([]string, error) {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	res, err := schema.Validate(gojsonschema.NewGoLoader(data))
+	if err != nil {
+		return nil, err
+	}
+	if res.Valid() {
+		return nil, nil
+	}
+
+	errs := make([]string, len(res.Errors()))
+	for i, e := range res.Errors() {
+		errs[i] = e.String()
+	}
+	return errs, nil
+}
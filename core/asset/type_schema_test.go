@@ -0,0 +1,321 @@
+package asset_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const sampleTypeSchema = `{
+	"type": "object",
+	"required": ["owner_team"],
+	"properties": {"owner_team": {"type": "string"}}
+}`
+
+func TestService_UpsertTypeSchema(t *testing.T) {
+	testCases := []struct {
+		Description string
+		TypeSchema  asset.TypeSchema
+		Setup       func(*mocks.TypeSchemaRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: `should return error if type is unknown`,
+			TypeSchema:  asset.TypeSchema{Type: "unknown", Schema: json.RawMessage(sampleTypeSchema)},
+			ExpectedErr: asset.ErrUnknownType,
+		},
+		{
+			Description: `should return error if mode is unknown`,
+			TypeSchema:  asset.TypeSchema{Type: "table", Schema: json.RawMessage(sampleTypeSchema), Mode: "strict"},
+			ExpectedErr: asset.InvalidTypeSchemaError{Type: "table", Err: errors.New(`unknown mode "strict"`)},
+		},
+		{
+			Description: `should return error if schema is empty`,
+			TypeSchema:  asset.TypeSchema{Type: "table"},
+			ExpectedErr: asset.InvalidTypeSchemaError{Type: "table", Err: errors.New("schema is empty")},
+		},
+		{
+			Description: `should enforce schema by default`,
+			TypeSchema:  asset.TypeSchema{Type: "table", Schema: json.RawMessage(sampleTypeSchema)},
+			Setup: func(tsr *mocks.TypeSchemaRepository) {
+				tsr.EXPECT().Upsert(mock.Anything, &asset.TypeSchema{
+					Type:   "table",
+					Schema: json.RawMessage(sampleTypeSchema),
+					Mode:   asset.SchemaModeEnforce,
+				}).Return(nil)
+			},
+		},
+		{
+			Description: `should return error if repository fails`,
+			TypeSchema:  asset.TypeSchema{Type: "table", Schema: json.RawMessage(sampleTypeSchema), Mode: asset.SchemaModeWarn},
+			Setup: func(tsr *mocks.TypeSchemaRepository) {
+				tsr.EXPECT().Upsert(mock.Anything, mock.Anything).Return(errors.New("unknown error"))
+			},
+			ExpectedErr: errors.New("unknown error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			typeSchemaRepo := mocks.NewTypeSchemaRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(typeSchemaRepo)
+			}
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{
+				TypeSchemaRepo: typeSchemaRepo,
+				Logger:         log.NewNoop(),
+			})
+			defer cancel()
+
+			err := svc.UpsertTypeSchema(context.Background(), &tc.TypeSchema)
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("should reject invalid schema", func(t *testing.T) {
+		svc, cancel := asset.NewService(asset.ServiceDeps{
+			TypeSchemaRepo: mocks.NewTypeSchemaRepository(t),
+			Logger:         log.NewNoop(),
+		})
+		defer cancel()
+
+		err := svc.UpsertTypeSchema(context.Background(), &asset.TypeSchema{
+			Type:   "table",
+			Schema: json.RawMessage(`{"type": "not-a-type"}`),
+		})
+		assert.ErrorAs(t, err, new(asset.InvalidTypeSchemaError))
+	})
+}
+
+func TestService_UpsertAssetWithTypeSchema(t *testing.T) {
+	typeSchema := func(mode asset.SchemaMode) asset.TypeSchema {
+		return asset.TypeSchema{
+			Type:      "table",
+			Schema:    json.RawMessage(sampleTypeSchema),
+			Mode:      mode,
+			UpdatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	validData := map[string]interface{}{"owner_team": "data-platform"}
+	invalidData := map[string]interface{}{"owner_team": 10}
+
+	testCases := []struct {
+		Description string
+		Data        map[string]interface{}
+		Setup       func(*mocks.TypeSchemaRepository, *mocks.AssetRepository, *mocks.DiscoveryRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: `should upsert asset if its type has no schema`,
+			Data:        invalidData,
+			Setup: func(tsr *mocks.TypeSchemaRepository, ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(asset.TypeSchema{}, asset.TypeSchemaNotFoundError{Type: "table"})
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&asset.Asset{ID: "some-id"}, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+		{
+			Description: `should upsert asset if its data is valid`,
+			Data:        validData,
+			Setup: func(tsr *mocks.TypeSchemaRepository, ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(typeSchema(asset.SchemaModeEnforce), nil)
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&asset.Asset{ID: "some-id"}, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+		{
+			Description: `should reject asset if its data violates the enforced schema`,
+			Data:        invalidData,
+			Setup: func(tsr *mocks.TypeSchemaRepository, _ *mocks.AssetRepository, _ *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(typeSchema(asset.SchemaModeEnforce), nil)
+			},
+			ExpectedErr: asset.DataValidationError{
+				Type:   "table",
+				Errors: []string{"owner_team: Invalid type. Expected: string, given: integer"},
+			},
+		},
+		{
+			Description: `should reject asset without data if the schema requires fields`,
+			Setup: func(tsr *mocks.TypeSchemaRepository, _ *mocks.AssetRepository, _ *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(typeSchema(asset.SchemaModeEnforce), nil)
+			},
+			ExpectedErr: asset.DataValidationError{
+				Type:   "table",
+				Errors: []string{"(root): owner_team is required"},
+			},
+		},
+		{
+			Description: `should upsert asset violating the schema under warn mode`,
+			Data:        invalidData,
+			Setup: func(tsr *mocks.TypeSchemaRepository, ar *mocks.AssetRepository, dr *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(typeSchema(asset.SchemaModeWarn), nil)
+				ar.EXPECT().Upsert(mock.Anything, mock.Anything, false, mock.Anything).Return(&asset.Asset{ID: "some-id"}, nil, nil)
+				dr.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+			},
+		},
+		{
+			Description: `should return error if schema can not be read`,
+			Data:        validData,
+			Setup: func(tsr *mocks.TypeSchemaRepository, _ *mocks.AssetRepository, _ *mocks.DiscoveryRepository) {
+				tsr.EXPECT().Get(mock.Anything, asset.Type("table")).Return(asset.TypeSchema{}, errors.New("unknown error"))
+			},
+			ExpectedErr: errors.New("get schema of type table: unknown error"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			typeSchemaRepo := mocks.NewTypeSchemaRepository(t)
+			assetRepo := mocks.NewAssetRepository(t)
+			discoveryRepo := mocks.NewDiscoveryRepository(t)
+			tc.Setup(typeSchemaRepo, assetRepo, discoveryRepo)
+
+			svc, cancel := asset.NewService(asset.ServiceDeps{
+				AssetRepo:      assetRepo,
+				DiscoveryRepo:  discoveryRepo,
+				LineageRepo:    mocks.NewLineageRepository(t),
+				TypeSchemaRepo: typeSchemaRepo,
+				Worker:         workermanager.NewInSituWorker(workermanager.Deps{DiscoveryRepo: discoveryRepo}),
+				Logger:         log.NewNoop(),
+			})
+			defer cancel()
+
+			_, err := svc.UpsertAssetWithoutLineage(context.Background(), &asset.Asset{
+				URN:     "some-urn",
+				Type:    "table",
+				Service: "bigquery",
+				Data:    tc.Data,
+			}, false)
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_UpsertPatchAssetWithTypeSchema(t *testing.T) {
+	ts := asset.TypeSchema{
+		Type:   "table",
+		Schema: json.RawMessage(sampleTypeSchema),
+		Mode:   asset.SchemaModeEnforce,
+	}
+	ast := &asset.Asset{URN: "some-urn", Type: "table", Service: "bigquery"}
+
+	t.Run("should validate the data of the stored asset once patched", func(t *testing.T) {
+		typeSchemaRepo := mocks.NewTypeSchemaRepository(t)
+		assetRepo := mocks.NewAssetRepository(t)
+		typeSchemaRepo.EXPECT().Get(mock.Anything, asset.Type("table")).Return(ts, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "some-urn").Return(asset.Asset{
+			URN:  "some-urn",
+			Type: "table",
+			Data: map[string]interface{}{"owner_team": "data-platform"},
+		}, nil)
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{
+			AssetRepo:      assetRepo,
+			TypeSchemaRepo: typeSchemaRepo,
+			Logger:         log.NewNoop(),
+		})
+		defer cancel()
+
+		_, err := svc.UpsertPatchAssetWithoutLineage(context.Background(), ast, map[string]interface{}{
+			"data": map[string]interface{}{"owner_team": false},
+		}, false)
+		assert.EqualError(t, err, `data violates schema of type "table": owner_team: Invalid type. Expected: string, given: boolean`)
+	})
+
+	t.Run("should validate the data of the new asset", func(t *testing.T) {
+		typeSchemaRepo := mocks.NewTypeSchemaRepository(t)
+		assetRepo := mocks.NewAssetRepository(t)
+		discoveryRepo := mocks.NewDiscoveryRepository(t)
+		typeSchemaRepo.EXPECT().Get(mock.Anything, asset.Type("table")).Return(ts, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "some-urn").Return(asset.Asset{}, asset.NotFoundError{URN: "some-urn"})
+		assetRepo.EXPECT().UpsertPatch(mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(&asset.Asset{ID: "some-id"}, nil, nil)
+		discoveryRepo.EXPECT().Upsert(mock.Anything, mock.AnythingOfType("asset.Asset")).Return(nil)
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{
+			AssetRepo:      assetRepo,
+			DiscoveryRepo:  discoveryRepo,
+			TypeSchemaRepo: typeSchemaRepo,
+			Worker:         workermanager.NewInSituWorker(workermanager.Deps{DiscoveryRepo: discoveryRepo}),
+			Logger:         log.NewNoop(),
+		})
+		defer cancel()
+
+		newAsset := *ast
+		newAsset.Data = map[string]interface{}{"owner_team": "data-platform"}
+		id, err := svc.UpsertPatchAssetWithoutLineage(context.Background(), &newAsset, map[string]interface{}{
+			"data": map[string]interface{}{"owner_team": "data-platform"},
+		}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "some-id", id)
+	})
+}
+
+func TestService_ValidateAssetsAgainstSchema(t *testing.T) {
+	t.Run("should return error if schema is invalid", func(t *testing.T) {
+		svc, cancel := asset.NewService(asset.ServiceDeps{Logger: log.NewNoop()})
+		defer cancel()
+
+		_, err := svc.ValidateAssetsAgainstSchema(context.Background(), asset.TypeSchema{
+			Type:   "table",
+			Schema: json.RawMessage(`{"required": "owner_team"}`),
+		})
+		assert.ErrorAs(t, err, new(asset.InvalidTypeSchemaError))
+	})
+
+	t.Run("should return the existing assets violating the schema", func(t *testing.T) {
+		assetRepo := mocks.NewAssetRepository(t)
+		assetRepo.EXPECT().GetAll(mock.Anything, asset.Filter{
+			Types: []asset.Type{"table"},
+			Size:  1000,
+		}).Return([]asset.Asset{
+			{URN: "valid-urn", Data: map[string]interface{}{"owner_team": "data-platform"}},
+			{URN: "invalid-urn"},
+		}, nil)
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{AssetRepo: assetRepo, Logger: log.NewNoop()})
+		defer cancel()
+
+		report, err := svc.ValidateAssetsAgainstSchema(context.Background(), asset.TypeSchema{
+			Type:   "table",
+			Schema: json.RawMessage(sampleTypeSchema),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, asset.SchemaValidationReport{
+			Checked: 2,
+			Invalid: 1,
+			Violations: []asset.SchemaViolation{
+				{URN: "invalid-urn", Errors: []string{"(root): owner_team is required"}},
+			},
+		}, report)
+	})
+
+	t.Run("should return error if assets can not be read", func(t *testing.T) {
+		assetRepo := mocks.NewAssetRepository(t)
+		assetRepo.EXPECT().GetAll(mock.Anything, mock.Anything).Return(nil, errors.New("unknown error"))
+
+		svc, cancel := asset.NewService(asset.ServiceDeps{AssetRepo: assetRepo, Logger: log.NewNoop()})
+		defer cancel()
+
+		_, err := svc.ValidateAssetsAgainstSchema(context.Background(), asset.TypeSchema{
+			Type:   "table",
+			Schema: json.RawMessage(sampleTypeSchema),
+		})
+		assert.EqualError(t, err, "get assets of type table: unknown error")
+	})
+}
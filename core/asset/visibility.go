@@ -0,0 +1,70 @@
+package asset
+
+import "slices"
+
+type VisibilityKind string
+
+const (
+	VisibilityPublic     VisibilityKind = "public"
+	VisibilityRestricted VisibilityKind = "restricted"
+)
+
+// Visibility restricts an asset to the members of some groups. Assets without
+// visibility are public.
+type Visibility struct {
+	Kind   VisibilityKind `json:"kind" diff:"kind"`
+	Groups []string       `json:"groups,omitempty" diff:"groups"`
+}
+
+// IsRestricted tells whether the asset is only visible to some groups.
+func (v *Visibility) IsRestricted() bool {
+	return v != nil && v.Kind == VisibilityRestricted
+}
+
+func (v *Visibility) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	switch v.Kind {
+	case VisibilityPublic:
+		if len(v.Groups) > 0 {
+			return ErrInvalidVisibility
+		}
+	case VisibilityRestricted:
+		if len(v.Groups) == 0 || slices.Contains(v.Groups, "") {
+			return ErrInvalidVisibility
+		}
+	default:
+		return ErrInvalidVisibility
+	}
+	return nil
+}
+
+// Viewer is the user the assets are read for. A nil viewer sees all the
+// assets, for the reads made by Compass itself.
+type Viewer struct {
+	Groups []string
+	// Unrestricted viewers, like the admins, see the restricted assets too.
+	Unrestricted bool
+}
+
+// CanSee tells whether the viewer is allowed to see an asset of the
+// visibility.
+func (v *Viewer) CanSee(vis *Visibility) bool {
+	if !v.IsRestricted() || !vis.IsRestricted() {
+		return true
+	}
+	for _, g := range vis.Groups {
+		if slices.Contains(v.Groups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRestricted tells whether the restricted assets have to be filtered out
+// for the viewer.
+func (v *Viewer) IsRestricted() bool {
+	return v != nil && !v.Unrestricted
+}
@@ -0,0 +1,50 @@
+package asset_test
+
+import (
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVisibilityValidate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Visibility  *asset.Visibility
+		ExpectedErr error
+	}{
+		{Description: "nil visibility is public", Visibility: nil},
+		{Description: "public visibility", Visibility: &asset.Visibility{Kind: asset.VisibilityPublic}},
+		{Description: "restricted visibility with groups", Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}}},
+		{Description: "restricted visibility without group", Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted}, ExpectedErr: asset.ErrInvalidVisibility},
+		{Description: "public visibility with groups", Visibility: &asset.Visibility{Kind: asset.VisibilityPublic, Groups: []string{"finance"}}, ExpectedErr: asset.ErrInvalidVisibility},
+		{Description: "unknown kind", Visibility: &asset.Visibility{Kind: "private"}, ExpectedErr: asset.ErrInvalidVisibility},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			assert.ErrorIs(t, tc.Visibility.Validate(), tc.ExpectedErr)
+		})
+	}
+}
+
+func TestViewerCanSee(t *testing.T) {
+	restricted := &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance", "hr"}}
+
+	cases := []struct {
+		Description string
+		Viewer      *asset.Viewer
+		Visibility  *asset.Visibility
+		Expected    bool
+	}{
+		{Description: "nil viewer sees restricted asset", Viewer: nil, Visibility: restricted, Expected: true},
+		{Description: "unrestricted viewer sees restricted asset", Viewer: &asset.Viewer{Unrestricted: true}, Visibility: restricted, Expected: true},
+		{Description: "viewer sees public asset", Viewer: &asset.Viewer{}, Visibility: nil, Expected: true},
+		{Description: "member of a group sees restricted asset", Viewer: &asset.Viewer{Groups: []string{"hr"}}, Visibility: restricted, Expected: true},
+		{Description: "non member does not see restricted asset", Viewer: &asset.Viewer{Groups: []string{"marketing"}}, Visibility: restricted, Expected: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, tc.Viewer.CanSee(tc.Visibility))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package audit
+
+//go:generate mockery --name=Repository -r --case underscore --with-expecter --structname AuditRepository --filename audit_repository.go --output=./mocks
+
+import (
+	"context"
+	"time"
+)
+
+// Repository stores the audit events, which are appended and never updated
+// nor deleted.
+type Repository interface {
+	Insert(ctx context.Context, e *Event) (string, error)
+	GetAll(ctx context.Context, flt Filter) ([]Event, error)
+}
+
+// Event records who did what, a call of a mutating method or a change made
+// outside of the API, like a cleanup.
+type Event struct {
+	ID string `json:"id"`
+	// Actor is the email of the user who made the change
+	Actor string `json:"actor"`
+	// Method is the full name of the method called, or the name of the
+	// operation made outside of the API
+	Method string `json:"method"`
+	// TargetIDs are the ids and urns of what the change targeted
+	TargetIDs []string `json:"target_ids"`
+	// Request is a json summary of the request, truncated
+	Request string `json:"request"`
+	// Result is the grpc status code of the call, OK if it succeeded
+	Result    string        `json:"result"`
+	Error     string        `json:"error"`
+	Latency   time.Duration `json:"latency"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// Filter is a config of audit events
+type Filter struct {
+	Actor string
+	// Method matches the full name of the method or its last element, like
+	// UpsertAsset
+	Method   string
+	TargetID string
+	Result   string
+	// Since and Until bound the time the events were recorded at, if set
+	Since time.Time
+	Until time.Time
+
+	// Number of relevant results to return
+	Size int
+
+	// Offset is a data offset in the table rows
+	Offset int
+}
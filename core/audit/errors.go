@@ -0,0 +1,9 @@
+package audit
+
+import "errors"
+
+var (
+	ErrEmptyMethod       = errors.New("audit event method is empty")
+	ErrInvalidTimeRange  = errors.New("audit filter since must be before until")
+	ErrInvalidPagination = errors.New("audit filter size and offset must not be negative")
+)
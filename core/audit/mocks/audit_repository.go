@@ -0,0 +1,154 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/goto/compass/core/audit"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepository is an autogenerated mock type for the Repository type
+type AuditRepository struct {
+	mock.Mock
+}
+
+type AuditRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditRepository) EXPECT() *AuditRepository_Expecter {
+	return &AuditRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAll provides a mock function with given fields: ctx, flt
+func (_m *AuditRepository) GetAll(ctx context.Context, flt audit.Filter) ([]audit.Event, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []audit.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) ([]audit.Event, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Event); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type AuditRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt audit.Filter
+func (_e *AuditRepository_Expecter) GetAll(ctx interface{}, flt interface{}) *AuditRepository_GetAll_Call {
+	return &AuditRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, flt)}
+}
+
+func (_c *AuditRepository_GetAll_Call) Run(run func(ctx context.Context, flt audit.Filter)) *AuditRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(audit.Filter))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_GetAll_Call) Return(_a0 []audit.Event, _a1 error) *AuditRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepository_GetAll_Call) RunAndReturn(run func(context.Context, audit.Filter) ([]audit.Event, error)) *AuditRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function with given fields: ctx, e
+func (_m *AuditRepository) Insert(ctx context.Context, e *audit.Event) (string, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *audit.Event) (string, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *audit.Event) string); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *audit.Event) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRepository_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type AuditRepository_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e *audit.Event
+func (_e *AuditRepository_Expecter) Insert(ctx interface{}, e interface{}) *AuditRepository_Insert_Call {
+	return &AuditRepository_Insert_Call{Call: _e.mock.On("Insert", ctx, e)}
+}
+
+func (_c *AuditRepository_Insert_Call) Run(run func(ctx context.Context, e *audit.Event)) *AuditRepository_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*audit.Event))
+	})
+	return _c
+}
+
+func (_c *AuditRepository_Insert_Call) Return(_a0 string, _a1 error) *AuditRepository_Insert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRepository_Insert_Call) RunAndReturn(run func(context.Context, *audit.Event) (string, error)) *AuditRepository_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditRepository creates a new instance of AuditRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepository {
+	mock := &AuditRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
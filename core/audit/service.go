@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxRequestLen bounds the summary of the request kept in an event, the
+	// upserted assets being too large to be kept whole.
+	maxRequestLen = 2048
+
+	defaultSize = 100
+)
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// Record appends the event to the audit log, its request summary truncated
+// and its target ids deduplicated.
+func (s *Service) Record(ctx context.Context, e *Event) error {
+	e.Method = strings.TrimSpace(e.Method)
+	if e.Method == "" {
+		return ErrEmptyMethod
+	}
+	e.Request = truncate(e.Request, maxRequestLen)
+
+	targets := make([]string, 0, len(e.TargetIDs))
+	seen := make(map[string]struct{}, len(e.TargetIDs))
+	for _, id := range e.TargetIDs {
+		if _, ok := seen[id]; ok || id == "" {
+			continue
+		}
+		seen[id] = struct{}{}
+		targets = append(targets, id)
+	}
+	e.TargetIDs = targets
+
+	id, err := s.repo.Insert(ctx, e)
+	if err != nil {
+		return err
+	}
+	e.ID = id
+	return nil
+}
+
+// GetEvents returns the events matching the filter, the latest first.
+func (s *Service) GetEvents(ctx context.Context, flt Filter) ([]Event, error) {
+	if flt.Size < 0 || flt.Offset < 0 {
+		return nil, ErrInvalidPagination
+	}
+	if !flt.Since.IsZero() && !flt.Until.IsZero() && flt.Until.Before(flt.Since) {
+		return nil, ErrInvalidTimeRange
+	}
+	if flt.Size == 0 {
+		flt.Size = defaultSize
+	}
+
+	return s.repo.GetAll(ctx, flt)
+}
+
+// Summarize returns the json of the request of a change made outside of the
+// API, for the Request of its event.
+func Summarize(req interface{}) string {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s + "..."
+}
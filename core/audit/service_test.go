@@ -0,0 +1,116 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/audit"
+	"github.com/goto/compass/core/audit/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Record(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Description string
+		Event       audit.Event
+		Setup       func(*mocks.AuditRepository)
+		ExpectedErr error
+		ExpectedID  string
+	}{
+		{
+			Description: "should return error if method is empty",
+			Event:       audit.Event{Actor: "john.doe@example.com", Method: " "},
+			ExpectedErr: audit.ErrEmptyMethod,
+		},
+		{
+			Description: "should insert event with deduplicated targets and truncated request",
+			Event: audit.Event{
+				Actor:     "john.doe@example.com",
+				Method:    "/gotocompany.compass.v1beta1.CompassService/DeleteAsset",
+				TargetIDs: []string{"asset-id", "", "asset-id", "urn:asset"},
+				Request:   strings.Repeat("a", 3000),
+				Result:    "OK",
+				Latency:   time.Second,
+			},
+			Setup: func(repo *mocks.AuditRepository) {
+				repo.EXPECT().Insert(ctx, &audit.Event{
+					Actor:     "john.doe@example.com",
+					Method:    "/gotocompany.compass.v1beta1.CompassService/DeleteAsset",
+					TargetIDs: []string{"asset-id", "urn:asset"},
+					Request:   strings.Repeat("a", 2048) + "...",
+					Result:    "OK",
+					Latency:   time.Second,
+				}).Return("event-id", nil)
+			},
+			ExpectedID: "event-id",
+		},
+		{
+			Description: "should return error if insert fails",
+			Event:       audit.Event{Method: "cleanup"},
+			Setup: func(repo *mocks.AuditRepository) {
+				repo.EXPECT().Insert(ctx, &audit.Event{Method: "cleanup", TargetIDs: []string{}}).Return("", errors.New("db down"))
+			},
+			ExpectedErr: errors.New("db down"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			repo := mocks.NewAuditRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(repo)
+			}
+
+			svc := audit.NewService(repo)
+			err := svc.Record(ctx, &tc.Event)
+			assert.Equal(t, tc.ExpectedErr, err)
+			assert.Equal(t, tc.ExpectedID, tc.Event.ID)
+		})
+	}
+}
+
+func TestService_GetEvents(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	cases := []struct {
+		Description string
+		Filter      audit.Filter
+		Setup       func(*mocks.AuditRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: "should return error if size is negative",
+			Filter:      audit.Filter{Size: -1},
+			ExpectedErr: audit.ErrInvalidPagination,
+		},
+		{
+			Description: "should return error if until is before since",
+			Filter:      audit.Filter{Since: now, Until: now.Add(-time.Hour)},
+			ExpectedErr: audit.ErrInvalidTimeRange,
+		},
+		{
+			Description: "should get events with the default size",
+			Filter:      audit.Filter{Actor: "john.doe@example.com"},
+			Setup: func(repo *mocks.AuditRepository) {
+				repo.EXPECT().GetAll(ctx, audit.Filter{Actor: "john.doe@example.com", Size: 100}).Return([]audit.Event{{ID: "event-id"}}, nil)
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			repo := mocks.NewAuditRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(repo)
+			}
+
+			svc := audit.NewService(repo)
+			_, err := svc.GetEvents(ctx, tc.Filter)
+			assert.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
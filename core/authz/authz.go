@@ -0,0 +1,128 @@
+package authz
+
+//go:generate mockery --name=Repository -r --case underscore --with-expecter --structname PolicyRepository --filename policy_repository.go --output=./mocks
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	Create(ctx context.Context, p *Policy) (string, error)
+	GetByID(ctx context.Context, id string) (Policy, error)
+	GetAll(ctx context.Context, flt Filter) ([]Policy, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// Role is a set of operations allowed on the assets, each role allows the
+// operations of the roles before it.
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+	RoleAdmin:  4,
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Includes checks whether the role allows the operations of the other role.
+func (r Role) Includes(other Role) bool {
+	return r.IsValid() && roleRanks[r] >= roleRanks[other]
+}
+
+type ScopeKind string
+
+const (
+	ScopeKindGlobal  ScopeKind = "global"
+	ScopeKindService ScopeKind = "service"
+	ScopeKindType    ScopeKind = "type"
+	ScopeKindAsset   ScopeKind = "asset"
+)
+
+func (k ScopeKind) IsValid() bool {
+	switch k {
+	case ScopeKindGlobal, ScopeKindService, ScopeKindType, ScopeKindAsset:
+		return true
+	}
+	return false
+}
+
+// Scope is the set of assets a policy applies to, either all of them or the
+// ones of a service, of a type or a single asset given by its URN.
+type Scope struct {
+	Kind  ScopeKind `json:"kind"`
+	Value string    `json:"value"`
+}
+
+// Includes checks whether the resource is in the scope. Operations not
+// related to an asset are only in the global scope.
+func (s Scope) Includes(res Resource) bool {
+	switch s.Kind {
+	case ScopeKindGlobal:
+		return true
+	case ScopeKindService:
+		return res.Service != "" && res.Service == s.Value
+	case ScopeKindType:
+		return res.Type != "" && res.Type == s.Value
+	case ScopeKindAsset:
+		return res.URN != "" && res.URN == s.Value
+	}
+	return false
+}
+
+func (s Scope) String() string {
+	if s.Kind == ScopeKindGlobal {
+		return string(s.Kind)
+	}
+	return string(s.Kind) + ":" + s.Value
+}
+
+// Policy grants a role on the assets in its scope to a user.
+type Policy struct {
+	ID string `json:"id"`
+	// Subject is the email of the user the role is granted to
+	Subject   string    `json:"subject"`
+	Role      Role      `json:"role"`
+	Scope     Scope     `json:"scope"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Resource is the asset an operation is authorized on. The zero value stands
+// for the operations not related to an asset.
+type Resource struct {
+	URN     string
+	Type    string
+	Service string
+	// Owners are the emails of the owners of the asset
+	Owners []string
+}
+
+func (r Resource) String() string {
+	if r.URN == "" {
+		return "all assets"
+	}
+	return "asset \"" + r.URN + "\""
+}
+
+// Filter is a config of policies
+type Filter struct {
+	Subject string
+}
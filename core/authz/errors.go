@@ -0,0 +1,50 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptySubject = errors.New("policy subject is empty")
+	ErrInvalidRole  = errors.New("policy role must be one of viewer, editor, owner and admin")
+	ErrInvalidScope = errors.New("policy scope must be global or have a value of service, type or asset")
+)
+
+type NotFoundError struct {
+	ID string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("could not find policy with id \"%s\"", e.ID)
+}
+
+type InvalidError struct {
+	ID string
+}
+
+func (e InvalidError) Error() string {
+	return fmt.Sprintf("invalid policy id \"%s\"", e.ID)
+}
+
+type DuplicateError struct {
+	Subject string
+	Role    Role
+	Scope   Scope
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("user \"%s\" already has role %s on %s", e.Subject, e.Role, e.Scope)
+}
+
+// ForbiddenError is returned when a user does not have the role required by
+// an operation.
+type ForbiddenError struct {
+	Subject  string
+	Role     Role
+	Resource Resource
+}
+
+func (e ForbiddenError) Error() string {
+	return fmt.Sprintf("user \"%s\" requires role %s on %s", e.Subject, e.Role, e.Resource)
+}
@@ -0,0 +1,258 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	authz "github.com/goto/compass/core/authz"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PolicyRepository is an autogenerated mock type for the Repository type
+type PolicyRepository struct {
+	mock.Mock
+}
+
+type PolicyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyRepository) EXPECT() *PolicyRepository_Expecter {
+	return &PolicyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, p
+func (_m *PolicyRepository) Create(ctx context.Context, p *authz.Policy) (string, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *authz.Policy) (string, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *authz.Policy) string); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *authz.Policy) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PolicyRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *authz.Policy
+func (_e *PolicyRepository_Expecter) Create(ctx interface{}, p interface{}) *PolicyRepository_Create_Call {
+	return &PolicyRepository_Create_Call{Call: _e.mock.On("Create", ctx, p)}
+}
+
+func (_c *PolicyRepository_Create_Call) Run(run func(ctx context.Context, p *authz.Policy)) *PolicyRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*authz.Policy))
+	})
+	return _c
+}
+
+func (_c *PolicyRepository_Create_Call) Return(_a0 string, _a1 error) *PolicyRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyRepository_Create_Call) RunAndReturn(run func(context.Context, *authz.Policy) (string, error)) *PolicyRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *PolicyRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PolicyRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PolicyRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *PolicyRepository_Expecter) Delete(ctx interface{}, id interface{}) *PolicyRepository_Delete_Call {
+	return &PolicyRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *PolicyRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *PolicyRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PolicyRepository_Delete_Call) Return(_a0 error) *PolicyRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PolicyRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *PolicyRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, flt
+func (_m *PolicyRepository) GetAll(ctx context.Context, flt authz.Filter) ([]authz.Policy, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []authz.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authz.Filter) ([]authz.Policy, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authz.Filter) []authz.Policy); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]authz.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authz.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type PolicyRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt authz.Filter
+func (_e *PolicyRepository_Expecter) GetAll(ctx interface{}, flt interface{}) *PolicyRepository_GetAll_Call {
+	return &PolicyRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, flt)}
+}
+
+func (_c *PolicyRepository_GetAll_Call) Run(run func(ctx context.Context, flt authz.Filter)) *PolicyRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(authz.Filter))
+	})
+	return _c
+}
+
+func (_c *PolicyRepository_GetAll_Call) Return(_a0 []authz.Policy, _a1 error) *PolicyRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyRepository_GetAll_Call) RunAndReturn(run func(context.Context, authz.Filter) ([]authz.Policy, error)) *PolicyRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *PolicyRepository) GetByID(ctx context.Context, id string) (authz.Policy, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 authz.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (authz.Policy, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) authz.Policy); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(authz.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type PolicyRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *PolicyRepository_Expecter) GetByID(ctx interface{}, id interface{}) *PolicyRepository_GetByID_Call {
+	return &PolicyRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *PolicyRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *PolicyRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PolicyRepository_GetByID_Call) Return(_a0 authz.Policy, _a1 error) *PolicyRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (authz.Policy, error)) *PolicyRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyRepository creates a new instance of PolicyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyRepository {
+	mock := &PolicyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,109 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goto/salt/log"
+)
+
+type Config struct {
+	// Enabled turns on the authorization of the write operations, all of
+	// them are allowed otherwise.
+	Enabled bool `mapstructure:"enabled" default:"false"`
+	// Admins are the emails of the users having the admin role regardless of
+	// the policies, to grant the first ones.
+	Admins []string `mapstructure:"admins"`
+	// DefaultRole is the role of the users on all the assets.
+	DefaultRole Role `mapstructure:"default_role" default:"viewer"`
+}
+
+type ServiceDeps struct {
+	Repo   Repository
+	Logger log.Logger
+	Config Config
+}
+
+type Service struct {
+	repo   Repository
+	logger log.Logger
+	config Config
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:   deps.Repo,
+		logger: deps.Logger,
+		config: deps.Config,
+	}
+}
+
+func (s *Service) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Authorize checks the user has the role on the resource, through the
+// default role, the ownership of the asset or a policy. Owners of an asset
+// have the owner role on it.
+func (s *Service) Authorize(ctx context.Context, subject string, role Role, res Resource) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if subject == "" {
+		return ForbiddenError{Subject: subject, Role: role, Resource: res}
+	}
+	if containsEmail(s.config.Admins, subject) || s.config.DefaultRole.Includes(role) {
+		return nil
+	}
+	if res.URN != "" && containsEmail(res.Owners, subject) && RoleOwner.Includes(role) {
+		return nil
+	}
+
+	policies, err := s.repo.GetAll(ctx, Filter{Subject: subject})
+	if err != nil {
+		return fmt.Errorf("get policies of %s: %w", subject, err)
+	}
+	for _, p := range policies {
+		if p.Role.Includes(role) && p.Scope.Includes(res) {
+			return nil
+		}
+	}
+
+	return ForbiddenError{Subject: subject, Role: role, Resource: res}
+}
+
+func (s *Service) CreatePolicy(ctx context.Context, p *Policy) (string, error) {
+	p.Subject = strings.TrimSpace(p.Subject)
+	if p.Subject == "" {
+		return "", ErrEmptySubject
+	}
+	if !p.Role.IsValid() {
+		return "", ErrInvalidRole
+	}
+	p.Scope.Value = strings.TrimSpace(p.Scope.Value)
+	if !p.Scope.Kind.IsValid() || (p.Scope.Kind == ScopeKindGlobal) != (p.Scope.Value == "") {
+		return "", ErrInvalidScope
+	}
+
+	return s.repo.Create(ctx, p)
+}
+
+func (s *Service) GetPolicyByID(ctx context.Context, id string) (Policy, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *Service) GetPolicies(ctx context.Context, flt Filter) ([]Policy, error) {
+	return s.repo.GetAll(ctx, flt)
+}
+
+func (s *Service) DeletePolicy(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func containsEmail(emails []string, email string) bool {
+	return slices.ContainsFunc(emails, func(e string) bool {
+		return strings.EqualFold(e, email)
+	})
+}
@@ -0,0 +1,228 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/core/authz/mocks"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newService(t *testing.T, cfg authz.Config) (*authz.Service, *mocks.PolicyRepository) {
+	repo := mocks.NewPolicyRepository(t)
+	svc := authz.NewService(authz.ServiceDeps{
+		Repo:   repo,
+		Logger: log.NewNoop(),
+		Config: cfg,
+	})
+	return svc, repo
+}
+
+func TestService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	enabled := authz.Config{
+		Enabled:     true,
+		Admins:      []string{"admin@example.com"},
+		DefaultRole: authz.RoleViewer,
+	}
+	table := authz.Resource{
+		URN:     "urn:bigquery:orders",
+		Type:    "table",
+		Service: "bigquery",
+		Owners:  []string{"owner@example.com"},
+	}
+
+	cases := []struct {
+		Description string
+		Config      authz.Config
+		Subject     string
+		Role        authz.Role
+		Resource    authz.Resource
+		Setup       func(*mocks.PolicyRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: "should allow everything if disabled",
+			Config:      authz.Config{},
+			Subject:     "user@example.com",
+			Role:        authz.RoleAdmin,
+		},
+		{
+			Description: "should forbid user without email",
+			Config:      enabled,
+			Role:        authz.RoleEditor,
+			Resource:    table,
+			ExpectedErr: authz.ForbiddenError{Role: authz.RoleEditor, Resource: table},
+		},
+		{
+			Description: "should allow configured admins",
+			Config:      enabled,
+			Subject:     "Admin@example.com",
+			Role:        authz.RoleAdmin,
+		},
+		{
+			Description: "should allow operations of default role",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleViewer,
+			Resource:    table,
+		},
+		{
+			Description: "should allow owners to delete their assets",
+			Config:      enabled,
+			Subject:     "owner@example.com",
+			Role:        authz.RoleOwner,
+			Resource:    table,
+		},
+		{
+			Description: "should not let owners act as admins",
+			Config:      enabled,
+			Subject:     "owner@example.com",
+			Role:        authz.RoleAdmin,
+			Resource:    table,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "owner@example.com"}).Return(nil, nil)
+			},
+			ExpectedErr: authz.ForbiddenError{Subject: "owner@example.com", Role: authz.RoleAdmin, Resource: table},
+		},
+		{
+			Description: "should allow user with policy on service of asset",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleEditor,
+			Resource:    table,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "user@example.com"}).Return([]authz.Policy{
+					{Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindType, Value: "topic"}},
+					{Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"}},
+				}, nil)
+			},
+		},
+		{
+			Description: "should forbid user with lower role",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleOwner,
+			Resource:    table,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "user@example.com"}).Return([]authz.Policy{
+					{Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindAsset, Value: "urn:bigquery:orders"}},
+				}, nil)
+			},
+			ExpectedErr: authz.ForbiddenError{Subject: "user@example.com", Role: authz.RoleOwner, Resource: table},
+		},
+		{
+			Description: "should only match global policies for operations not related to an asset",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleAdmin,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "user@example.com"}).Return([]authz.Policy{
+					{Role: authz.RoleAdmin, Scope: authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"}},
+				}, nil)
+			},
+			ExpectedErr: authz.ForbiddenError{Subject: "user@example.com", Role: authz.RoleAdmin},
+		},
+		{
+			Description: "should allow user with global policy",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleAdmin,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "user@example.com"}).Return([]authz.Policy{
+					{Role: authz.RoleAdmin, Scope: authz.Scope{Kind: authz.ScopeKindGlobal}},
+				}, nil)
+			},
+		},
+		{
+			Description: "should return error if policies can not be fetched",
+			Config:      enabled,
+			Subject:     "user@example.com",
+			Role:        authz.RoleEditor,
+			Resource:    table,
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().GetAll(ctx, authz.Filter{Subject: "user@example.com"}).Return(nil, errors.New("unknown error"))
+			},
+			ExpectedErr: errors.New("get policies of user@example.com: unknown error"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			svc, repo := newService(t, tc.Config)
+			if tc.Setup != nil {
+				tc.Setup(repo)
+			}
+
+			err := svc.Authorize(ctx, tc.Subject, tc.Role, tc.Resource)
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestService_CreatePolicy(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Description string
+		Policy      authz.Policy
+		Setup       func(*mocks.PolicyRepository)
+		ExpectedID  string
+		ExpectedErr error
+	}{
+		{
+			Description: "should return error if subject is empty",
+			Policy:      authz.Policy{Subject: " ", Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindGlobal}},
+			ExpectedErr: authz.ErrEmptySubject,
+		},
+		{
+			Description: "should return error if role is invalid",
+			Policy:      authz.Policy{Subject: "user@example.com", Role: "writer", Scope: authz.Scope{Kind: authz.ScopeKindGlobal}},
+			ExpectedErr: authz.ErrInvalidRole,
+		},
+		{
+			Description: "should return error if scope kind is invalid",
+			Policy:      authz.Policy{Subject: "user@example.com", Role: authz.RoleEditor, Scope: authz.Scope{Kind: "team", Value: "data"}},
+			ExpectedErr: authz.ErrInvalidScope,
+		},
+		{
+			Description: "should return error if scope has no value",
+			Policy:      authz.Policy{Subject: "user@example.com", Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindService}},
+			ExpectedErr: authz.ErrInvalidScope,
+		},
+		{
+			Description: "should return error if global scope has a value",
+			Policy:      authz.Policy{Subject: "user@example.com", Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindGlobal, Value: "bigquery"}},
+			ExpectedErr: authz.ErrInvalidScope,
+		},
+		{
+			Description: "should create policy",
+			Policy:      authz.Policy{Subject: " user@example.com ", Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"}},
+			Setup: func(repo *mocks.PolicyRepository) {
+				repo.EXPECT().Create(ctx, mock.MatchedBy(func(p *authz.Policy) bool {
+					return p.Subject == "user@example.com"
+				})).Return("policy-1", nil)
+			},
+			ExpectedID: "policy-1",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			svc, repo := newService(t, authz.Config{})
+			if tc.Setup != nil {
+				tc.Setup(repo)
+			}
+
+			id, err := svc.CreatePolicy(ctx, &tc.Policy)
+			assert.ErrorIs(t, err, tc.ExpectedErr)
+			assert.Equal(t, tc.ExpectedID, id)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package savedsearch
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptyUserID = errors.New("saved search is not related to any user")
+	ErrEmptyName   = errors.New("saved search name is empty")
+	ErrEmptyQuery  = errors.New("saved search has neither text, filters nor queries")
+
+	ErrInvalidWebhookURL = errors.New("saved search webhook url must be an absolute http(s) url")
+)
+
+type NotFoundError struct {
+	ID string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("could not find saved search with id \"%s\"", e.ID)
+}
+
+type InvalidError struct {
+	ID string
+}
+
+func (e InvalidError) Error() string {
+	return fmt.Sprintf("invalid saved search id \"%s\"", e.ID)
+}
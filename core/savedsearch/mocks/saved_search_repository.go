@@ -0,0 +1,488 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	savedsearch "github.com/goto/compass/core/savedsearch"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SavedSearchRepository is an autogenerated mock type for the Repository type
+type SavedSearchRepository struct {
+	mock.Mock
+}
+
+type SavedSearchRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedSearchRepository) EXPECT() *SavedSearchRepository_Expecter {
+	return &SavedSearchRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, ss
+func (_m *SavedSearchRepository) Create(ctx context.Context, ss *savedsearch.SavedSearch) (string, error) {
+	ret := _m.Called(ctx, ss)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *savedsearch.SavedSearch) (string, error)); ok {
+		return rf(ctx, ss)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *savedsearch.SavedSearch) string); ok {
+		r0 = rf(ctx, ss)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *savedsearch.SavedSearch) error); ok {
+		r1 = rf(ctx, ss)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SavedSearchRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ss *savedsearch.SavedSearch
+func (_e *SavedSearchRepository_Expecter) Create(ctx interface{}, ss interface{}) *SavedSearchRepository_Create_Call {
+	return &SavedSearchRepository_Create_Call{Call: _e.mock.On("Create", ctx, ss)}
+}
+
+func (_c *SavedSearchRepository_Create_Call) Run(run func(ctx context.Context, ss *savedsearch.SavedSearch)) *SavedSearchRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*savedsearch.SavedSearch))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_Create_Call) Return(_a0 string, _a1 error) *SavedSearchRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_Create_Call) RunAndReturn(run func(context.Context, *savedsearch.SavedSearch) (string, error)) *SavedSearchRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *SavedSearchRepository) Delete(ctx context.Context, userID string, id string) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedSearchRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SavedSearchRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *SavedSearchRepository_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *SavedSearchRepository_Delete_Call {
+	return &SavedSearchRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
+}
+
+func (_c *SavedSearchRepository_Delete_Call) Run(run func(ctx context.Context, userID string, id string)) *SavedSearchRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_Delete_Call) Return(_a0 error) *SavedSearchRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedSearchRepository_Delete_Call) RunAndReturn(run func(context.Context, string, string) error) *SavedSearchRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllByUserID provides a mock function with given fields: ctx, userID
+func (_m *SavedSearchRepository) GetAllByUserID(ctx context.Context, userID string) ([]savedsearch.SavedSearch, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByUserID")
+	}
+
+	var r0 []savedsearch.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]savedsearch.SavedSearch, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []savedsearch.SavedSearch); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]savedsearch.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_GetAllByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByUserID'
+type SavedSearchRepository_GetAllByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAllByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *SavedSearchRepository_Expecter) GetAllByUserID(ctx interface{}, userID interface{}) *SavedSearchRepository_GetAllByUserID_Call {
+	return &SavedSearchRepository_GetAllByUserID_Call{Call: _e.mock.On("GetAllByUserID", ctx, userID)}
+}
+
+func (_c *SavedSearchRepository_GetAllByUserID_Call) Run(run func(ctx context.Context, userID string)) *SavedSearchRepository_GetAllByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetAllByUserID_Call) Return(_a0 []savedsearch.SavedSearch, _a1 error) *SavedSearchRepository_GetAllByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetAllByUserID_Call) RunAndReturn(run func(context.Context, string) ([]savedsearch.SavedSearch, error)) *SavedSearchRepository_GetAllByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *SavedSearchRepository) GetByID(ctx context.Context, id string) (savedsearch.SavedSearch, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 savedsearch.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (savedsearch.SavedSearch, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) savedsearch.SavedSearch); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(savedsearch.SavedSearch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type SavedSearchRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SavedSearchRepository_Expecter) GetByID(ctx interface{}, id interface{}) *SavedSearchRepository_GetByID_Call {
+	return &SavedSearchRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *SavedSearchRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *SavedSearchRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetByID_Call) Return(_a0 savedsearch.SavedSearch, _a1 error) *SavedSearchRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (savedsearch.SavedSearch, error)) *SavedSearchRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDueIDs provides a mock function with given fields: ctx, since
+func (_m *SavedSearchRepository) GetDueIDs(ctx context.Context, since time.Time) ([]string, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_GetDueIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueIDs'
+type SavedSearchRepository_GetDueIDs_Call struct {
+	*mock.Call
+}
+
+// GetDueIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *SavedSearchRepository_Expecter) GetDueIDs(ctx interface{}, since interface{}) *SavedSearchRepository_GetDueIDs_Call {
+	return &SavedSearchRepository_GetDueIDs_Call{Call: _e.mock.On("GetDueIDs", ctx, since)}
+}
+
+func (_c *SavedSearchRepository_GetDueIDs_Call) Run(run func(ctx context.Context, since time.Time)) *SavedSearchRepository_GetDueIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetDueIDs_Call) Return(_a0 []string, _a1 error) *SavedSearchRepository_GetDueIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetDueIDs_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *SavedSearchRepository_GetDueIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMatches provides a mock function with given fields: ctx, id, flt
+func (_m *SavedSearchRepository) GetMatches(ctx context.Context, id string, flt savedsearch.Filter) ([]savedsearch.Match, error) {
+	ret := _m.Called(ctx, id, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatches")
+	}
+
+	var r0 []savedsearch.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, savedsearch.Filter) ([]savedsearch.Match, error)); ok {
+		return rf(ctx, id, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, savedsearch.Filter) []savedsearch.Match); ok {
+		r0 = rf(ctx, id, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]savedsearch.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, savedsearch.Filter) error); ok {
+		r1 = rf(ctx, id, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_GetMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatches'
+type SavedSearchRepository_GetMatches_Call struct {
+	*mock.Call
+}
+
+// GetMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - flt savedsearch.Filter
+func (_e *SavedSearchRepository_Expecter) GetMatches(ctx interface{}, id interface{}, flt interface{}) *SavedSearchRepository_GetMatches_Call {
+	return &SavedSearchRepository_GetMatches_Call{Call: _e.mock.On("GetMatches", ctx, id, flt)}
+}
+
+func (_c *SavedSearchRepository_GetMatches_Call) Run(run func(ctx context.Context, id string, flt savedsearch.Filter)) *SavedSearchRepository_GetMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(savedsearch.Filter))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetMatches_Call) Return(_a0 []savedsearch.Match, _a1 error) *SavedSearchRepository_GetMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_GetMatches_Call) RunAndReturn(run func(context.Context, string, savedsearch.Filter) ([]savedsearch.Match, error)) *SavedSearchRepository_GetMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMatches provides a mock function with given fields: ctx, id, assetIDs, initial
+func (_m *SavedSearchRepository) InsertMatches(ctx context.Context, id string, assetIDs []string, initial bool) ([]string, error) {
+	ret := _m.Called(ctx, id, assetIDs, initial)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMatches")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, bool) ([]string, error)); ok {
+		return rf(ctx, id, assetIDs, initial)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, bool) []string); ok {
+		r0 = rf(ctx, id, assetIDs, initial)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, bool) error); ok {
+		r1 = rf(ctx, id, assetIDs, initial)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRepository_InsertMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMatches'
+type SavedSearchRepository_InsertMatches_Call struct {
+	*mock.Call
+}
+
+// InsertMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - assetIDs []string
+//   - initial bool
+func (_e *SavedSearchRepository_Expecter) InsertMatches(ctx interface{}, id interface{}, assetIDs interface{}, initial interface{}) *SavedSearchRepository_InsertMatches_Call {
+	return &SavedSearchRepository_InsertMatches_Call{Call: _e.mock.On("InsertMatches", ctx, id, assetIDs, initial)}
+}
+
+func (_c *SavedSearchRepository_InsertMatches_Call) Run(run func(ctx context.Context, id string, assetIDs []string, initial bool)) *SavedSearchRepository_InsertMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_InsertMatches_Call) Return(_a0 []string, _a1 error) *SavedSearchRepository_InsertMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRepository_InsertMatches_Call) RunAndReturn(run func(context.Context, string, []string, bool) ([]string, error)) *SavedSearchRepository_InsertMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastRunAt provides a mock function with given fields: ctx, id, lastRunAt
+func (_m *SavedSearchRepository) UpdateLastRunAt(ctx context.Context, id string, lastRunAt time.Time) error {
+	ret := _m.Called(ctx, id, lastRunAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastRunAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, lastRunAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedSearchRepository_UpdateLastRunAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastRunAt'
+type SavedSearchRepository_UpdateLastRunAt_Call struct {
+	*mock.Call
+}
+
+// UpdateLastRunAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - lastRunAt time.Time
+func (_e *SavedSearchRepository_Expecter) UpdateLastRunAt(ctx interface{}, id interface{}, lastRunAt interface{}) *SavedSearchRepository_UpdateLastRunAt_Call {
+	return &SavedSearchRepository_UpdateLastRunAt_Call{Call: _e.mock.On("UpdateLastRunAt", ctx, id, lastRunAt)}
+}
+
+func (_c *SavedSearchRepository_UpdateLastRunAt_Call) Run(run func(ctx context.Context, id string, lastRunAt time.Time)) *SavedSearchRepository_UpdateLastRunAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRepository_UpdateLastRunAt_Call) Return(_a0 error) *SavedSearchRepository_UpdateLastRunAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedSearchRepository_UpdateLastRunAt_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *SavedSearchRepository_UpdateLastRunAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedSearchRepository creates a new instance of SavedSearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedSearchRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedSearchRepository {
+	mock := &SavedSearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
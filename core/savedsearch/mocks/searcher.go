@@ -0,0 +1,97 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	asset "github.com/goto/compass/core/asset"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Searcher is an autogenerated mock type for the Searcher type
+type Searcher struct {
+	mock.Mock
+}
+
+type Searcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Searcher) EXPECT() *Searcher_Expecter {
+	return &Searcher_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx, cfg
+func (_m *Searcher) Search(ctx context.Context, cfg asset.SearchConfig) ([]asset.SearchResult, error) {
+	ret := _m.Called(ctx, cfg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []asset.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.SearchConfig) ([]asset.SearchResult, error)); ok {
+		return rf(ctx, cfg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.SearchConfig) []asset.SearchResult); ok {
+		r0 = rf(ctx, cfg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]asset.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.SearchConfig) error); ok {
+		r1 = rf(ctx, cfg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Searcher_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type Searcher_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cfg asset.SearchConfig
+func (_e *Searcher_Expecter) Search(ctx interface{}, cfg interface{}) *Searcher_Search_Call {
+	return &Searcher_Search_Call{Call: _e.mock.On("Search", ctx, cfg)}
+}
+
+func (_c *Searcher_Search_Call) Run(run func(ctx context.Context, cfg asset.SearchConfig)) *Searcher_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.SearchConfig))
+	})
+	return _c
+}
+
+func (_c *Searcher_Search_Call) Return(_a0 []asset.SearchResult, _a1 error) *Searcher_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Searcher_Search_Call) RunAndReturn(run func(context.Context, asset.SearchConfig) ([]asset.SearchResult, error)) *Searcher_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearcher creates a new instance of Searcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Searcher {
+	mock := &Searcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
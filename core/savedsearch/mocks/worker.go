@@ -0,0 +1,86 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Worker is an autogenerated mock type for the Worker type
+type Worker struct {
+	mock.Mock
+}
+
+type Worker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Worker) EXPECT() *Worker_Expecter {
+	return &Worker_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueRunSavedSearchJob provides a mock function with given fields: ctx, id, runAt
+func (_m *Worker) EnqueueRunSavedSearchJob(ctx context.Context, id string, runAt time.Time) error {
+	ret := _m.Called(ctx, id, runAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueRunSavedSearchJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, runAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worker_EnqueueRunSavedSearchJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueRunSavedSearchJob'
+type Worker_EnqueueRunSavedSearchJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueRunSavedSearchJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - runAt time.Time
+func (_e *Worker_Expecter) EnqueueRunSavedSearchJob(ctx interface{}, id interface{}, runAt interface{}) *Worker_EnqueueRunSavedSearchJob_Call {
+	return &Worker_EnqueueRunSavedSearchJob_Call{Call: _e.mock.On("EnqueueRunSavedSearchJob", ctx, id, runAt)}
+}
+
+func (_c *Worker_EnqueueRunSavedSearchJob_Call) Run(run func(ctx context.Context, id string, runAt time.Time)) *Worker_EnqueueRunSavedSearchJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Worker_EnqueueRunSavedSearchJob_Call) Return(_a0 error) *Worker_EnqueueRunSavedSearchJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_EnqueueRunSavedSearchJob_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *Worker_EnqueueRunSavedSearchJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorker creates a new instance of Worker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Worker {
+	mock := &Worker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+package savedsearch
+
+//go:generate mockery --name=Repository -r --case underscore --with-expecter --structname SavedSearchRepository --filename saved_search_repository.go --output=./mocks
+//go:generate mockery --name=Searcher -r --case underscore --with-expecter --structname Searcher --filename searcher.go --output=./mocks
+//go:generate mockery --name=Notifier -r --case underscore --with-expecter --structname Notifier --filename notifier.go --output=./mocks
+//go:generate mockery --name=Worker -r --case underscore --with-expecter --structname Worker --filename worker.go --output=./mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+)
+
+type Repository interface {
+	Create(ctx context.Context, ss *SavedSearch) (string, error)
+	GetByID(ctx context.Context, id string) (SavedSearch, error)
+	GetAllByUserID(ctx context.Context, userID string) ([]SavedSearch, error)
+	Delete(ctx context.Context, userID, id string) error
+	// InsertMatches records the assets matching the saved search and
+	// returns the IDs of the ones that were not recorded before. Matches
+	// recorded as initial are not returned by GetMatches.
+	InsertMatches(ctx context.Context, id string, assetIDs []string, initial bool) ([]string, error)
+	GetMatches(ctx context.Context, id string, flt Filter) ([]Match, error)
+	UpdateLastRunAt(ctx context.Context, id string, lastRunAt time.Time) error
+	// GetDueIDs returns the IDs of the saved searches not run since the
+	// given time.
+	GetDueIDs(ctx context.Context, since time.Time) ([]string, error)
+}
+
+// Searcher runs the search query of a saved search.
+type Searcher interface {
+	Search(ctx context.Context, cfg asset.SearchConfig) ([]asset.SearchResult, error)
+}
+
+// Notifier tells the subscriber of a saved search about new matches.
+type Notifier interface {
+	Notify(ctx context.Context, ss SavedSearch, matches []Match) error
+}
+
+type Worker interface {
+	EnqueueRunSavedSearchJob(ctx context.Context, id string, runAt time.Time) error
+}
+
+// SavedSearch is a search query saved by a user, it is run periodically
+// to find the assets that newly match it.
+type SavedSearch struct {
+	ID         string             `json:"id"`
+	UserID     string             `json:"user_id"`
+	Name       string             `json:"name"`
+	Text       string             `json:"text"`
+	Filters    asset.SearchFilter `json:"filters"`
+	Queries    map[string]string  `json:"queries"`
+	Flags      asset.SearchFlags  `json:"flags"`
+	WebhookURL string             `json:"webhook_url"`
+	LastRunAt  *time.Time         `json:"last_run_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+	UpdatedAt  time.Time          `json:"updated_at"`
+}
+
+// SearchConfig returns the configuration to run the search query with, for
+// the viewer the assets are matched for.
+func (ss SavedSearch) SearchConfig(maxResults int, viewer *asset.Viewer) asset.SearchConfig {
+	return asset.SearchConfig{
+		Text:          ss.Text,
+		Filters:       ss.Filters,
+		Queries:       ss.Queries,
+		Flags:         ss.Flags,
+		MaxResults:    maxResults,
+		IncludeFields: []string{"id", "urn", "type", "service", "name", "description"},
+		Viewer:        viewer,
+	}
+}
+
+// Match is an asset that started matching a saved search.
+type Match struct {
+	Asset     asset.Asset `json:"asset"`
+	MatchedAt time.Time   `json:"matched_at"`
+}
+
+// Filter is a config of saved search matches
+type Filter struct {
+	// Number of relevant results to return
+	Size int
+
+	// Offset is a data offset in the table rows
+	Offset int
+}
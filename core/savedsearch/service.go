@@ -0,0 +1,216 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/salt/log"
+)
+
+type Config struct {
+	// MaxResults caps the number of assets matched by a single run.
+	MaxResults int `mapstructure:"max_results" default:"1000"`
+	// WebhookAllowedHosts are the hosts the matches may be posted to even
+	// though they resolve to loopback, private or link-local addresses.
+	WebhookAllowedHosts []string `mapstructure:"webhook_allowed_hosts"`
+}
+
+type ServiceDeps struct {
+	Repo     Repository
+	Searcher Searcher
+	Notifier Notifier
+	Worker   Worker
+	// UserRepo and TeamRepo resolve the groups of the owner of a saved
+	// search when running it.
+	UserRepo user.Repository
+	TeamRepo team.Repository
+	Logger   log.Logger
+	Config   Config
+}
+
+type Service struct {
+	repo     Repository
+	searcher Searcher
+	notifier Notifier
+	worker   Worker
+	userRepo user.Repository
+	teamRepo team.Repository
+	logger   log.Logger
+	config   Config
+}
+
+func NewService(deps ServiceDeps) *Service {
+	return &Service{
+		repo:     deps.Repo,
+		searcher: deps.Searcher,
+		notifier: deps.Notifier,
+		worker:   deps.Worker,
+		userRepo: deps.UserRepo,
+		teamRepo: deps.TeamRepo,
+		logger:   deps.Logger,
+		config:   deps.Config,
+	}
+}
+
+// Create saves the search and schedules its first run, which records the
+// assets that already match it.
+func (s *Service) Create(ctx context.Context, ss *SavedSearch) (string, error) {
+	if ss.UserID == "" {
+		return "", ErrEmptyUserID
+	}
+	ss.Name = strings.TrimSpace(ss.Name)
+	if ss.Name == "" {
+		return "", ErrEmptyName
+	}
+	ss.Text = strings.TrimSpace(ss.Text)
+	if ss.Text == "" && len(ss.Filters) == 0 && len(ss.Queries) == 0 {
+		return "", ErrEmptyQuery
+	}
+	if ss.WebhookURL != "" && !isHTTPURL(ss.WebhookURL) {
+		return "", ErrInvalidWebhookURL
+	}
+
+	id, err := s.repo.Create(ctx, ss)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.worker.EnqueueRunSavedSearchJob(ctx, id, time.Now()); err != nil {
+		return "", fmt.Errorf("schedule saved search run: %w", err)
+	}
+
+	return id, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, userID, id string) (SavedSearch, error) {
+	ss, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return SavedSearch{}, err
+	}
+	// saved searches of other users are not disclosed
+	if ss.UserID != userID {
+		return SavedSearch{}, NotFoundError{ID: id}
+	}
+
+	return ss, nil
+}
+
+func (s *Service) GetByUserID(ctx context.Context, userID string) ([]SavedSearch, error) {
+	return s.repo.GetAllByUserID(ctx, userID)
+}
+
+func (s *Service) Delete(ctx context.Context, userID, id string) error {
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// GetDueIDs returns the IDs of the saved searches due to run, the ones not
+// run since the given time.
+func (s *Service) GetDueIDs(ctx context.Context, since time.Time) ([]string, error) {
+	return s.repo.GetDueIDs(ctx, since)
+}
+
+func (s *Service) GetMatches(ctx context.Context, userID, id string, flt Filter) ([]Match, error) {
+	if _, err := s.GetByID(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetMatches(ctx, id, flt)
+}
+
+// Run runs the saved search and records the assets matching it. The assets
+// that were not matching on the previous run are returned and notified to
+// the webhook of the saved search, if any. The first run only records the
+// assets already matching.
+func (s *Service) Run(ctx context.Context, id string) ([]Match, error) {
+	ss, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := s.ownerViewer(ctx, ss.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve owner of saved search '%s': %w", id, err)
+	}
+
+	runAt := time.Now()
+	results, err := s.searcher.Search(ctx, ss.SearchConfig(s.config.MaxResults, viewer))
+	if err != nil {
+		return nil, fmt.Errorf("run saved search '%s': %w", id, err)
+	}
+
+	assetIDs := make([]string, 0, len(results))
+	resultsByID := make(map[string]asset.SearchResult, len(results))
+	for _, r := range results {
+		assetIDs = append(assetIDs, r.ID)
+		resultsByID[r.ID] = r
+	}
+
+	initial := ss.LastRunAt == nil
+	newIDs, err := s.repo.InsertMatches(ctx, id, assetIDs, initial)
+	if err != nil {
+		return nil, fmt.Errorf("record matches of saved search '%s': %w", id, err)
+	}
+
+	if err := s.repo.UpdateLastRunAt(ctx, id, runAt); err != nil {
+		return nil, fmt.Errorf("update last run of saved search '%s': %w", id, err)
+	}
+
+	if initial || len(newIDs) == 0 {
+		return nil, nil
+	}
+
+	matches := make([]Match, 0, len(newIDs))
+	for _, assetID := range newIDs {
+		matches = append(matches, Match{
+			Asset:     resultsByID[assetID].ToAsset(),
+			MatchedAt: runAt,
+		})
+	}
+
+	if ss.WebhookURL != "" && s.notifier != nil {
+		// matches are already recorded, a failed notification is not retried
+		if err := s.notifier.Notify(ctx, ss, matches); err != nil {
+			s.logger.Warn("failed to notify saved search matches", "id", id, "err", err)
+		}
+	}
+
+	return matches, nil
+}
+
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ownerViewer returns the viewer a saved search of the user is run for, the
+// groups of the user being the teams it is currently a member of. The users
+// gone or inactive see no restricted asset.
+func (s *Service) ownerViewer(ctx context.Context, userID string) (*asset.Viewer, error) {
+	owner, err := s.userRepo.GetByID(ctx, userID)
+	if errors.As(err, new(user.NotFoundError)) {
+		return &asset.Viewer{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if owner.Inactive {
+		return &asset.Viewer{}, nil
+	}
+
+	teams, err := s.teamRepo.GetAll(ctx, team.Filter{MemberID: owner.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &asset.Viewer{Groups: team.Names(teams)}, nil
+}
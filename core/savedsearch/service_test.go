@@ -0,0 +1,278 @@
+package savedsearch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/core/savedsearch/mocks"
+	"github.com/goto/compass/core/team"
+	teammocks "github.com/goto/compass/core/team/mocks"
+	"github.com/goto/compass/core/user"
+	usermocks "github.com/goto/compass/core/user/mocks"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type serviceMocks struct {
+	repo     *mocks.SavedSearchRepository
+	searcher *mocks.Searcher
+	notifier *mocks.Notifier
+	worker   *mocks.Worker
+	users    *usermocks.UserRepository
+	teams    *teammocks.TeamRepository
+}
+
+func newService(t *testing.T) (*savedsearch.Service, serviceMocks) {
+	m := serviceMocks{
+		repo:     mocks.NewSavedSearchRepository(t),
+		searcher: mocks.NewSearcher(t),
+		notifier: mocks.NewNotifier(t),
+		worker:   mocks.NewWorker(t),
+		users:    usermocks.NewUserRepository(t),
+		teams:    teammocks.NewTeamRepository(t),
+	}
+	svc := savedsearch.NewService(savedsearch.ServiceDeps{
+		Repo:     m.repo,
+		Searcher: m.searcher,
+		Notifier: m.notifier,
+		Worker:   m.worker,
+		UserRepo: m.users,
+		TeamRepo: m.teams,
+		Logger:   log.NewNoop(),
+		Config:   savedsearch.Config{MaxResults: 100},
+	})
+	return svc, m
+}
+
+func TestService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Description string
+		SavedSearch savedsearch.SavedSearch
+		Setup       func(serviceMocks)
+		ExpectedID  string
+		ExpectedErr error
+	}{
+		{
+			Description: "should return error if user id is empty",
+			SavedSearch: savedsearch.SavedSearch{Name: "pii", Text: "pii"},
+			ExpectedErr: savedsearch.ErrEmptyUserID,
+		},
+		{
+			Description: "should return error if name is empty",
+			SavedSearch: savedsearch.SavedSearch{UserID: "user-1", Name: " ", Text: "pii"},
+			ExpectedErr: savedsearch.ErrEmptyName,
+		},
+		{
+			Description: "should return error if there is nothing to search for",
+			SavedSearch: savedsearch.SavedSearch{UserID: "user-1", Name: "pii"},
+			ExpectedErr: savedsearch.ErrEmptyQuery,
+		},
+		{
+			Description: "should return error if webhook url is not an http url",
+			SavedSearch: savedsearch.SavedSearch{UserID: "user-1", Name: "pii", Text: "pii", WebhookURL: "ftp://example.com"},
+			ExpectedErr: savedsearch.ErrInvalidWebhookURL,
+		},
+		{
+			Description: "should return error if repository fails",
+			SavedSearch: savedsearch.SavedSearch{UserID: "user-1", Name: "pii", Filters: asset.SearchFilter{"labels.pii": {"true"}}},
+			Setup: func(m serviceMocks) {
+				m.repo.EXPECT().Create(ctx, mock.Anything).Return("", errors.New("some error"))
+			},
+			ExpectedErr: errors.New("some error"),
+		},
+		{
+			Description: "should create saved search and schedule its first run",
+			SavedSearch: savedsearch.SavedSearch{UserID: "user-1", Name: "pii", Filters: asset.SearchFilter{"labels.pii": {"true"}}},
+			Setup: func(m serviceMocks) {
+				m.repo.EXPECT().Create(ctx, mock.Anything).Return("ss-1", nil)
+				m.worker.EXPECT().EnqueueRunSavedSearchJob(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+			},
+			ExpectedID: "ss-1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			svc, m := newService(t)
+			if tc.Setup != nil {
+				tc.Setup(m)
+			}
+
+			id, err := svc.Create(ctx, &tc.SavedSearch)
+			if tc.ExpectedErr != nil {
+				assert.EqualError(t, err, tc.ExpectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedID, id)
+		})
+	}
+}
+
+func TestService_GetMatches(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not disclose saved search of another user", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(savedsearch.SavedSearch{ID: "ss-1", UserID: "user-2"}, nil)
+
+		_, err := svc.GetMatches(ctx, "user-1", "ss-1", savedsearch.Filter{})
+		assert.ErrorIs(t, err, savedsearch.NotFoundError{ID: "ss-1"})
+	})
+
+	t.Run("should return matches of own saved search", func(t *testing.T) {
+		svc, m := newService(t)
+		matches := []savedsearch.Match{{Asset: asset.Asset{ID: "asset-1"}}}
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(savedsearch.SavedSearch{ID: "ss-1", UserID: "user-1"}, nil)
+		m.repo.EXPECT().GetMatches(ctx, "ss-1", savedsearch.Filter{Size: 10}).Return(matches, nil)
+
+		actual, err := svc.GetMatches(ctx, "user-1", "ss-1", savedsearch.Filter{Size: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, matches, actual)
+	})
+}
+
+func TestService_Run(t *testing.T) {
+	ctx := context.Background()
+	lastRunAt := time.Now().Add(-time.Hour)
+	ss := savedsearch.SavedSearch{
+		ID:         "ss-1",
+		UserID:     "user-1",
+		Name:       "pii",
+		Filters:    asset.SearchFilter{"labels.pii": {"true"}},
+		WebhookURL: "http://example.com/hook",
+		LastRunAt:  &lastRunAt,
+	}
+	results := []asset.SearchResult{
+		{ID: "asset-1", URN: "urn-1", Type: "table"},
+		{ID: "asset-2", URN: "urn-2", Type: "table"},
+	}
+	viewer := &asset.Viewer{Groups: []string{"finance"}}
+	expectOwner := func(m serviceMocks) {
+		m.users.EXPECT().GetByID(ctx, "user-1").Return(user.User{ID: "user-1", Email: "user@example.com"}, nil)
+		m.teams.EXPECT().GetAll(ctx, team.Filter{MemberID: "user-1"}).Return([]team.Team{{Name: "finance"}}, nil)
+	}
+
+	t.Run("should return error if saved search does not exist", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(savedsearch.SavedSearch{}, savedsearch.NotFoundError{ID: "ss-1"})
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.ErrorIs(t, err, savedsearch.NotFoundError{ID: "ss-1"})
+	})
+
+	t.Run("should return error if search fails", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		expectOwner(m)
+		m.searcher.EXPECT().Search(ctx, ss.SearchConfig(100, viewer)).Return(nil, errors.New("some error"))
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("should only search the assets the current teams of the owner can see", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		expectOwner(m)
+		m.searcher.EXPECT().Search(ctx, mock.Anything).
+			Run(func(_ context.Context, cfg asset.SearchConfig) {
+				assert.Equal(t, viewer, cfg.Viewer)
+			}).
+			Return(nil, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{}, false).Return(nil, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not search the restricted assets if the owner is gone", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		m.users.EXPECT().GetByID(ctx, "user-1").Return(user.User{}, user.NotFoundError{ID: "user-1"})
+		m.searcher.EXPECT().Search(ctx, ss.SearchConfig(100, &asset.Viewer{})).Return(nil, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{}, false).Return(nil, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not search the restricted assets if the owner is inactive", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		m.users.EXPECT().GetByID(ctx, "user-1").Return(user.User{ID: "user-1", Inactive: true}, nil)
+		m.searcher.EXPECT().Search(ctx, ss.SearchConfig(100, &asset.Viewer{})).Return(nil, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{}, false).Return(nil, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error if teams of the owner can not be fetched", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		m.users.EXPECT().GetByID(ctx, "user-1").Return(user.User{ID: "user-1"}, nil)
+		m.teams.EXPECT().GetAll(ctx, team.Filter{MemberID: "user-1"}).Return(nil, errors.New("some error"))
+
+		_, err := svc.Run(ctx, "ss-1")
+		assert.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("should only record matches on first run", func(t *testing.T) {
+		svc, m := newService(t)
+		first := ss
+		first.LastRunAt = nil
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(first, nil)
+		expectOwner(m)
+		m.searcher.EXPECT().Search(ctx, first.SearchConfig(100, viewer)).Return(results, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{"asset-1", "asset-2"}, true).Return([]string{"asset-1", "asset-2"}, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+		matches, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+
+	t.Run("should notify new matches", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		expectOwner(m)
+		m.searcher.EXPECT().Search(ctx, ss.SearchConfig(100, viewer)).Return(results, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{"asset-1", "asset-2"}, false).Return([]string{"asset-2"}, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+		m.notifier.EXPECT().Notify(ctx, ss, mock.Anything).
+			Run(func(_ context.Context, _ savedsearch.SavedSearch, matches []savedsearch.Match) {
+				assert.Len(t, matches, 1)
+				assert.Equal(t, "asset-2", matches[0].Asset.ID)
+			}).
+			Return(errors.New("webhook is down"))
+
+		matches, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+		assert.Len(t, matches, 1)
+		assert.Equal(t, "urn-2", matches[0].Asset.URN)
+	})
+
+	t.Run("should not notify when there is no new match", func(t *testing.T) {
+		svc, m := newService(t)
+		m.repo.EXPECT().GetByID(ctx, "ss-1").Return(ss, nil)
+		expectOwner(m)
+		m.searcher.EXPECT().Search(ctx, ss.SearchConfig(100, viewer)).Return(results, nil)
+		m.repo.EXPECT().InsertMatches(ctx, "ss-1", []string{"asset-1", "asset-2"}, false).Return(nil, nil)
+		m.repo.EXPECT().UpdateLastRunAt(ctx, "ss-1", mock.AnythingOfType("time.Time")).Return(nil)
+
+		matches, err := svc.Run(ctx, "ss-1")
+		assert.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+}
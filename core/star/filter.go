@@ -1,5 +1,7 @@
 package star
 
+import "github.com/goto/compass/core/asset"
+
 const (
 	SortKeyCreated             = "created"
 	SortKeyUpdated             = "updated"
@@ -20,4 +22,7 @@ type Filter struct {
 
 	// SortDirection of sort, ascending/descending
 	SortDirection string
+
+	// Viewer filters out the starred assets it can not see, if set
+	Viewer *asset.Viewer
 }
@@ -0,0 +1,274 @@
+package tag
+
+//go:generate mockery --name=BulkTagJobRepository -r --case underscore --with-expecter --structname BulkTagJobRepository --filename bulk_tag_job_repository.go --output=./mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/queryexpr"
+)
+
+// BulkTagRequest applies the values of a template to many assets, or removes
+// the tags of the template from them. The assets are given either by id or
+// urn, or as a query expression on their fields. The actor is the user
+// starting the job, the tags are recorded in their history as changed by them.
+type BulkTagRequest struct {
+	TemplateURN string     `json:"template_urn"`
+	Assets      []string   `json:"assets"`
+	QueryExpr   string     `json:"query_expr"`
+	TagValues   []TagValue `json:"tag_values"`
+	Remove      bool       `json:"remove"`
+	Actor       string     `json:"actor,omitempty"`
+	// JobID is the recorded job the outcome of the tagging is stored in
+	JobID string `json:"job_id,omitempty"`
+}
+
+// BulkTagReport is the outcome of a bulk tagging for each of the assets
+type BulkTagReport struct {
+	TemplateURN       string           `json:"template_urn"`
+	Assets            int              `json:"assets"`
+	SucceededAssetIDs []string         `json:"succeeded_asset_ids"`
+	Failures          []BulkTagFailure `json:"failures"`
+	// JobID is the id of the job tagging the assets on the worker, it is
+	// only set when the job is recorded
+	JobID string `json:"job_id,omitempty"`
+}
+
+// BulkTagFailure is an asset which could not be tagged or untagged, the asset
+// is the given id or urn if it could not be found
+type BulkTagFailure struct {
+	Asset  string `json:"asset"`
+	Reason string `json:"reason"`
+}
+
+// BulkTagJobRepository stores the bulk taggings run on the worker, along with
+// their outcome
+type BulkTagJobRepository interface {
+	Create(ctx context.Context, job *BulkTagJob) error
+	Complete(ctx context.Context, job BulkTagJob) error
+	GetByID(ctx context.Context, id string) (BulkTagJob, error)
+}
+
+type BulkTagJobStatus string
+
+const (
+	BulkTagJobPending BulkTagJobStatus = "pending"
+	BulkTagJobDone    BulkTagJobStatus = "done"
+	BulkTagJobFailed  BulkTagJobStatus = "failed"
+)
+
+// BulkTagJob is a bulk tagging run on the worker. The report is set once it
+// is done, the error of its last attempt once it failed. A failed job may
+// still be done by a later attempt.
+type BulkTagJob struct {
+	ID          string           `json:"id"`
+	TemplateURN string           `json:"template_urn"`
+	Remove      bool             `json:"remove"`
+	Status      BulkTagJobStatus `json:"status"`
+	Report      BulkTagReport    `json:"report"`
+	Error       string           `json:"error"`
+	CreatedBy   string           `json:"created_by"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// PlanBulkTag is a dry run of the bulk tagging, it reports the assets which
+// would fail without tagging any of them
+func (s *Service) PlanBulkTag(ctx context.Context, req BulkTagRequest) (BulkTagReport, error) {
+	return s.bulkTag(ctx, req, true)
+}
+
+// BulkTagAssets tags or untags the assets one by one, the assets failing are
+// reported without stopping the others. The outcome is stored in the job of
+// the request, if any.
+func (s *Service) BulkTagAssets(ctx context.Context, req BulkTagRequest) (BulkTagReport, error) {
+	report, err := s.bulkTag(ctx, req, false)
+	if req.JobID == "" || s.bulkTagJobRepo == nil {
+		return report, err
+	}
+
+	job := BulkTagJob{ID: req.JobID, Status: BulkTagJobDone, Report: report}
+	if err != nil {
+		job.Status, job.Error = BulkTagJobFailed, err.Error()
+	}
+	if completeErr := s.bulkTagJobRepo.Complete(ctx, job); completeErr != nil {
+		return report, errors.Join(err, fmt.Errorf("error recording bulk tagging job: %w", completeErr))
+	}
+	report.JobID = req.JobID
+	return report, err
+}
+
+// StartBulkTag reports a dry run of the bulk tagging and enqueues the job
+// tagging the assets, along with the id of its record if the jobs are
+// recorded. Without a worker, the assets are tagged right away.
+func (s *Service) StartBulkTag(ctx context.Context, req BulkTagRequest) (BulkTagReport, error) {
+	if s.worker == nil {
+		return s.BulkTagAssets(ctx, req)
+	}
+
+	report, err := s.PlanBulkTag(ctx, req)
+	if err != nil {
+		return BulkTagReport{}, err
+	}
+	if report.Assets == len(report.Failures) {
+		return report, nil
+	}
+
+	req.Actor = user.FromContext(ctx).Email
+	if s.bulkTagJobRepo != nil {
+		job := BulkTagJob{
+			TemplateURN: report.TemplateURN,
+			Remove:      req.Remove,
+			Status:      BulkTagJobPending,
+			CreatedBy:   req.Actor,
+		}
+		if err := s.bulkTagJobRepo.Create(ctx, &job); err != nil {
+			return BulkTagReport{}, fmt.Errorf("error recording bulk tagging job: %w", err)
+		}
+		req.JobID, report.JobID = job.ID, job.ID
+	}
+
+	if err := s.worker.EnqueueBulkTagAssetsJob(ctx, req); err != nil {
+		if req.JobID != "" {
+			failed := BulkTagJob{ID: req.JobID, Status: BulkTagJobFailed, Error: err.Error()}
+			if completeErr := s.bulkTagJobRepo.Complete(ctx, failed); completeErr != nil {
+				err = errors.Join(err, completeErr)
+			}
+		}
+		return BulkTagReport{}, fmt.Errorf("error enqueueing bulk tagging: %w", err)
+	}
+	return report, nil
+}
+
+// GetBulkTagJob returns the recorded bulk tagging job
+func (s *Service) GetBulkTagJob(ctx context.Context, id string) (BulkTagJob, error) {
+	if s.bulkTagJobRepo == nil {
+		return BulkTagJob{}, errors.New("bulk tagging jobs are not recorded")
+	}
+	return s.bulkTagJobRepo.GetByID(ctx, id)
+}
+
+func (s *Service) bulkTag(ctx context.Context, req BulkTagRequest, dryRun bool) (BulkTagReport, error) {
+	if s.assetRepo == nil {
+		return BulkTagReport{}, errors.New("bulk tagging needs the asset repository to find the assets")
+	}
+	if (len(req.Assets) == 0) == (req.QueryExpr == "") {
+		return BulkTagReport{}, buildFieldError("assets", "either assets or query_expr should be given")
+	}
+
+	template, err := s.templateService.GetTemplate(ctx, req.TemplateURN)
+	if err != nil {
+		return BulkTagReport{}, err
+	}
+	if !req.Remove {
+		if len(req.TagValues) == 0 {
+			return BulkTagReport{}, buildFieldError("tag_values", "cannot be empty")
+		}
+		if err := s.validateTagValues(ctx, Tag{TemplateURN: template.URN, TagValues: req.TagValues}, template); err != nil {
+			return BulkTagReport{}, err
+		}
+	}
+
+	assetIDs, failures, err := s.findBulkTagAssets(ctx, req)
+	if err != nil {
+		return BulkTagReport{}, err
+	}
+
+	report := BulkTagReport{
+		TemplateURN: template.URN,
+		Assets:      len(assetIDs) + len(failures),
+		Failures:    failures,
+	}
+	for _, assetID := range assetIDs {
+		if err := s.bulkTagAsset(ctx, req, template.URN, assetID, dryRun); err != nil {
+			report.Failures = append(report.Failures, BulkTagFailure{
+				Asset:  assetID,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		if !dryRun {
+			report.SucceededAssetIDs = append(report.SucceededAssetIDs, assetID)
+		}
+	}
+
+	return report, nil
+}
+
+// findBulkTagAssets returns the ids of the assets to tag, the given assets
+// which do not exist are reported as failures
+func (s *Service) findBulkTagAssets(ctx context.Context, req BulkTagRequest) ([]string, []BulkTagFailure, error) {
+	if req.QueryExpr != "" {
+		expr := asset.SelectAssetExpr{
+			ExprStr: queryexpr.SQLExpr(fmt.Sprintf("(%s) && is_deleted == false", req.QueryExpr)),
+		}
+		if err := expr.Validate(); err != nil {
+			return nil, nil, buildFieldError("query_expr", err.Error())
+		}
+		ids, err := s.assetRepo.GetIDsByQueryExpr(ctx, expr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error finding assets by query: %w", err)
+		}
+		return ids, nil, nil
+	}
+
+	var (
+		ids      []string
+		failures []BulkTagFailure
+	)
+	for _, idOrURN := range req.Assets {
+		var (
+			ast asset.Asset
+			err error
+		)
+		if _, parseErr := uuid.Parse(idOrURN); parseErr == nil {
+			ast, err = s.assetRepo.GetByID(ctx, idOrURN)
+		} else {
+			ast, err = s.assetRepo.GetByURN(ctx, idOrURN)
+		}
+		if errors.As(err, new(asset.NotFoundError)) || (err == nil && ast.IsDeleted) {
+			failures = append(failures, BulkTagFailure{Asset: idOrURN, Reason: "asset not found"})
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error finding asset [%s]: %w", idOrURN, err)
+		}
+		if !slices.Contains(ids, ast.ID) {
+			ids = append(ids, ast.ID)
+		}
+	}
+	return ids, failures, nil
+}
+
+// bulkTagAsset replaces the values of the tag of the asset, or creates it if
+// the asset is not tagged with the template yet
+func (s *Service) bulkTagAsset(ctx context.Context, req BulkTagRequest, templateURN, assetID string, dryRun bool) error {
+	existingTags, err := s.repository.Read(ctx, Tag{AssetID: assetID, TemplateURN: templateURN})
+	if err != nil && !errors.As(err, new(NotFoundError)) {
+		return fmt.Errorf("error reading tag: %w", err)
+	}
+	isTagged := len(existingTags) > 0
+
+	tag := Tag{AssetID: assetID, TemplateURN: templateURN}
+	switch {
+	case req.Remove && !isTagged:
+		return NotFoundError{AssetID: assetID, Template: templateURN}
+	case dryRun:
+		return nil
+	case req.Remove:
+		return s.repository.Delete(ctx, tag)
+	}
+
+	tag.TagValues = slices.Clone(req.TagValues)
+	if !isTagged {
+		return s.repository.Create(ctx, &tag)
+	}
+	return s.repository.Update(ctx, &tag)
+}
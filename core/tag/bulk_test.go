@@ -0,0 +1,258 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goto/compass/core/asset"
+	assetmocks "github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/tag/mocks"
+	"github.com/goto/compass/core/user"
+	usermocks "github.com/goto/compass/core/user/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceTestSuite) TestBulkTagAssets() {
+	ctx := context.TODO()
+
+	const (
+		assetA = "8d4c3b3e-2b4f-4f43-a1b5-77ad8e1d1c56"
+		assetB = "1f8b2d6e-5f35-4a8f-9d71-0b9f2b4e2f0a"
+	)
+	taggingReq := func() tag.BulkTagRequest {
+		return tag.BulkTagRequest{
+			TemplateURN: "governance_policy",
+			Assets:      []string{assetA, "urn:b", "urn:missing"},
+			TagValues:   s.buildTag().TagValues,
+		}
+	}
+	setupAssets := func(assetRepo *assetmocks.AssetRepository) {
+		assetRepo.EXPECT().GetByID(mock.Anything, assetA).Return(asset.Asset{ID: assetA}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:b").Return(asset.Asset{ID: assetB}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:missing").Return(asset.Asset{}, asset.NotFoundError{URN: "urn:missing"})
+	}
+	newService := func(opts ...tag.ServiceOption) (*tag.Service, *assetmocks.AssetRepository) {
+		assetRepo := assetmocks.NewAssetRepository(s.T())
+		opts = append(opts, tag.WithReferenceRepositories(usermocks.NewUserRepository(s.T()), assetRepo))
+		return tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), opts...), assetRepo
+	}
+
+	s.Run("should return error if neither or both of assets and query are given", func() {
+		s.Setup()
+		tagService, _ := newService()
+
+		_, err := tagService.PlanBulkTag(ctx, tag.BulkTagRequest{TemplateURN: "governance_policy"})
+		s.EqualError(err, "error with [assets : either assets or query_expr should be given]")
+
+		req := taggingReq()
+		req.QueryExpr = `service == "bigquery"`
+		_, err = tagService.PlanBulkTag(ctx, req)
+		s.EqualError(err, "error with [assets : either assets or query_expr should be given]")
+	})
+
+	s.Run("should return error if template is not found", func() {
+		s.Setup()
+		tagService, _ := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{}, nil)
+
+		_, err := tagService.PlanBulkTag(ctx, taggingReq())
+
+		s.ErrorIs(err, tag.TemplateNotFoundError{URN: "governance_policy"})
+	})
+
+	s.Run("should return error if tag values are not valid", func() {
+		s.Setup()
+		tagService, _ := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+
+		req := taggingReq()
+		req.TagValues = req.TagValues[2:]
+		_, err := tagService.PlanBulkTag(ctx, req)
+
+		s.ErrorAs(err, new(tag.ValidationError))
+	})
+
+	s.Run("should return error if query expression is not valid", func() {
+		s.Setup()
+		tagService, _ := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+
+		req := taggingReq()
+		req.Assets = nil
+		req.QueryExpr = `unknown_field == "x"`
+		_, err := tagService.PlanBulkTag(ctx, req)
+
+		s.ErrorAs(err, new(tag.ValidationError))
+		s.ErrorContains(err, "query_expr")
+	})
+
+	s.Run("should report the assets not found without tagging on dry run", func() {
+		s.Setup()
+		tagService, assetRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, tag.NotFoundError{})
+
+		report, err := tagService.PlanBulkTag(ctx, taggingReq())
+
+		s.NoError(err)
+		s.Equal(3, report.Assets)
+		s.Empty(report.SucceededAssetIDs)
+		s.Equal([]tag.BulkTagFailure{{Asset: "urn:missing", Reason: "asset not found"}}, report.Failures)
+		s.repository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.repository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should create or replace the tags of the assets and report the failures", func() {
+		s.Setup()
+		tagService, assetRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetA, TemplateURN: "governance_policy"}).Return(nil, tag.NotFoundError{})
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetB, TemplateURN: "governance_policy"}).Return([]tag.Tag{s.buildTag()}, nil)
+		s.repository.EXPECT().Create(mock.Anything, mock.MatchedBy(func(t *tag.Tag) bool { return t.AssetID == assetA })).Return(nil)
+		s.repository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *tag.Tag) bool { return t.AssetID == assetB })).Return(errors.New("random error"))
+
+		report, err := tagService.BulkTagAssets(ctx, taggingReq())
+
+		s.NoError(err)
+		s.Equal(3, report.Assets)
+		s.Equal([]string{assetA}, report.SucceededAssetIDs)
+		s.Equal([]tag.BulkTagFailure{
+			{Asset: "urn:missing", Reason: "asset not found"},
+			{Asset: assetB, Reason: "random error"},
+		}, report.Failures)
+	})
+
+	s.Run("should remove the tags of the assets found by query", func() {
+		s.Setup()
+		tagService, assetRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		assetRepo.EXPECT().GetIDsByQueryExpr(mock.Anything, mock.Anything).Return([]string{assetA, assetB}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetA, TemplateURN: "governance_policy"}).Return([]tag.Tag{s.buildTag()}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetB, TemplateURN: "governance_policy"}).Return(nil, tag.NotFoundError{})
+		s.repository.EXPECT().Delete(mock.Anything, tag.Tag{AssetID: assetA, TemplateURN: "governance_policy"}).Return(nil)
+
+		report, err := tagService.BulkTagAssets(ctx, tag.BulkTagRequest{
+			TemplateURN: "governance_policy",
+			QueryExpr:   `service == "bigquery"`,
+			Remove:      true,
+		})
+
+		s.NoError(err)
+		s.Equal(2, report.Assets)
+		s.Equal([]string{assetA}, report.SucceededAssetIDs)
+		s.Require().Len(report.Failures, 1)
+		s.Equal(assetB, report.Failures[0].Asset)
+	})
+
+	s.Run("should enqueue the bulk tagging after a dry run if worker is given", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		tagService, assetRepo := newService(tag.WithWorker(wrkr))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, tag.NotFoundError{})
+		enqueued := taggingReq()
+		enqueued.Actor = "user@example.com"
+		wrkr.EXPECT().EnqueueBulkTagAssetsJob(mock.Anything, enqueued).Return(nil)
+
+		report, err := tagService.StartBulkTag(user.NewContext(ctx, user.User{Email: "user@example.com"}), taggingReq())
+
+		s.NoError(err)
+		s.Equal(3, report.Assets)
+		s.Len(report.Failures, 1)
+	})
+
+	s.Run("should record the job of the bulk tagging when enqueueing it", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		jobRepo := mocks.NewBulkTagJobRepository(s.T())
+		tagService, assetRepo := newService(tag.WithWorker(wrkr), tag.WithBulkTagJobRepository(jobRepo))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, tag.NotFoundError{})
+		jobRepo.EXPECT().Create(mock.Anything, &tag.BulkTagJob{
+			TemplateURN: "governance_policy",
+			Status:      tag.BulkTagJobPending,
+			CreatedBy:   "user@example.com",
+		}).Run(func(_ context.Context, job *tag.BulkTagJob) {
+			job.ID = "job-id"
+		}).Return(nil)
+		enqueued := taggingReq()
+		enqueued.Actor, enqueued.JobID = "user@example.com", "job-id"
+		wrkr.EXPECT().EnqueueBulkTagAssetsJob(mock.Anything, enqueued).Return(nil)
+
+		report, err := tagService.StartBulkTag(user.NewContext(ctx, user.User{Email: "user@example.com"}), taggingReq())
+
+		s.NoError(err)
+		s.Equal("job-id", report.JobID)
+	})
+
+	s.Run("should mark the job as failed if the bulk tagging can not be enqueued", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		jobRepo := mocks.NewBulkTagJobRepository(s.T())
+		tagService, assetRepo := newService(tag.WithWorker(wrkr), tag.WithBulkTagJobRepository(jobRepo))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, tag.NotFoundError{})
+		jobRepo.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, job *tag.BulkTagJob) {
+			job.ID = "job-id"
+		}).Return(nil)
+		wrkr.EXPECT().EnqueueBulkTagAssetsJob(mock.Anything, mock.Anything).Return(errors.New("some error"))
+		jobRepo.EXPECT().Complete(mock.Anything, tag.BulkTagJob{
+			ID:     "job-id",
+			Status: tag.BulkTagJobFailed,
+			Error:  "some error",
+		}).Return(nil)
+
+		_, err := tagService.StartBulkTag(ctx, taggingReq())
+
+		s.EqualError(err, "error enqueueing bulk tagging: some error")
+	})
+
+	s.Run("should store the outcome of the bulk tagging in its job", func() {
+		s.Setup()
+		jobRepo := mocks.NewBulkTagJobRepository(s.T())
+		tagService, assetRepo := newService(tag.WithBulkTagJobRepository(jobRepo))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		setupAssets(assetRepo)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, tag.NotFoundError{})
+		s.repository.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		jobRepo.EXPECT().Complete(mock.Anything, tag.BulkTagJob{
+			ID:     "job-id",
+			Status: tag.BulkTagJobDone,
+			Report: tag.BulkTagReport{
+				TemplateURN:       "governance_policy",
+				Assets:            3,
+				SucceededAssetIDs: []string{assetA, assetB},
+				Failures:          []tag.BulkTagFailure{{Asset: "urn:missing", Reason: "asset not found"}},
+			},
+		}).Return(nil)
+
+		req := taggingReq()
+		req.JobID = "job-id"
+		report, err := tagService.BulkTagAssets(ctx, req)
+
+		s.NoError(err)
+		s.Equal("job-id", report.JobID)
+	})
+
+	s.Run("should not enqueue the bulk tagging if every asset would fail", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		tagService, assetRepo := newService(tag.WithWorker(wrkr))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:missing").Return(asset.Asset{}, asset.NotFoundError{URN: "urn:missing"})
+
+		req := taggingReq()
+		req.Assets = []string{"urn:missing"}
+		report, err := tagService.StartBulkTag(ctx, req)
+
+		s.NoError(err)
+		s.Equal(1, report.Assets)
+		s.Len(report.Failures, 1)
+	})
+}
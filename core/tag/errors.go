@@ -6,10 +6,19 @@ import (
 
 type NotFoundError struct {
 	AssetID  string
+	Column   string
 	Template string
 }
 
 func (e NotFoundError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf(
+			"could not find tag with asset id: \"%s\", column: \"%s\", template: \"%s\"",
+			e.AssetID,
+			e.Column,
+			e.Template,
+		)
+	}
 	return fmt.Sprintf(
 		"could not find tag with asset id: \"%s\", template: \"%s\"",
 		e.AssetID,
@@ -25,12 +34,24 @@ func (e TemplateNotFoundError) Error() string {
 	return fmt.Sprintf("could not find template \"%s\"", e.URN)
 }
 
+type BulkTagJobNotFoundError struct {
+	ID string
+}
+
+func (e BulkTagJobNotFoundError) Error() string {
+	return fmt.Sprintf("could not find bulk tagging job \"%s\"", e.ID)
+}
+
 type DuplicateError struct {
 	AssetID     string
+	Column      string
 	TemplateURN string
 }
 
 func (e DuplicateError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("tag of asset ID \"%s\", column \"%s\" and template URN \"%s\" already exists", e.AssetID, e.Column, e.TemplateURN)
+	}
 	return fmt.Sprintf("tag of asset ID \"%s\" and template URN \"%s\" already exists", e.AssetID, e.TemplateURN)
 }
 
@@ -0,0 +1,41 @@
+package tag
+
+import (
+	"context"
+	"time"
+)
+
+type HistoryAction string
+
+const (
+	HistoryActionCreate HistoryAction = "create"
+	HistoryActionUpdate HistoryAction = "update"
+	HistoryActionDelete HistoryAction = "delete"
+)
+
+// History is a change of the tag of a template on an asset, or on one of its
+// columns. The values before and after the change are given by field urn, as
+// they are stored.
+type History struct {
+	AssetID     string            `json:"asset_id"`
+	Column      string            `json:"column,omitempty"`
+	TemplateURN string            `json:"template_urn"`
+	Action      HistoryAction     `json:"action"`
+	Actor       string            `json:"actor"`
+	Before      map[string]string `json:"before"`
+	After       map[string]string `json:"after"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// GetTagHistory returns the changes of the tags of the template on the asset
+// and on its columns, the latest first. The history is kept after the tag is
+// deleted.
+func (s *Service) GetTagHistory(ctx context.Context, assetID, templateURN string) ([]History, error) {
+	if assetID == "" {
+		return nil, buildFieldError("asset_id", "cannot be empty")
+	}
+	if templateURN == "" {
+		return nil, buildFieldError("template_urn", "cannot be empty")
+	}
+	return s.repository.ReadHistory(ctx, assetID, templateURN)
+}
@@ -0,0 +1,52 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceTestSuite) TestGetTagHistory() {
+	ctx := context.TODO()
+
+	s.Run("should return error if asset id or template urn is empty", func() {
+		s.Setup()
+
+		_, err := s.tagService.GetTagHistory(ctx, "", "governance_policy")
+		s.EqualError(err, "error with [asset_id : cannot be empty]")
+
+		_, err = s.tagService.GetTagHistory(ctx, "asset-a", "")
+		s.EqualError(err, "error with [template_urn : cannot be empty]")
+	})
+
+	s.Run("should return error if history could not be read", func() {
+		s.Setup()
+		s.repository.EXPECT().ReadHistory(mock.Anything, "asset-a", "governance_policy").Return(nil, errors.New("random error"))
+
+		_, err := s.tagService.GetTagHistory(ctx, "asset-a", "governance_policy")
+
+		s.EqualError(err, "random error")
+	})
+
+	s.Run("should return the history of the tag", func() {
+		s.Setup()
+		history := []tag.History{
+			{
+				AssetID:     "asset-a",
+				TemplateURN: "governance_policy",
+				Action:      tag.HistoryActionUpdate,
+				Actor:       "user@example.com",
+				Before:      map[string]string{"classification": "Public"},
+				After:       map[string]string{"classification": "Restricted"},
+			},
+		}
+		s.repository.EXPECT().ReadHistory(mock.Anything, "asset-a", "governance_policy").Return(history, nil)
+
+		actual, err := s.tagService.GetTagHistory(ctx, "asset-a", "governance_policy")
+
+		s.NoError(err)
+		s.Equal(history, actual)
+	})
+}
@@ -0,0 +1,181 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/compass/core/user"
+)
+
+const migrationBatchSize = 100
+
+// TemplateMigration rewrites the tags written against an older version of
+// the template to its current version. The actor is the user starting the
+// migration, the rewritten tags are recorded in their history as changed by
+// them.
+type TemplateMigration struct {
+	TemplateURN string              `json:"template_urn"`
+	Mappings    []FieldValueMapping `json:"mappings"`
+	Actor       string              `json:"actor,omitempty"`
+}
+
+// FieldValueMapping maps the old values of a field to its new values, the
+// values without a mapping are kept as they are
+type FieldValueMapping struct {
+	FieldID uint              `json:"field_id"`
+	Values  map[string]string `json:"values"`
+}
+
+// TemplateTagsFilter selects the tags of a template written against a
+// version older than BelowVersion, ordered by asset id
+type TemplateTagsFilter struct {
+	TemplateURN  string
+	BelowVersion uint
+	AfterAssetID string
+	Size         int
+}
+
+// MigrationReport is the outcome of a migration of the tags of a template
+type MigrationReport struct {
+	TemplateURN      string             `json:"template_urn"`
+	TemplateVersion  uint               `json:"template_version"`
+	Tags             int                `json:"tags"`
+	MigratedAssetIDs []string           `json:"migrated_asset_ids"`
+	Failures         []MigrationFailure `json:"failures"`
+}
+
+// MigrationFailure is a tag failing the validation against the current
+// version of the template once its values are mapped
+type MigrationFailure struct {
+	AssetID string `json:"asset_id"`
+	Reason  string `json:"reason"`
+}
+
+// PlanTemplateMigration is a dry run of the migration, it reports the tags
+// which would fail the validation without rewriting any of them
+func (s *Service) PlanTemplateMigration(ctx context.Context, migration TemplateMigration) (MigrationReport, error) {
+	return s.migrateTemplateTags(ctx, migration, true)
+}
+
+// MigrateTemplateTags rewrites the outdated tags of the template in batches,
+// the tags failing the validation are left untouched and reported
+func (s *Service) MigrateTemplateTags(ctx context.Context, migration TemplateMigration) (MigrationReport, error) {
+	return s.migrateTemplateTags(ctx, migration, false)
+}
+
+// StartTemplateMigration reports a dry run of the migration and enqueues the
+// job rewriting the tags. Without a worker, the tags are rewritten right away.
+func (s *Service) StartTemplateMigration(ctx context.Context, migration TemplateMigration) (MigrationReport, error) {
+	if s.worker == nil {
+		return s.MigrateTemplateTags(ctx, migration)
+	}
+
+	report, err := s.PlanTemplateMigration(ctx, migration)
+	if err != nil {
+		return MigrationReport{}, err
+	}
+	if report.Tags == len(report.Failures) {
+		return report, nil
+	}
+
+	migration.Actor = user.FromContext(ctx).Email
+	if err := s.worker.EnqueueMigrateTemplateTagsJob(ctx, migration); err != nil {
+		return MigrationReport{}, fmt.Errorf("error enqueueing template migration: %w", err)
+	}
+	return report, nil
+}
+
+func (s *Service) migrateTemplateTags(ctx context.Context, migration TemplateMigration, dryRun bool) (MigrationReport, error) {
+	template, err := s.templateService.GetTemplate(ctx, migration.TemplateURN)
+	if err != nil {
+		return MigrationReport{}, err
+	}
+	if err := validateMappings(migration.Mappings, template); err != nil {
+		return MigrationReport{}, err
+	}
+
+	report := MigrationReport{
+		TemplateURN:     template.URN,
+		TemplateVersion: template.Version,
+	}
+	filter := TemplateTagsFilter{
+		TemplateURN:  template.URN,
+		BelowVersion: template.Version,
+		Size:         migrationBatchSize,
+	}
+	for {
+		tags, err := s.repository.ReadByTemplate(ctx, filter)
+		if err != nil {
+			return MigrationReport{}, fmt.Errorf("error reading outdated tags: %w", err)
+		}
+
+		for _, tg := range tags {
+			report.Tags++
+			migrated := mapTagValues(tg, migration.Mappings)
+			if err := s.validateTagValues(ctx, migrated, template); err != nil {
+				report.Failures = append(report.Failures, MigrationFailure{
+					AssetID: tg.AssetID,
+					Reason:  err.Error(),
+				})
+				continue
+			}
+			if dryRun {
+				continue
+			}
+			if err := s.repository.Update(ctx, &migrated); err != nil {
+				return report, fmt.Errorf("error migrating tag of asset [%s]: %w", tg.AssetID, err)
+			}
+			report.MigratedAssetIDs = append(report.MigratedAssetIDs, tg.AssetID)
+		}
+
+		if len(tags) < filter.Size {
+			break
+		}
+		filter.AfterAssetID = tags[len(tags)-1].AssetID
+	}
+
+	return report, nil
+}
+
+func (s *Service) validateTagValues(ctx context.Context, tag Tag, template Template) error {
+	if err := s.validateFieldIsMemberOfTemplate(tag, template); err != nil {
+		return err
+	}
+	if err := s.validateRequiredFieldIsPassed(tag, template); err != nil {
+		return err
+	}
+	return s.validateFieldValueIsValid(ctx, tag, template)
+}
+
+func validateMappings(mappings []FieldValueMapping, template Template) error {
+	isFieldIDPartOfTemplate := make(map[uint]bool)
+	for _, field := range template.Fields {
+		isFieldIDPartOfTemplate[field.ID] = true
+	}
+	for i, mapping := range mappings {
+		if !isFieldIDPartOfTemplate[mapping.FieldID] {
+			return buildFieldError(
+				fmt.Sprintf("mappings[%d].field_id", i),
+				fmt.Sprintf("not part of template [%s]", template.URN),
+			)
+		}
+	}
+	return nil
+}
+
+func mapTagValues(tag Tag, mappings []FieldValueMapping) Tag {
+	valuesByFieldID := make(map[uint]map[string]string)
+	for _, mapping := range mappings {
+		valuesByFieldID[mapping.FieldID] = mapping.Values
+	}
+
+	tagValues := make([]TagValue, len(tag.TagValues))
+	for i, value := range tag.TagValues {
+		if newValue, ok := valuesByFieldID[value.FieldID][fmt.Sprintf("%v", value.FieldValue)]; ok {
+			value.FieldValue = newValue
+		}
+		tagValues[i] = value
+	}
+	tag.TagValues = tagValues
+	return tag
+}
@@ -0,0 +1,154 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/tag/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceTestSuite) TestMigrateTemplateTags() {
+	ctx := context.TODO()
+
+	migration := tag.TemplateMigration{
+		TemplateURN: "governance_policy",
+		Mappings: []tag.FieldValueMapping{
+			{FieldID: 1, Values: map[string]string{"Restricted": "Internal"}},
+		},
+	}
+	outdatedFilter := tag.TemplateTagsFilter{
+		TemplateURN:  "governance_policy",
+		BelowVersion: 2,
+		Size:         100,
+	}
+
+	s.Run("should return error if template is not found", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{}, nil)
+
+		_, err := s.tagService.PlanTemplateMigration(ctx, migration)
+
+		s.ErrorIs(err, tag.TemplateNotFoundError{URN: migration.TemplateURN})
+	})
+
+	s.Run("should return error if a mapped field is not part of the template", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+
+		_, err := s.tagService.PlanTemplateMigration(ctx, tag.TemplateMigration{
+			TemplateURN: migration.TemplateURN,
+			Mappings:    []tag.FieldValueMapping{{FieldID: 50}},
+		})
+
+		s.EqualError(err, "error with [mappings[0].field_id : not part of template [governance_policy]]")
+	})
+
+	s.Run("should return error if outdated tags could not be read", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(nil, errors.New("random error"))
+
+		_, err := s.tagService.MigrateTemplateTags(ctx, migration)
+
+		s.ErrorContains(err, "random error")
+	})
+
+	s.Run("should report the tags failing validation without rewriting on dry run", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(s.buildOutdatedTags(), nil)
+
+		report, err := s.tagService.PlanTemplateMigration(ctx, migration)
+
+		s.NoError(err)
+		s.Equal(2, report.Tags)
+		s.Empty(report.MigratedAssetIDs)
+		s.EqualValues(2, report.TemplateVersion)
+		s.Require().Len(report.Failures, 1)
+		s.Equal("asset-b", report.Failures[0].AssetID)
+		s.Contains(report.Failures[0].Reason, "should be one of (Public, Internal)")
+		s.repository.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should rewrite the tags passing validation with the mapped values", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(s.buildOutdatedTags(), nil)
+		s.repository.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *tag.Tag) bool {
+			return t.AssetID == "asset-a" && t.TagValues[0].FieldValue == "Internal"
+		})).Return(nil).Once()
+
+		report, err := s.tagService.MigrateTemplateTags(ctx, migration)
+
+		s.NoError(err)
+		s.Equal(2, report.Tags)
+		s.Equal([]string{"asset-a"}, report.MigratedAssetIDs)
+		s.Len(report.Failures, 1)
+	})
+
+	s.Run("should enqueue the migration after a dry run if worker is given", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(wrkr))
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(s.buildOutdatedTags(), nil)
+		wrkr.EXPECT().EnqueueMigrateTemplateTagsJob(mock.Anything, migration).Return(nil)
+
+		report, err := tagService.StartTemplateMigration(ctx, migration)
+
+		s.NoError(err)
+		s.Equal(2, report.Tags)
+		s.Len(report.Failures, 1)
+	})
+
+	s.Run("should not enqueue the migration if no tag would be migrated", func() {
+		s.Setup()
+		wrkr := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(wrkr))
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(s.buildOutdatedTags()[1:], nil)
+
+		report, err := tagService.StartTemplateMigration(ctx, migration)
+
+		s.NoError(err)
+		s.Equal(1, report.Tags)
+		s.Len(report.Failures, 1)
+	})
+
+	s.Run("should rewrite the tags right away if worker is not given", func() {
+		s.Setup()
+		s.templateRepo.EXPECT().Read(mock.Anything, migration.TemplateURN).Return([]tag.Template{s.buildMigratedTemplate()}, nil)
+		s.repository.EXPECT().ReadByTemplate(mock.Anything, outdatedFilter).Return(s.buildOutdatedTags(), nil)
+		s.repository.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+
+		report, err := s.tagService.StartTemplateMigration(ctx, migration)
+
+		s.NoError(err)
+		s.Equal([]string{"asset-a"}, report.MigratedAssetIDs)
+	})
+}
+
+// buildMigratedTemplate is the second version of the template, the
+// "Restricted" option of the classification is replaced by "Internal"
+func (s *ServiceTestSuite) buildMigratedTemplate() tag.Template {
+	template := s.buildTemplate()
+	template.Version = 2
+	template.Fields[0].Options = []string{"Public", "Internal"}
+	return template
+}
+
+func (s *ServiceTestSuite) buildOutdatedTags() []tag.Tag {
+	restricted := s.buildTag()
+	restricted.AssetID = "asset-a"
+	restricted.TemplateVersion = 1
+	restricted.TagValues[0].FieldValue = "Restricted"
+
+	secret := s.buildTag()
+	secret.AssetID = "asset-b"
+	secret.TemplateVersion = 1
+	secret.TagValues[0].FieldValue = "Secret"
+
+	return []tag.Tag{restricted, secret}
+}
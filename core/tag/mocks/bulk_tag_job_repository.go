@@ -0,0 +1,188 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BulkTagJobRepository is an autogenerated mock type for the BulkTagJobRepository type
+type BulkTagJobRepository struct {
+	mock.Mock
+}
+
+type BulkTagJobRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BulkTagJobRepository) EXPECT() *BulkTagJobRepository_Expecter {
+	return &BulkTagJobRepository_Expecter{mock: &_m.Mock}
+}
+
+// Complete provides a mock function with given fields: ctx, job
+func (_m *BulkTagJobRepository) Complete(ctx context.Context, job tag.BulkTagJob) error {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BulkTagJobRepository_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type BulkTagJobRepository_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job tag.BulkTagJob
+func (_e *BulkTagJobRepository_Expecter) Complete(ctx interface{}, job interface{}) *BulkTagJobRepository_Complete_Call {
+	return &BulkTagJobRepository_Complete_Call{Call: _e.mock.On("Complete", ctx, job)}
+}
+
+func (_c *BulkTagJobRepository_Complete_Call) Run(run func(ctx context.Context, job tag.BulkTagJob)) *BulkTagJobRepository_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.BulkTagJob))
+	})
+	return _c
+}
+
+func (_c *BulkTagJobRepository_Complete_Call) Return(_a0 error) *BulkTagJobRepository_Complete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BulkTagJobRepository_Complete_Call) RunAndReturn(run func(context.Context, tag.BulkTagJob) error) *BulkTagJobRepository_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, job
+func (_m *BulkTagJobRepository) Create(ctx context.Context, job *tag.BulkTagJob) error {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *tag.BulkTagJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BulkTagJobRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type BulkTagJobRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *tag.BulkTagJob
+func (_e *BulkTagJobRepository_Expecter) Create(ctx interface{}, job interface{}) *BulkTagJobRepository_Create_Call {
+	return &BulkTagJobRepository_Create_Call{Call: _e.mock.On("Create", ctx, job)}
+}
+
+func (_c *BulkTagJobRepository_Create_Call) Run(run func(ctx context.Context, job *tag.BulkTagJob)) *BulkTagJobRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*tag.BulkTagJob))
+	})
+	return _c
+}
+
+func (_c *BulkTagJobRepository_Create_Call) Return(_a0 error) *BulkTagJobRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BulkTagJobRepository_Create_Call) RunAndReturn(run func(context.Context, *tag.BulkTagJob) error) *BulkTagJobRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *BulkTagJobRepository) GetByID(ctx context.Context, id string) (tag.BulkTagJob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 tag.BulkTagJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (tag.BulkTagJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) tag.BulkTagJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(tag.BulkTagJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkTagJobRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type BulkTagJobRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *BulkTagJobRepository_Expecter) GetByID(ctx interface{}, id interface{}) *BulkTagJobRepository_GetByID_Call {
+	return &BulkTagJobRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *BulkTagJobRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *BulkTagJobRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BulkTagJobRepository_GetByID_Call) Return(_a0 tag.BulkTagJob, _a1 error) *BulkTagJobRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BulkTagJobRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (tag.BulkTagJob, error)) *BulkTagJobRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBulkTagJobRepository creates a new instance of BulkTagJobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBulkTagJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BulkTagJobRepository {
+	mock := &BulkTagJobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
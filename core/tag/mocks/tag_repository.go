@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
@@ -175,6 +175,125 @@ func (_c *TagRepository_Read_Call) RunAndReturn(run func(context.Context, tag.Ta
 	return _c
 }
 
+// ReadByTemplate provides a mock function with given fields: ctx, filter
+func (_m *TagRepository) ReadByTemplate(ctx context.Context, filter tag.TemplateTagsFilter) ([]tag.Tag, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadByTemplate")
+	}
+
+	var r0 []tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateTagsFilter) ([]tag.Tag, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateTagsFilter) []tag.Tag); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.TemplateTagsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_ReadByTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadByTemplate'
+type TagRepository_ReadByTemplate_Call struct {
+	*mock.Call
+}
+
+// ReadByTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter tag.TemplateTagsFilter
+func (_e *TagRepository_Expecter) ReadByTemplate(ctx interface{}, filter interface{}) *TagRepository_ReadByTemplate_Call {
+	return &TagRepository_ReadByTemplate_Call{Call: _e.mock.On("ReadByTemplate", ctx, filter)}
+}
+
+func (_c *TagRepository_ReadByTemplate_Call) Run(run func(ctx context.Context, filter tag.TemplateTagsFilter)) *TagRepository_ReadByTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TemplateTagsFilter))
+	})
+	return _c
+}
+
+func (_c *TagRepository_ReadByTemplate_Call) Return(_a0 []tag.Tag, _a1 error) *TagRepository_ReadByTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_ReadByTemplate_Call) RunAndReturn(run func(context.Context, tag.TemplateTagsFilter) ([]tag.Tag, error)) *TagRepository_ReadByTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadHistory provides a mock function with given fields: ctx, assetID, templateURN
+func (_m *TagRepository) ReadHistory(ctx context.Context, assetID string, templateURN string) ([]tag.History, error) {
+	ret := _m.Called(ctx, assetID, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadHistory")
+	}
+
+	var r0 []tag.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]tag.History, error)); ok {
+		return rf(ctx, assetID, templateURN)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []tag.History); ok {
+		r0 = rf(ctx, assetID, templateURN)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tag.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, assetID, templateURN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_ReadHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadHistory'
+type TagRepository_ReadHistory_Call struct {
+	*mock.Call
+}
+
+// ReadHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - templateURN string
+func (_e *TagRepository_Expecter) ReadHistory(ctx interface{}, assetID interface{}, templateURN interface{}) *TagRepository_ReadHistory_Call {
+	return &TagRepository_ReadHistory_Call{Call: _e.mock.On("ReadHistory", ctx, assetID, templateURN)}
+}
+
+func (_c *TagRepository_ReadHistory_Call) Run(run func(ctx context.Context, assetID string, templateURN string)) *TagRepository_ReadHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_ReadHistory_Call) Return(_a0 []tag.History, _a1 error) *TagRepository_ReadHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_ReadHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]tag.History, error)) *TagRepository_ReadHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *TagRepository) Update(ctx context.Context, _a1 *tag.Tag) error {
 	ret := _m.Called(ctx, _a1)
@@ -0,0 +1,227 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Worker is an autogenerated mock type for the Worker type
+type Worker struct {
+	mock.Mock
+}
+
+type Worker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Worker) EXPECT() *Worker_Expecter {
+	return &Worker_Expecter{mock: &_m.Mock}
+}
+
+// EnqueueBulkTagAssetsJob provides a mock function with given fields: ctx, req
+func (_m *Worker) EnqueueBulkTagAssetsJob(ctx context.Context, req tag.BulkTagRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueBulkTagAssetsJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worker_EnqueueBulkTagAssetsJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueBulkTagAssetsJob'
+type Worker_EnqueueBulkTagAssetsJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueBulkTagAssetsJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req tag.BulkTagRequest
+func (_e *Worker_Expecter) EnqueueBulkTagAssetsJob(ctx interface{}, req interface{}) *Worker_EnqueueBulkTagAssetsJob_Call {
+	return &Worker_EnqueueBulkTagAssetsJob_Call{Call: _e.mock.On("EnqueueBulkTagAssetsJob", ctx, req)}
+}
+
+func (_c *Worker_EnqueueBulkTagAssetsJob_Call) Run(run func(ctx context.Context, req tag.BulkTagRequest)) *Worker_EnqueueBulkTagAssetsJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.BulkTagRequest))
+	})
+	return _c
+}
+
+func (_c *Worker_EnqueueBulkTagAssetsJob_Call) Return(_a0 error) *Worker_EnqueueBulkTagAssetsJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_EnqueueBulkTagAssetsJob_Call) RunAndReturn(run func(context.Context, tag.BulkTagRequest) error) *Worker_EnqueueBulkTagAssetsJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueMigrateTemplateTagsJob provides a mock function with given fields: ctx, migration
+func (_m *Worker) EnqueueMigrateTemplateTagsJob(ctx context.Context, migration tag.TemplateMigration) error {
+	ret := _m.Called(ctx, migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueMigrateTemplateTagsJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) error); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worker_EnqueueMigrateTemplateTagsJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueMigrateTemplateTagsJob'
+type Worker_EnqueueMigrateTemplateTagsJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueMigrateTemplateTagsJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration tag.TemplateMigration
+func (_e *Worker_Expecter) EnqueueMigrateTemplateTagsJob(ctx interface{}, migration interface{}) *Worker_EnqueueMigrateTemplateTagsJob_Call {
+	return &Worker_EnqueueMigrateTemplateTagsJob_Call{Call: _e.mock.On("EnqueueMigrateTemplateTagsJob", ctx, migration)}
+}
+
+func (_c *Worker_EnqueueMigrateTemplateTagsJob_Call) Run(run func(ctx context.Context, migration tag.TemplateMigration)) *Worker_EnqueueMigrateTemplateTagsJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TemplateMigration))
+	})
+	return _c
+}
+
+func (_c *Worker_EnqueueMigrateTemplateTagsJob_Call) Return(_a0 error) *Worker_EnqueueMigrateTemplateTagsJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_EnqueueMigrateTemplateTagsJob_Call) RunAndReturn(run func(context.Context, tag.TemplateMigration) error) *Worker_EnqueueMigrateTemplateTagsJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueuePropagateTagJob provides a mock function with given fields: ctx, assetID, column, templateURN
+func (_m *Worker) EnqueuePropagateTagJob(ctx context.Context, assetID string, column string, templateURN string) error {
+	ret := _m.Called(ctx, assetID, column, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueuePropagateTagJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worker_EnqueuePropagateTagJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePropagateTagJob'
+type Worker_EnqueuePropagateTagJob_Call struct {
+	*mock.Call
+}
+
+// EnqueuePropagateTagJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - column string
+//   - templateURN string
+func (_e *Worker_Expecter) EnqueuePropagateTagJob(ctx interface{}, assetID interface{}, column interface{}, templateURN interface{}) *Worker_EnqueuePropagateTagJob_Call {
+	return &Worker_EnqueuePropagateTagJob_Call{Call: _e.mock.On("EnqueuePropagateTagJob", ctx, assetID, column, templateURN)}
+}
+
+func (_c *Worker_EnqueuePropagateTagJob_Call) Run(run func(ctx context.Context, assetID string, column string, templateURN string)) *Worker_EnqueuePropagateTagJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Worker_EnqueuePropagateTagJob_Call) Return(_a0 error) *Worker_EnqueuePropagateTagJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_EnqueuePropagateTagJob_Call) RunAndReturn(run func(context.Context, string, string, string) error) *Worker_EnqueuePropagateTagJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueReindexAssetJob provides a mock function with given fields: ctx, assetID
+func (_m *Worker) EnqueueReindexAssetJob(ctx context.Context, assetID string) error {
+	ret := _m.Called(ctx, assetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueReindexAssetJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, assetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Worker_EnqueueReindexAssetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueReindexAssetJob'
+type Worker_EnqueueReindexAssetJob_Call struct {
+	*mock.Call
+}
+
+// EnqueueReindexAssetJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+func (_e *Worker_Expecter) EnqueueReindexAssetJob(ctx interface{}, assetID interface{}) *Worker_EnqueueReindexAssetJob_Call {
+	return &Worker_EnqueueReindexAssetJob_Call{Call: _e.mock.On("EnqueueReindexAssetJob", ctx, assetID)}
+}
+
+func (_c *Worker_EnqueueReindexAssetJob_Call) Run(run func(ctx context.Context, assetID string)) *Worker_EnqueueReindexAssetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Worker_EnqueueReindexAssetJob_Call) Return(_a0 error) *Worker_EnqueueReindexAssetJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Worker_EnqueueReindexAssetJob_Call) RunAndReturn(run func(context.Context, string) error) *Worker_EnqueueReindexAssetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWorker creates a new instance of Worker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Worker {
+	mock := &Worker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
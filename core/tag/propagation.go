@@ -0,0 +1,214 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/goto/compass/core/asset"
+)
+
+// PropagationReport is the outcome of the propagation of the tag of an asset,
+// or of one of its columns, to the assets downstream of it
+type PropagationReport struct {
+	TemplateURN       string   `json:"template_urn"`
+	SourceURN         string   `json:"source_urn"`
+	SourceColumn      string   `json:"source_column,omitempty"`
+	InheritedAssetIDs []string `json:"inherited_asset_ids"`
+	RemovedAssetIDs   []string `json:"removed_asset_ids"`
+}
+
+// PropagateTag makes the tag of the template on the asset inherited by the
+// assets downstream of it, as configured by the propagation rule of the
+// template. The tag of a column is inherited by the columns downstream of it
+// in the column lineage. The tags inherited from the asset are removed once it
+// is not tagged anymore or its tag does not match the conditions of the rule.
+// The assets having their own tag of the template, or one inherited from
+// another asset, are left untouched.
+func (s *Service) PropagateTag(ctx context.Context, assetID, column, templateURN string) (PropagationReport, error) {
+	if s.assetRepo == nil || s.lineageRepo == nil {
+		return PropagationReport{}, errors.New("tag propagation needs the asset and lineage repositories")
+	}
+
+	template, err := s.templateService.GetTemplate(ctx, templateURN)
+	if err != nil {
+		return PropagationReport{}, err
+	}
+	report := PropagationReport{TemplateURN: template.URN, SourceColumn: column}
+	if template.Propagation == nil {
+		return report, nil
+	}
+
+	source, err := s.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		if errors.As(err, new(asset.NotFoundError)) {
+			return report, nil
+		}
+		return report, fmt.Errorf("error getting asset [%s]: %w", assetID, err)
+	}
+	report.SourceURN = source.URN
+
+	values, err := s.propagatedValues(ctx, Tag{AssetID: assetID, Column: column, TemplateURN: template.URN}, template)
+	if err != nil {
+		return report, err
+	}
+
+	graph, err := s.downstreamGraph(ctx, source.URN, column, template.Propagation.Depth)
+	if err != nil {
+		return report, err
+	}
+
+	for _, node := range downstreamNodes(source.URN, column, graph) {
+		downstream, err := s.assetRepo.GetByURN(ctx, node.URN)
+		if err != nil {
+			if errors.As(err, new(asset.NotFoundError)) {
+				continue
+			}
+			return report, fmt.Errorf("error getting asset [%s]: %w", node.URN, err)
+		}
+
+		key := Tag{AssetID: downstream.ID, Column: node.Column, TemplateURN: template.URN}
+		changed, err := s.inheritTag(ctx, key, source.URN, values)
+		if err != nil {
+			return report, fmt.Errorf("error propagating tag to asset [%s]: %w", node.URN, err)
+		}
+		switch {
+		case changed && values == nil:
+			report.RemovedAssetIDs = append(report.RemovedAssetIDs, downstream.ID)
+		case changed:
+			report.InheritedAssetIDs = append(report.InheritedAssetIDs, downstream.ID)
+		}
+	}
+
+	return report, nil
+}
+
+// downstreamGraph returns the lineage downstream of the asset, or the column
+// lineage downstream of the column of the asset, up to the depth
+func (s *Service) downstreamGraph(ctx context.Context, urn, column string, depth int) (asset.LineageGraph, error) {
+	query := asset.LineageQuery{
+		Level:     depth,
+		Direction: asset.LineageDirectionDownstream,
+	}
+	if column == "" {
+		graph, err := s.lineageRepo.GetGraph(ctx, urn, query)
+		if err != nil {
+			return nil, fmt.Errorf("error getting downstreams of asset [%s]: %w", urn, err)
+		}
+		return graph, nil
+	}
+
+	query.TargetColumn = column
+	graph, err := s.lineageRepo.GetColumnGraph(ctx, urn, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting downstreams of column [%s] of asset [%s]: %w", column, urn, err)
+	}
+	return graph, nil
+}
+
+// propagateTag enqueues the propagation of the tag of the asset, or of its
+// column, if the template has a propagation rule
+func (s *Service) propagateTag(ctx context.Context, assetID, column string, template Template) error {
+	if s.worker == nil || template.Propagation == nil {
+		return nil
+	}
+	if err := s.worker.EnqueuePropagateTagJob(ctx, assetID, column, template.URN); err != nil {
+		return fmt.Errorf("error enqueueing propagation of tag: %w", err)
+	}
+	return nil
+}
+
+// propagatedValues returns the values of the tag to propagate, nil if there is
+// no such tag, the tag is itself inherited or does not match the conditions of
+// the rule
+func (s *Service) propagatedValues(ctx context.Context, key Tag, template Template) ([]TagValue, error) {
+	tags, err := s.repository.Read(ctx, key)
+	if err != nil && !errors.As(err, new(NotFoundError)) {
+		return nil, fmt.Errorf("error reading tag: %w", err)
+	}
+	if len(tags) == 0 || tags[0].InheritedFrom != "" {
+		return nil, nil
+	}
+
+	tg := tags[0]
+	for fieldURN, expected := range template.Propagation.Conditions {
+		if !slices.ContainsFunc(tg.TagValues, func(tv TagValue) bool {
+			return tv.FieldURN == fieldURN && hasValue(tv.FieldValue, expected)
+		}) {
+			return nil, nil
+		}
+	}
+
+	values := make([]TagValue, 0, len(tg.TagValues))
+	for _, tv := range tg.TagValues {
+		if tv.FieldValue == nil {
+			continue
+		}
+		values = append(values, TagValue{FieldID: tv.FieldID, FieldValue: tv.FieldValue})
+	}
+	return values, nil
+}
+
+// inheritTag writes the inherited values on the tag of the key, or removes it
+// without values, if there is no such tag or it is inherited from the same
+// source. It reports whether the tag is changed.
+func (s *Service) inheritTag(ctx context.Context, key Tag, sourceURN string, values []TagValue) (bool, error) {
+	existingTags, err := s.repository.Read(ctx, key)
+	if err != nil && !errors.As(err, new(NotFoundError)) {
+		return false, fmt.Errorf("error reading tag: %w", err)
+	}
+	isTagged := len(existingTags) > 0
+
+	switch {
+	case isTagged && existingTags[0].InheritedFrom != sourceURN:
+		return false, nil
+	case values == nil && !isTagged:
+		return false, nil
+	case values == nil:
+		return true, s.repository.Delete(ctx, key)
+	}
+
+	tg := key
+	tg.TagValues = slices.Clone(values)
+	tg.InheritedFrom = sourceURN
+	if !isTagged {
+		return true, s.repository.Create(ctx, &tg)
+	}
+	return true, s.repository.Update(ctx, &tg)
+}
+
+// lineageNode is an asset, or a column of it, in the lineage
+type lineageNode struct {
+	URN    string
+	Column string
+}
+
+// downstreamNodes returns the nodes downstream of the node of the urn and the
+// column in the graph, in the order of the edges
+func downstreamNodes(urn, column string, graph asset.LineageGraph) []lineageNode {
+	source := lineageNode{URN: urn, Column: column}
+	var nodes []lineageNode
+	for _, edge := range graph {
+		node := lineageNode{URN: edge.Target}
+		if column != "" {
+			node.Column = edge.TargetColumn
+		}
+		if node == source || slices.Contains(nodes, node) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// hasValue checks the value, or any of the values of a multi-valued field, is
+// the expected one
+func hasValue(value interface{}, expected string) bool {
+	if values, ok := value.([]interface{}); ok {
+		return slices.ContainsFunc(values, func(v interface{}) bool {
+			return FormatTagValue(v) == expected
+		})
+	}
+	return FormatTagValue(value) == expected
+}
@@ -0,0 +1,187 @@
+package tag_test
+
+import (
+	"context"
+
+	"github.com/goto/compass/core/asset"
+	assetmocks "github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/tag/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *ServiceTestSuite) TestPropagateTag() {
+	ctx := context.TODO()
+
+	const (
+		sourceID = "8d4c3b3e-2b4f-4f43-a1b5-77ad8e1d1c56"
+		assetB   = "1f8b2d6e-5f35-4a8f-9d71-0b9f2b4e2f0a"
+		assetC   = "c3a7e5d1-92b4-4a8e-8f3b-6d1e0a7c2b94"
+		assetD   = "5e9b1c7a-3d2f-4b6e-a8c4-0f7d2e9b1a63"
+	)
+	propagatingTemplate := func(conditions map[string]string) tag.Template {
+		template := s.buildTemplate()
+		template.Propagation = &tag.PropagationRule{Depth: 2, Conditions: conditions}
+		return template
+	}
+	inheritedValues := func() []tag.TagValue {
+		var values []tag.TagValue
+		for _, tv := range s.buildTag().TagValues {
+			values = append(values, tag.TagValue{FieldID: tv.FieldID, FieldValue: tv.FieldValue})
+		}
+		return values
+	}
+	newService := func() (*tag.Service, *assetmocks.AssetRepository, *assetmocks.LineageRepository) {
+		assetRepo := assetmocks.NewAssetRepository(s.T())
+		lineageRepo := assetmocks.NewLineageRepository(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo),
+			tag.WithReferenceRepositories(nil, assetRepo),
+			tag.WithLineageRepository(lineageRepo),
+		)
+		return tagService, assetRepo, lineageRepo
+	}
+	setupLineage := func(assetRepo *assetmocks.AssetRepository, lineageRepo *assetmocks.LineageRepository) {
+		assetRepo.EXPECT().GetByID(mock.Anything, sourceID).Return(asset.Asset{ID: sourceID, URN: "urn:a"}, nil)
+		lineageRepo.EXPECT().GetGraph(mock.Anything, "urn:a", asset.LineageQuery{
+			Level:     2,
+			Direction: asset.LineageDirectionDownstream,
+		}).Return(asset.LineageGraph{
+			{Source: "urn:a", Target: "urn:b"},
+			{Source: "urn:a", Target: "urn:c"},
+			{Source: "urn:b", Target: "urn:d"},
+			{Source: "urn:c", Target: "urn:d"},
+			{Source: "urn:c", Target: "urn:missing"},
+		}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:b").Return(asset.Asset{ID: assetB, URN: "urn:b"}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:c").Return(asset.Asset{ID: assetC, URN: "urn:c"}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:d").Return(asset.Asset{ID: assetD, URN: "urn:d"}, nil)
+		assetRepo.EXPECT().GetByURN(mock.Anything, "urn:missing").Return(asset.Asset{}, asset.NotFoundError{URN: "urn:missing"})
+	}
+
+	s.Run("should return error if asset or lineage repository is not given", func() {
+		s.Setup()
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo))
+
+		_, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.EqualError(err, "tag propagation needs the asset and lineage repositories")
+	})
+
+	s.Run("should do nothing if template has no propagation rule", func() {
+		s.Setup()
+		tagService, _, _ := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{s.buildTemplate()}, nil)
+
+		report, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.NoError(err)
+		s.Equal(tag.PropagationReport{TemplateURN: "governance_policy"}, report)
+	})
+
+	s.Run("should do nothing if asset is not found", func() {
+		s.Setup()
+		tagService, assetRepo, _ := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{propagatingTemplate(nil)}, nil)
+		assetRepo.EXPECT().GetByID(mock.Anything, sourceID).Return(asset.Asset{}, asset.NotFoundError{AssetID: sourceID})
+
+		report, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.NoError(err)
+		s.Equal(tag.PropagationReport{TemplateURN: "governance_policy"}, report)
+	})
+
+	s.Run("should inherit tag on downstream assets not having their own tag", func() {
+		s.Setup()
+		tagService, assetRepo, lineageRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{propagatingTemplate(nil)}, nil)
+		setupLineage(assetRepo, lineageRepo)
+
+		sourceTag := s.buildTag()
+		sourceTag.AssetID = sourceID
+		ownTag := s.buildTag()
+		ownTag.AssetID = assetC
+		inheritedTag := tag.Tag{AssetID: assetD, TemplateURN: "governance_policy", InheritedFrom: "urn:a"}
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: sourceID, TemplateURN: "governance_policy"}).Return([]tag.Tag{sourceTag}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetB, TemplateURN: "governance_policy"}).Return(nil, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetC, TemplateURN: "governance_policy"}).Return([]tag.Tag{ownTag}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetD, TemplateURN: "governance_policy"}).Return([]tag.Tag{inheritedTag}, nil)
+		s.repository.EXPECT().Create(mock.Anything, &tag.Tag{
+			AssetID:       assetB,
+			TemplateURN:   "governance_policy",
+			TagValues:     inheritedValues(),
+			InheritedFrom: "urn:a",
+		}).Return(nil)
+		s.repository.EXPECT().Update(mock.Anything, &tag.Tag{
+			AssetID:       assetD,
+			TemplateURN:   "governance_policy",
+			TagValues:     inheritedValues(),
+			InheritedFrom: "urn:a",
+		}).Return(nil)
+
+		report, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.NoError(err)
+		s.Equal(tag.PropagationReport{
+			TemplateURN:       "governance_policy",
+			SourceURN:         "urn:a",
+			InheritedAssetIDs: []string{assetB, assetD},
+		}, report)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("should remove inherited tags if tag does not match the conditions", func() {
+		s.Setup()
+		tagService, assetRepo, lineageRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").
+			Return([]tag.Template{propagatingTemplate(map[string]string{"classification": "Restricted"})}, nil)
+		setupLineage(assetRepo, lineageRepo)
+
+		sourceTag := s.buildTag()
+		sourceTag.AssetID = sourceID
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: sourceID, TemplateURN: "governance_policy"}).Return([]tag.Tag{sourceTag}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetB, TemplateURN: "governance_policy"}).
+			Return([]tag.Tag{{AssetID: assetB, InheritedFrom: "urn:a"}}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetC, TemplateURN: "governance_policy"}).
+			Return([]tag.Tag{{AssetID: assetC, InheritedFrom: "urn:other"}}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: assetD, TemplateURN: "governance_policy"}).
+			Return(nil, tag.NotFoundError{})
+		s.repository.EXPECT().Delete(mock.Anything, tag.Tag{AssetID: assetB, TemplateURN: "governance_policy"}).Return(nil)
+
+		report, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.NoError(err)
+		s.Equal(tag.PropagationReport{
+			TemplateURN:     "governance_policy",
+			SourceURN:       "urn:a",
+			RemovedAssetIDs: []string{assetB},
+		}, report)
+		s.repository.AssertExpectations(s.T())
+	})
+
+	s.Run("should not propagate a tag that is itself inherited", func() {
+		s.Setup()
+		tagService, assetRepo, lineageRepo := newService()
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{propagatingTemplate(nil)}, nil)
+		setupLineage(assetRepo, lineageRepo)
+
+		sourceTag := s.buildTag()
+		sourceTag.AssetID = sourceID
+		sourceTag.InheritedFrom = "urn:upstream"
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{AssetID: sourceID, TemplateURN: "governance_policy"}).Return([]tag.Tag{sourceTag}, nil)
+		s.repository.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, nil)
+
+		report, err := tagService.PropagateTag(ctx, sourceID, "", "governance_policy")
+		s.NoError(err)
+		s.Equal(tag.PropagationReport{TemplateURN: "governance_policy", SourceURN: "urn:a"}, report)
+		s.repository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("should enqueue propagation when tag of a propagating template is written", func() {
+		s.Setup()
+		worker := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(worker))
+		s.templateRepo.EXPECT().Read(mock.Anything, "governance_policy").Return([]tag.Template{propagatingTemplate(nil)}, nil)
+
+		tg := s.buildTag()
+		s.repository.EXPECT().Delete(mock.Anything, tag.Tag{AssetID: tg.AssetID, TemplateURN: "governance_policy"}).Return(nil)
+		worker.EXPECT().EnqueueReindexAssetJob(mock.Anything, tg.AssetID).Return(nil)
+		worker.EXPECT().EnqueuePropagateTagJob(mock.Anything, tg.AssetID, "", "governance_policy").Return(nil)
+
+		s.NoError(tagService.DeleteTag(ctx, tg.AssetID, "governance_policy"))
+	})
+}
@@ -1,18 +1,73 @@
 package tag
 
+//go:generate mockery --name=Worker -r --case underscore --with-expecter --structname Worker --filename worker.go --output=./mocks
+
 import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/tag/validator"
+	"github.com/goto/compass/core/user"
 )
 
+// Worker runs the jobs following the changes of tags asynchronously
+type Worker interface {
+	EnqueueReindexAssetJob(ctx context.Context, assetID string) error
+	EnqueueMigrateTemplateTagsJob(ctx context.Context, migration TemplateMigration) error
+	EnqueueBulkTagAssetsJob(ctx context.Context, req BulkTagRequest) error
+	EnqueuePropagateTagJob(ctx context.Context, assetID, column, templateURN string) error
+}
+
 // Service is a type that manages business process
 type Service struct {
 	validator       validator.Validator
 	repository      TagRepository
 	templateService *TemplateService
+	worker          Worker
+	userRepo        user.Repository
+	assetRepo       asset.Repository
+	lineageRepo     asset.LineageRepository
+	bulkTagJobRepo  BulkTagJobRepository
+}
+
+// ServiceOption configures the optional dependencies of the Service
+type ServiceOption func(*Service)
+
+// WithWorker makes the Service reindex the assets of the changed tags and run
+// the migrations of templates on the worker
+func WithWorker(w Worker) ServiceOption {
+	return func(s *Service) {
+		s.worker = w
+	}
+}
+
+// WithReferenceRepositories makes the Service check that the values of user
+// and asset fields reference existing users and assets. The asset repository
+// also finds the assets of bulk tagging and the columns of column tags.
+func WithReferenceRepositories(userRepo user.Repository, assetRepo asset.Repository) ServiceOption {
+	return func(s *Service) {
+		s.userRepo = userRepo
+		s.assetRepo = assetRepo
+	}
+}
+
+// WithLineageRepository makes the Service propagate the tags of templates
+// with a propagation rule to the assets downstream of the tagged asset
+func WithLineageRepository(lineageRepo asset.LineageRepository) ServiceOption {
+	return func(s *Service) {
+		s.lineageRepo = lineageRepo
+	}
+}
+
+// WithBulkTagJobRepository makes the Service record the bulk taggings run on
+// the worker, for their outcome to be read by the id of their job
+func WithBulkTagJobRepository(repo BulkTagJobRepository) ServiceOption {
+	return func(s *Service) {
+		s.bulkTagJobRepo = repo
+	}
 }
 
 // Validate validates domain tag based on business requirement
@@ -44,14 +99,19 @@ func (s *Service) CreateTag(ctx context.Context, tag *Tag) error {
 	if err := s.validateRequiredFieldIsPassed(*tag, template); err != nil {
 		return err
 	}
-	if err := s.validateFieldValueIsValid(*tag, template); err != nil {
+	if err := s.validateFieldValueIsValid(ctx, *tag, template); err != nil {
+		return err
+	}
+	if err := s.validateColumnExists(ctx, *tag); err != nil {
 		return err
 	}
 	if err := s.repository.Create(ctx, tag); err != nil {
 		return err
 	}
-
-	return nil
+	if err := s.reindexAsset(ctx, tag.AssetID); err != nil {
+		return err
+	}
+	return s.propagateTag(ctx, tag.AssetID, tag.Column, template)
 }
 
 // GetTagsByAssetID handles business process to get tags by its asset id
@@ -62,17 +122,22 @@ func (s *Service) GetTagsByAssetID(ctx context.Context, assetID string) ([]Tag,
 
 // FindByAssetAndTemplate handles business process to get tags by its asset id and template id
 func (s *Service) FindTagByAssetIDAndTemplateURN(ctx context.Context, assetID, templateURN string) (Tag, error) {
+	return s.FindColumnTag(ctx, assetID, "", templateURN)
+}
+
+// FindColumnTag returns the tag of the template on the column of the asset
+func (s *Service) FindColumnTag(ctx context.Context, assetID, column, templateURN string) (Tag, error) {
 	_, err := s.templateService.GetTemplate(ctx, templateURN)
 	if err != nil {
 		return Tag{}, err
 	}
-	listOfTag, err := s.repository.Read(ctx, Tag{AssetID: assetID, TemplateURN: templateURN})
+	listOfTag, err := s.repository.Read(ctx, Tag{AssetID: assetID, Column: column, TemplateURN: templateURN})
 	if err != nil {
 		return Tag{}, err
 	}
 	var output Tag
 	if len(listOfTag) == 0 {
-		return Tag{}, NotFoundError{AssetID: assetID, Template: templateURN}
+		return Tag{}, NotFoundError{AssetID: assetID, Column: column, Template: templateURN}
 	}
 
 	output = listOfTag[0]
@@ -81,17 +146,26 @@ func (s *Service) FindTagByAssetIDAndTemplateURN(ctx context.Context, assetID, t
 
 // DeleteTag handles business process to delete a tag
 func (s *Service) DeleteTag(ctx context.Context, assetID, templateURN string) error {
-	_, err := s.templateService.GetTemplate(ctx, templateURN)
+	return s.DeleteColumnTag(ctx, assetID, "", templateURN)
+}
+
+// DeleteColumnTag deletes the tag of the template on the column of the asset
+func (s *Service) DeleteColumnTag(ctx context.Context, assetID, column, templateURN string) error {
+	template, err := s.templateService.GetTemplate(ctx, templateURN)
 	if err != nil {
 		return fmt.Errorf("error finding template: %w", err)
 	}
 	if err := s.repository.Delete(ctx, Tag{
 		AssetID:     assetID,
+		Column:      column,
 		TemplateURN: templateURN,
 	}); err != nil {
 		return err
 	}
-	return nil
+	if err := s.reindexAsset(ctx, assetID); err != nil {
+		return err
+	}
+	return s.propagateTag(ctx, assetID, column, template)
 }
 
 // Update handles business process for update
@@ -105,24 +179,42 @@ func (s *Service) UpdateTag(ctx context.Context, tag *Tag) error {
 	}
 	existingTags, err := s.repository.Read(ctx, Tag{
 		AssetID:     tag.AssetID,
+		Column:      tag.Column,
 		TemplateURN: tag.TemplateURN,
 	})
 	if err != nil {
-		return NotFoundError{AssetID: tag.AssetID, Template: tag.TemplateURN}
+		return NotFoundError{AssetID: tag.AssetID, Column: tag.Column, Template: tag.TemplateURN}
 	}
 	if len(existingTags) == 0 {
-		return NotFoundError{AssetID: tag.AssetID, Template: tag.TemplateURN}
+		return NotFoundError{AssetID: tag.AssetID, Column: tag.Column, Template: tag.TemplateURN}
 	}
 
 	if err = s.validateFieldIsMemberOfTemplate(*tag, template); err != nil {
 		return err
 	}
-	if err := s.validateFieldValueIsValid(*tag, template); err != nil {
+	if err := s.validateFieldValueIsValid(ctx, *tag, template); err != nil {
+		return err
+	}
+	if err := s.validateColumnExists(ctx, *tag); err != nil {
 		return err
 	}
 	if err := s.repository.Update(ctx, tag); err != nil {
 		return fmt.Errorf("error updating tag: %w", err)
 	}
+	if err := s.reindexAsset(ctx, tag.AssetID); err != nil {
+		return err
+	}
+	return s.propagateTag(ctx, tag.AssetID, tag.Column, template)
+}
+
+// reindexAsset makes the current tags of the asset searchable
+func (s *Service) reindexAsset(ctx context.Context, assetID string) error {
+	if s.worker == nil {
+		return nil
+	}
+	if err := s.worker.EnqueueReindexAssetJob(ctx, assetID); err != nil {
+		return fmt.Errorf("error enqueueing reindex of asset: %w", err)
+	}
 	return nil
 }
 
@@ -146,7 +238,7 @@ func (s *Service) validateFieldIsMemberOfTemplate(tag Tag, template Template) er
 func (s *Service) validateRequiredFieldIsPassed(tag Tag, template Template) error {
 	passedFieldMap := make(map[uint]bool)
 	for _, value := range tag.TagValues {
-		if value.FieldValue != nil && value.FieldValue != "" {
+		if !isEmptyFieldValue(value.FieldValue) {
 			passedFieldMap[value.FieldID] = true
 		}
 	}
@@ -160,32 +252,114 @@ func (s *Service) validateRequiredFieldIsPassed(tag Tag, template Template) erro
 	return nil
 }
 
-func (s *Service) validateFieldValueIsValid(tag Tag, template Template) error {
+// isEmptyFieldValue tells whether the value is left empty, an empty list
+// being the empty value of a multi-valued field
+func isEmptyFieldValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func (s *Service) validateFieldValueIsValid(ctx context.Context, tag Tag, template Template) error {
 	domainFieldByID := make(map[uint]Field)
 	for _, field := range template.Fields {
 		domainFieldByID[field.ID] = field
 	}
 	for i, value := range tag.TagValues {
 		if value.FieldValue != nil && value.FieldValue != "" {
-			fieldValue := fmt.Sprintf("%v", value.FieldValue)
+			fieldValue := FormatTagValue(value.FieldValue)
 			fieldID := value.FieldID
 			domainField := domainFieldByID[fieldID]
-			_, err := ParseTagValue(template.URN, domainField.ID,
-				domainField.DataType, fieldValue, domainField.Options,
-			)
+			if _, isList := value.FieldValue.([]interface{}); isList && !domainField.Multiple {
+				return buildFieldError(fmt.Sprintf("fields[%d].value", i),
+					fmt.Sprintf("template [%s] on field [%d] should be a single value", template.URN, fieldID),
+				)
+			}
+			parsedValue, err := ParseTagValue(template.URN, domainField, fieldValue)
 			if err != nil {
 				return buildFieldError(fmt.Sprintf("fields[%d].value", i), err.Error())
 			}
+			if err := s.validateFieldValueReferences(ctx, fmt.Sprintf("fields[%d].value", i), template.URN, domainField, parsedValue); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// validateFieldValueReferences checks that the users and assets referenced by
+// the value exist. It is skipped without the repositories to look them up.
+func (s *Service) validateFieldValueReferences(ctx context.Context, key, templateURN string, field Field, value interface{}) error {
+	if field.DataType != "user" && field.DataType != "asset" {
+		return nil
+	}
+
+	references := []interface{}{value}
+	if values, ok := value.([]interface{}); ok {
+		references = values
+	}
+	for _, ref := range references {
+		ref := fmt.Sprintf("%v", ref)
+		switch {
+		case field.DataType == "user" && s.userRepo != nil:
+			_, err := s.userRepo.GetByEmail(ctx, ref)
+			if errors.As(err, new(user.NotFoundError)) {
+				return buildFieldError(key, fmt.Sprintf("template [%s] on field [%d] should be the email of an existing user", templateURN, field.ID))
+			}
+			if err != nil {
+				return fmt.Errorf("error finding user [%s]: %w", ref, err)
+			}
+		case field.DataType == "asset" && s.assetRepo != nil:
+			_, err := s.assetRepo.GetByURN(ctx, ref)
+			if errors.As(err, new(asset.NotFoundError)) {
+				return buildFieldError(key, fmt.Sprintf("template [%s] on field [%d] should be the urn of an existing asset", templateURN, field.ID))
+			}
+			if err != nil {
+				return fmt.Errorf("error finding asset [%s]: %w", ref, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateColumnExists checks that the column of a column tag is one of the
+// columns of the asset
+func (s *Service) validateColumnExists(ctx context.Context, tag Tag) error {
+	if tag.Column == "" {
+		return nil
+	}
+	if s.assetRepo == nil {
+		return errors.New("column tags need the asset repository")
+	}
+
+	ast, err := s.assetRepo.GetByID(ctx, tag.AssetID)
+	if errors.As(err, new(asset.NotFoundError)) {
+		return buildFieldError("asset_id", fmt.Sprintf("asset [%s] does not exist", tag.AssetID))
+	}
+	if err != nil {
+		return fmt.Errorf("error finding asset [%s]: %w", tag.AssetID, err)
+	}
+	if !slices.Contains(columnNames(ast.Data), tag.Column) {
+		return buildFieldError("column", fmt.Sprintf("asset [%s] has no column [%s]", tag.AssetID, tag.Column))
+	}
+	return nil
+}
+
 // NewService initializes service tag
-func NewService(repository TagRepository, templateService *TemplateService) *Service {
-	return &Service{
+func NewService(repository TagRepository, templateService *TemplateService, opts ...ServiceOption) *Service {
+	s := &Service{
 		validator:       newValidator(),
 		repository:      repository,
 		templateService: templateService,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
@@ -8,9 +8,13 @@ import (
 
 	"github.com/golang-module/carbon/v2"
 	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	assetmocks "github.com/goto/compass/core/asset/mocks"
 	"github.com/goto/compass/core/tag"
 	"github.com/goto/compass/core/tag/mocks"
 	"github.com/goto/compass/core/tag/validator"
+	"github.com/goto/compass/core/user"
+	usermocks "github.com/goto/compass/core/user/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -240,6 +244,20 @@ func (s *ServiceTestSuite) TestCreate() {
 		s.EqualValues(expectedFieldError, actualError)
 	})
 
+	s.Run("should return error if required multi-valued field is an empty list", func() {
+		s.Setup()
+		t := s.buildTag()
+		template := s.buildTemplate()
+		template.Fields[1].DataType = "string"
+		template.Fields[1].Multiple = true
+		t.TagValues[1].FieldValue = []interface{}{}
+		s.templateRepo.EXPECT().Read(mock.Anything, template.URN).Return([]tag.Template{template}, nil)
+
+		actualError := s.tagService.CreateTag(ctx, &t)
+
+		s.EqualError(actualError, "error with [fields[1].id : required by template [governance_policy]]")
+	})
+
 	s.Run("should return repository error if repository met error", func() {
 		s.Setup()
 		t := s.buildTag()
@@ -263,6 +281,22 @@ func (s *ServiceTestSuite) TestCreate() {
 
 		s.NoError(actualError)
 	})
+
+	s.Run("should enqueue reindex of the asset if worker is given", func() {
+		s.Setup()
+		t := s.buildTag()
+		wrkr := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(wrkr))
+
+		template := s.buildTemplate()
+		s.templateRepo.EXPECT().Read(mock.Anything, template.URN).Return([]tag.Template{template}, nil)
+		s.repository.EXPECT().Create(mock.Anything, &t).Return(nil)
+		wrkr.EXPECT().EnqueueReindexAssetJob(mock.Anything, t.AssetID).Return(errors.New("random error"))
+
+		actualError := tagService.CreateTag(ctx, &t)
+
+		s.ErrorContains(actualError, "error enqueueing reindex of asset: random error")
+	})
 }
 
 func (s *ServiceTestSuite) TestGetByAsset() {
@@ -465,6 +499,26 @@ func (s *ServiceTestSuite) TestUpdate() {
 
 		s.NoError(actualError)
 	})
+
+	s.Run("should enqueue reindex of the asset if worker is given", func() {
+		s.Setup()
+		t := s.buildTag()
+		wrkr := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(wrkr))
+
+		template := s.buildTemplate()
+		s.templateRepo.EXPECT().Read(mock.Anything, template.URN).Return([]tag.Template{template}, nil)
+		s.repository.EXPECT().Read(mock.Anything, tag.Tag{
+			AssetID:     t.AssetID,
+			TemplateURN: t.TemplateURN,
+		}).Return([]tag.Tag{t}, nil)
+		s.repository.EXPECT().Update(mock.Anything, &t).Return(nil)
+		wrkr.EXPECT().EnqueueReindexAssetJob(mock.Anything, t.AssetID).Return(nil)
+
+		actualError := tagService.UpdateTag(ctx, &t)
+
+		s.NoError(actualError)
+	})
 }
 
 func (s *ServiceTestSuite) TestDelete() {
@@ -494,6 +548,242 @@ func (s *ServiceTestSuite) TestDelete() {
 		err := s.tagService.DeleteTag(ctx, assetID, template.URN)
 		s.Error(err)
 	})
+
+	s.Run("should enqueue reindex of the asset if worker is given", func() {
+		s.Setup()
+		assetID := uuid.NewString()
+		wrkr := mocks.NewWorker(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithWorker(wrkr))
+
+		template := s.buildTemplate()
+		s.templateRepo.EXPECT().Read(mock.Anything, template.URN).Return([]tag.Template{template}, nil)
+		s.repository.EXPECT().Delete(mock.Anything, tag.Tag{
+			AssetID:     assetID,
+			TemplateURN: template.URN,
+		}).Return(nil)
+		wrkr.EXPECT().EnqueueReindexAssetJob(mock.Anything, assetID).Return(nil)
+
+		err := tagService.DeleteTag(ctx, assetID, template.URN)
+		s.NoError(err)
+	})
+}
+
+func (s *ServiceTestSuite) TestColumnTag() {
+	ctx := context.TODO()
+	tableData := map[string]interface{}{
+		"columns": []interface{}{
+			map[string]interface{}{"name": "id"},
+			map[string]interface{}{"name": "email"},
+		},
+	}
+	columnTag := func(column string) tag.Tag {
+		t := s.buildTag()
+		t.Column = column
+		return t
+	}
+
+	s.Run("should return error if asset repository is not given", func() {
+		s.Setup()
+		t := columnTag("email")
+		s.templateRepo.EXPECT().Read(mock.Anything, t.TemplateURN).Return([]tag.Template{s.buildTemplate()}, nil)
+
+		err := s.tagService.CreateTag(ctx, &t)
+		s.EqualError(err, "column tags need the asset repository")
+	})
+
+	s.Run("should return error if asset does not exist", func() {
+		s.Setup()
+		assetRepo := assetmocks.NewAssetRepository(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithReferenceRepositories(nil, assetRepo))
+		t := columnTag("email")
+		s.templateRepo.EXPECT().Read(mock.Anything, t.TemplateURN).Return([]tag.Template{s.buildTemplate()}, nil)
+		assetRepo.EXPECT().GetByID(mock.Anything, t.AssetID).Return(asset.Asset{}, asset.NotFoundError{AssetID: t.AssetID})
+
+		err := tagService.CreateTag(ctx, &t)
+		s.ErrorAs(err, new(tag.ValidationError))
+		s.ErrorContains(err, fmt.Sprintf("asset [%s] does not exist", t.AssetID))
+	})
+
+	s.Run("should return error if column is not a column of the asset", func() {
+		s.Setup()
+		assetRepo := assetmocks.NewAssetRepository(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithReferenceRepositories(nil, assetRepo))
+		t := columnTag("missing")
+		s.templateRepo.EXPECT().Read(mock.Anything, t.TemplateURN).Return([]tag.Template{s.buildTemplate()}, nil)
+		assetRepo.EXPECT().GetByID(mock.Anything, t.AssetID).Return(asset.Asset{ID: t.AssetID, Data: tableData}, nil)
+
+		err := tagService.CreateTag(ctx, &t)
+		s.ErrorAs(err, new(tag.ValidationError))
+		s.ErrorContains(err, fmt.Sprintf("asset [%s] has no column [missing]", t.AssetID))
+	})
+
+	s.Run("should create tag if column is a column of the asset", func() {
+		s.Setup()
+		assetRepo := assetmocks.NewAssetRepository(s.T())
+		tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithReferenceRepositories(nil, assetRepo))
+		t := columnTag("email")
+		s.templateRepo.EXPECT().Read(mock.Anything, t.TemplateURN).Return([]tag.Template{s.buildTemplate()}, nil)
+		assetRepo.EXPECT().GetByID(mock.Anything, t.AssetID).Return(asset.Asset{ID: t.AssetID, Data: tableData}, nil)
+		s.repository.EXPECT().Create(mock.Anything, &t).Return(nil)
+
+		s.NoError(tagService.CreateTag(ctx, &t))
+	})
+
+	s.Run("should find and delete the tag of the column", func() {
+		s.Setup()
+		t := columnTag("email")
+		key := tag.Tag{AssetID: t.AssetID, Column: "email", TemplateURN: t.TemplateURN}
+		s.templateRepo.EXPECT().Read(mock.Anything, t.TemplateURN).Return([]tag.Template{s.buildTemplate()}, nil)
+		s.repository.EXPECT().Read(mock.Anything, key).Return([]tag.Tag{t}, nil)
+		s.repository.EXPECT().Delete(mock.Anything, key).Return(nil)
+
+		found, err := s.tagService.FindColumnTag(ctx, t.AssetID, "email", t.TemplateURN)
+		s.NoError(err)
+		s.Equal(t, found)
+		s.NoError(s.tagService.DeleteColumnTag(ctx, t.AssetID, "email", t.TemplateURN))
+	})
+}
+
+func (s *ServiceTestSuite) TestCreateWithConstrainedFields() {
+	ctx := context.TODO()
+	minValue, maxValue := 0.0, 100.0
+	template := tag.Template{
+		URN:         "data_contract",
+		DisplayName: "Data Contract",
+		Description: "Contract of the data of this asset",
+		Fields: []tag.Field{
+			{ID: 1, URN: "regions", DataType: "enumerated", Options: []string{"id", "sg", "vn"}, Multiple: true},
+			{ID: 2, URN: "freshness", DataType: "integer", Min: &minValue, Max: &maxValue},
+			{ID: 3, URN: "team", DataType: "string", Pattern: "[a-z-]+"},
+			{ID: 4, URN: "runbook", DataType: "url"},
+			{ID: 5, URN: "steward", DataType: "user"},
+			{ID: 6, URN: "source", DataType: "asset"},
+		},
+	}
+
+	type testCase struct {
+		Description string
+		Value       tag.TagValue
+		Setup       func(userRepo *usermocks.UserRepository, assetRepo *assetmocks.AssetRepository)
+		ExpectedErr string
+	}
+	testCases := []testCase{
+		{
+			Description: "should accept a list of values for a multi-valued field",
+			Value:       tag.TagValue{FieldID: 1, FieldValue: []interface{}{"id", "sg"}},
+		},
+		{
+			Description: "should return error if a value of a multi-valued field is invalid",
+			Value:       tag.TagValue{FieldID: 1, FieldValue: []interface{}{"id", "th"}},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [1] should be one of (id, sg, vn)]",
+		},
+		{
+			Description: "should return error if value of a multi-valued field is not a list",
+			Value:       tag.TagValue{FieldID: 1, FieldValue: "id"},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [1] should be a list]",
+		},
+		{
+			Description: "should return error if a list is given for a single-valued field",
+			Value:       tag.TagValue{FieldID: 6, FieldValue: []interface{}{"urn:orders"}},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [6] should be a single value]",
+		},
+		{
+			Description: "should accept an integer within the range",
+			Value:       tag.TagValue{FieldID: 2, FieldValue: float64(24)},
+		},
+		{
+			Description: "should return error if value is not an integer",
+			Value:       tag.TagValue{FieldID: 2, FieldValue: 1.5},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [2] should be integer]",
+		},
+		{
+			Description: "should return error if value is out of the range",
+			Value:       tag.TagValue{FieldID: 2, FieldValue: float64(120)},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [2] should be at most 100]",
+		},
+		{
+			Description: "should return error if value does not match the whole pattern",
+			Value:       tag.TagValue{FieldID: 3, FieldValue: "data team"},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [3] should match pattern [[a-z-]+]]",
+		},
+		{
+			Description: "should return error if value is not an absolute URL",
+			Value:       tag.TagValue{FieldID: 4, FieldValue: "wiki/runbook"},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [4] should be an absolute http or https URL]",
+		},
+		{
+			Description: "should return error if URL is not http or https",
+			Value:       tag.TagValue{FieldID: 4, FieldValue: "javascript://runbook/%0Aalert(1)"},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [4] should be an absolute http or https URL]",
+		},
+		{
+			Description: "should return error if URL has no host",
+			Value:       tag.TagValue{FieldID: 4, FieldValue: "https:///runbook"},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [4] should be an absolute http or https URL]",
+		},
+		{
+			Description: "should accept an https URL",
+			Value:       tag.TagValue{FieldID: 4, FieldValue: "https://wiki.example.com/runbook"},
+		},
+		{
+			Description: "should accept the email of an existing user",
+			Value:       tag.TagValue{FieldID: 5, FieldValue: "steward@gotocompany.com"},
+			Setup: func(userRepo *usermocks.UserRepository, _ *assetmocks.AssetRepository) {
+				userRepo.EXPECT().GetByEmail(ctx, "steward@gotocompany.com").Return(user.User{Email: "steward@gotocompany.com"}, nil)
+			},
+		},
+		{
+			Description: "should return error if user does not exist",
+			Value:       tag.TagValue{FieldID: 5, FieldValue: "steward@gotocompany.com"},
+			Setup: func(userRepo *usermocks.UserRepository, _ *assetmocks.AssetRepository) {
+				userRepo.EXPECT().GetByEmail(ctx, "steward@gotocompany.com").Return(user.User{}, user.NotFoundError{Email: "steward@gotocompany.com"})
+			},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [5] should be the email of an existing user]",
+		},
+		{
+			Description: "should return error if asset does not exist",
+			Value:       tag.TagValue{FieldID: 6, FieldValue: "urn:orders"},
+			Setup: func(_ *usermocks.UserRepository, assetRepo *assetmocks.AssetRepository) {
+				assetRepo.EXPECT().GetByURN(ctx, "urn:orders").Return(asset.Asset{}, asset.NotFoundError{URN: "urn:orders"})
+			},
+			ExpectedErr: "error with [fields[0].value : template [data_contract] on field [6] should be the urn of an existing asset]",
+		},
+		{
+			Description: "should return error if asset could not be looked up",
+			Value:       tag.TagValue{FieldID: 6, FieldValue: "urn:orders"},
+			Setup: func(_ *usermocks.UserRepository, assetRepo *assetmocks.AssetRepository) {
+				assetRepo.EXPECT().GetByURN(ctx, "urn:orders").Return(asset.Asset{}, errors.New("random error"))
+			},
+			ExpectedErr: "error finding asset [urn:orders]: random error",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.Description, func() {
+			s.Setup()
+			userRepo := usermocks.NewUserRepository(s.T())
+			assetRepo := assetmocks.NewAssetRepository(s.T())
+			if tc.Setup != nil {
+				tc.Setup(userRepo, assetRepo)
+			}
+			tagService := tag.NewService(s.repository, tag.NewTemplateService(s.templateRepo), tag.WithReferenceRepositories(userRepo, assetRepo))
+			t := tag.Tag{
+				AssetID:     uuid.NewString(),
+				TemplateURN: template.URN,
+				TagValues:   []tag.TagValue{tc.Value},
+			}
+			s.templateRepo.EXPECT().Read(mock.Anything, template.URN).Return([]tag.Template{template}, nil)
+			if tc.ExpectedErr == "" {
+				s.repository.EXPECT().Create(mock.Anything, &t).Return(nil)
+			}
+
+			err := tagService.CreateTag(ctx, &t)
+			if tc.ExpectedErr != "" {
+				s.EqualError(err, tc.ExpectedErr)
+				return
+			}
+			s.NoError(err)
+		})
+	}
 }
 
 func (s *ServiceTestSuite) buildTemplate() tag.Template {
@@ -6,21 +6,33 @@ import (
 	"time"
 )
 
-// TagRepository is a contract to communicate with the primary store
+// TagRepository is a contract to communicate with the primary store. The
+// changes of the tags are recorded in their history, along with the user of
+// the context making them. Filtered by template, tags are read and deleted on
+// the column of the filter, or on the asset itself without one; filtered by
+// asset only, the tags of the asset and of its columns are all included.
 type TagRepository interface {
 	Create(ctx context.Context, tag *Tag) error
 	Read(ctx context.Context, filter Tag) ([]Tag, error)
 	Update(ctx context.Context, tag *Tag) error
 	Delete(ctx context.Context, filter Tag) error
+	ReadByTemplate(ctx context.Context, filter TemplateTagsFilter) ([]Tag, error)
+	ReadHistory(ctx context.Context, assetID, templateURN string) ([]History, error)
 }
 
-// Tag is the tag to be managed
+// Tag is the tag to be managed. A tag is either on the asset itself or, with
+// a column, on one of the columns of the asset in data.columns, by name. An
+// inherited tag is propagated from the tag of the asset, by urn, upstream of
+// it. It is no longer inherited once it is updated.
 type Tag struct {
 	AssetID             string     `json:"asset_id" validate:"required"`
+	Column              string     `json:"column,omitempty"`
 	TemplateURN         string     `json:"template_urn" validate:"required"`
 	TagValues           []TagValue `json:"tag_values" validate:"required,min=1,dive"`
 	TemplateDisplayName string     `json:"template_display_name"`
 	TemplateDescription string     `json:"template_description"`
+	TemplateVersion     uint       `json:"template_version"`
+	InheritedFrom       string     `json:"inherited_from,omitempty"`
 }
 
 // TagValue is one of the value for a tag
@@ -16,25 +16,46 @@ type TagTemplateRepository interface {
 	Delete(ctx context.Context, templateURN string) error
 }
 
-// Template is a template of a tag for a resource
+// Template is a template of a tag for a resource. With a propagation rule,
+// the tags of the template are inherited by the assets downstream of the
+// tagged asset.
 type Template struct {
-	URN         string    `json:"urn" validate:"required"`
-	DisplayName string    `json:"display_name" validate:"required"`
-	Description string    `json:"description" validate:"required"`
-	Fields      []Field   `json:"fields" validate:"required,min=1,dive"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	URN         string           `json:"urn" validate:"required"`
+	DisplayName string           `json:"display_name" validate:"required"`
+	Description string           `json:"description" validate:"required"`
+	Fields      []Field          `json:"fields" validate:"required,min=1,dive"`
+	Propagation *PropagationRule `json:"propagation,omitempty"`
+	Version     uint             `json:"version"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// PropagationRule makes the tags of a template inherited by the assets
+// downstream of the tagged asset in the lineage, up to the given depth. With
+// conditions, only the tags having the given value on each of the fields, by
+// urn, are propagated.
+type PropagationRule struct {
+	Depth      int               `json:"depth" validate:"min=1,max=10"`
+	Conditions map[string]string `json:"conditions,omitempty"`
 }
 
-// Field is a field for a single template
+// Field is a field for a single template. A multi-valued field takes a list
+// of values of its data type. Min and Max bound the values of integer and
+// double fields, Pattern is the regular expression the whole value of a
+// string field should match. The values of user and asset fields are the
+// email of an existing user and the urn of an existing asset.
 type Field struct {
 	ID          uint      `json:"id"`
 	URN         string    `json:"urn" validate:"required"`
 	DisplayName string    `json:"display_name" validate:"required"`
 	Description string    `json:"description" validate:"required"`
-	DataType    string    `json:"data_type" validate:"oneof=string double boolean enumerated datetime"`
+	DataType    string    `json:"data_type" validate:"oneof=string integer double boolean enumerated datetime url user asset"`
 	Options     []string  `json:"options"`
 	Required    bool      `json:"required"`
+	Multiple    bool      `json:"multiple"`
+	Min         *float64  `json:"min,omitempty"`
+	Max         *float64  `json:"max,omitempty"`
+	Pattern     string    `json:"pattern,omitempty" validate:"omitempty,regexp"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
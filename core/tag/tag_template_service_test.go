@@ -180,10 +180,10 @@ func (s *TemplateServiceTestSuite) TestValidate() {
 		template := s.buildTemplate()
 		template.Fields[0].DataType = "Random_Type"
 
-		expectedErrorMsg := "error with [fields[0].data_type : data_type must be one of [string double boolean enumerated datetime]]"
+		expectedErrorMsg := "error with [fields[0].data_type : data_type must be one of [string integer double boolean enumerated datetime url user asset]]"
 		expectedFieldError := tag.ValidationError{
 			validator.FieldError{
-				"fields[0].data_type": "data_type must be one of [string double boolean enumerated datetime]",
+				"fields[0].data_type": "data_type must be one of [string integer double boolean enumerated datetime url user asset]",
 			},
 		}
 
@@ -255,6 +255,101 @@ func (s *TemplateServiceTestSuite) TestValidate() {
 
 		s.NoError(actualError)
 	})
+
+	s.Run("should return error if range is set on a field that is not a number", func() {
+		template := s.buildTemplate()
+		minValue := 1.0
+		template.Fields[0].Min = &minValue
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"fields[0].min": "can only be set with data_type [integer] or [double]",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
+
+	s.Run("should return error if min is greater than max", func() {
+		template := s.buildTemplate()
+		minValue, maxValue := 10.0, 1.0
+		template.Fields[1].DataType = "integer"
+		template.Fields[1].Min = &minValue
+		template.Fields[1].Max = &maxValue
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"fields[1].min": "cannot be greater than max",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
+
+	s.Run("should return error if pattern is set on a field that is not a string", func() {
+		template := s.buildTemplate()
+		template.Fields[0].Pattern = "[a-z]+"
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"fields[0].pattern": "can only be set with data_type [string]",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
+
+	s.Run("should return error if pattern is not a valid regular expression", func() {
+		template := s.buildTemplate()
+		template.Fields[1].DataType = "string"
+		template.Fields[1].Pattern = "[a-z"
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"fields[1].pattern": "must be a valid regular expression",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
+
+	s.Run("should return error if propagation depth is out of range", func() {
+		template := s.buildTemplate()
+		template.Propagation = &tag.PropagationRule{Depth: 11}
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"propagation.depth": "must be at most 10",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
+
+	s.Run("should return error if propagation condition is not on a field of the template", func() {
+		template := s.buildTemplate()
+		template.Propagation = &tag.PropagationRule{Depth: 1, Conditions: map[string]string{"unknown": "value"}}
+
+		expectedFieldError := tag.ValidationError{
+			validator.FieldError{
+				"propagation.conditions[unknown]": "is not a field of the template",
+			},
+		}
+
+		actualError := service.Validate(template)
+
+		s.EqualValues(expectedFieldError, actualError)
+	})
 }
 
 func (s *TemplateServiceTestSuite) TestCreate() {
@@ -1,7 +1,10 @@
 package tag
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -17,28 +20,59 @@ func buildFieldError(key, message string) error {
 	}
 }
 
-func ParseTagValue(templateURN string, fieldID uint,
-	dataType, tagValue string, options []string) (interface{}, error,
-) {
+// ParseTagValue parses the string representation of a value of the field, the
+// values of a multi-valued field are represented as a JSON array
+func ParseTagValue(templateURN string, field Field, tagValue string) (interface{}, error) {
 	if tagValue == "" {
 		return nil, nil
 	}
+	if !field.Multiple {
+		return parseFieldValue(templateURN, field, tagValue)
+	}
+
+	var values []string
+	if err := json.Unmarshal([]byte(tagValue), &values); err != nil {
+		return nil, fmt.Errorf("template [%s] on field [%d] should be a list", templateURN, field.ID)
+	}
+	output := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		parsed, err := parseFieldValue(templateURN, field, value)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, parsed)
+	}
+	return output, nil
+}
+
+func parseFieldValue(templateURN string, field Field, tagValue string) (interface{}, error) {
 	var output interface{}
 	var err error
-	switch dataType {
+	switch field.DataType {
 	case "double":
-		output, err = strconv.ParseFloat(tagValue, 64)
+		var value float64
+		value, err = strconv.ParseFloat(tagValue, 64)
 		if err != nil {
-			err = fmt.Errorf("template [%s] on field [%d] should be double", templateURN, fieldID)
+			err = fmt.Errorf("template [%s] on field [%d] should be double", templateURN, field.ID)
+			break
 		}
+		output, err = value, validateRange(templateURN, field, value)
+	case "integer":
+		var value int64
+		value, err = strconv.ParseInt(tagValue, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("template [%s] on field [%d] should be integer", templateURN, field.ID)
+			break
+		}
+		output, err = value, validateRange(templateURN, field, float64(value))
 	case "boolean":
 		output, err = strconv.ParseBool(tagValue)
 		if err != nil {
-			err = fmt.Errorf("template [%s] on field [%d] should be boolean", templateURN, fieldID)
+			err = fmt.Errorf("template [%s] on field [%d] should be boolean", templateURN, field.ID)
 		}
 	case "enumerated":
 		isValueValid := false
-		for _, opt := range options {
+		for _, opt := range field.Options {
 			if tagValue == opt {
 				isValueValid = true
 				output = opt
@@ -47,18 +81,83 @@ func ParseTagValue(templateURN string, fieldID uint,
 		}
 		if !isValueValid {
 			err = fmt.Errorf("template [%s] on field [%d] should be one of (%s)",
-				templateURN, fieldID, strings.Join(options, ", "),
+				templateURN, field.ID, strings.Join(field.Options, ", "),
 			)
 		}
 	case "datetime":
 		output, err = time.Parse(time.RFC3339, tagValue)
 		if err != nil {
 			err = fmt.Errorf("template [%s] on field [%d] should follow RFC3339",
-				templateURN, fieldID,
+				templateURN, field.ID,
 			)
 		}
 	case "string":
 		output = tagValue
+		if field.Pattern == "" {
+			break
+		}
+		matched, matchErr := regexp.MatchString(fmt.Sprintf("^(?:%s)$", field.Pattern), tagValue)
+		if matchErr != nil || !matched {
+			err = fmt.Errorf("template [%s] on field [%d] should match pattern [%s]",
+				templateURN, field.ID, field.Pattern,
+			)
+		}
+	case "url":
+		output = tagValue
+		if u, parseErr := url.Parse(tagValue); parseErr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err = fmt.Errorf("template [%s] on field [%d] should be an absolute http or https URL", templateURN, field.ID)
+		}
+	case "user", "asset":
+		output = tagValue
 	}
 	return output, err
 }
+
+func validateRange(templateURN string, field Field, value float64) error {
+	if field.Min != nil && value < *field.Min {
+		return fmt.Errorf("template [%s] on field [%d] should be at least %v", templateURN, field.ID, *field.Min)
+	}
+	if field.Max != nil && value > *field.Max {
+		return fmt.Errorf("template [%s] on field [%d] should be at most %v", templateURN, field.ID, *field.Max)
+	}
+	return nil
+}
+
+// FormatTagValue formats a parsed tag value back to its string representation,
+// the values of a multi-valued field are formatted as a JSON array
+func FormatTagValue(value interface{}) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, elem := range v {
+			values[i] = FormatTagValue(elem)
+		}
+		output, _ := json.Marshal(values)
+		return string(output)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// columnNames returns the names of the columns in data.columns of an asset
+func columnNames(data map[string]interface{}) []string {
+	columns, ok := data["columns"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(columns))
+	for _, c := range columns {
+		col, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _ := col["name"].(string); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
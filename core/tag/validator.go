@@ -2,6 +2,8 @@ package tag
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 
 	ut "github.com/go-playground/universal-translator"
 	v "github.com/go-playground/validator/v10"
@@ -38,6 +40,15 @@ func newValidator() validator.Validator {
 // newTemplateValidator initializes validator for tag template
 func newTemplateValidator() validator.Validator {
 	v, err := validator.NewBuilder().
+		WithFieldValidations([]validator.FieldValidation{
+			{
+				Tag: "regexp",
+				Func: func(fl v.FieldLevel) bool {
+					_, err := regexp.Compile(fl.Field().String())
+					return err == nil
+				},
+			},
+		}).
 		WithStructValidations([]validator.StructValidation{
 			{
 				Type: Template{},
@@ -62,6 +73,31 @@ func newTemplateValidator() validator.Validator {
 								}
 							}
 						}
+						switch {
+						case (field.Min != nil || field.Max != nil) && field.DataType != "integer" && field.DataType != "double":
+							sl.ReportError(
+								nil, fmt.Sprintf("fields[%d].min", i), "", "range_restricted", "",
+							)
+						case field.Min != nil && field.Max != nil && *field.Min > *field.Max:
+							sl.ReportError(
+								nil, fmt.Sprintf("fields[%d].min", i), "", "range_invalid", "",
+							)
+						}
+						if field.Pattern != "" && field.DataType != "string" {
+							sl.ReportError(
+								nil, fmt.Sprintf("fields[%d].pattern", i), "", "pattern_restricted", "",
+							)
+						}
+					}
+
+					if template.Propagation != nil {
+						for urn := range template.Propagation.Conditions {
+							if !slices.ContainsFunc(template.Fields, func(f Field) bool { return f.URN == urn }) {
+								sl.ReportError(
+									nil, fmt.Sprintf("propagation.conditions[%s]", urn), "", "field_unknown", "",
+								)
+							}
+						}
 					}
 				},
 			},
@@ -97,6 +133,55 @@ func newTemplateValidator() validator.Validator {
 					return output
 				},
 			},
+			{
+				Tag:     "range_restricted",
+				Message: "can only be set with data_type [integer] or [double]",
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag())
+					return output
+				},
+			},
+			{
+				Tag:     "range_invalid",
+				Message: "cannot be greater than max",
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag())
+					return output
+				},
+			},
+			{
+				Tag:     "pattern_restricted",
+				Message: "can only be set with data_type [string]",
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag())
+					return output
+				},
+			},
+			{
+				Tag:     "field_unknown",
+				Message: "is not a field of the template",
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag())
+					return output
+				},
+			},
+			{
+				Tag:      "max",
+				Message:  "must be at most {0}",
+				Override: true,
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag(), fe.Param())
+					return output
+				},
+			},
+			{
+				Tag:     "regexp",
+				Message: "must be a valid regular expression",
+				TranslationFunc: func(t ut.Translator, fe v.FieldError) string {
+					output, _ := t.T(fe.Tag())
+					return output
+				},
+			},
 		}).
 		Build()
 	if err != nil {
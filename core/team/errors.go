@@ -0,0 +1,36 @@
+package team
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidName         = errors.New("team name must be lowercase letters, digits, dots, dashes and underscores, starting with a letter or a digit")
+	ErrInvalidSlackChannel = errors.New("team slack channel must start with #")
+	ErrEmptyMemberEmail    = errors.New("team member email is empty")
+)
+
+type NotFoundError struct {
+	ID string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("could not find team with id \"%s\"", e.ID)
+}
+
+type InvalidError struct {
+	ID string
+}
+
+func (e InvalidError) Error() string {
+	return fmt.Sprintf("invalid team id \"%s\"", e.ID)
+}
+
+type DuplicateError struct {
+	Name string
+}
+
+func (e DuplicateError) Error() string {
+	return fmt.Sprintf("team \"%s\" already exists", e.Name)
+}
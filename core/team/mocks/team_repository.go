@@ -0,0 +1,304 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	team "github.com/goto/compass/core/team"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TeamRepository is an autogenerated mock type for the Repository type
+type TeamRepository struct {
+	mock.Mock
+}
+
+type TeamRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamRepository) EXPECT() *TeamRepository_Expecter {
+	return &TeamRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, t
+func (_m *TeamRepository) Create(ctx context.Context, t *team.Team) (string, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) (string, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) string); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *team.Team) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TeamRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *team.Team
+func (_e *TeamRepository_Expecter) Create(ctx interface{}, t interface{}) *TeamRepository_Create_Call {
+	return &TeamRepository_Create_Call{Call: _e.mock.On("Create", ctx, t)}
+}
+
+func (_c *TeamRepository_Create_Call) Run(run func(ctx context.Context, t *team.Team)) *TeamRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*team.Team))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_Create_Call) Return(_a0 string, _a1 error) *TeamRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_Create_Call) RunAndReturn(run func(context.Context, *team.Team) (string, error)) *TeamRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TeamRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TeamRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TeamRepository_Expecter) Delete(ctx interface{}, id interface{}) *TeamRepository_Delete_Call {
+	return &TeamRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *TeamRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *TeamRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_Delete_Call) Return(_a0 error) *TeamRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *TeamRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, flt
+func (_m *TeamRepository) GetAll(ctx context.Context, flt team.Filter) ([]team.Team, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []team.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, team.Filter) ([]team.Team, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, team.Filter) []team.Team); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]team.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, team.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TeamRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt team.Filter
+func (_e *TeamRepository_Expecter) GetAll(ctx interface{}, flt interface{}) *TeamRepository_GetAll_Call {
+	return &TeamRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, flt)}
+}
+
+func (_c *TeamRepository_GetAll_Call) Run(run func(ctx context.Context, flt team.Filter)) *TeamRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(team.Filter))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_GetAll_Call) Return(_a0 []team.Team, _a1 error) *TeamRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_GetAll_Call) RunAndReturn(run func(context.Context, team.Filter) ([]team.Team, error)) *TeamRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *TeamRepository) GetByID(ctx context.Context, id string) (team.Team, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 team.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (team.Team, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) team.Team); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(team.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type TeamRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TeamRepository_Expecter) GetByID(ctx interface{}, id interface{}) *TeamRepository_GetByID_Call {
+	return &TeamRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *TeamRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *TeamRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_GetByID_Call) Return(_a0 team.Team, _a1 error) *TeamRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (team.Team, error)) *TeamRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, t
+func (_m *TeamRepository) Update(ctx context.Context, t *team.Team) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TeamRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *team.Team
+func (_e *TeamRepository_Expecter) Update(ctx interface{}, t interface{}) *TeamRepository_Update_Call {
+	return &TeamRepository_Update_Call{Call: _e.mock.On("Update", ctx, t)}
+}
+
+func (_c *TeamRepository_Update_Call) Run(run func(ctx context.Context, t *team.Team)) *TeamRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*team.Team))
+	})
+	return _c
+}
+
+func (_c *TeamRepository_Update_Call) Return(_a0 error) *TeamRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamRepository_Update_Call) RunAndReturn(run func(context.Context, *team.Team) error) *TeamRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamRepository creates a new instance of TeamRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamRepository {
+	mock := &TeamRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
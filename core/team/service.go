@@ -0,0 +1,81 @@
+package team
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+)
+
+var nameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+func (s *Service) CreateTeam(ctx context.Context, t *Team) (string, error) {
+	t.Name = strings.TrimSpace(t.Name)
+	if !nameRegexp.MatchString(t.Name) {
+		return "", ErrInvalidName
+	}
+	if err := normalize(t); err != nil {
+		return "", err
+	}
+
+	return s.repo.Create(ctx, t)
+}
+
+func (s *Service) GetTeamByID(ctx context.Context, id string) (Team, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *Service) GetTeams(ctx context.Context, flt Filter) ([]Team, error) {
+	return s.repo.GetAll(ctx, flt)
+}
+
+// UpdateTeam replaces the details and the members of the team, its name is
+// kept as is.
+func (s *Service) UpdateTeam(ctx context.Context, t *Team) error {
+	if err := normalize(t); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, t)
+}
+
+func (s *Service) DeleteTeam(ctx context.Context, id string) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func normalize(t *Team) error {
+	t.Description = strings.TrimSpace(t.Description)
+	t.OnCall = strings.TrimSpace(t.OnCall)
+	t.SlackChannel = strings.TrimSpace(t.SlackChannel)
+	if t.SlackChannel != "" && !strings.HasPrefix(t.SlackChannel, "#") {
+		return ErrInvalidSlackChannel
+	}
+
+	members := make([]user.User, 0, len(t.Members))
+	seen := make(map[string]struct{}, len(t.Members))
+	for _, m := range t.Members {
+		email := strings.TrimSpace(m.Email)
+		if email == "" {
+			return ErrEmptyMemberEmail
+		}
+		if _, ok := seen[strings.ToLower(email)]; ok {
+			continue
+		}
+		seen[strings.ToLower(email)] = struct{}{}
+		members = append(members, user.User{Email: email})
+	}
+	t.Members = members
+
+	return nil
+}
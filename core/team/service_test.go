@@ -0,0 +1,101 @@
+package team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/team/mocks"
+	"github.com/goto/compass/core/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_CreateTeam(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Description string
+		Team        team.Team
+		Setup       func(*mocks.TeamRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: "should return error if name is empty",
+			Team:        team.Team{Name: " "},
+			ExpectedErr: team.ErrInvalidName,
+		},
+		{
+			Description: "should return error if name is not lowercase",
+			Team:        team.Team{Name: "Data Platform"},
+			ExpectedErr: team.ErrInvalidName,
+		},
+		{
+			Description: "should return error if slack channel does not start with #",
+			Team:        team.Team{Name: "data-platform", SlackChannel: "data-platform"},
+			ExpectedErr: team.ErrInvalidSlackChannel,
+		},
+		{
+			Description: "should return error if member has no email",
+			Team:        team.Team{Name: "data-platform", Members: []user.User{{Email: " "}}},
+			ExpectedErr: team.ErrEmptyMemberEmail,
+		},
+		{
+			Description: "should create team with deduplicated members",
+			Team: team.Team{
+				Name:         " data-platform ",
+				OnCall:       " oncall@example.com ",
+				SlackChannel: "#data-platform",
+				Members: []user.User{
+					{Email: "john.doe@example.com"},
+					{Email: " John.Doe@example.com"},
+					{Email: "jane.doe@example.com"},
+				},
+			},
+			Setup: func(repo *mocks.TeamRepository) {
+				repo.EXPECT().Create(ctx, &team.Team{
+					Name:         "data-platform",
+					OnCall:       "oncall@example.com",
+					SlackChannel: "#data-platform",
+					Members: []user.User{
+						{Email: "john.doe@example.com"},
+						{Email: "jane.doe@example.com"},
+					},
+				}).Return("team-id", nil)
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			repo := mocks.NewTeamRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(repo)
+			}
+
+			_, err := team.NewService(repo).CreateTeam(ctx, &tc.Team)
+			assert.ErrorIs(t, err, tc.ExpectedErr)
+		})
+	}
+}
+
+func TestService_UpdateTeam(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return error if slack channel is invalid", func(t *testing.T) {
+		repo := mocks.NewTeamRepository(t)
+		err := team.NewService(repo).UpdateTeam(ctx, &team.Team{ID: "team-id", SlackChannel: "data"})
+		assert.ErrorIs(t, err, team.ErrInvalidSlackChannel)
+	})
+
+	t.Run("should update team", func(t *testing.T) {
+		repo := mocks.NewTeamRepository(t)
+		repo.EXPECT().Update(ctx, &team.Team{ID: "team-id", Description: "data", Members: []user.User{}}).Return(nil)
+
+		err := team.NewService(repo).UpdateTeam(ctx, &team.Team{ID: "team-id", Description: " data "})
+		assert.NoError(t, err)
+	})
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, team.Names([]team.Team{{Name: "a"}, {Name: "b"}}))
+	assert.Empty(t, team.Names(nil))
+}
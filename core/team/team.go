@@ -0,0 +1,60 @@
+package team
+
+//go:generate mockery --name=Repository -r --case underscore --with-expecter --structname TeamRepository --filename team_repository.go --output=./mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/compass/core/user"
+)
+
+type Repository interface {
+	Create(ctx context.Context, t *Team) (string, error)
+	GetByID(ctx context.Context, id string) (Team, error)
+	GetAll(ctx context.Context, flt Filter) ([]Team, error)
+	Update(ctx context.Context, t *Team) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Team is a group of users owning assets together, for the ownership of the
+// assets to outlive the membership of any of them.
+type Team struct {
+	ID string `json:"id"`
+	// Name identifies the team in the owner_teams of the assets, it can not
+	// be changed
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// OnCall is the contact to reach the team at, like an email or a pager
+	// handle
+	OnCall       string      `json:"on_call"`
+	SlackChannel string      `json:"slack_channel"`
+	Members      []user.User `json:"members"`
+	CreatedBy    string      `json:"created_by"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// MemberEmails returns the emails of the members of the team
+func (t Team) MemberEmails() []string {
+	emails := make([]string, 0, len(t.Members))
+	for _, m := range t.Members {
+		emails = append(emails, m.Email)
+	}
+	return emails
+}
+
+// Names returns the names of the teams
+func Names(teams []Team) []string {
+	names := make([]string, 0, len(teams))
+	for _, t := range teams {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// Filter is a config of teams
+type Filter struct {
+	// MemberID only returns the teams the user is a member of, if set
+	MemberID string
+}
@@ -20,7 +20,7 @@ func TestContext(t *testing.T) {
 
 	t.Run("should return empty user if not exist in context", func(t *testing.T) {
 		actual := user.FromContext(context.Background())
-		if actual != (user.User{}) {
+		if !cmp.Equal(actual, user.User{}) {
 			t.Fatalf("actual is \"%+v\" but expected was \"%+v\"", actual, "")
 		}
 	})
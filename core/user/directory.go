@@ -0,0 +1,292 @@
+package user
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DirectoryFormat is the format of a directory export the users are synced
+// from.
+type DirectoryFormat string
+
+const (
+	// DirectoryFormatJSON is a SCIM list response, or a list of SCIM or LDAP
+	// shaped users.
+	DirectoryFormatJSON DirectoryFormat = "json"
+	// DirectoryFormatCSV is a list of users with a header row naming the
+	// columns.
+	DirectoryFormatCSV DirectoryFormat = "csv"
+)
+
+// DirectoryFormatOf tells the format of the directory export from the
+// extension of its path.
+func DirectoryFormatOf(path string) (DirectoryFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return DirectoryFormatJSON, nil
+	case ".csv":
+		return DirectoryFormatCSV, nil
+	default:
+		return "", UnknownDirectoryFormatError{Format: filepath.Ext(path)}
+	}
+}
+
+// ParseDirectory reads the users of a directory export. The email is read from
+// email, mail, the primary of emails or userName. The name is read from
+// displayName, name or cn. The manager is a reference, by email, id or dn, to
+// another user of the export, or the email of a user outside of it. Users are
+// active unless active is false.
+func ParseDirectory(r io.Reader, format DirectoryFormat) ([]User, error) {
+	var (
+		records []directoryRecord
+		err     error
+	)
+	switch format {
+	case DirectoryFormatJSON:
+		records, err = parseJSONDirectory(r)
+	case DirectoryFormatCSV:
+		records, err = parseCSVDirectory(r)
+	default:
+		return nil, UnknownDirectoryFormatError{Format: string(format)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s directory: %w", format, err)
+	}
+
+	return resolveDirectory(records)
+}
+
+type directoryRecord struct {
+	ID       string
+	DN       string
+	Email    string
+	Name     string
+	Manager  string
+	Inactive bool
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type scimManager struct {
+	Value string `json:"value"`
+}
+
+// jsonDirectoryUser is the union of the SCIM and LDAP shapes of a user.
+type jsonDirectoryUser struct {
+	ID          string          `json:"id"`
+	DN          string          `json:"dn"`
+	UserName    string          `json:"userName"`
+	Email       string          `json:"email"`
+	Mail        string          `json:"mail"`
+	Emails      []scimEmail     `json:"emails"`
+	DisplayName string          `json:"displayName"`
+	CN          string          `json:"cn"`
+	Name        json.RawMessage `json:"name"`
+	Manager     json.RawMessage `json:"manager"`
+	Active      *bool           `json:"active"`
+	Enterprise  *struct {
+		Manager *scimManager `json:"manager"`
+	} `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"`
+}
+
+func parseJSONDirectory(r io.Reader) ([]directoryRecord, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []jsonDirectoryUser
+	if err := json.Unmarshal(data, &users); err != nil {
+		var list struct {
+			Resources []jsonDirectoryUser `json:"Resources"`
+		}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+		users = list.Resources
+	}
+
+	records := make([]directoryRecord, 0, len(users))
+	for _, u := range users {
+		records = append(records, directoryRecord{
+			ID:       u.ID,
+			DN:       u.DN,
+			Email:    firstNonEmpty(u.Email, u.Mail, primaryEmail(u.Emails), emailOrEmpty(u.UserName)),
+			Name:     firstNonEmpty(u.DisplayName, formattedName(u.Name), u.CN),
+			Manager:  managerRef(u),
+			Inactive: u.Active != nil && !*u.Active,
+		})
+	}
+	return records, nil
+}
+
+func parseCSVDirectory(r io.Reader) ([]directoryRecord, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	get := func(row []string, names ...string) string {
+		for _, name := range names {
+			if i, ok := columns[name]; ok && i < len(row) && strings.TrimSpace(row[i]) != "" {
+				return strings.TrimSpace(row[i])
+			}
+		}
+		return ""
+	}
+
+	records := make([]directoryRecord, 0, len(rows)-1)
+	for n, row := range rows[1:] {
+		rec := directoryRecord{
+			ID:      get(row, "id"),
+			DN:      get(row, "dn"),
+			Email:   get(row, "email", "mail"),
+			Name:    get(row, "name", "displayname", "cn"),
+			Manager: get(row, "manager", "manager_email"),
+		}
+		if active := get(row, "active"); active != "" {
+			ok, err := strconv.ParseBool(active)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid active %q", n+2, active)
+			}
+			rec.Inactive = !ok
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func resolveDirectory(records []directoryRecord) ([]User, error) {
+	emails := make(map[string]string, len(records)*2)
+	for _, rec := range records {
+		if rec.ID != "" {
+			emails[rec.ID] = rec.Email
+		}
+		if rec.DN != "" {
+			emails[strings.ToLower(rec.DN)] = rec.Email
+		}
+	}
+
+	users := make([]User, 0, len(records))
+	seen := make(map[string]struct{}, len(records))
+	for i, rec := range records {
+		email := strings.TrimSpace(rec.Email)
+		if email == "" {
+			return nil, fmt.Errorf("%w: entry %d", ErrDirectoryUserWithoutEmail, i+1)
+		}
+		key := strings.ToLower(email)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		manager := rec.Manager
+		if resolved, ok := emails[manager]; ok {
+			manager = resolved
+		} else if resolved, ok := emails[strings.ToLower(manager)]; ok {
+			manager = resolved
+		} else if !strings.Contains(manager, "@") {
+			manager = ""
+		}
+		if strings.EqualFold(manager, email) {
+			manager = ""
+		}
+
+		users = append(users, User{
+			Email:    email,
+			Name:     rec.Name,
+			Manager:  strings.TrimSpace(manager),
+			Inactive: rec.Inactive,
+		})
+	}
+	return users, nil
+}
+
+func primaryEmail(emails []scimEmail) string {
+	for _, e := range emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(emails) > 0 {
+		return emails[0].Value
+	}
+	return ""
+}
+
+// formattedName reads the name either as a string or as a SCIM name.
+func formattedName(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name
+	}
+
+	var scimName struct {
+		Formatted  string `json:"formatted"`
+		GivenName  string `json:"givenName"`
+		FamilyName string `json:"familyName"`
+	}
+	if err := json.Unmarshal(raw, &scimName); err != nil {
+		return ""
+	}
+	if scimName.Formatted != "" {
+		return scimName.Formatted
+	}
+	return strings.TrimSpace(scimName.GivenName + " " + scimName.FamilyName)
+}
+
+// managerRef reads the manager either from the SCIM enterprise extension, or
+// as a string or an object with a value.
+func managerRef(u jsonDirectoryUser) string {
+	if u.Enterprise != nil && u.Enterprise.Manager != nil && u.Enterprise.Manager.Value != "" {
+		return u.Enterprise.Manager.Value
+	}
+	if len(u.Manager) == 0 {
+		return ""
+	}
+
+	var ref string
+	if err := json.Unmarshal(u.Manager, &ref); err == nil {
+		return ref
+	}
+	var m scimManager
+	if err := json.Unmarshal(u.Manager, &m); err == nil {
+		return m.Value
+	}
+	return ""
+}
+
+func emailOrEmpty(s string) string {
+	if strings.Contains(s, "@") {
+		return s
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,106 @@
+package user_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDirectory(t *testing.T) {
+	cases := []struct {
+		name        string
+		format      user.DirectoryFormat
+		input       string
+		expected    []user.User
+		expectedErr error
+	}{
+		{
+			name:   "scim list response",
+			format: user.DirectoryFormatJSON,
+			input: `{
+				"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"],
+				"Resources": [
+					{"id": "1", "userName": "jane@example.com", "name": {"givenName": "Jane", "familyName": "Doe"}},
+					{
+						"id": "2",
+						"userName": "john",
+						"emails": [{"value": "john.work@example.com", "primary": true}],
+						"displayName": "John",
+						"active": false,
+						"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"manager": {"value": "1"}}
+					}
+				]
+			}`,
+			expected: []user.User{
+				{Email: "jane@example.com", Name: "Jane Doe"},
+				{Email: "john.work@example.com", Name: "John", Manager: "jane@example.com", Inactive: true},
+			},
+		},
+		{
+			name:   "ldap entries with manager dn",
+			format: user.DirectoryFormatJSON,
+			input: `[
+				{"dn": "uid=jane,ou=people,dc=example,dc=com", "mail": "jane@example.com", "cn": "Jane"},
+				{"dn": "uid=john,ou=people,dc=example,dc=com", "mail": "john@example.com", "cn": "John", "manager": "UID=jane,ou=people,dc=example,dc=com"}
+			]`,
+			expected: []user.User{
+				{Email: "jane@example.com", Name: "Jane"},
+				{Email: "john@example.com", Name: "John", Manager: "jane@example.com"},
+			},
+		},
+		{
+			name:   "csv with header",
+			format: user.DirectoryFormatCSV,
+			input: "Email,Name,Manager,Active\n" +
+				"jane@example.com,Jane,,true\n" +
+				"john@example.com,John,jane@example.com,false\n" +
+				"JANE@example.com,Jane again,,\n",
+			expected: []user.User{
+				{Email: "jane@example.com", Name: "Jane"},
+				{Email: "john@example.com", Name: "John", Manager: "jane@example.com", Inactive: true},
+			},
+		},
+		{
+			name:   "unresolved manager reference is dropped",
+			format: user.DirectoryFormatJSON,
+			input:  `[{"email": "john@example.com", "manager": "42"}]`,
+			expected: []user.User{
+				{Email: "john@example.com"},
+			},
+		},
+		{
+			name:        "user without email",
+			format:      user.DirectoryFormatJSON,
+			input:       `[{"email": "jane@example.com"}, {"userName": "john"}]`,
+			expectedErr: user.ErrDirectoryUserWithoutEmail,
+		},
+		{
+			name:        "unknown format",
+			format:      "xml",
+			expectedErr: user.UnknownDirectoryFormatError{Format: "xml"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := user.ParseDirectory(strings.NewReader(tc.input), tc.format)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestDirectoryFormatOf(t *testing.T) {
+	format, err := user.DirectoryFormatOf("/exports/users.JSON")
+	assert.NoError(t, err)
+	assert.Equal(t, user.DirectoryFormatJSON, format)
+
+	_, err = user.DirectoryFormatOf("/exports/users.ldif")
+	assert.ErrorIs(t, err, user.UnknownDirectoryFormatError{Format: ".ldif"})
+}
@@ -8,11 +8,15 @@ import (
 var ErrNoUserInformation = errors.New("no user information")
 
 type NotFoundError struct {
+	ID    string
 	Email string
 }
 
 func (e NotFoundError) Error() string {
 	cause := "could not find user"
+	if e.ID != "" {
+		cause += fmt.Sprintf(" with id \"%s\"", e.ID)
+	}
 	if e.Email != "" {
 		cause += fmt.Sprintf(" with email \"%s\"", e.Email)
 	}
@@ -38,3 +42,59 @@ type InvalidError struct {
 func (e InvalidError) Error() string {
 	return fmt.Sprintf("empty field with email %q", e.Email)
 }
+
+var (
+	ErrInvalidServiceAccountName = errors.New("service account name must be 3 to 50 lowercase alphanumerics, dashes or underscores")
+	ErrEmptyTokenScopes          = errors.New("api token has no scope")
+	ErrTokenExpiryInPast         = errors.New("api token expiry is in the past")
+	ErrInvalidAPIToken           = errors.New("invalid api token")
+	ErrAPITokenExpired           = errors.New("api token is expired")
+	ErrAPITokenScope             = errors.New("api token does not have the write scope")
+
+	errNoServiceAccountRepository = errors.New("service accounts are not supported")
+
+	// ErrDirectoryUserWithoutEmail is returned for a user of a directory
+	// export without an email, for the user not to be taken as departed.
+	ErrDirectoryUserWithoutEmail = errors.New("directory user has no email")
+	ErrEmptyDirectory            = errors.New("directory has no user")
+)
+
+type UnknownDirectoryFormatError struct {
+	Format string
+}
+
+func (e UnknownDirectoryFormatError) Error() string {
+	return fmt.Sprintf("unknown directory format %q, expected json or csv", e.Format)
+}
+
+type ServiceAccountNotFoundError struct {
+	ID string
+}
+
+func (e ServiceAccountNotFoundError) Error() string {
+	return fmt.Sprintf("could not find service account with id \"%s\"", e.ID)
+}
+
+type ServiceAccountExistsError struct {
+	Name string
+}
+
+func (e ServiceAccountExistsError) Error() string {
+	return fmt.Sprintf("service account \"%s\" already exists", e.Name)
+}
+
+type APITokenNotFoundError struct {
+	ID string
+}
+
+func (e APITokenNotFoundError) Error() string {
+	return fmt.Sprintf("could not find api token with id \"%s\"", e.ID)
+}
+
+type InvalidTokenScopeError struct {
+	Scope TokenScope
+}
+
+func (e InvalidTokenScopeError) Error() string {
+	return fmt.Sprintf("invalid api token scope \"%s\", must be read or write", e.Scope)
+}
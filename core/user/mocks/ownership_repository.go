@@ -0,0 +1,144 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	user "github.com/goto/compass/core/user"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OwnershipRepository is an autogenerated mock type for the OwnershipRepository type
+type OwnershipRepository struct {
+	mock.Mock
+}
+
+type OwnershipRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OwnershipRepository) EXPECT() *OwnershipRepository_Expecter {
+	return &OwnershipRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetOrphanedAssets provides a mock function with given fields: ctx
+func (_m *OwnershipRepository) GetOrphanedAssets(ctx context.Context) ([]user.OrphanedAsset, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphanedAssets")
+	}
+
+	var r0 []user.OrphanedAsset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]user.OrphanedAsset, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []user.OrphanedAsset); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.OrphanedAsset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OwnershipRepository_GetOrphanedAssets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrphanedAssets'
+type OwnershipRepository_GetOrphanedAssets_Call struct {
+	*mock.Call
+}
+
+// GetOrphanedAssets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OwnershipRepository_Expecter) GetOrphanedAssets(ctx interface{}) *OwnershipRepository_GetOrphanedAssets_Call {
+	return &OwnershipRepository_GetOrphanedAssets_Call{Call: _e.mock.On("GetOrphanedAssets", ctx)}
+}
+
+func (_c *OwnershipRepository_GetOrphanedAssets_Call) Run(run func(ctx context.Context)) *OwnershipRepository_GetOrphanedAssets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OwnershipRepository_GetOrphanedAssets_Call) Return(_a0 []user.OrphanedAsset, _a1 error) *OwnershipRepository_GetOrphanedAssets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OwnershipRepository_GetOrphanedAssets_Call) RunAndReturn(run func(context.Context) ([]user.OrphanedAsset, error)) *OwnershipRepository_GetOrphanedAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceOwner provides a mock function with given fields: ctx, assetID, fromUserID, toUserID
+func (_m *OwnershipRepository) ReplaceOwner(ctx context.Context, assetID string, fromUserID string, toUserID string) error {
+	ret := _m.Called(ctx, assetID, fromUserID, toUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceOwner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, assetID, fromUserID, toUserID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OwnershipRepository_ReplaceOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceOwner'
+type OwnershipRepository_ReplaceOwner_Call struct {
+	*mock.Call
+}
+
+// ReplaceOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - fromUserID string
+//   - toUserID string
+func (_e *OwnershipRepository_Expecter) ReplaceOwner(ctx interface{}, assetID interface{}, fromUserID interface{}, toUserID interface{}) *OwnershipRepository_ReplaceOwner_Call {
+	return &OwnershipRepository_ReplaceOwner_Call{Call: _e.mock.On("ReplaceOwner", ctx, assetID, fromUserID, toUserID)}
+}
+
+func (_c *OwnershipRepository_ReplaceOwner_Call) Run(run func(ctx context.Context, assetID string, fromUserID string, toUserID string)) *OwnershipRepository_ReplaceOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OwnershipRepository_ReplaceOwner_Call) Return(_a0 error) *OwnershipRepository_ReplaceOwner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OwnershipRepository_ReplaceOwner_Call) RunAndReturn(run func(context.Context, string, string, string) error) *OwnershipRepository_ReplaceOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOwnershipRepository creates a new instance of OwnershipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOwnershipRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OwnershipRepository {
+	mock := &OwnershipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,477 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	user "github.com/goto/compass/core/user"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceAccountRepository is an autogenerated mock type for the ServiceAccountRepository type
+type ServiceAccountRepository struct {
+	mock.Mock
+}
+
+type ServiceAccountRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccountRepository) EXPECT() *ServiceAccountRepository_Expecter {
+	return &ServiceAccountRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, sa
+func (_m *ServiceAccountRepository) Create(ctx context.Context, sa *user.ServiceAccount) (string, error) {
+	ret := _m.Called(ctx, sa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.ServiceAccount) (string, error)); ok {
+		return rf(ctx, sa)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *user.ServiceAccount) string); ok {
+		r0 = rf(ctx, sa)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *user.ServiceAccount) error); ok {
+		r1 = rf(ctx, sa)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ServiceAccountRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sa *user.ServiceAccount
+func (_e *ServiceAccountRepository_Expecter) Create(ctx interface{}, sa interface{}) *ServiceAccountRepository_Create_Call {
+	return &ServiceAccountRepository_Create_Call{Call: _e.mock.On("Create", ctx, sa)}
+}
+
+func (_c *ServiceAccountRepository_Create_Call) Run(run func(ctx context.Context, sa *user.ServiceAccount)) *ServiceAccountRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Create_Call) Return(_a0 string, _a1 error) *ServiceAccountRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Create_Call) RunAndReturn(run func(context.Context, *user.ServiceAccount) (string, error)) *ServiceAccountRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateToken provides a mock function with given fields: ctx, t
+func (_m *ServiceAccountRepository) CreateToken(ctx context.Context, t *user.APIToken) (string, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.APIToken) (string, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *user.APIToken) string); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *user.APIToken) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_CreateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateToken'
+type ServiceAccountRepository_CreateToken_Call struct {
+	*mock.Call
+}
+
+// CreateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *user.APIToken
+func (_e *ServiceAccountRepository_Expecter) CreateToken(ctx interface{}, t interface{}) *ServiceAccountRepository_CreateToken_Call {
+	return &ServiceAccountRepository_CreateToken_Call{Call: _e.mock.On("CreateToken", ctx, t)}
+}
+
+func (_c *ServiceAccountRepository_CreateToken_Call) Run(run func(ctx context.Context, t *user.APIToken)) *ServiceAccountRepository_CreateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.APIToken))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_CreateToken_Call) Return(_a0 string, _a1 error) *ServiceAccountRepository_CreateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_CreateToken_Call) RunAndReturn(run func(context.Context, *user.APIToken) (string, error)) *ServiceAccountRepository_CreateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ServiceAccountRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ServiceAccountRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ServiceAccountRepository_Expecter) Delete(ctx interface{}, id interface{}) *ServiceAccountRepository_Delete_Call {
+	return &ServiceAccountRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) Return(_a0 error) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *ServiceAccountRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteToken provides a mock function with given fields: ctx, serviceAccountID, id
+func (_m *ServiceAccountRepository) DeleteToken(ctx context.Context, serviceAccountID string, id string) error {
+	ret := _m.Called(ctx, serviceAccountID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, serviceAccountID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAccountRepository_DeleteToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteToken'
+type ServiceAccountRepository_DeleteToken_Call struct {
+	*mock.Call
+}
+
+// DeleteToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+//   - id string
+func (_e *ServiceAccountRepository_Expecter) DeleteToken(ctx interface{}, serviceAccountID interface{}, id interface{}) *ServiceAccountRepository_DeleteToken_Call {
+	return &ServiceAccountRepository_DeleteToken_Call{Call: _e.mock.On("DeleteToken", ctx, serviceAccountID, id)}
+}
+
+func (_c *ServiceAccountRepository_DeleteToken_Call) Run(run func(ctx context.Context, serviceAccountID string, id string)) *ServiceAccountRepository_DeleteToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_DeleteToken_Call) Return(_a0 error) *ServiceAccountRepository_DeleteToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_DeleteToken_Call) RunAndReturn(run func(context.Context, string, string) error) *ServiceAccountRepository_DeleteToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ServiceAccountRepository) GetAll(ctx context.Context) ([]user.ServiceAccount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []user.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]user.ServiceAccount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []user.ServiceAccount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ServiceAccountRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ServiceAccountRepository_Expecter) GetAll(ctx interface{}) *ServiceAccountRepository_GetAll_Call {
+	return &ServiceAccountRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ServiceAccountRepository_GetAll_Call) Run(run func(ctx context.Context)) *ServiceAccountRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetAll_Call) Return(_a0 []user.ServiceAccount, _a1 error) *ServiceAccountRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetAll_Call) RunAndReturn(run func(context.Context) ([]user.ServiceAccount, error)) *ServiceAccountRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *ServiceAccountRepository) GetByID(ctx context.Context, id string) (user.ServiceAccount, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 user.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.ServiceAccount, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.ServiceAccount); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(user.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type ServiceAccountRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ServiceAccountRepository_Expecter) GetByID(ctx interface{}, id interface{}) *ServiceAccountRepository_GetByID_Call {
+	return &ServiceAccountRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *ServiceAccountRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *ServiceAccountRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetByID_Call) Return(_a0 user.ServiceAccount, _a1 error) *ServiceAccountRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (user.ServiceAccount, error)) *ServiceAccountRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokenByHash provides a mock function with given fields: ctx, hash
+func (_m *ServiceAccountRepository) GetTokenByHash(ctx context.Context, hash string) (user.APIToken, error) {
+	ret := _m.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenByHash")
+	}
+
+	var r0 user.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.APIToken, error)); ok {
+		return rf(ctx, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.APIToken); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(user.APIToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_GetTokenByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokenByHash'
+type ServiceAccountRepository_GetTokenByHash_Call struct {
+	*mock.Call
+}
+
+// GetTokenByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *ServiceAccountRepository_Expecter) GetTokenByHash(ctx interface{}, hash interface{}) *ServiceAccountRepository_GetTokenByHash_Call {
+	return &ServiceAccountRepository_GetTokenByHash_Call{Call: _e.mock.On("GetTokenByHash", ctx, hash)}
+}
+
+func (_c *ServiceAccountRepository_GetTokenByHash_Call) Run(run func(ctx context.Context, hash string)) *ServiceAccountRepository_GetTokenByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetTokenByHash_Call) Return(_a0 user.APIToken, _a1 error) *ServiceAccountRepository_GetTokenByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetTokenByHash_Call) RunAndReturn(run func(context.Context, string) (user.APIToken, error)) *ServiceAccountRepository_GetTokenByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTokens provides a mock function with given fields: ctx, serviceAccountID
+func (_m *ServiceAccountRepository) GetTokens(ctx context.Context, serviceAccountID string) ([]user.APIToken, error) {
+	ret := _m.Called(ctx, serviceAccountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokens")
+	}
+
+	var r0 []user.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]user.APIToken, error)); ok {
+		return rf(ctx, serviceAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []user.APIToken); ok {
+		r0 = rf(ctx, serviceAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepository_GetTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTokens'
+type ServiceAccountRepository_GetTokens_Call struct {
+	*mock.Call
+}
+
+// GetTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+func (_e *ServiceAccountRepository_Expecter) GetTokens(ctx interface{}, serviceAccountID interface{}) *ServiceAccountRepository_GetTokens_Call {
+	return &ServiceAccountRepository_GetTokens_Call{Call: _e.mock.On("GetTokens", ctx, serviceAccountID)}
+}
+
+func (_c *ServiceAccountRepository_GetTokens_Call) Run(run func(ctx context.Context, serviceAccountID string)) *ServiceAccountRepository_GetTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetTokens_Call) Return(_a0 []user.APIToken, _a1 error) *ServiceAccountRepository_GetTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepository_GetTokens_Call) RunAndReturn(run func(context.Context, string) ([]user.APIToken, error)) *ServiceAccountRepository_GetTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccountRepository creates a new instance of ServiceAccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountRepository {
+	mock := &ServiceAccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
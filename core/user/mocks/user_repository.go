@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
@@ -79,6 +79,124 @@ func (_c *UserRepository_Create_Call) RunAndReturn(run func(context.Context, *us
 	return _c
 }
 
+// DeactivateExcept provides a mock function with given fields: ctx, emails
+func (_m *UserRepository) DeactivateExcept(ctx context.Context, emails []string) ([]user.User, error) {
+	ret := _m.Called(ctx, emails)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateExcept")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]user.User, error)); ok {
+		return rf(ctx, emails)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []user.User); ok {
+		r0 = rf(ctx, emails)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, emails)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_DeactivateExcept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateExcept'
+type UserRepository_DeactivateExcept_Call struct {
+	*mock.Call
+}
+
+// DeactivateExcept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - emails []string
+func (_e *UserRepository_Expecter) DeactivateExcept(ctx interface{}, emails interface{}) *UserRepository_DeactivateExcept_Call {
+	return &UserRepository_DeactivateExcept_Call{Call: _e.mock.On("DeactivateExcept", ctx, emails)}
+}
+
+func (_c *UserRepository_DeactivateExcept_Call) Run(run func(ctx context.Context, emails []string)) *UserRepository_DeactivateExcept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_DeactivateExcept_Call) Return(_a0 []user.User, _a1 error) *UserRepository_DeactivateExcept_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_DeactivateExcept_Call) RunAndReturn(run func(context.Context, []string) ([]user.User, error)) *UserRepository_DeactivateExcept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, flt
+func (_m *UserRepository) GetAll(ctx context.Context, flt user.Filter) ([]user.User, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.Filter) ([]user.User, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, user.Filter) []user.User); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, user.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type UserRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt user.Filter
+func (_e *UserRepository_Expecter) GetAll(ctx interface{}, flt interface{}) *UserRepository_GetAll_Call {
+	return &UserRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, flt)}
+}
+
+func (_c *UserRepository_GetAll_Call) Run(run func(ctx context.Context, flt user.Filter)) *UserRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.Filter))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetAll_Call) Return(_a0 []user.User, _a1 error) *UserRepository_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetAll_Call) RunAndReturn(run func(context.Context, user.Filter) ([]user.User, error)) *UserRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByEmail provides a mock function with given fields: ctx, email
 func (_m *UserRepository) GetByEmail(ctx context.Context, email string) (user.User, error) {
 	ret := _m.Called(ctx, email)
@@ -136,6 +254,120 @@ func (_c *UserRepository_GetByEmail_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetByID(ctx context.Context, id string) (user.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(user.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type UserRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UserRepository_Expecter) GetByID(ctx interface{}, id interface{}) *UserRepository_GetByID_Call {
+	return &UserRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *UserRepository_GetByID_Call) Run(run func(ctx context.Context, id string)) *UserRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) Return(_a0 user.User, _a1 error) *UserRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetByID_Call) RunAndReturn(run func(context.Context, string) (user.User, error)) *UserRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCount provides a mock function with given fields: ctx, flt
+func (_m *UserRepository) GetCount(ctx context.Context, flt user.Filter) (int, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.Filter) (int, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, user.Filter) int); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, user.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCount'
+type UserRepository_GetCount_Call struct {
+	*mock.Call
+}
+
+// GetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt user.Filter
+func (_e *UserRepository_Expecter) GetCount(ctx interface{}, flt interface{}) *UserRepository_GetCount_Call {
+	return &UserRepository_GetCount_Call{Call: _e.mock.On("GetCount", ctx, flt)}
+}
+
+func (_c *UserRepository_GetCount_Call) Run(run func(ctx context.Context, flt user.Filter)) *UserRepository_GetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.Filter))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetCount_Call) Return(_a0 int, _a1 error) *UserRepository_GetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetCount_Call) RunAndReturn(run func(context.Context, user.Filter) (int, error)) *UserRepository_GetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrInsertByEmail provides a mock function with given fields: ctx, u
 func (_m *UserRepository) GetOrInsertByEmail(ctx context.Context, u *user.User) (string, error) {
 	ret := _m.Called(ctx, u)
@@ -250,6 +482,110 @@ func (_c *UserRepository_InsertByEmail_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// Update provides a mock function with given fields: ctx, u
+func (_m *UserRepository) Update(ctx context.Context, u *user.User) error {
+	ret := _m.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.User) error); ok {
+		r0 = rf(ctx, u)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UserRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *user.User
+func (_e *UserRepository_Expecter) Update(ctx interface{}, u interface{}) *UserRepository_Update_Call {
+	return &UserRepository_Update_Call{Call: _e.mock.On("Update", ctx, u)}
+}
+
+func (_c *UserRepository_Update_Call) Run(run func(ctx context.Context, u *user.User)) *UserRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Update_Call) Return(_a0 error) *UserRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserRepository_Update_Call) RunAndReturn(run func(context.Context, *user.User) error) *UserRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function with given fields: ctx, u
+func (_m *UserRepository) Upsert(ctx context.Context, u *user.User) (string, error) {
+	ret := _m.Called(ctx, u)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.User) (string, error)); ok {
+		return rf(ctx, u)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *user.User) string); ok {
+		r0 = rf(ctx, u)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *user.User) error); ok {
+		r1 = rf(ctx, u)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type UserRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - u *user.User
+func (_e *UserRepository_Expecter) Upsert(ctx interface{}, u interface{}) *UserRepository_Upsert_Call {
+	return &UserRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, u)}
+}
+
+func (_c *UserRepository_Upsert_Call) Run(run func(ctx context.Context, u *user.User)) *UserRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.User))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Upsert_Call) Return(_a0 string, _a1 error) *UserRepository_Upsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_Upsert_Call) RunAndReturn(run func(context.Context, *user.User) (string, error)) *UserRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
@@ -8,8 +8,10 @@ import (
 
 // Service is a type of service that manages business process
 type Service struct {
-	repository Repository
-	logger     log.Logger
+	repository               Repository
+	serviceAccountRepository ServiceAccountRepository
+	ownershipRepository      OwnershipRepository
+	logger                   log.Logger
 }
 
 // ValidateUser checks if user email is already in DB
@@ -41,3 +43,19 @@ func NewService(logger log.Logger, repository Repository, opts ...func(*Service)
 
 	return s
 }
+
+// WithServiceAccountRepository enables the service accounts and their API
+// tokens.
+func WithServiceAccountRepository(repo ServiceAccountRepository) func(*Service) {
+	return func(s *Service) {
+		s.serviceAccountRepository = repo
+	}
+}
+
+// WithOwnershipRepository enables the report and the reassignment of the
+// assets left without an active owner by a directory sync.
+func WithOwnershipRepository(repo OwnershipRepository) func(*Service) {
+	return func(s *Service) {
+		s.ownershipRepository = repo
+	}
+}
@@ -0,0 +1,223 @@
+package user
+
+//go:generate mockery --name=ServiceAccountRepository -r --case underscore --with-expecter --structname ServiceAccountRepository --filename service_account_repository.go --output=./mocks
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// ServiceAccountEmailDomain is the domain of the emails given to the
+	// service accounts, for them to be told apart from the humans.
+	ServiceAccountEmailDomain = "serviceaccount.compass"
+	// ServiceAccountProvider is the provider of the service accounts.
+	ServiceAccountProvider = "compass"
+	// APITokenPrefix starts every API token, telling them apart from the
+	// other bearer tokens.
+	APITokenPrefix = "compass_"
+
+	defaultAPITokenTTL = 90 * 24 * time.Hour
+)
+
+var serviceAccountNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{2,49}$`)
+
+type ServiceAccountRepository interface {
+	// Create registers the service account along with its user, whose ID is
+	// the ID of the service account.
+	Create(ctx context.Context, sa *ServiceAccount) (string, error)
+	GetByID(ctx context.Context, id string) (ServiceAccount, error)
+	GetAll(ctx context.Context) ([]ServiceAccount, error)
+	// Delete removes the service account and its tokens, keeping its user for
+	// the changes it made to stay attributed.
+	Delete(ctx context.Context, id string) error
+	CreateToken(ctx context.Context, t *APIToken) (string, error)
+	GetTokens(ctx context.Context, serviceAccountID string) ([]APIToken, error)
+	GetTokenByHash(ctx context.Context, hash string) (APIToken, error)
+	DeleteToken(ctx context.Context, serviceAccountID, id string) error
+}
+
+// ServiceAccount is a user for the machine clients, like the ingestion
+// pipelines, authenticated with API tokens.
+type ServiceAccount struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Email       string    `json:"email"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type TokenScope string
+
+const (
+	// TokenScopeRead allows the read operations only.
+	TokenScopeRead TokenScope = "read"
+	// TokenScopeWrite allows the read and write operations.
+	TokenScopeWrite TokenScope = "write"
+)
+
+func (s TokenScope) IsValid() bool {
+	return s == TokenScopeRead || s == TokenScopeWrite
+}
+
+// APIToken authenticates a service account until it expires. Only the hash
+// of the token is stored.
+type APIToken struct {
+	ID               string       `json:"id"`
+	ServiceAccountID string       `json:"service_account_id"`
+	Name             string       `json:"name"`
+	Hash             string       `json:"-"`
+	Scopes           []TokenScope `json:"scopes"`
+	ExpiresAt        time.Time    `json:"expires_at"`
+	CreatedBy        string       `json:"created_by"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+// Allows checks whether the token can be used for a read or write operation.
+func (t APIToken) Allows(write bool) bool {
+	return slices.Contains(t.Scopes, TokenScopeWrite) || (!write && slices.Contains(t.Scopes, TokenScopeRead))
+}
+
+func (s *Service) CreateServiceAccount(ctx context.Context, sa *ServiceAccount) (string, error) {
+	if s.serviceAccountRepository == nil {
+		return "", errNoServiceAccountRepository
+	}
+	if !serviceAccountNameRegex.MatchString(sa.Name) {
+		return "", ErrInvalidServiceAccountName
+	}
+
+	sa.Email = sa.Name + "@" + ServiceAccountEmailDomain
+	return s.serviceAccountRepository.Create(ctx, sa)
+}
+
+func (s *Service) GetServiceAccountByID(ctx context.Context, id string) (ServiceAccount, error) {
+	if s.serviceAccountRepository == nil {
+		return ServiceAccount{}, ServiceAccountNotFoundError{ID: id}
+	}
+	return s.serviceAccountRepository.GetByID(ctx, id)
+}
+
+func (s *Service) GetServiceAccounts(ctx context.Context) ([]ServiceAccount, error) {
+	if s.serviceAccountRepository == nil {
+		return nil, nil
+	}
+	return s.serviceAccountRepository.GetAll(ctx)
+}
+
+func (s *Service) DeleteServiceAccount(ctx context.Context, id string) error {
+	if s.serviceAccountRepository == nil {
+		return ServiceAccountNotFoundError{ID: id}
+	}
+	return s.serviceAccountRepository.Delete(ctx, id)
+}
+
+// CreateAPIToken issues a token for the service account and returns it, it
+// can not be read afterwards. The token expires in 90 days if not set.
+func (s *Service) CreateAPIToken(ctx context.Context, t *APIToken) (string, error) {
+	if s.serviceAccountRepository == nil {
+		return "", errNoServiceAccountRepository
+	}
+	if len(t.Scopes) == 0 {
+		return "", ErrEmptyTokenScopes
+	}
+	for _, scope := range t.Scopes {
+		if !scope.IsValid() {
+			return "", InvalidTokenScopeError{Scope: scope}
+		}
+	}
+	if t.ExpiresAt.IsZero() {
+		t.ExpiresAt = time.Now().Add(defaultAPITokenTTL)
+	}
+	if !t.ExpiresAt.After(time.Now()) {
+		return "", ErrTokenExpiryInPast
+	}
+
+	if _, err := s.serviceAccountRepository.GetByID(ctx, t.ServiceAccountID); err != nil {
+		return "", err
+	}
+
+	token, err := generateAPIToken()
+	if err != nil {
+		return "", fmt.Errorf("generate api token: %w", err)
+	}
+	t.Hash = hashAPIToken(token)
+
+	id, err := s.serviceAccountRepository.CreateToken(ctx, t)
+	if err != nil {
+		return "", err
+	}
+	t.ID = id
+
+	return token, nil
+}
+
+func (s *Service) GetAPITokens(ctx context.Context, serviceAccountID string) ([]APIToken, error) {
+	if s.serviceAccountRepository == nil {
+		return nil, ServiceAccountNotFoundError{ID: serviceAccountID}
+	}
+	if _, err := s.serviceAccountRepository.GetByID(ctx, serviceAccountID); err != nil {
+		return nil, err
+	}
+	return s.serviceAccountRepository.GetTokens(ctx, serviceAccountID)
+}
+
+func (s *Service) RevokeAPIToken(ctx context.Context, serviceAccountID, id string) error {
+	if s.serviceAccountRepository == nil {
+		return APITokenNotFoundError{ID: id}
+	}
+	return s.serviceAccountRepository.DeleteToken(ctx, serviceAccountID, id)
+}
+
+// AuthenticateToken returns the user of the service account the API token
+// was issued for, if the token is allowed to be used for the operation.
+func (s *Service) AuthenticateToken(ctx context.Context, token string, write bool) (User, error) {
+	if s.serviceAccountRepository == nil || !strings.HasPrefix(token, APITokenPrefix) {
+		return User{}, ErrInvalidAPIToken
+	}
+
+	t, err := s.serviceAccountRepository.GetTokenByHash(ctx, hashAPIToken(token))
+	if errors.As(err, new(APITokenNotFoundError)) {
+		return User{}, ErrInvalidAPIToken
+	}
+	if err != nil {
+		return User{}, err
+	}
+	if !t.ExpiresAt.After(time.Now()) {
+		return User{}, ErrAPITokenExpired
+	}
+	if !t.Allows(write) {
+		return User{}, ErrAPITokenScope
+	}
+
+	sa, err := s.serviceAccountRepository.GetByID(ctx, t.ServiceAccountID)
+	if err != nil {
+		return User{}, fmt.Errorf("get service account of api token: %w", err)
+	}
+
+	return User{ID: sa.ID, Email: sa.Email, Provider: ServiceAccountProvider}, nil
+}
+
+func generateAPIToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPIToken hashes the token with SHA-256, enough for random tokens of
+// 256 bits to not be guessed from their hashes.
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
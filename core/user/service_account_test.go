@@ -0,0 +1,196 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/core/user/mocks"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateServiceAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return error if name is invalid", func(t *testing.T) {
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t),
+			user.WithServiceAccountRepository(mocks.NewServiceAccountRepository(t)))
+
+		_, err := svc.CreateServiceAccount(ctx, &user.ServiceAccount{Name: "Airflow Ingestion"})
+		assert.ErrorIs(t, err, user.ErrInvalidServiceAccountName)
+	})
+
+	t.Run("should create service account with email of its own domain", func(t *testing.T) {
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().Create(ctx, &user.ServiceAccount{
+			Name:  "airflow-ingestion",
+			Email: "airflow-ingestion@serviceaccount.compass",
+		}).Return("sa-1", nil)
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		id, err := svc.CreateServiceAccount(ctx, &user.ServiceAccount{Name: "airflow-ingestion"})
+		assert.NoError(t, err)
+		assert.Equal(t, "sa-1", id)
+	})
+}
+
+func TestService_CreateAPIToken(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		Description string
+		Token       user.APIToken
+		Setup       func(*mocks.ServiceAccountRepository)
+		ExpectedErr error
+	}{
+		{
+			Description: "should return error if token has no scope",
+			Token:       user.APIToken{ServiceAccountID: "sa-1"},
+			ExpectedErr: user.ErrEmptyTokenScopes,
+		},
+		{
+			Description: "should return error if scope is invalid",
+			Token:       user.APIToken{ServiceAccountID: "sa-1", Scopes: []user.TokenScope{"admin"}},
+			ExpectedErr: user.InvalidTokenScopeError{Scope: "admin"},
+		},
+		{
+			Description: "should return error if expiry is in the past",
+			Token:       user.APIToken{ServiceAccountID: "sa-1", Scopes: []user.TokenScope{user.TokenScopeRead}, ExpiresAt: time.Now().Add(-time.Minute)},
+			ExpectedErr: user.ErrTokenExpiryInPast,
+		},
+		{
+			Description: "should return error if service account does not exist",
+			Token:       user.APIToken{ServiceAccountID: "sa-1", Scopes: []user.TokenScope{user.TokenScopeRead}},
+			Setup: func(repo *mocks.ServiceAccountRepository) {
+				repo.EXPECT().GetByID(ctx, "sa-1").Return(user.ServiceAccount{}, user.ServiceAccountNotFoundError{ID: "sa-1"})
+			},
+			ExpectedErr: user.ServiceAccountNotFoundError{ID: "sa-1"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			saRepo := mocks.NewServiceAccountRepository(t)
+			if tc.Setup != nil {
+				tc.Setup(saRepo)
+			}
+			svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+			_, err := svc.CreateAPIToken(ctx, &tc.Token)
+			assert.ErrorIs(t, err, tc.ExpectedErr)
+		})
+	}
+
+	t.Run("should only store hash of token expiring in 90 days by default", func(t *testing.T) {
+		var stored *user.APIToken
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().GetByID(ctx, "sa-1").Return(user.ServiceAccount{ID: "sa-1"}, nil)
+		saRepo.EXPECT().CreateToken(ctx, mock.Anything).
+			Run(func(_ context.Context, t *user.APIToken) { stored = t }).
+			Return("token-1", nil)
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		tkn := user.APIToken{ServiceAccountID: "sa-1", Scopes: []user.TokenScope{user.TokenScopeWrite}}
+		secret, err := svc.CreateAPIToken(ctx, &tkn)
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(secret, user.APITokenPrefix))
+		assert.Equal(t, "token-1", tkn.ID)
+		assert.Len(t, stored.Hash, 64)
+		assert.NotContains(t, secret, stored.Hash)
+		assert.WithinDuration(t, time.Now().Add(90*24*time.Hour), stored.ExpiresAt, time.Minute)
+	})
+}
+
+func TestService_AuthenticateToken(t *testing.T) {
+	ctx := context.Background()
+	sa := user.ServiceAccount{ID: "sa-1", Name: "airflow", Email: "airflow@serviceaccount.compass"}
+
+	// issue returns a token stored in the repository with the scopes, and the
+	// repository serving it
+	issue := func(t *testing.T, scopes []user.TokenScope) (string, *mocks.ServiceAccountRepository) {
+		t.Helper()
+
+		var stored user.APIToken
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().GetByID(ctx, "sa-1").Return(sa, nil)
+		saRepo.EXPECT().CreateToken(ctx, mock.Anything).
+			Run(func(_ context.Context, t *user.APIToken) { stored = *t }).
+			Return("token-1", nil)
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		secret, err := svc.CreateAPIToken(ctx, &user.APIToken{ServiceAccountID: "sa-1", Scopes: scopes})
+		require.NoError(t, err)
+
+		saRepo.EXPECT().GetTokenByHash(ctx, stored.Hash).RunAndReturn(func(context.Context, string) (user.APIToken, error) {
+			return stored, nil
+		}).Maybe()
+		return secret, saRepo
+	}
+
+	t.Run("should return error if token is not an api token", func(t *testing.T) {
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t),
+			user.WithServiceAccountRepository(mocks.NewServiceAccountRepository(t)))
+
+		_, err := svc.AuthenticateToken(ctx, "eyJhbGciOiJSUzI1NiJ9.e30.sig", false)
+		assert.ErrorIs(t, err, user.ErrInvalidAPIToken)
+	})
+
+	t.Run("should return error if token is unknown", func(t *testing.T) {
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().GetTokenByHash(ctx, mock.Anything).Return(user.APIToken{}, user.APITokenNotFoundError{})
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		_, err := svc.AuthenticateToken(ctx, user.APITokenPrefix+"unknown", false)
+		assert.ErrorIs(t, err, user.ErrInvalidAPIToken)
+	})
+
+	t.Run("should return error if token is expired", func(t *testing.T) {
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().GetTokenByHash(ctx, mock.Anything).Return(user.APIToken{
+			ServiceAccountID: "sa-1",
+			Scopes:           []user.TokenScope{user.TokenScopeWrite},
+			ExpiresAt:        time.Now().Add(-time.Minute),
+		}, nil)
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		_, err := svc.AuthenticateToken(ctx, user.APITokenPrefix+"token", false)
+		assert.ErrorIs(t, err, user.ErrAPITokenExpired)
+	})
+
+	t.Run("should return error if read token is used to write", func(t *testing.T) {
+		secret, saRepo := issue(t, []user.TokenScope{user.TokenScopeRead})
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		_, err := svc.AuthenticateToken(ctx, secret, true)
+		assert.ErrorIs(t, err, user.ErrAPITokenScope)
+	})
+
+	t.Run("should return user of service account", func(t *testing.T) {
+		secret, saRepo := issue(t, []user.TokenScope{user.TokenScopeWrite})
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		actual, err := svc.AuthenticateToken(ctx, secret, true)
+		assert.NoError(t, err)
+		assert.Equal(t, user.User{ID: "sa-1", Email: "airflow@serviceaccount.compass", Provider: user.ServiceAccountProvider}, actual)
+	})
+
+	t.Run("should return error if service account can not be fetched", func(t *testing.T) {
+		saRepo := mocks.NewServiceAccountRepository(t)
+		saRepo.EXPECT().GetTokenByHash(ctx, mock.Anything).Return(user.APIToken{
+			ServiceAccountID: "sa-2",
+			Scopes:           []user.TokenScope{user.TokenScopeRead},
+			ExpiresAt:        time.Now().Add(time.Hour),
+		}, nil)
+		saRepo.EXPECT().GetByID(ctx, "sa-2").Return(user.ServiceAccount{}, errors.New("unknown error"))
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t), user.WithServiceAccountRepository(saRepo))
+
+		_, err := svc.AuthenticateToken(ctx, user.APITokenPrefix+"token", false)
+		assert.EqualError(t, err, "get service account of api token: unknown error")
+	})
+}
@@ -0,0 +1,166 @@
+package user
+
+//go:generate mockery --name=OwnershipRepository -r --case underscore --with-expecter --structname OwnershipRepository --filename ownership_repository.go --output=./mocks
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// maxManagerChain bounds the walk up the managers of a departed user, in case
+// the directory has a cycle.
+const maxManagerChain = 10
+
+type OwnershipRepository interface {
+	// GetOrphanedAssets returns the assets all the owners of which are
+	// inactive. Assets owned by a team are not orphaned.
+	GetOrphanedAssets(ctx context.Context) ([]OrphanedAsset, error)
+	// ReplaceOwner gives the asset owned by the user to another user.
+	ReplaceOwner(ctx context.Context, assetID, fromUserID, toUserID string) error
+}
+
+// OrphanedAsset is an asset all the owners of which are inactive, along with
+// the active manager of one of them, if any, to take it over.
+type OrphanedAsset struct {
+	ID             string `json:"id"`
+	URN            string `json:"urn"`
+	Type           string `json:"type"`
+	Service        string `json:"service"`
+	Name           string `json:"name"`
+	Owners         []User `json:"owners"`
+	SuggestedOwner string `json:"suggested_owner,omitempty"`
+}
+
+// SyncReport is the outcome of a sync of the users with a directory.
+// Orphaned are the assets found without an active owner, the ones given to
+// the suggested owner are in ReassignedAssetIDs.
+type SyncReport struct {
+	Upserted           int             `json:"upserted"`
+	Deactivated        []User          `json:"deactivated"`
+	Orphaned           []OrphanedAsset `json:"orphaned"`
+	ReassignedAssetIDs []string        `json:"reassigned_asset_ids"`
+}
+
+// SyncDirectoryFile syncs the users with the directory export at the path, see
+// SyncDirectory.
+func (s *Service) SyncDirectoryFile(ctx context.Context, path string, reassign bool) (SyncReport, error) {
+	format, err := DirectoryFormatOf(path)
+	if err != nil {
+		return SyncReport{}, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return SyncReport{}, fmt.Errorf("open directory: %w", err)
+	}
+	defer f.Close()
+
+	users, err := ParseDirectory(f, format)
+	if err != nil {
+		return SyncReport{}, err
+	}
+
+	return s.SyncDirectory(ctx, users, reassign)
+}
+
+// SyncDirectory upserts the users of the directory and marks inactive the
+// users who are not in it anymore, service accounts aside. It then reports
+// the assets whose owners are all inactive, and gives them to the closest
+// active manager of their owners when reassign is set. An empty directory is
+// refused, for a broken export not to deactivate everyone.
+func (s *Service) SyncDirectory(ctx context.Context, users []User, reassign bool) (SyncReport, error) {
+	if len(users) == 0 {
+		return SyncReport{}, ErrEmptyDirectory
+	}
+
+	directory := make(map[string]User, len(users))
+	emails := make([]string, 0, len(users))
+	for _, u := range users {
+		id, err := s.repository.Upsert(ctx, &u)
+		if err != nil {
+			return SyncReport{}, fmt.Errorf("upsert user %q: %w", u.Email, err)
+		}
+		u.ID = id
+		directory[strings.ToLower(u.Email)] = u
+		emails = append(emails, u.Email)
+	}
+
+	deactivated, err := s.repository.DeactivateExcept(ctx, emails)
+	if err != nil {
+		return SyncReport{}, fmt.Errorf("deactivate departed users: %w", err)
+	}
+
+	report := SyncReport{
+		Upserted:    len(users),
+		Deactivated: deactivated,
+	}
+	if s.ownershipRepository == nil {
+		return report, nil
+	}
+
+	orphaned, err := s.ownershipRepository.GetOrphanedAssets(ctx)
+	if err != nil {
+		return report, fmt.Errorf("get orphaned assets: %w", err)
+	}
+
+	for i, a := range orphaned {
+		reassigned := false
+		for _, owner := range a.Owners {
+			manager, ok := s.activeManager(ctx, owner, directory)
+			if !ok {
+				continue
+			}
+			if orphaned[i].SuggestedOwner == "" {
+				orphaned[i].SuggestedOwner = manager.Email
+			}
+			if !reassign {
+				break
+			}
+			if err := s.ownershipRepository.ReplaceOwner(ctx, a.ID, owner.ID, manager.ID); err != nil {
+				return report, fmt.Errorf("reassign asset %q: %w", a.URN, err)
+			}
+			reassigned = true
+		}
+		if reassigned {
+			report.ReassignedAssetIDs = append(report.ReassignedAssetIDs, a.ID)
+		}
+	}
+	report.Orphaned = orphaned
+
+	return report, nil
+}
+
+// activeManager walks up the managers of the user until an active one, the
+// managers of the users who left before being looked up in the repository.
+func (s *Service) activeManager(ctx context.Context, u User, directory map[string]User) (User, bool) {
+	seen := map[string]struct{}{strings.ToLower(u.Email): {}}
+	email := u.Manager
+	for i := 0; i < maxManagerChain && email != ""; i++ {
+		key := strings.ToLower(email)
+		if _, ok := seen[key]; ok {
+			return User{}, false
+		}
+		seen[key] = struct{}{}
+
+		manager, ok := directory[key]
+		if !ok {
+			var err error
+			manager, err = s.repository.GetByEmail(ctx, email)
+			if err != nil {
+				if !errors.As(err, new(NotFoundError)) {
+					s.logger.Warn("get manager of departed user", "email", email, "err", err)
+				}
+				return User{}, false
+			}
+			// users missing from the directory were deactivated
+			manager.Inactive = true
+		}
+		if !manager.Inactive && manager.ID != "" {
+			return manager, true
+		}
+		email = manager.Manager
+	}
+	return User{}, false
+}
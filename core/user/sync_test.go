@@ -0,0 +1,96 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/core/user/mocks"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_SyncDirectory(t *testing.T) {
+	ctx := context.Background()
+	directory := []user.User{
+		{Email: "cto@example.com"},
+		{Email: "lead@example.com", Manager: "cto@example.com", Inactive: true},
+	}
+	departed := user.User{ID: "departed-id", Email: "dev@example.com", Manager: "lead@example.com", Inactive: true}
+	orphaned := user.OrphanedAsset{ID: "asset-id", URN: "urn:orders", Owners: []user.User{departed}}
+
+	setupUpserts := func(repo *mocks.UserRepository) {
+		repo.EXPECT().Upsert(ctx, &user.User{Email: "cto@example.com"}).Return("cto-id", nil)
+		repo.EXPECT().Upsert(ctx, &user.User{Email: "lead@example.com", Manager: "cto@example.com", Inactive: true}).Return("lead-id", nil)
+		repo.EXPECT().DeactivateExcept(ctx, []string{"cto@example.com", "lead@example.com"}).Return([]user.User{departed}, nil)
+	}
+
+	t.Run("refuse empty directory", func(t *testing.T) {
+		svc := user.NewService(log.NewNoop(), mocks.NewUserRepository(t))
+
+		_, err := svc.SyncDirectory(ctx, nil, false)
+		assert.ErrorIs(t, err, user.ErrEmptyDirectory)
+	})
+
+	t.Run("return error if upsert fails", func(t *testing.T) {
+		repo := mocks.NewUserRepository(t)
+		repo.EXPECT().Upsert(ctx, mock.Anything).Return("", errors.New("some error"))
+		svc := user.NewService(log.NewNoop(), repo)
+
+		_, err := svc.SyncDirectory(ctx, directory, false)
+		assert.ErrorContains(t, err, "some error")
+	})
+
+	t.Run("report orphaned assets with the closest active manager", func(t *testing.T) {
+		repo := mocks.NewUserRepository(t)
+		ownership := mocks.NewOwnershipRepository(t)
+		setupUpserts(repo)
+		ownership.EXPECT().GetOrphanedAssets(ctx).Return([]user.OrphanedAsset{orphaned}, nil)
+		svc := user.NewService(log.NewNoop(), repo, user.WithOwnershipRepository(ownership))
+
+		report, err := svc.SyncDirectory(ctx, directory, false)
+		assert.NoError(t, err)
+
+		expected := orphaned
+		expected.SuggestedOwner = "cto@example.com"
+		assert.Equal(t, user.SyncReport{
+			Upserted:    2,
+			Deactivated: []user.User{departed},
+			Orphaned:    []user.OrphanedAsset{expected},
+		}, report)
+	})
+
+	t.Run("reassign orphaned assets to the manager", func(t *testing.T) {
+		repo := mocks.NewUserRepository(t)
+		ownership := mocks.NewOwnershipRepository(t)
+		setupUpserts(repo)
+		ownership.EXPECT().GetOrphanedAssets(ctx).Return([]user.OrphanedAsset{orphaned}, nil)
+		ownership.EXPECT().ReplaceOwner(ctx, "asset-id", "departed-id", "cto-id").Return(nil)
+		svc := user.NewService(log.NewNoop(), repo, user.WithOwnershipRepository(ownership))
+
+		report, err := svc.SyncDirectory(ctx, directory, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"asset-id"}, report.ReassignedAssetIDs)
+	})
+
+	t.Run("look up managers who left before", func(t *testing.T) {
+		repo := mocks.NewUserRepository(t)
+		ownership := mocks.NewOwnershipRepository(t)
+		setupUpserts(repo)
+		formerLead := departed
+		formerLead.Manager = "former-lead@example.com"
+		repo.EXPECT().GetByEmail(ctx, "former-lead@example.com").
+			Return(user.User{ID: "former-lead-id", Email: "former-lead@example.com", Inactive: true}, nil)
+		ownership.EXPECT().GetOrphanedAssets(ctx).Return([]user.OrphanedAsset{
+			{ID: "asset-id", URN: "urn:orders", Owners: []user.User{formerLead}},
+		}, nil)
+		svc := user.NewService(log.NewNoop(), repo, user.WithOwnershipRepository(ownership))
+
+		report, err := svc.SyncDirectory(ctx, directory, true)
+		assert.NoError(t, err)
+		assert.Empty(t, report.Orphaned[0].SuggestedOwner)
+		assert.Empty(t, report.ReassignedAssetIDs)
+	})
+}
@@ -13,6 +13,21 @@ type User struct {
 	Provider  string    `json:"provider" diff:"-" db:"provider"`
 	CreatedAt time.Time `json:"-" diff:"-" db:"created_at"`
 	UpdatedAt time.Time `json:"-" diff:"-" db:"updated_at"`
+	// Groups are the groups the user belongs to according to the identity
+	// of the request, they are not stored
+	Groups []string `json:"groups,omitempty" diff:"-" db:"-"`
+	// Name, Manager and Inactive come from the directory the users are
+	// synced from, Manager being the email of the manager of the user
+	Name     string `json:"name,omitempty" diff:"-" db:"name"`
+	Manager  string `json:"manager,omitempty" diff:"-" db:"manager_email"`
+	Inactive bool   `json:"inactive,omitempty" diff:"-" db:"inactive"`
+}
+
+// IsZero tells whether the user is the zero value
+func (u User) IsZero() bool {
+	return u.ID == "" && u.Email == "" && u.Provider == "" &&
+		u.Name == "" && u.Manager == "" && !u.Inactive &&
+		u.CreatedAt.IsZero() && u.UpdatedAt.IsZero() && len(u.Groups) == 0
 }
 
 // Validate validates a user is valid or not
@@ -28,10 +43,31 @@ func (u *User) Validate() error {
 	return nil
 }
 
+// Filter narrows down the users, service accounts being left out
+type Filter struct {
+	// Email matches the email ignoring the case
+	Email  string
+	Name   string
+	Offset int
+	Size   int
+}
+
 // Repository contains interface of supported methods
 type Repository interface {
 	Create(ctx context.Context, u *User) (string, error)
+	GetByID(ctx context.Context, id string) (User, error)
 	GetByEmail(ctx context.Context, email string) (User, error)
+	GetAll(ctx context.Context, flt Filter) ([]User, error)
+	GetCount(ctx context.Context, flt Filter) (int, error)
+	// Update updates the email, name, manager and inactive flag of the user
+	// with the ID.
+	Update(ctx context.Context, u *User) error
 	InsertByEmail(ctx context.Context, u *User) (string, error)
 	GetOrInsertByEmail(ctx context.Context, u *User) (string, error)
+	// Upsert inserts the user or updates the name, manager and inactive flag
+	// of the user with the same email.
+	Upsert(ctx context.Context, u *User) (string, error)
+	// DeactivateExcept marks inactive the active users whose email is not
+	// given, leaving out the service accounts, and returns them.
+	DeactivateExcept(ctx context.Context, emails []string) ([]User, error)
 }
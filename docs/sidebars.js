@@ -22,6 +22,8 @@ module.exports = {
         "guides/starring",
         "guides/tagging",
         "guides/discussion",
+        "guides/audit",
+        "guides/rate_limit",
       ],
     },
     {
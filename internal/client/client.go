@@ -15,6 +15,8 @@ type Config struct {
 	Host                   string `mapstructure:"host" default:"localhost:8081"`
 	ServerHeaderKeyEmail   string `yaml:"serverheaderkey_email" mapstructure:"serverheaderkey_email" default:"Compass-User-Email"`
 	ServerHeaderValueEmail string `yaml:"serverheadervalue_email" mapstructure:"serverheadervalue_email" default:"compass@gotocompany.com"`
+	// ServerAuthToken is sent as bearer token to the servers identifying users by JWT
+	ServerAuthToken string `yaml:"server_auth_token" mapstructure:"server_auth_token"`
 }
 
 func Create(ctx context.Context, cfg Config) (compassv1beta1.CompassServiceClient, func(), error) {
@@ -36,6 +38,9 @@ func Create(ctx context.Context, cfg Config) (compassv1beta1.CompassServiceClien
 
 func SetMetadata(ctx context.Context, cfg Config) context.Context {
 	md := metadata.New(map[string]string{cfg.ServerHeaderKeyEmail: cfg.ServerHeaderValueEmail})
+	if cfg.ServerAuthToken != "" {
+		md.Set("authorization", "Bearer "+cfg.ServerAuthToken)
+	}
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	return ctx
@@ -0,0 +1,44 @@
+package embedder
+
+import (
+	"fmt"
+
+	"github.com/goto/compass/core/asset"
+)
+
+const (
+	ProviderHash = "hash"
+	ProviderHTTP = "http"
+)
+
+type Config struct {
+	// Provider selects the embedder implementation, semantic search is
+	// disabled when it is empty.
+	Provider string `mapstructure:"provider" default:""`
+	// Host of an OpenAI compatible embeddings API, used by the http provider.
+	Host  string `mapstructure:"host" default:""`
+	Model string `mapstructure:"model" default:""`
+	Dims  int    `mapstructure:"dims" default:"384"`
+}
+
+// New returns the asset.Embedder configured by cfg, or nil when semantic
+// search is not enabled.
+func New(cfg Config) (asset.Embedder, error) {
+	if cfg.Provider != "" && cfg.Dims <= 0 {
+		return nil, fmt.Errorf("embedder dims must be greater than 0, got %d", cfg.Dims)
+	}
+
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case ProviderHash:
+		return NewHashEmbedder(cfg.Dims), nil
+	case ProviderHTTP:
+		if cfg.Host == "" {
+			return nil, fmt.Errorf("embedder host is required for provider %q", ProviderHTTP)
+		}
+		return NewHTTPClient(cfg.Host, cfg.Model, cfg.Dims), nil
+	default:
+		return nil, fmt.Errorf("unknown embedder provider %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,132 @@
+package embedder_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/compass/internal/embedder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("should return nil embedder when provider is empty", func(t *testing.T) {
+		e, err := embedder.New(embedder.Config{})
+		assert.NoError(t, err)
+		assert.Nil(t, e)
+	})
+
+	t.Run("should return hash embedder", func(t *testing.T) {
+		e, err := embedder.New(embedder.Config{Provider: embedder.ProviderHash, Dims: 16})
+		require.NoError(t, err)
+		assert.IsType(t, &embedder.HashEmbedder{}, e)
+		assert.Equal(t, 16, e.Dims())
+	})
+
+	t.Run("should return error when http provider has no host", func(t *testing.T) {
+		_, err := embedder.New(embedder.Config{Provider: embedder.ProviderHTTP, Dims: 16})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when dims is not positive", func(t *testing.T) {
+		_, err := embedder.New(embedder.Config{Provider: embedder.ProviderHash})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error for unknown provider", func(t *testing.T) {
+		_, err := embedder.New(embedder.Config{Provider: "unknown", Dims: 16})
+		assert.Error(t, err)
+	})
+}
+
+func TestHashEmbedder_Embed(t *testing.T) {
+	ctx := context.Background()
+	e := embedder.NewHashEmbedder(64)
+
+	t.Run("should be deterministic and normalised", func(t *testing.T) {
+		v1, err := e.Embed(ctx, "Orders placed by customers")
+		require.NoError(t, err)
+		v2, err := e.Embed(ctx, "orders, placed by CUSTOMERS")
+		require.NoError(t, err)
+
+		assert.Len(t, v1, 64)
+		assert.Equal(t, v1, v2)
+		assert.InDelta(t, 1.0, norm(v1), 1e-6)
+	})
+
+	t.Run("should place texts sharing tokens closer", func(t *testing.T) {
+		query, err := e.Embed(ctx, "customer orders")
+		require.NoError(t, err)
+		related, err := e.Embed(ctx, "orders placed by customer")
+		require.NoError(t, err)
+		unrelated, err := e.Embed(ctx, "daily weather forecast")
+		require.NoError(t, err)
+
+		assert.Greater(t, dot(query, related), dot(query, unrelated))
+	})
+
+	t.Run("should return zero vector for text without tokens", func(t *testing.T) {
+		v, err := e.Embed(ctx, " ,. ")
+		require.NoError(t, err)
+		assert.Zero(t, norm(v))
+	})
+}
+
+func TestHTTPClient_Embed(t *testing.T) {
+	t.Run("should return embedding from response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/embeddings", r.URL.Path)
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "some text", body["input"])
+			assert.Equal(t, "a-model", body["model"])
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]interface{}{{"embedding": []float32{0.6, 0.8}}},
+			})
+		}))
+		defer srv.Close()
+
+		c := embedder.NewHTTPClient(srv.URL+"/", "a-model", 2)
+		v, err := c.Embed(context.Background(), "some text")
+		require.NoError(t, err)
+		assert.Equal(t, []float32{0.6, 0.8}, v)
+	})
+
+	t.Run("should return error when dims do not match", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]interface{}{{"embedding": []float32{0.6, 0.8}}},
+			})
+		}))
+		defer srv.Close()
+
+		_, err := embedder.NewHTTPClient(srv.URL, "", 3).Embed(context.Background(), "some text")
+		assert.ErrorContains(t, err, "expected 3")
+	})
+
+	t.Run("should return error on non 2xx status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		_, err := embedder.NewHTTPClient(srv.URL, "", 2).Embed(context.Background(), "some text")
+		assert.ErrorContains(t, err, "502")
+	})
+}
+
+func norm(v []float32) float64 {
+	return math.Sqrt(dot(v, v))
+}
+
+func dot(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i] * b[i])
+	}
+	return sum
+}
@@ -0,0 +1,59 @@
+package embedder
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// HashEmbedder implements asset.Embedder by hashing the tokens of the text
+// into a fixed number of buckets. It is deterministic and needs no external
+// service, which makes it suitable for tests and local setups. Only texts
+// sharing tokens end up close to each other.
+type HashEmbedder struct {
+	dims int
+}
+
+func NewHashEmbedder(dims int) *HashEmbedder {
+	return &HashEmbedder{dims: dims}
+}
+
+func (e *HashEmbedder) Dims() int { return e.dims }
+
+// Embed returns the L2 normalised token histogram of text. A text without
+// any token yields the zero vector.
+func (e *HashEmbedder) Embed(_ context.Context, text string) ([]float32, error) {
+	vec := make([]float32, e.dims)
+	tokens := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, tok := range tokens {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(tok))
+		sum := h.Sum64()
+
+		// the highest bit decides the sign to spread collisions around zero
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vec[sum%uint64(e.dims)] += sign
+	}
+
+	var norm float64
+	for _, v := range vec {
+		norm += float64(v * v)
+	}
+	if norm == 0 {
+		return vec, nil
+	}
+
+	norm = math.Sqrt(norm)
+	for i := range vec {
+		vec[i] = float32(float64(vec[i]) / norm)
+	}
+
+	return vec, nil
+}
@@ -0,0 +1,96 @@
+package embedder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	embeddingsAPIPath = "/v1/embeddings"
+
+	httpTimeout = 10 * time.Second
+)
+
+// HTTPClient implements asset.Embedder by calling an OpenAI compatible
+// embeddings API.
+type HTTPClient struct {
+	httpClient *http.Client
+	host       string
+	model      string
+	dims       int
+}
+
+func NewHTTPClient(host, model string, dims int) *HTTPClient {
+	return &HTTPClient{
+		httpClient: &http.Client{Timeout: httpTimeout},
+		host:       strings.TrimSuffix(host, "/"),
+		model:      model,
+		dims:       dims,
+	}
+}
+
+func (c *HTTPClient) Dims() int { return c.dims }
+
+func (c *HTTPClient) Embed(ctx context.Context, text string) ([]float32, error) {
+	payload, err := json.Marshal(embeddingsRequest{
+		Model:      c.model,
+		Input:      text,
+		Dimensions: c.dims,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode embeddings payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.host+embeddingsAPIPath, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("create embeddings request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send embeddings request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read embeddings response body: %w", err)
+	}
+	if res.StatusCode >= 300 {
+		return nil, fmt.Errorf("embeddings response status code %d: %s", res.StatusCode, string(body))
+	}
+
+	var response embeddingsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("parse embeddings response: %w", err)
+	}
+	if len(response.Data) == 0 {
+		return nil, fmt.Errorf("embeddings response has no data")
+	}
+
+	vec := response.Data[0].Embedding
+	if len(vec) != c.dims {
+		return nil, fmt.Errorf("embedding has %d dims, expected %d", len(vec), c.dims)
+	}
+
+	return vec, nil
+}
+
+type embeddingsRequest struct {
+	Model      string `json:"model,omitempty"`
+	Input      string `json:"input"`
+	Dimensions int    `json:"dimensions,omitempty"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
@@ -0,0 +1,42 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// filter is a SCIM filter comparing an attribute to a value, the only kind the
+// identity providers use to look up users and groups.
+type filter struct {
+	Attribute string
+	Value     string
+}
+
+// parseFilter parses a filter of the form `attribute eq "value"`, the
+// attribute being lowercased. An empty filter matches everything.
+func parseFilter(s string) (filter, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return filter{}, nil
+	}
+
+	parts := strings.SplitN(s, " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return filter{}, fmt.Errorf("unsupported filter %q, only eq is supported", s)
+	}
+
+	value := strings.TrimSpace(parts[2])
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return filter{}, fmt.Errorf("invalid filter value %s", value)
+		}
+		value = unquoted
+	}
+
+	return filter{
+		Attribute: strings.ToLower(parts[0]),
+		Value:     value,
+	}, nil
+}
@@ -0,0 +1,330 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+)
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+	startIndex, count := page(r)
+
+	flt, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	var match func(team.Team) bool
+	switch normalizeAttr(flt.Attribute) {
+	case "":
+		match = func(team.Team) bool { return true }
+	case "displayname":
+		name := teamName(flt.Value)
+		match = func(t team.Team) bool { return t.Name == name }
+	case "id":
+		match = func(t team.Team) bool { return t.ID == flt.Value }
+	case "externalid":
+		// external ids are not stored, the groups are looked up by displayName
+		match = func(team.Team) bool { return false }
+	default:
+		writeError(w, http.StatusBadRequest, "invalidFilter", "unsupported filter attribute "+flt.Attribute)
+		return
+	}
+
+	// teams are few, they are filtered and paged here rather than in the store
+	teams, err := h.teams.GetTeams(ctx, team.Filter{})
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	var matched []team.Team
+	for _, t := range teams {
+		if match(t) {
+			matched = append(matched, t)
+		}
+	}
+
+	withMembers := !excludesMembers(r)
+	from := min(startIndex-1, len(matched))
+	to := min(from+count, len(matched))
+	resources := make([]Group, 0, to-from)
+	for _, t := range matched[from:to] {
+		resources = append(resources, teamToResource(t, withMembers, location(r, "Groups", t.ID)))
+	}
+	writeList(w, resources, len(matched), startIndex)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+
+	var res Group
+	if err := decode(r, &res); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	t := team.Team{Name: teamName(res.DisplayName)}
+	members, err := h.members(ctx, res.Members)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	t.Members = members
+
+	id, err := h.teams.CreateTeam(ctx, &t)
+	if err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+
+	created, err := h.teams.GetTeamByID(ctx, id)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, teamToResource(created, true, location(r, "Groups", created.ID)))
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	t, err := h.teams.GetTeamByID(r.Context(), pathParams["id"])
+	if err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, teamToResource(t, !excludesMembers(r), location(r, "Groups", t.ID)))
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	var res Group
+	if err := decode(r, &res); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	t, err := h.teams.GetTeamByID(ctx, pathParams["id"])
+	if err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+	if err := checkDisplayName(t, res.DisplayName); err != nil {
+		h.fail(w, err)
+		return
+	}
+	members, err := h.members(ctx, res.Members)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	t.Members = members
+
+	h.updateGroup(w, r, t)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	var req PatchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	t, err := h.teams.GetTeamByID(ctx, pathParams["id"])
+	if err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+	for _, op := range req.Operations {
+		if err := h.applyGroupOperation(ctx, &t, op); err != nil {
+			h.fail(w, err)
+			return
+		}
+	}
+
+	h.updateGroup(w, r, t)
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	if err := h.teams.DeleteTeam(r.Context(), pathParams["id"]); err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) updateGroup(w http.ResponseWriter, r *http.Request, t team.Team) {
+	ctx := r.Context()
+
+	if err := h.teams.UpdateTeam(ctx, &t); err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+
+	updated, err := h.teams.GetTeamByID(ctx, t.ID)
+	if err != nil {
+		h.fail(w, teamError(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, teamToResource(updated, true, location(r, "Groups", updated.ID)))
+}
+
+// applyGroupOperation applies the patch operation to the team, only its
+// members can be changed.
+func (h *Handler) applyGroupOperation(ctx context.Context, t *team.Team, op PatchOperation) error {
+	path := normalizeAttr(op.Path)
+
+	if path == "" {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return invalidValue("value of an operation without path must be an object")
+		}
+		for attr, value := range values {
+			err := h.applyGroupOperation(ctx, t, PatchOperation{Op: op.Op, Path: attr, Value: value})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if path == "displayname" {
+		var displayName string
+		if err := json.Unmarshal(op.Value, &displayName); err != nil {
+			return invalidValue("displayName must be a string")
+		}
+		return checkDisplayName(*t, displayName)
+	}
+
+	memberID, ok := memberPath(op.Path)
+	if !ok {
+		// other attributes are not stored
+		return nil
+	}
+
+	var refs []Reference
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &refs); err != nil {
+			return invalidValue("members must be a list of references")
+		}
+	}
+	if memberID != "" {
+		refs = append(refs, Reference{Value: memberID})
+	}
+
+	switch strings.ToLower(op.Op) {
+	case "add":
+		added, err := h.members(ctx, refs)
+		if err != nil {
+			return err
+		}
+		t.Members = append(t.Members, added...)
+
+	case "replace":
+		members, err := h.members(ctx, refs)
+		if err != nil {
+			return err
+		}
+		t.Members = members
+
+	case "remove":
+		// a remove without a value removes all the members
+		if len(refs) == 0 {
+			t.Members = nil
+			return nil
+		}
+		removed := make(map[string]struct{}, len(refs))
+		for _, ref := range refs {
+			removed[ref.Value] = struct{}{}
+		}
+		members := make([]user.User, 0, len(t.Members))
+		for _, m := range t.Members {
+			if _, ok := removed[m.ID]; !ok {
+				members = append(members, m)
+			}
+		}
+		t.Members = members
+
+	default:
+		return invalidValue("unsupported operation %q", op.Op)
+	}
+	return nil
+}
+
+// members resolves the references to the users, which the teams keep by
+// email.
+func (h *Handler) members(ctx context.Context, refs []Reference) ([]user.User, error) {
+	members := make([]user.User, 0, len(refs))
+	for _, ref := range refs {
+		u, err := h.users.GetByID(ctx, ref.Value)
+		if errors.As(err, new(user.NotFoundError)) {
+			return nil, invalidValue("member %q does not exist", ref.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, u)
+	}
+	return members, nil
+}
+
+// memberPath tells whether the path is the members, returning the id of the
+// member for a `members[value eq "id"]` path.
+func memberPath(path string) (string, bool) {
+	path = strings.TrimSpace(path)
+	prefix := "members["
+	if normalizeAttr(path) == "members" {
+		return "", true
+	}
+	if len(path) <= len(prefix) || !strings.EqualFold(path[:len(prefix)], prefix) || !strings.HasSuffix(path, "]") {
+		return "", false
+	}
+
+	flt, err := parseFilter(path[len(prefix) : len(path)-1])
+	if err != nil || flt.Attribute != "value" {
+		return "", false
+	}
+	return flt.Value, true
+}
+
+// checkDisplayName refuses to rename the team, the assets it owns referring
+// to it by name.
+func checkDisplayName(t team.Team, displayName string) error {
+	if displayName == "" || teamName(displayName) == t.Name {
+		return nil
+	}
+	return requestError{
+		status:   http.StatusBadRequest,
+		scimType: "mutability",
+		detail:   "displayName of group " + t.Name + " can not be changed",
+	}
+}
+
+func excludesMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if normalizeAttr(attr) == "members" {
+			return true
+		}
+	}
+	return false
+}
+
+func teamError(err error) error {
+	switch {
+	case errors.As(err, new(team.NotFoundError)), errors.As(err, new(team.InvalidError)):
+		return requestError{status: http.StatusNotFound, detail: err.Error()}
+	case errors.As(err, new(team.DuplicateError)):
+		return requestError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+	case errors.Is(err, team.ErrInvalidName), errors.Is(err, team.ErrEmptyMemberEmail):
+		return invalidValue(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+)
+
+const (
+	schemaUser               = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaEnterpriseUser     = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	schemaGroup              = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse       = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp            = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError              = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderCfg = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+type Meta struct {
+	ResourceType string     `json:"resourceType"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type EnterpriseUser struct {
+	Manager *Reference `json:"manager,omitempty"`
+}
+
+// User is the SCIM representation of a user, the userName being its email.
+type User struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	UserName    string          `json:"userName"`
+	Name        *Name           `json:"name,omitempty"`
+	DisplayName string          `json:"displayName,omitempty"`
+	Emails      []Email         `json:"emails,omitempty"`
+	Active      *bool           `json:"active,omitempty"`
+	Enterprise  *EnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta        *Meta           `json:"meta,omitempty"`
+}
+
+// Group is the SCIM representation of a team, the displayName being its name.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// email is the email of the user, its userName unless it is not an email.
+func (u User) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return strings.TrimSpace(u.UserName)
+	}
+	for _, e := range u.Emails {
+		if e.Primary {
+			return strings.TrimSpace(e.Value)
+		}
+	}
+	if len(u.Emails) > 0 {
+		return strings.TrimSpace(u.Emails[0].Value)
+	}
+	return strings.TrimSpace(u.UserName)
+}
+
+func (u User) name() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+func (u User) managerID() string {
+	if u.Enterprise == nil || u.Enterprise.Manager == nil {
+		return ""
+	}
+	return u.Enterprise.Manager.Value
+}
+
+func userToResource(u user.User, managerID, location string) User {
+	active := !u.Inactive
+	res := User{
+		Schemas:  []string{schemaUser},
+		ID:       u.ID,
+		UserName: u.Email,
+		Emails:   []Email{{Value: u.Email, Type: "work", Primary: true}},
+		Active:   &active,
+		Meta:     &Meta{ResourceType: "User", Location: location},
+	}
+	if u.Name != "" {
+		res.Name = &Name{Formatted: u.Name}
+		res.DisplayName = u.Name
+	}
+	if managerID != "" {
+		res.Schemas = append(res.Schemas, schemaEnterpriseUser)
+		res.Enterprise = &EnterpriseUser{Manager: &Reference{Value: managerID, Display: u.Manager}}
+	}
+	if !u.CreatedAt.IsZero() {
+		res.Meta.Created = &u.CreatedAt
+	}
+	if !u.UpdatedAt.IsZero() {
+		res.Meta.LastModified = &u.UpdatedAt
+	}
+	return res
+}
+
+func teamToResource(t team.Team, withMembers bool, location string) Group {
+	res := Group{
+		Schemas:     []string{schemaGroup},
+		ID:          t.ID,
+		DisplayName: t.Name,
+		Meta:        &Meta{ResourceType: "Group", Location: location},
+	}
+	if withMembers {
+		for _, m := range t.Members {
+			res.Members = append(res.Members, Reference{Value: m.ID, Display: m.Email})
+		}
+	}
+	if !t.CreatedAt.IsZero() {
+		res.Meta.Created = &t.CreatedAt
+	}
+	if !t.UpdatedAt.IsZero() {
+		res.Meta.LastModified = &t.UpdatedAt
+	}
+	return res
+}
+
+// teamName turns the display name of a group into a valid team name, by
+// lowercasing it and replacing the runs of other characters than letters,
+// digits, dots, dashes and underscores with a dash.
+func teamName(displayName string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(displayName)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			b.WriteRune(r)
+			dash = false
+		case !dash:
+			b.WriteRune('-')
+			dash = true
+		}
+	}
+	return strings.Trim(b.String(), "-._")
+}
@@ -0,0 +1,207 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+	handlersv1beta1 "github.com/goto/compass/internal/server/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	basePath     = "/scim/v2"
+	contentType  = "application/scim+json"
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+// Config enables the SCIM endpoints, for the identity provider to provision
+// the users and the groups.
+type Config struct {
+	// Token is the bearer token the identity provider authenticates with, the
+	// endpoints are not served without it.
+	Token string `yaml:"token" mapstructure:"token"`
+}
+
+// Handler serves the SCIM 2.0 users, backed by the user repository, and
+// groups, backed by the teams.
+type Handler struct {
+	users    user.Repository
+	teams    handlersv1beta1.TeamService
+	provider string
+	logger   log.Logger
+}
+
+// NewHandler creates the SCIM handler, the users it creates having the
+// provider.
+func NewHandler(users user.Repository, teams handlersv1beta1.TeamService, provider string, logger log.Logger) *Handler {
+	return &Handler{
+		users:    users,
+		teams:    teams,
+		provider: provider,
+		logger:   logger,
+	}
+}
+
+// Register mounts the SCIM endpoints on the mux, authenticating the requests
+// with the bearer token.
+func (h *Handler) Register(mux *runtime.ServeMux, token string) error {
+	if token == "" {
+		return errors.New("register scim handler: token is empty")
+	}
+
+	routes := []struct {
+		method  string
+		path    string
+		handler runtime.HandlerFunc
+	}{
+		{http.MethodGet, "/ServiceProviderConfig", h.getServiceProviderConfig},
+		{http.MethodGet, "/Users", h.listUsers},
+		{http.MethodPost, "/Users", h.createUser},
+		{http.MethodGet, "/Users/{id}", h.getUser},
+		{http.MethodPut, "/Users/{id}", h.replaceUser},
+		{http.MethodPatch, "/Users/{id}", h.patchUser},
+		{http.MethodDelete, "/Users/{id}", h.deleteUser},
+		{http.MethodGet, "/Groups", h.listGroups},
+		{http.MethodPost, "/Groups", h.createGroup},
+		{http.MethodGet, "/Groups/{id}", h.getGroup},
+		{http.MethodPut, "/Groups/{id}", h.replaceGroup},
+		{http.MethodPatch, "/Groups/{id}", h.patchGroup},
+		{http.MethodDelete, "/Groups/{id}", h.deleteGroup},
+	}
+	for _, rt := range routes {
+		if err := mux.HandlePath(rt.method, basePath+rt.path, authenticated(token, rt.handler)); err != nil {
+			return fmt.Errorf("register scim handler: %s %s: %w", rt.method, rt.path, err)
+		}
+	}
+	return nil
+}
+
+func authenticated(token string, next runtime.HandlerFunc) runtime.HandlerFunc {
+	expected := []byte("Bearer " + token)
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			writeError(w, http.StatusUnauthorized, "", "invalid bearer token")
+			return
+		}
+		next(w, r, pathParams)
+	}
+}
+
+func (*Handler) getServiceProviderConfig(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	supported := func(ok bool) map[string]bool { return map[string]bool{"supported": ok} }
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{schemaServiceProviderCfg},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]string{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the bearer token of the scim config",
+		}},
+	})
+}
+
+// page reads the 1-based startIndex and the count of the request.
+func page(r *http.Request) (startIndex, count int) {
+	startIndex, count = 1, defaultCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 {
+		count = min(v, maxCount)
+	}
+	return startIndex, count
+}
+
+func location(r *http.Request, resource, id string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return fmt.Sprintf("%s://%s%s/%s/%s", scheme, r.Host, basePath, resource, id)
+}
+
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	writeJSON(w, status, Error{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func writeList[T any](w http.ResponseWriter, resources []T, total, startIndex int) {
+	if resources == nil {
+		resources = []T{}
+	}
+	writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// requestError is an error of the request, reported to the identity provider
+// with its status and SCIM type.
+type requestError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e requestError) Error() string { return e.detail }
+
+func invalidValue(format string, args ...interface{}) error {
+	return requestError{status: http.StatusBadRequest, scimType: "invalidValue", detail: fmt.Sprintf(format, args...)}
+}
+
+func (h *Handler) fail(w http.ResponseWriter, err error) {
+	var reqErr requestError
+	if errors.As(err, &reqErr) {
+		writeError(w, reqErr.status, reqErr.scimType, reqErr.detail)
+		return
+	}
+
+	h.logger.Error("scim request failed", "err", err)
+	writeError(w, http.StatusInternalServerError, "", "internal server error")
+}
+
+// normalizeAttr lowercases the attribute path, dropping the core schema
+// prefix.
+func normalizeAttr(path string) string {
+	path = strings.ToLower(strings.TrimSpace(path))
+	for _, schema := range []string{schemaUser, schemaGroup} {
+		path = strings.TrimPrefix(path, strings.ToLower(schema)+":")
+	}
+	return path
+}
@@ -0,0 +1,262 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	usermocks "github.com/goto/compass/core/user/mocks"
+	"github.com/goto/compass/internal/server/scim"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	"github.com/goto/salt/log"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token     = "scim-token"
+	provider  = "shield"
+	userID    = "2d8b6d42-1f0f-4f49-9c0a-5d2c2fb0a7e1"
+	managerID = "8f2d7ac9-3c8e-4e0b-a5a0-7b1f9e8b6c21"
+	teamID    = "6a1d9b52-96b4-4b5c-8a6e-3f1b1d2c4e9f"
+)
+
+func TestHandler(t *testing.T) {
+	alice := user.User{ID: userID, Email: "alice@example.com", Name: "Alice", Manager: "bob@example.com", Provider: provider}
+	bob := user.User{ID: managerID, Email: "bob@example.com", Name: "Bob", Provider: provider}
+
+	type testCase struct {
+		Description  string
+		Method       string
+		Path         string
+		Body         string
+		Token        string
+		Setup        func(*usermocks.UserRepository, *mocks.TeamService)
+		ExpectStatus int
+		PostCheck    func(t *testing.T, body map[string]interface{})
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return unauthorized without the bearer token",
+			Method:       http.MethodGet,
+			Path:         "/scim/v2/Users",
+			Token:        "wrong",
+			ExpectStatus: http.StatusUnauthorized,
+		},
+		{
+			Description: "should create the user with the manager resolved to its email",
+			Method:      http.MethodPost,
+			Path:        "/scim/v2/Users",
+			Body: `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","displayName":"Alice","active":true,
+				"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User":{"manager":{"value":"` + managerID + `"}}}`,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				ur.EXPECT().GetByID(mock.Anything, managerID).Return(bob, nil)
+				ur.EXPECT().GetByEmail(mock.Anything, "alice@example.com").Return(user.User{}, user.NotFoundError{Email: "alice@example.com"})
+				ur.EXPECT().Upsert(mock.Anything, &user.User{Email: "alice@example.com", Name: "Alice", Manager: "bob@example.com", Provider: provider}).Return(userID, nil)
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(alice, nil)
+				ur.EXPECT().GetByEmail(mock.Anything, "bob@example.com").Return(bob, nil)
+			},
+			ExpectStatus: http.StatusCreated,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, userID, body["id"])
+				assert.Equal(t, "alice@example.com", body["userName"])
+				assert.Equal(t, true, body["active"])
+				ext := body["urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"].(map[string]interface{})
+				assert.Equal(t, managerID, ext["manager"].(map[string]interface{})["value"])
+			},
+		},
+		{
+			Description: "should return conflict if the user exists",
+			Method:      http.MethodPost,
+			Path:        "/scim/v2/Users",
+			Body:        `{"userName":"alice@example.com"}`,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				ur.EXPECT().GetByEmail(mock.Anything, "alice@example.com").Return(alice, nil)
+			},
+			ExpectStatus: http.StatusConflict,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, "uniqueness", body["scimType"])
+			},
+		},
+		{
+			Description: "should list the users matching the userName filter",
+			Method:      http.MethodGet,
+			Path:        `/scim/v2/Users?filter=userName+eq+%22alice@example.com%22`,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				flt := user.Filter{Email: "alice@example.com", Size: 100}
+				ur.EXPECT().GetCount(mock.Anything, flt).Return(1, nil)
+				ur.EXPECT().GetAll(mock.Anything, flt).Return([]user.User{alice}, nil)
+				ur.EXPECT().GetByEmail(mock.Anything, "bob@example.com").Return(bob, nil)
+			},
+			ExpectStatus: http.StatusOK,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.EqualValues(t, 1, body["totalResults"])
+				resources := body["Resources"].([]interface{})
+				require.Len(t, resources, 1)
+				assert.Equal(t, userID, resources[0].(map[string]interface{})["id"])
+			},
+		},
+		{
+			Description:  "should return bad request for an unsupported filter",
+			Method:       http.MethodGet,
+			Path:         `/scim/v2/Users?filter=title+eq+%22engineer%22`,
+			ExpectStatus: http.StatusBadRequest,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, "invalidFilter", body["scimType"])
+			},
+		},
+		{
+			Description: "should deactivate the user on a patch of active",
+			Method:      http.MethodPatch,
+			Path:        "/scim/v2/Users/" + userID,
+			Body:        `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"}]}`,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				deactivated := alice
+				deactivated.Inactive = true
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(alice, nil).Once()
+				ur.EXPECT().Update(mock.Anything, &deactivated).Return(nil)
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(deactivated, nil).Once()
+				ur.EXPECT().GetByEmail(mock.Anything, "bob@example.com").Return(bob, nil)
+			},
+			ExpectStatus: http.StatusOK,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, false, body["active"])
+			},
+		},
+		{
+			Description: "should deactivate the user on delete",
+			Method:      http.MethodDelete,
+			Path:        "/scim/v2/Users/" + userID,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				deactivated := alice
+				deactivated.Inactive = true
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(alice, nil)
+				ur.EXPECT().Update(mock.Anything, &deactivated).Return(nil)
+			},
+			ExpectStatus: http.StatusNoContent,
+		},
+		{
+			Description: "should return not found for an unknown user",
+			Method:      http.MethodGet,
+			Path:        "/scim/v2/Users/" + userID,
+			Setup: func(ur *usermocks.UserRepository, _ *mocks.TeamService) {
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(user.User{}, user.NotFoundError{ID: userID})
+			},
+			ExpectStatus: http.StatusNotFound,
+		},
+		{
+			Description: "should create the group as a team with its members",
+			Method:      http.MethodPost,
+			Path:        "/scim/v2/Groups",
+			Body:        `{"displayName":"Data Platform","members":[{"value":"` + userID + `"}]}`,
+			Setup: func(ur *usermocks.UserRepository, ts *mocks.TeamService) {
+				ur.EXPECT().GetByID(mock.Anything, userID).Return(alice, nil)
+				ts.EXPECT().CreateTeam(mock.Anything, &team.Team{Name: "data-platform", Members: []user.User{alice}}).Return(teamID, nil)
+				ts.EXPECT().GetTeamByID(mock.Anything, teamID).Return(team.Team{ID: teamID, Name: "data-platform", Members: []user.User{alice}}, nil)
+			},
+			ExpectStatus: http.StatusCreated,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, teamID, body["id"])
+				assert.Equal(t, "data-platform", body["displayName"])
+				assert.Len(t, body["members"], 1)
+			},
+		},
+		{
+			Description: "should add and remove members on a patch of the group",
+			Method:      http.MethodPatch,
+			Path:        "/scim/v2/Groups/" + teamID,
+			Body: `{"Operations":[{"op":"add","path":"members","value":[{"value":"` + managerID + `"}]},
+				{"op":"remove","path":"members[value eq \"` + userID + `\"]"}]}`,
+			Setup: func(ur *usermocks.UserRepository, ts *mocks.TeamService) {
+				ts.EXPECT().GetTeamByID(mock.Anything, teamID).Return(team.Team{ID: teamID, Name: "data-platform", Members: []user.User{alice}}, nil).Once()
+				ur.EXPECT().GetByID(mock.Anything, managerID).Return(bob, nil)
+				ts.EXPECT().UpdateTeam(mock.Anything, &team.Team{ID: teamID, Name: "data-platform", Members: []user.User{bob}}).Return(nil)
+				ts.EXPECT().GetTeamByID(mock.Anything, teamID).Return(team.Team{ID: teamID, Name: "data-platform", Members: []user.User{bob}}, nil).Once()
+			},
+			ExpectStatus: http.StatusOK,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				members := body["members"].([]interface{})
+				require.Len(t, members, 1)
+				assert.Equal(t, managerID, members[0].(map[string]interface{})["value"])
+			},
+		},
+		{
+			Description: "should refuse to rename the group",
+			Method:      http.MethodPatch,
+			Path:        "/scim/v2/Groups/" + teamID,
+			Body:        `{"Operations":[{"op":"replace","path":"displayName","value":"Data Engineering"}]}`,
+			Setup: func(_ *usermocks.UserRepository, ts *mocks.TeamService) {
+				ts.EXPECT().GetTeamByID(mock.Anything, teamID).Return(team.Team{ID: teamID, Name: "data-platform"}, nil)
+			},
+			ExpectStatus: http.StatusBadRequest,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.Equal(t, "mutability", body["scimType"])
+			},
+		},
+		{
+			Description: "should list the groups matching the displayName filter",
+			Method:      http.MethodGet,
+			Path:        `/scim/v2/Groups?filter=displayName+eq+%22Data+Platform%22&excludedAttributes=members`,
+			Setup: func(_ *usermocks.UserRepository, ts *mocks.TeamService) {
+				ts.EXPECT().GetTeams(mock.Anything, team.Filter{}).Return([]team.Team{
+					{ID: "other", Name: "payments"},
+					{ID: teamID, Name: "data-platform", Members: []user.User{alice}},
+				}, nil)
+			},
+			ExpectStatus: http.StatusOK,
+			PostCheck: func(t *testing.T, body map[string]interface{}) {
+				assert.EqualValues(t, 1, body["totalResults"])
+				resources := body["Resources"].([]interface{})
+				require.Len(t, resources, 1)
+				group := resources[0].(map[string]interface{})
+				assert.Equal(t, teamID, group["id"])
+				assert.NotContains(t, group, "members")
+			},
+		},
+		{
+			Description: "should return not found when deleting an unknown group",
+			Method:      http.MethodDelete,
+			Path:        "/scim/v2/Groups/" + teamID,
+			Setup: func(_ *usermocks.UserRepository, ts *mocks.TeamService) {
+				ts.EXPECT().DeleteTeam(mock.Anything, teamID).Return(team.NotFoundError{ID: teamID})
+			},
+			ExpectStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			userRepo := usermocks.NewUserRepository(t)
+			teamSvc := mocks.NewTeamService(t)
+			if tc.Setup != nil {
+				tc.Setup(userRepo, teamSvc)
+			}
+
+			gwmux := runtime.NewServeMux()
+			handler := scim.NewHandler(userRepo, teamSvc, provider, log.NewNoop())
+			require.NoError(t, handler.Register(gwmux, token))
+
+			req := httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body))
+			if tc.Token == "" {
+				tc.Token = token
+			}
+			req.Header.Set("Authorization", "Bearer "+tc.Token)
+			rec := httptest.NewRecorder()
+
+			gwmux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.ExpectStatus, rec.Code, rec.Body.String())
+			if tc.PostCheck != nil {
+				var body map[string]interface{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				tc.PostCheck(t, body)
+			}
+		})
+	}
+}
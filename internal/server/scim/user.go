@@ -0,0 +1,377 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+)
+
+var enterpriseManagerAttr = strings.ToLower(schemaEnterpriseUser) + ":manager"
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+	startIndex, count := page(r)
+
+	flt, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	userFlt := user.Filter{Offset: startIndex - 1, Size: count}
+	switch normalizeAttr(flt.Attribute) {
+	case "":
+	case "username", "emails", "emails.value":
+		userFlt.Email = flt.Value
+	case "displayname", "name.formatted":
+		userFlt.Name = flt.Value
+	case "id":
+		h.listUserByID(w, r, flt.Value, startIndex)
+		return
+	case "externalid":
+		// external ids are not stored, the users are looked up by userName
+		writeList[User](w, nil, 0, startIndex)
+		return
+	default:
+		writeError(w, http.StatusBadRequest, "invalidFilter", "unsupported filter attribute "+flt.Attribute)
+		return
+	}
+
+	total, err := h.users.GetCount(ctx, userFlt)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	var users []user.User
+	if count > 0 {
+		if users, err = h.users.GetAll(ctx, userFlt); err != nil {
+			h.fail(w, err)
+			return
+		}
+	}
+
+	resources := make([]User, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, h.userResource(ctx, r, u))
+	}
+	writeList(w, resources, total, startIndex)
+}
+
+func (h *Handler) listUserByID(w http.ResponseWriter, r *http.Request, id string, startIndex int) {
+	u, err := h.users.GetByID(r.Context(), id)
+	if errors.As(err, new(user.NotFoundError)) {
+		writeList[User](w, nil, 0, startIndex)
+		return
+	}
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	writeList(w, []User{h.userResource(r.Context(), r, u)}, 1, startIndex)
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+
+	var res User
+	if err := decode(r, &res); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	u := user.User{Provider: h.provider}
+	if err := h.replaceUserFields(ctx, &u, res); err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	_, err := h.users.GetByEmail(ctx, u.Email)
+	if err == nil {
+		writeError(w, http.StatusConflict, "uniqueness", "user with userName "+u.Email+" already exists")
+		return
+	}
+	if !errors.As(err, new(user.NotFoundError)) {
+		h.fail(w, err)
+		return
+	}
+
+	id, err := h.users.Upsert(ctx, &u)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	created, err := h.users.GetByID(ctx, id)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, h.userResource(ctx, r, created))
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	u, err := h.getUserByID(r.Context(), pathParams["id"])
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.userResource(r.Context(), r, u))
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	var res User
+	if err := decode(r, &res); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	u, err := h.getUserByID(ctx, pathParams["id"])
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	if err := h.replaceUserFields(ctx, &u, res); err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	h.updateUser(w, r, u)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	var req PatchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	u, err := h.getUserByID(ctx, pathParams["id"])
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	for _, op := range req.Operations {
+		if err := h.applyUserOperation(ctx, &u, op); err != nil {
+			h.fail(w, err)
+			return
+		}
+	}
+
+	h.updateUser(w, r, u)
+}
+
+// deleteUser deactivates the user, whose changes and ownerships are kept.
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	u, err := h.getUserByID(ctx, pathParams["id"])
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+
+	u.Inactive = true
+	if err := h.users.Update(ctx, &u); err != nil {
+		h.fail(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, u user.User) {
+	ctx := r.Context()
+
+	if err := h.users.Update(ctx, &u); err != nil {
+		if errors.As(err, new(user.DuplicateRecordError)) {
+			writeError(w, http.StatusConflict, "uniqueness", err.Error())
+			return
+		}
+		h.fail(w, err)
+		return
+	}
+
+	updated, err := h.users.GetByID(ctx, u.ID)
+	if err != nil {
+		h.fail(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.userResource(ctx, r, updated))
+}
+
+func (h *Handler) getUserByID(ctx context.Context, id string) (user.User, error) {
+	u, err := h.users.GetByID(ctx, id)
+	if errors.As(err, new(user.NotFoundError)) {
+		return user.User{}, requestError{status: http.StatusNotFound, detail: err.Error()}
+	}
+	return u, err
+}
+
+// replaceUserFields sets the user as described by the resource, an absent
+// active meaning active.
+func (h *Handler) replaceUserFields(ctx context.Context, u *user.User, res User) error {
+	u.Email = res.email()
+	if u.Email == "" {
+		return invalidValue("userName is required")
+	}
+	u.Name = res.name()
+	u.Inactive = res.Active != nil && !*res.Active
+
+	manager, err := h.managerEmail(ctx, res.managerID())
+	if err != nil {
+		return err
+	}
+	u.Manager = manager
+	return nil
+}
+
+// applyUserOperation applies the patch operation to the user. Attributes that
+// are not stored are ignored, as the identity providers send many of them.
+func (h *Handler) applyUserOperation(ctx context.Context, u *user.User, op PatchOperation) error {
+	path := normalizeAttr(op.Path)
+
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if path != "" {
+			return h.setUserAttr(ctx, u, path, op.Value)
+		}
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &values); err != nil {
+			return invalidValue("value of an operation without path must be an object")
+		}
+		for attr, value := range values {
+			if err := h.setUserAttr(ctx, u, normalizeAttr(attr), value); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "remove":
+		switch path {
+		case "displayname", "name", "name.formatted":
+			u.Name = ""
+		case enterpriseManagerAttr, "manager":
+			u.Manager = ""
+		}
+		return nil
+
+	default:
+		return invalidValue("unsupported operation %q", op.Op)
+	}
+}
+
+func (h *Handler) setUserAttr(ctx context.Context, u *user.User, attr string, value json.RawMessage) error {
+	switch attr {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		u.Inactive = !active
+
+	case "username":
+		var email string
+		if err := json.Unmarshal(value, &email); err != nil || strings.TrimSpace(email) == "" {
+			return invalidValue("userName must be a non empty string")
+		}
+		u.Email = strings.TrimSpace(email)
+
+	case "displayname", "name.formatted":
+		var name string
+		if err := json.Unmarshal(value, &name); err != nil {
+			return invalidValue("%s must be a string", attr)
+		}
+		u.Name = name
+
+	case "name":
+		var name Name
+		if err := json.Unmarshal(value, &name); err != nil {
+			return invalidValue("name must be an object")
+		}
+		u.Name = User{Name: &name}.name()
+
+	case strings.ToLower(schemaEnterpriseUser):
+		var ext EnterpriseUser
+		if err := json.Unmarshal(value, &ext); err != nil {
+			return invalidValue("enterprise extension must be an object")
+		}
+		if ext.Manager == nil {
+			return nil
+		}
+		return h.setManager(ctx, u, ext.Manager.Value)
+
+	case enterpriseManagerAttr, "manager":
+		var ref Reference
+		if err := json.Unmarshal(value, &ref); err != nil {
+			if err := json.Unmarshal(value, &ref.Value); err != nil {
+				return invalidValue("manager must be a reference or an id")
+			}
+		}
+		return h.setManager(ctx, u, ref.Value)
+	}
+	return nil
+}
+
+func (h *Handler) setManager(ctx context.Context, u *user.User, id string) error {
+	manager, err := h.managerEmail(ctx, id)
+	if err != nil {
+		return err
+	}
+	u.Manager = manager
+	return nil
+}
+
+// managerEmail resolves the id of the manager to its email.
+func (h *Handler) managerEmail(ctx context.Context, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	manager, err := h.users.GetByID(ctx, id)
+	if errors.As(err, new(user.NotFoundError)) {
+		return "", invalidValue("manager %q does not exist", id)
+	}
+	if err != nil {
+		return "", err
+	}
+	return manager.Email, nil
+}
+
+// userResource represents the user, with the id of its manager if the manager
+// is a known user.
+func (h *Handler) userResource(ctx context.Context, r *http.Request, u user.User) User {
+	var managerID string
+	if u.Manager != "" {
+		manager, err := h.users.GetByEmail(ctx, u.Manager)
+		if err == nil {
+			managerID = manager.ID
+		} else if !errors.As(err, new(user.NotFoundError)) {
+			h.logger.Warn("get manager of scim user", "email", u.Manager, "err", err)
+		}
+	}
+	return userToResource(u, managerID, location(r, "Users", u.ID))
+}
+
+// parseBool reads a boolean, some identity providers sending it as a string.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, invalidValue("active must be a boolean")
+}
@@ -8,10 +8,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/goto/compass/core/user"
 	"github.com/goto/compass/internal/server/health"
+	"github.com/goto/compass/internal/server/scim"
 	handlersv1beta1 "github.com/goto/compass/internal/server/v1beta1"
 	"github.com/goto/compass/internal/store/postgres"
 	"github.com/goto/compass/pkg/grpc_interceptor"
+	"github.com/goto/compass/pkg/jwtauth"
+	"github.com/goto/compass/pkg/ratelimit"
 	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
 	"github.com/goto/salt/log"
 	"github.com/goto/salt/mux"
@@ -40,6 +44,11 @@ type Config struct {
 	RequestTimeout time.Duration  `mapstructure:"request_timeout" default:"10s"`
 	// GRPC Config
 	GRPC GRPCConfig `mapstructure:"grpc"`
+	// SCIM serves the /scim/v2 endpoints for an identity provider to
+	// provision the users and the teams
+	SCIM scim.Config `mapstructure:"scim"`
+	// RateLimit limits the rate of the gRPC calls of each user to each method
+	RateLimit ratelimit.Config `mapstructure:"rate_limit"`
 }
 
 func (cfg Config) addr() string     { return fmt.Sprintf("%s:%d", cfg.Host, cfg.Port) }
@@ -47,9 +56,14 @@ func (cfg Config) grpcAddr() string { return fmt.Sprintf("%s:%d", cfg.Host, cfg.
 
 type IdentityConfig struct {
 	// User Identity
-	HeaderKeyEmail      string `yaml:"headerkey_email" mapstructure:"headerkey_email" default:"Compass-User-Email"`
+	HeaderKeyEmail string `yaml:"headerkey_email" mapstructure:"headerkey_email" default:"Compass-User-Email"`
+	// HeaderKeyGroups holds the comma separated groups of the user, the
+	// restricted assets being only visible to the members of their groups
+	HeaderKeyGroups     string `yaml:"headerkey_groups" mapstructure:"headerkey_groups" default:"Compass-User-Groups"`
 	HeaderValueEmail    string `yaml:"headervalue_email" mapstructure:"headervalue_email" default:"gotocompany@email.com"`
 	ProviderDefaultName string `yaml:"provider_default_name" mapstructure:"provider_default_name" default:""`
+	// JWT identifies the users by a bearer token in place of the email header
+	JWT jwtauth.Config `yaml:"jwt" mapstructure:"jwt"`
 }
 
 type GRPCConfig struct {
@@ -59,6 +73,13 @@ type GRPCConfig struct {
 	MaxSendMsgSize int           `yaml:"max_send_msg_size" mapstructure:"max_send_msg_size" default:"33554432"`
 }
 
+// UserService identifies the users, including the service accounts
+// authenticated with API tokens
+type UserService interface {
+	handlersv1beta1.UserService
+	grpc_interceptor.TokenAuthenticator
+}
+
 func Serve(
 	ctx context.Context,
 	config Config,
@@ -70,7 +91,12 @@ func Serve(
 	discussionService handlersv1beta1.DiscussionService,
 	tagService handlersv1beta1.TagService,
 	tagTemplateService handlersv1beta1.TagTemplateService,
-	userService handlersv1beta1.UserService,
+	userService UserService,
+	savedSearchService handlersv1beta1.SavedSearchService,
+	authzService handlersv1beta1.AuthzService,
+	teamService handlersv1beta1.TeamService,
+	auditService handlersv1beta1.AuditService,
+	userRepository user.Repository,
 ) error {
 	v1beta1Handler := handlersv1beta1.NewAPIServer(handlersv1beta1.APIServerDeps{
 		AssetSvc:       assetService,
@@ -79,23 +105,51 @@ func Serve(
 		TagSvc:         tagService,
 		TagTemplateSvc: tagTemplateService,
 		UserSvc:        userService,
+		SavedSearchSvc: savedSearchService,
+		AuthzSvc:       authzService,
+		TeamSvc:        teamService,
+		AuditSvc:       auditService,
 		Logger:         logger,
 	})
 
 	healthHandler := health.NewHandler()
 
+	userInterceptor := grpc_interceptor.UserHeaderCtx(config.Identity.HeaderKeyEmail, config.Identity.HeaderKeyGroups)
+	if config.Identity.JWT.Enabled {
+		verifier, err := jwtauth.NewVerifier(ctx, config.Identity.JWT)
+		if err != nil {
+			return fmt.Errorf("create jwt verifier: %w", err)
+		}
+		userInterceptor = grpc_interceptor.JWTUserCtx(verifier)
+	}
+	userInterceptor = grpc_interceptor.APITokenUserCtx(userService, userInterceptor)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		grpclogrus.UnaryServerInterceptor(logger.Entry()),
+		otelgrpc.UnaryServerInterceptor(),
+		nrgrpc.UnaryServerInterceptor(nrApp),
+		userInterceptor,
+	}
+	if config.RateLimit.Enabled {
+		limiter, err := newRateLimiter(ctx, config.RateLimit, pgClient, logger)
+		if err != nil {
+			return err
+		}
+		// the calls over the limit are refused before they are audited
+		interceptors = append(interceptors, grpc_interceptor.RateLimit(limiter))
+	}
+	interceptors = append(interceptors,
+		grpc_interceptor.Audit(v1beta1Handler),
+		grpc_interceptor.Authorization(v1beta1Handler),
+		grpcctxtags.UnaryServerInterceptor(),
+		grpcrecovery.UnaryServerInterceptor(),
+	)
+
 	// init grpc
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(config.GRPC.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(config.GRPC.MaxSendMsgSize),
-		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-			grpclogrus.UnaryServerInterceptor(logger.Entry()),
-			otelgrpc.UnaryServerInterceptor(),
-			nrgrpc.UnaryServerInterceptor(nrApp),
-			grpc_interceptor.UserHeaderCtx(config.Identity.HeaderKeyEmail),
-			grpcctxtags.UnaryServerInterceptor(),
-			grpcrecovery.UnaryServerInterceptor(),
-		)),
+		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(interceptors...)),
 	)
 	reflection.Register(grpcServer)
 
@@ -126,6 +180,7 @@ func Serve(
 	gwmux := runtime.NewServeMux(
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
 		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				UseProtoNames:   true,
@@ -142,6 +197,13 @@ func Serve(
 		return err
 	}
 
+	if config.SCIM.Token != "" {
+		scimHandler := scim.NewHandler(userRepository, teamService, config.Identity.ProviderDefaultName, logger)
+		if err := scimHandler.Register(gwmux, config.SCIM.Token); err != nil {
+			return err
+		}
+	}
+
 	defer func() {
 		if pgClient != nil {
 			logger.Warn("closing db...")
@@ -176,10 +238,44 @@ func Serve(
 func makeHeaderMatcher(c Config) func(key string) (string, bool) {
 	return func(key string) (string, bool) {
 		switch strings.ToLower(key) {
-		case strings.ToLower(c.Identity.HeaderKeyEmail):
+		case strings.ToLower(c.Identity.HeaderKeyEmail), strings.ToLower(c.Identity.HeaderKeyGroups):
 			return key, true
 		default:
 			return runtime.DefaultHeaderMatcher(key)
 		}
 	}
 }
+
+// outgoingHeaderMatcher forwards the retry-after metadata of the calls over
+// the rate limit as the Retry-After header of the HTTP responses.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == grpc_interceptor.RetryAfterKey {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// rateLimitPruneInterval is how often the idle buckets of the shared rate
+// limits are deleted.
+const rateLimitPruneInterval = 10 * time.Minute
+
+func newRateLimiter(ctx context.Context, cfg ratelimit.Config, pgClient *postgres.Client, logger log.Logger) (*ratelimit.Limiter, error) {
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.Shared {
+		repo, err := postgres.NewRateLimitRepository(pgClient)
+		if err != nil {
+			return nil, fmt.Errorf("create rate limit repository: %w", err)
+		}
+		store = repo
+	}
+
+	limiter, err := ratelimit.New(cfg, store, logger)
+	if err != nil {
+		return nil, fmt.Errorf("create rate limiter: %w", err)
+	}
+	// the memory store prunes its buckets itself
+	if p, ok := store.(ratelimit.Pruner); ok {
+		go ratelimit.Prune(ctx, p, rateLimitPruneInterval, logger)
+	}
+	return limiter, nil
+}
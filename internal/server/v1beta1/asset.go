@@ -27,6 +27,7 @@ type AssetService interface {
 	GetAssetByIDWithoutProbes(ctx context.Context, id string) (asset.Asset, error)
 	GetAssetByVersion(ctx context.Context, id, version string) (asset.Asset, error)
 	GetAssetVersionHistory(ctx context.Context, flt asset.Filter, id string) ([]asset.Asset, error)
+	GetSchemaDiff(ctx context.Context, urn, fromVersion, toVersion string) (asset.SchemaDiff, error)
 	UpsertAsset(ctx context.Context, ast *asset.Asset, upstreams, downstreams []string, isUpdateOnly bool) (string, error)
 	UpsertAssetWithoutLineage(ctx context.Context, ast *asset.Asset, isUpdateOnly bool) (string, error)
 	UpsertPatchAsset(ctx context.Context, ast *asset.Asset, upstreams, downstreams []string, patchData map[string]interface{}, isUpdateOnly bool) (string, error)
@@ -40,6 +41,14 @@ type AssetService interface {
 	GetLineage(ctx context.Context, urn string, query asset.LineageQuery) (asset.Lineage, error)
 	GetColumnLineage(ctx context.Context, urn string, query asset.LineageQuery) (asset.Lineage, error)
 	GetTypes(ctx context.Context, flt asset.Filter) (map[asset.Type]int, error)
+	GetTypeDefinition(ctx context.Context, name asset.Type) (asset.TypeDefinition, error)
+	CreateType(ctx context.Context, def *asset.TypeDefinition) error
+	UpdateType(ctx context.Context, def *asset.TypeDefinition) error
+	DeleteType(ctx context.Context, name asset.Type) error
+	UpsertTypeSchema(ctx context.Context, ts *asset.TypeSchema) error
+	GetTypeSchema(ctx context.Context, typ asset.Type) (asset.TypeSchema, error)
+	DeleteTypeSchema(ctx context.Context, typ asset.Type) error
+	ValidateAssetsAgainstSchema(ctx context.Context, ts asset.TypeSchema) (asset.SchemaValidationReport, error)
 
 	SearchAssets(ctx context.Context, cfg asset.SearchConfig) (results []asset.SearchResult, err error)
 	GroupAssets(ctx context.Context, cfg asset.GroupConfig) (results []asset.GroupResult, err error)
@@ -76,6 +85,11 @@ func (server *APIServer) GetAllAssets(ctx context.Context, req *compassv1beta1.G
 		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
 	}
 
+	flt.Viewer, err = server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	assets, totalCount, err := server.assetService.GetAllAssets(ctx, flt, req.GetWithTotal())
 	if err != nil {
 		return nil, internalServerError(server.logger, err.Error())
@@ -118,6 +132,16 @@ func (server *APIServer) GetAssetByID(ctx context.Context, req *compassv1beta1.G
 		return nil, internalServerError(server.logger, err.Error())
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// restricted assets are not found by the users not allowed to see them,
+	// for their existence not to leak
+	if !viewer.CanSee(ast.Visibility) {
+		return nil, assetNotFoundError(req.GetId())
+	}
+
 	astProto, err := assetToProto(ast, false)
 	if err != nil {
 		return nil, internalServerError(server.logger, err.Error())
@@ -128,12 +152,52 @@ func (server *APIServer) GetAssetByID(ctx context.Context, req *compassv1beta1.G
 	}, nil
 }
 
+// checkAssetVisible returns a not found error if the asset, by id or urn, is
+// restricted to groups the user in the context is not a member of, the same
+// as GetAssetByID does, for the reads of its history, stars and tags.
+func (server *APIServer) checkAssetVisible(ctx context.Context, id string) error {
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return err
+	}
+	if !viewer.IsRestricted() {
+		return nil
+	}
+
+	ast, err := server.assetService.GetAssetByID(ctx, id)
+	if err != nil {
+		if errors.As(err, new(asset.InvalidError)) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(asset.NotFoundError)) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return internalServerError(server.logger, err.Error())
+	}
+	if !viewer.CanSee(ast.Visibility) {
+		return assetNotFoundError(id)
+	}
+	return nil
+}
+
+func assetNotFoundError(id string) error {
+	notFound := asset.NotFoundError{URN: id}
+	if isValidUUID(id) {
+		notFound = asset.NotFoundError{AssetID: id}
+	}
+	return status.Error(codes.NotFound, notFound.Error())
+}
+
 func (server *APIServer) GetAssetStargazers(ctx context.Context, req *compassv1beta1.GetAssetStargazersRequest) (*compassv1beta1.GetAssetStargazersResponse, error) {
 	_, err := server.ValidateUserInCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := server.checkAssetVisible(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
 	users, err := server.starService.GetStargazers(ctx, star.Filter{
 		Size:   int(req.GetSize()),
 		Offset: int(req.GetOffset()),
@@ -164,6 +228,10 @@ func (server *APIServer) GetAssetVersionHistory(ctx context.Context, req *compas
 		return nil, err
 	}
 
+	if err := server.checkAssetVisible(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
 	assetVersions, err := server.assetService.GetAssetVersionHistory(ctx, asset.Filter{
 		Size:   int(req.GetSize()),
 		Offset: int(req.GetOffset()),
@@ -202,6 +270,10 @@ func (server *APIServer) GetAssetByVersion(ctx context.Context, req *compassv1be
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	if err := server.checkAssetVisible(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
 	ast, err := server.assetService.GetAssetByVersion(ctx, req.GetId(), req.GetVersion())
 	if err != nil {
 		if errors.As(err, new(asset.InvalidError)) {
@@ -223,6 +295,38 @@ func (server *APIServer) GetAssetByVersion(ctx context.Context, req *compassv1be
 	}, nil
 }
 
+func (server *APIServer) GetAssetSchemaDiff(ctx context.Context, req *compassv1beta1.GetAssetSchemaDiffRequest) (*compassv1beta1.GetAssetSchemaDiffResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+	for _, v := range []string{req.GetFromVersion(), req.GetToVersion()} {
+		if _, err := asset.ParseVersion(v); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := server.checkAssetVisible(ctx, req.GetUrn()); err != nil {
+		return nil, err
+	}
+
+	diff, err := server.assetService.GetSchemaDiff(ctx, req.GetUrn(), req.GetFromVersion(), req.GetToVersion())
+	if err != nil {
+		if errors.As(err, new(asset.NotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.GetAssetSchemaDiffResponse{
+		Data: schemaDiffToProto(diff),
+	}, nil
+}
+
 func (server *APIServer) UpsertAsset(ctx context.Context, req *compassv1beta1.UpsertAssetRequest) (*compassv1beta1.UpsertAssetResponse, error) {
 	userID, err := server.ValidateUserInCtx(ctx)
 	if err != nil {
@@ -433,6 +537,10 @@ func (server *APIServer) upsertAsset(
 			return "", status.Error(codes.InvalidArgument, err.Error())
 		case errors.As(err, new(asset.NotFoundError)): // only possible when updateOnly is true
 			return "", nil
+		case errors.As(err, new(asset.DataValidationError)):
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, new(asset.BreakingSchemaChangeError)):
+			return "", status.Error(codes.FailedPrecondition, err.Error())
 		}
 		return "", internalServerError(server.logger, err.Error())
 	}
@@ -463,6 +571,10 @@ func (server *APIServer) upsertPatchAssetWithoutLineage(
 			return "", status.Error(codes.InvalidArgument, err.Error())
 		case errors.As(err, new(asset.NotFoundError)): // only possible when updateOnly is true
 			return "", nil
+		case errors.As(err, new(asset.DataValidationError)):
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, new(asset.BreakingSchemaChangeError)):
+			return "", status.Error(codes.FailedPrecondition, err.Error())
 		}
 
 		return "", internalServerError(server.logger, err.Error())
@@ -481,6 +593,8 @@ func (server *APIServer) buildAsset(baseAsset *compassv1beta1.UpsertAssetRequest
 		Data:        baseAsset.GetData().AsMap(),
 		URL:         baseAsset.Url,
 		Labels:      baseAsset.GetLabels(),
+		OwnerTeams:  dedupeStrings(baseAsset.GetOwnerTeams()),
+		Visibility:  visibilityFromProto(baseAsset.GetVisibility()),
 	}
 
 	var owners []user.User
@@ -522,7 +636,7 @@ func (*APIServer) validateUpsertAsset(ast *compassv1beta1.UpsertAssetRequest_Ass
 		return fmt.Errorf("service is required and can't be empty")
 	}
 
-	return nil
+	return visibilityFromProto(ast.GetVisibility()).Validate()
 }
 
 func (*APIServer) validateUpsertPatchAsset(ast *compassv1beta1.UpsertPatchAssetRequest_Asset) error {
@@ -543,7 +657,7 @@ func (*APIServer) validateUpsertPatchAsset(ast *compassv1beta1.UpsertPatchAssetR
 		return fmt.Errorf("service is required and can't be empty")
 	}
 
-	return nil
+	return visibilityFromProto(ast.GetVisibility()).Validate()
 }
 
 func decodePatchAssetToMap(pb *compassv1beta1.UpsertPatchAssetRequest_Asset) map[string]interface{} {
@@ -590,6 +704,19 @@ func decodePatchAssetToMap(pb *compassv1beta1.UpsertPatchAssetRequest_Asset) map
 		}
 		m["owners"] = ownersMap
 	}
+	if len(pb.GetOwnerTeams()) > 0 {
+		m["owner_teams"] = dedupeStrings(pb.GetOwnerTeams())
+	}
+	if pb.GetVisibility() != nil {
+		groups := make([]interface{}, 0, len(pb.GetVisibility().GetGroups()))
+		for _, g := range pb.GetVisibility().GetGroups() {
+			groups = append(groups, g)
+		}
+		m["visibility"] = map[string]interface{}{
+			"kind":   pb.GetVisibility().GetKind(),
+			"groups": groups,
+		}
+	}
 
 	return m
 }
@@ -617,6 +744,22 @@ func dedupe(owners []*compassv1beta1.User) []*compassv1beta1.User {
 	return uniq
 }
 
+func dedupeStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	uniq := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		uniq = append(uniq, v)
+	}
+	return uniq
+}
+
 // assetToProto transforms struct to proto
 func assetToProto(a asset.Asset, withChangelog bool) (*compassv1beta1.Asset, error) {
 	var data *structpb.Struct
@@ -680,6 +823,8 @@ func assetToProto(a asset.Asset, withChangelog bool) (*compassv1beta1.Asset, err
 		UpdatedAt:   updatedAt,
 		Probes:      probes,
 		IsDeleted:   a.IsDeleted,
+		OwnerTeams:  a.OwnerTeams,
+		Visibility:  visibilityToProto(a.Visibility),
 	}, nil
 }
 
@@ -723,6 +868,27 @@ func changelogToProto(cl diff.Changelog) ([]*compassv1beta1.Change, error) {
 	return protoChanges, nil
 }
 
+func schemaDiffToProto(d asset.SchemaDiff) *compassv1beta1.SchemaDiff {
+	changes := make([]*compassv1beta1.ColumnChange, len(d.Changes))
+	for i, c := range d.Changes {
+		changes[i] = &compassv1beta1.ColumnChange{
+			Type:        string(c.Type),
+			Column:      c.Column,
+			OldColumn:   c.OldColumn,
+			DataType:    c.DataType,
+			OldDataType: c.OldDataType,
+			Breaking:    c.Type.IsBreaking(),
+		}
+	}
+
+	return &compassv1beta1.SchemaDiff{
+		Urn:         d.URN,
+		FromVersion: d.FromVersion,
+		ToVersion:   d.ToVersion,
+		Changes:     changes,
+	}
+}
+
 func diffChangeToProto(dc diff.Change) (*compassv1beta1.Change, error) {
 	from, err := structpb.NewValue(dc.From)
 	if err != nil {
@@ -798,6 +964,38 @@ func assetFromProto(pb *compassv1beta1.Asset) asset.Asset {
 		Changelog:   clog,
 		UpdatedBy:   updatedBy,
 		IsDeleted:   pb.GetIsDeleted(),
+		OwnerTeams:  pb.GetOwnerTeams(),
+		Visibility:  buildVisibilityFromProto(pb.GetVisibility()),
+	}
+}
+
+// visibilityFromProto returns nil if no visibility is given
+func visibilityFromProto(pb *compassv1beta1.AssetVisibility) *asset.Visibility {
+	if pb == nil {
+		return nil
+	}
+	return &asset.Visibility{
+		Kind:   asset.VisibilityKind(pb.GetKind()),
+		Groups: pb.GetGroups(),
+	}
+}
+
+// buildVisibilityFromProto returns nil for the public assets, the way they
+// are stored
+func buildVisibilityFromProto(pb *compassv1beta1.AssetVisibility) *asset.Visibility {
+	if vis := visibilityFromProto(pb); vis.IsRestricted() {
+		return vis
+	}
+	return nil
+}
+
+func visibilityToProto(v *asset.Visibility) *compassv1beta1.AssetVisibility {
+	if !v.IsRestricted() {
+		return nil
+	}
+	return &compassv1beta1.AssetVisibility{
+		Kind:   string(v.Kind),
+		Groups: v.Groups,
 	}
 }
 
@@ -55,7 +55,7 @@ func TestGetAllAssets(t *testing.T) {
 			ExpectStatus: codes.Internal,
 			Request:      &compassv1beta1.GetAllAssetsRequest{},
 			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
-				as.EXPECT().GetAllAssets(ctx, asset.Filter{}, false).Return([]asset.Asset{}, 0, errors.New("unknown error"))
+				as.EXPECT().GetAllAssets(ctx, asset.Filter{Viewer: &asset.Viewer{}}, false).Return([]asset.Asset{}, 0, errors.New("unknown error"))
 			},
 		},
 		{
@@ -84,7 +84,7 @@ func TestGetAllAssets(t *testing.T) {
 			},
 			ExpectStatus: codes.Internal,
 			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
-				as.EXPECT().GetAllAssets(ctx, asset.Filter{}, true).Return([]asset.Asset{}, 0, errors.New("unknown error"))
+				as.EXPECT().GetAllAssets(ctx, asset.Filter{Viewer: &asset.Viewer{}}, true).Return([]asset.Asset{}, 0, errors.New("unknown error"))
 			},
 		},
 		{
@@ -119,6 +119,7 @@ func TestGetAllAssets(t *testing.T) {
 						"dataset": {"booking"},
 						"project": {"p-godata-id"},
 					},
+					Viewer: &asset.Viewer{},
 				}
 				as.EXPECT().GetAllAssets(ctx, cfg, false).Return([]asset.Asset{}, 0, nil)
 			},
@@ -127,7 +128,7 @@ func TestGetAllAssets(t *testing.T) {
 			Description:  "should return status OK along with list of assets",
 			ExpectStatus: codes.OK,
 			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
-				as.EXPECT().GetAllAssets(ctx, asset.Filter{}, false).Return([]asset.Asset{
+				as.EXPECT().GetAllAssets(ctx, asset.Filter{Viewer: &asset.Viewer{}}, false).Return([]asset.Asset{
 					{ID: "testid-1"},
 					{ID: "testid-2", Owners: []user.User{{Email: "dummy@trash.com"}}},
 				}, 0, nil)
@@ -162,6 +163,7 @@ func TestGetAllAssets(t *testing.T) {
 					Services: []string{"kafka"},
 					Size:     10,
 					Offset:   5,
+					Viewer:   &asset.Viewer{},
 				}, true).Return([]asset.Asset{
 					{ID: "testid-1"},
 					{ID: "testid-2"},
@@ -287,6 +289,38 @@ func TestGetAssetByID(t *testing.T) {
 				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{}, errors.New("unknown error"))
 			},
 		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
+		{
+			Description:  `should return asset restricted to a group of the user`,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance", "hr"}},
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetAssetByIDResponse) error {
+				expected := &compassv1beta1.GetAssetByIDResponse{
+					Data: &compassv1beta1.Asset{
+						Id:         assetID,
+						Visibility: &compassv1beta1.AssetVisibility{Kind: "restricted", Groups: []string{"finance", "hr"}},
+					},
+				}
+				if d := cmp.Diff(resp, expected, protocmp.Transform()); d != "" {
+					return fmt.Errorf("mismatch (-want +got):\n%s", d)
+				}
+				return nil
+			},
+		},
 		{
 			Description:  "should return http 200 status along with the asset, if found",
 			ExpectStatus: codes.OK,
@@ -328,7 +362,7 @@ func TestGetAssetByID(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.Description, func(t *testing.T) {
-			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail, Groups: []string{"hr"}})
 
 			logger := log.NewNoop()
 			mockUserSvc := mocks.NewUserService(t)
@@ -482,6 +516,20 @@ func TestUpsertAsset(t *testing.T) {
 			},
 			ExpectStatus: codes.InvalidArgument,
 		},
+		{
+			Description: "restricted visibility without groups will return invalid argument",
+			Request: &compassv1beta1.UpsertAssetRequest{
+				Asset: &compassv1beta1.UpsertAssetRequest_Asset{
+					Urn:        "some-urn",
+					Name:       "some-name",
+					Data:       &structpb.Struct{},
+					Service:    "some-service",
+					Type:       "table",
+					Visibility: &compassv1beta1.AssetVisibility{Kind: "restricted"},
+				},
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
 		{
 			Description: "should return internal server error when upserting asset service failed",
 			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
@@ -497,6 +545,34 @@ func TestUpsertAsset(t *testing.T) {
 			Request:      validPayload,
 			ExpectStatus: codes.Internal,
 		},
+		{
+			Description: "should return failed precondition when the upsert makes breaking schema changes",
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().UpsertAsset(
+					ctx,
+					mock.AnythingOfType("*asset.Asset"),
+					mock.AnythingOfType("[]string"),
+					mock.AnythingOfType("[]string"),
+					mock.AnythingOfType("bool"),
+				).Return("", asset.BreakingSchemaChangeError{URN: "test dagger", Downstreams: []string{"downstream-1"}})
+			},
+			Request:      validPayload,
+			ExpectStatus: codes.FailedPrecondition,
+		},
+		{
+			Description: "should return invalid argument when the data violates the schema of the type",
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().UpsertAsset(
+					ctx,
+					mock.AnythingOfType("*asset.Asset"),
+					mock.AnythingOfType("[]string"),
+					mock.AnythingOfType("[]string"),
+					mock.AnythingOfType("bool"),
+				).Return("", asset.DataValidationError{Type: "table", Errors: []string{"(root): owner_team is required"}})
+			},
+			Request:      validPayload,
+			ExpectStatus: codes.InvalidArgument,
+		},
 		{
 			Description: "should return OK but empty asset id if the asset does not exist and isUpdateOnly is true",
 			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
@@ -1106,7 +1182,7 @@ func TestGetAssetStargazers(t *testing.T) {
 		Description  string
 		Request      *compassv1beta1.GetAssetStargazersRequest
 		ExpectStatus codes.Code
-		Setup        func(context.Context, *mocks.StarService, *mocks.UserService)
+		Setup        func(context.Context, *mocks.AssetService, *mocks.StarService, *mocks.UserService)
 		PostCheck    func(resp *compassv1beta1.GetAssetStargazersResponse) error
 	}
 
@@ -1115,10 +1191,25 @@ func TestGetAssetStargazers(t *testing.T) {
 			Description:  `should return error if user validation in ctx fails`,
 			ExpectStatus: codes.Internal,
 			Request:      &compassv1beta1.GetAssetStargazersRequest{},
-			Setup: func(ctx context.Context, as *mocks.StarService, us *mocks.UserService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, _ *mocks.StarService, us *mocks.UserService) {
 				us.EXPECT().ValidateUser(ctx, mock.AnythingOfType("string")).Return("", errors.New("some-error"))
 			},
 		},
+		{
+			Description:  "should return not found if asset is restricted to groups of others",
+			ExpectStatus: codes.NotFound,
+			Request: &compassv1beta1.GetAssetStargazersRequest{
+				Id:     assetID,
+				Size:   uint32(size),
+				Offset: uint32(offset),
+			},
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.StarService, _ *mocks.UserService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description:  "should return invalid argument error if GetStargazers returns invalid error",
 			ExpectStatus: codes.InvalidArgument,
@@ -1127,7 +1218,7 @@ func TestGetAssetStargazers(t *testing.T) {
 				Size:   uint32(size),
 				Offset: uint32(offset),
 			},
-			Setup: func(ctx context.Context, ss *mocks.StarService, us *mocks.UserService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ss *mocks.StarService, _ *mocks.UserService) {
 				ss.EXPECT().GetStargazers(ctx, defaultStarCfg, assetID).Return(nil, star.InvalidError{})
 			},
 		},
@@ -1139,7 +1230,7 @@ func TestGetAssetStargazers(t *testing.T) {
 				Size:   uint32(size),
 				Offset: uint32(offset),
 			},
-			Setup: func(ctx context.Context, ss *mocks.StarService, us *mocks.UserService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ss *mocks.StarService, _ *mocks.UserService) {
 				ss.EXPECT().GetStargazers(ctx, defaultStarCfg, assetID).Return(nil, errors.New("some error"))
 			},
 		},
@@ -1151,7 +1242,7 @@ func TestGetAssetStargazers(t *testing.T) {
 				Size:   uint32(size),
 				Offset: uint32(offset),
 			},
-			Setup: func(ctx context.Context, ss *mocks.StarService, _ *mocks.UserService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ss *mocks.StarService, _ *mocks.UserService) {
 				ss.EXPECT().GetStargazers(ctx, defaultStarCfg, assetID).Return(nil, star.NotFoundError{})
 			},
 		},
@@ -1163,7 +1254,7 @@ func TestGetAssetStargazers(t *testing.T) {
 				Size:   uint32(size),
 				Offset: uint32(offset),
 			},
-			Setup: func(ctx context.Context, ss *mocks.StarService, _ *mocks.UserService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ss *mocks.StarService, _ *mocks.UserService) {
 				ss.EXPECT().GetStargazers(ctx, defaultStarCfg, assetID).Return([]user.User{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil)
 			},
 		},
@@ -1174,15 +1265,18 @@ func TestGetAssetStargazers(t *testing.T) {
 
 			logger := log.NewNoop()
 			mockUserSvc := new(mocks.UserService)
+			mockAssetSvc := new(mocks.AssetService)
 			mockStarSvc := new(mocks.StarService)
 			if tc.Setup != nil {
-				tc.Setup(ctx, mockStarSvc, mockUserSvc)
+				tc.Setup(ctx, mockAssetSvc, mockStarSvc, mockUserSvc)
 			}
+			defer mockAssetSvc.AssertExpectations(t)
 			defer mockStarSvc.AssertExpectations(t)
 
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
 
-			handler := NewAPIServer(APIServerDeps{StarSvc: mockStarSvc, UserSvc: mockUserSvc, Logger: logger})
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockAssetSvc, StarSvc: mockStarSvc, UserSvc: mockUserSvc, Logger: logger})
 
 			got, err := handler.GetAssetStargazers(ctx, tc.Request)
 			code := status.Code(err)
@@ -1223,6 +1317,19 @@ func TestGetAssetVersionHistory(t *testing.T) {
 				us.EXPECT().ValidateUser(ctx, mock.AnythingOfType("string")).Return("", errors.New("some-error"))
 			},
 		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			ExpectStatus: codes.NotFound,
+			Request: &compassv1beta1.GetAssetVersionHistoryRequest{
+				Id: assetID,
+			},
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description:  `should return invalid argument if asset id is not uuid`,
 			ExpectStatus: codes.InvalidArgument,
@@ -1312,6 +1419,7 @@ func TestGetAssetVersionHistory(t *testing.T) {
 			defer mockAssetSvc.AssertExpectations(t)
 
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockAssetSvc, UserSvc: mockUserSvc, Logger: logger})
 
@@ -1360,6 +1468,20 @@ func TestGetAssetByVersion(t *testing.T) {
 				us.EXPECT().ValidateUser(ctx, mock.AnythingOfType("string")).Return("", errors.New("some-error"))
 			},
 		},
+		{
+			Description: `should return not found if asset is restricted to groups of others`,
+			Request: &compassv1beta1.GetAssetByVersionRequest{
+				Id:      assetID,
+				Version: version,
+			},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.UserService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description: `should return invalid argument if asset id is not uuid`,
 			Request: &compassv1beta1.GetAssetByVersionRequest{
@@ -1432,6 +1554,7 @@ func TestGetAssetByVersion(t *testing.T) {
 			defer mockAssetSvc.AssertExpectations(t)
 
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockAssetSvc, UserSvc: mockUserSvc, Logger: logger})
 
@@ -1451,6 +1574,136 @@ func TestGetAssetByVersion(t *testing.T) {
 	}
 }
 
+func TestGetAssetSchemaDiff(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = uuid.NewString()
+		urn          = "sample-urn"
+		validRequest = &compassv1beta1.GetAssetSchemaDiffRequest{
+			Urn:         urn,
+			FromVersion: "0.1",
+			ToVersion:   "0.2",
+		}
+	)
+
+	type TestCase struct {
+		Description  string
+		Request      *compassv1beta1.GetAssetSchemaDiffRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.GetAssetSchemaDiffResponse) error
+	}
+
+	testCases := []TestCase{
+		{
+			Description: `should return invalid argument if a version is missing`,
+			Request: &compassv1beta1.GetAssetSchemaDiffRequest{
+				Urn:         urn,
+				FromVersion: "0.1",
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description: `should return invalid argument if a version is not valid`,
+			Request: &compassv1beta1.GetAssetSchemaDiffRequest{
+				Urn:         urn,
+				FromVersion: "0.1",
+				ToVersion:   "latest",
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetAssetByID(ctx, urn).Return(asset.Asset{
+					URN:        urn,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
+		{
+			Description:  `should return not found if a version of the asset doesn't exist`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetSchemaDiff(ctx, urn, "0.1", "0.2").Return(asset.SchemaDiff{}, asset.NotFoundError{URN: urn})
+			},
+		},
+		{
+			Description:  `should return internal server error if fetching fails`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetSchemaDiff(ctx, urn, "0.1", "0.2").Return(asset.SchemaDiff{}, errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  "should return status OK along with the changes of the columns",
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetSchemaDiff(ctx, urn, "0.1", "0.2").Return(asset.SchemaDiff{
+					URN:         urn,
+					FromVersion: "0.1",
+					ToVersion:   "0.2",
+					Changes: []asset.ColumnChange{
+						{Type: asset.ColumnAdded, Column: "created_at", DataType: "TIMESTAMP"},
+						{Type: asset.ColumnRenamed, Column: "email", OldColumn: "mail", DataType: "STRING"},
+					},
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetAssetSchemaDiffResponse) error {
+				expected := &compassv1beta1.GetAssetSchemaDiffResponse{
+					Data: &compassv1beta1.SchemaDiff{
+						Urn:         urn,
+						FromVersion: "0.1",
+						ToVersion:   "0.2",
+						Changes: []*compassv1beta1.ColumnChange{
+							{Type: "added", Column: "created_at", DataType: "TIMESTAMP"},
+							{Type: "renamed", Column: "email", OldColumn: "mail", DataType: "STRING", Breaking: true},
+						},
+					},
+				}
+				if d := cmp.Diff(resp, expected, protocmp.Transform()); d != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			logger := log.NewNoop()
+			mockUserSvc := mocks.NewUserService(t)
+			mockAssetSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAssetSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, urn).Return(asset.Asset{URN: urn}, nil).Maybe()
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockAssetSvc, UserSvc: mockUserSvc, Logger: logger})
+
+			got, err := handler.GetAssetSchemaDiff(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
 func TestCreateAssetProbe(t *testing.T) {
 	var (
 		userID    = uuid.NewString()
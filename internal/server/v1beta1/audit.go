@@ -0,0 +1,168 @@
+package handlersv1beta1
+
+//go:generate mockery --name=AuditService -r --case underscore --with-expecter --structname AuditService --filename audit_service.go --output=./mocks
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goto/compass/core/audit"
+	"github.com/goto/compass/core/user"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditService interface {
+	Record(ctx context.Context, e *audit.Event) error
+	GetEvents(ctx context.Context, flt audit.Filter) ([]audit.Event, error)
+}
+
+// Audit records the call of a write method in the audit log. It is called by
+// the audit interceptor once the call is handled, a failure to record it
+// being logged only.
+func (server *APIServer) Audit(ctx context.Context, fullMethod string, req, resp interface{}, err error, latency time.Duration) {
+	if server.auditService == nil {
+		return
+	}
+
+	e := audit.Event{
+		Actor:     user.FromContext(ctx).Email,
+		Method:    fullMethod,
+		TargetIDs: auditTargets(req, resp),
+		Request:   auditRequest(req),
+		Result:    status.Code(err).String(),
+		Latency:   latency,
+	}
+	if err != nil {
+		e.Error = status.Convert(err).Message()
+	}
+
+	// the event is recorded even if the client went away
+	if err := server.auditService.Record(context.WithoutCancel(ctx), &e); err != nil {
+		server.logger.Error("failed to record audit event", "method", fullMethod, "err", err)
+	}
+}
+
+func (server *APIServer) ListAuditEvents(ctx context.Context, req *compassv1beta1.ListAuditEventsRequest) (*compassv1beta1.ListAuditEventsResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	flt := audit.Filter{
+		Actor:    req.GetActor(),
+		Method:   req.GetMethod(),
+		TargetID: req.GetTargetId(),
+		Result:   req.GetResult(),
+		Size:     int(req.GetSize()),
+		Offset:   int(req.GetOffset()),
+	}
+	if req.GetSince() != nil {
+		flt.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		flt.Until = req.GetUntil().AsTime()
+	}
+
+	events, err := server.auditService.GetEvents(ctx, flt)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidTimeRange) || errors.Is(err, audit.ErrInvalidPagination) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	data := make([]*compassv1beta1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		data = append(data, auditEventToProto(e))
+	}
+
+	return &compassv1beta1.ListAuditEventsResponse{
+		Data: data,
+	}, nil
+}
+
+// auditRequest summarizes the request as json, the audit service truncating
+// it.
+func auditRequest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// auditTargets collects the ids and urns of the request and of the response,
+// from their top level fields and from the ones of the message they carry,
+// like the upserted asset or the created resource.
+func auditTargets(msgs ...interface{}) []string {
+	var targets []string
+	for _, m := range msgs {
+		msg, ok := m.(proto.Message)
+		if !ok || msg == nil {
+			continue
+		}
+		targets = appendTargets(targets, msg.ProtoReflect(), 1)
+	}
+	return targets
+}
+
+func appendTargets(targets []string, m protoreflect.Message, depth int) []string {
+	if !m.IsValid() {
+		return targets
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			// labels and the like hold no targets
+		case fd.Kind() == protoreflect.StringKind && isTargetField(string(fd.Name())):
+			if !fd.IsList() {
+				targets = append(targets, v.String())
+				break
+			}
+			for i := 0; i < v.List().Len(); i++ {
+				targets = append(targets, v.List().Get(i).String())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && depth > 0:
+			targets = appendTargets(targets, v.Message(), depth-1)
+		}
+		return true
+	})
+	return targets
+}
+
+func isTargetField(name string) bool {
+	switch name {
+	case "id", "urn", "ids", "urns", "assets":
+		return true
+	}
+	return strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_urn")
+}
+
+func auditEventToProto(e audit.Event) *compassv1beta1.AuditEvent {
+	return &compassv1beta1.AuditEvent{
+		Id:        e.ID,
+		Actor:     e.Actor,
+		Method:    e.Method,
+		TargetIds: e.TargetIDs,
+		Request:   e.Request,
+		Result:    e.Result,
+		Error:     e.Error,
+		LatencyMs: e.Latency.Milliseconds(),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
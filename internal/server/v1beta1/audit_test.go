@@ -0,0 +1,196 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/audit"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAudit(t *testing.T) {
+	const (
+		userEmail  = "test@test.com"
+		fullMethod = "/gotocompany.compass.v1beta1.CompassService/UpsertAsset"
+	)
+	req := &compassv1beta1.UpsertAssetRequest{
+		Asset: &compassv1beta1.UpsertAssetRequest_Asset{Urn: "urn:table", Type: "table", Service: "bigquery"},
+	}
+
+	type testCase struct {
+		Description string
+		Response    interface{}
+		Err         error
+		Setup       func(*mocks.AuditService)
+	}
+
+	testCases := []testCase{
+		{
+			Description: "should record the call with the targets of the request and the response",
+			Response:    &compassv1beta1.UpsertAssetResponse{Id: "asset-id"},
+			Setup: func(svc *mocks.AuditService) {
+				svc.EXPECT().Record(mock.Anything, mock.MatchedBy(func(e *audit.Event) bool {
+					return e.Actor == userEmail && e.Method == fullMethod && e.Result == "OK" && e.Error == "" &&
+						assert.ElementsMatch(t, []string{"urn:table", "asset-id"}, e.TargetIDs) &&
+						assert.JSONEq(t, `{"asset":{"urn":"urn:table","type":"table","service":"bigquery"}}`, e.Request) &&
+						e.Latency == time.Second
+				})).Return(nil)
+			},
+		},
+		{
+			Description: "should record the failure of the call",
+			Err:         status.Error(codes.PermissionDenied, "editor role required"),
+			Setup: func(svc *mocks.AuditService) {
+				svc.EXPECT().Record(mock.Anything, mock.MatchedBy(func(e *audit.Event) bool {
+					return e.Result == "PermissionDenied" && e.Error == "editor role required" &&
+						assert.Equal(t, []string{"urn:table"}, e.TargetIDs)
+				})).Return(nil)
+			},
+		},
+		{
+			Description: "should not fail if the event can not be recorded",
+			Setup: func(svc *mocks.AuditService) {
+				svc.EXPECT().Record(mock.Anything, mock.Anything).Return(errors.New("some error"))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockAuditSvc := mocks.NewAuditService(t)
+			if tc.Setup != nil {
+				tc.Setup(mockAuditSvc)
+			}
+
+			handler := NewAPIServer(APIServerDeps{AuditSvc: mockAuditSvc, Logger: log.NewNoop()})
+			handler.Audit(ctx, fullMethod, req, tc.Response, tc.Err, time.Second)
+		})
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		now       = time.Now().UTC()
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.ListAuditEventsRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AuditService)
+		PostCheck    func(resp *compassv1beta1.ListAuditEventsResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if time range is invalid",
+			Request:      &compassv1beta1.ListAuditEventsRequest{Since: timestamppb.New(now), Until: timestamppb.New(now.Add(-time.Hour))},
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, svc *mocks.AuditService) {
+				svc.EXPECT().GetEvents(ctx, mock.Anything).Return(nil, audit.ErrInvalidTimeRange)
+			},
+		},
+		{
+			Description:  "should return internal server error if failed to get events",
+			Request:      &compassv1beta1.ListAuditEventsRequest{},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, svc *mocks.AuditService) {
+				svc.EXPECT().GetEvents(ctx, audit.Filter{}).Return(nil, errors.New("some error"))
+			},
+		},
+		{
+			Description: "should return events matching the filter",
+			Request: &compassv1beta1.ListAuditEventsRequest{
+				Actor:    "john.doe@example.com",
+				Method:   "DeleteAsset",
+				TargetId: "asset-id",
+				Result:   "OK",
+				Since:    timestamppb.New(now.Add(-time.Hour)),
+				Size:     10,
+				Offset:   5,
+			},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.AuditService) {
+				svc.EXPECT().GetEvents(ctx, audit.Filter{
+					Actor:    "john.doe@example.com",
+					Method:   "DeleteAsset",
+					TargetID: "asset-id",
+					Result:   "OK",
+					Since:    now.Add(-time.Hour),
+					Size:     10,
+					Offset:   5,
+				}).Return([]audit.Event{{
+					ID:        "event-id",
+					Actor:     "john.doe@example.com",
+					Method:    "/gotocompany.compass.v1beta1.CompassService/DeleteAsset",
+					TargetIDs: []string{"asset-id"},
+					Request:   `{"id":"asset-id"}`,
+					Result:    "OK",
+					Latency:   1500 * time.Millisecond,
+					CreatedAt: now,
+				}}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.ListAuditEventsResponse) error {
+				expected := &compassv1beta1.ListAuditEventsResponse{
+					Data: []*compassv1beta1.AuditEvent{{
+						Id:        "event-id",
+						Actor:     "john.doe@example.com",
+						Method:    "/gotocompany.compass.v1beta1.CompassService/DeleteAsset",
+						TargetIds: []string{"asset-id"},
+						Request:   `{"id":"asset-id"}`,
+						Result:    "OK",
+						LatencyMs: 1500,
+						CreatedAt: timestamppb.New(now),
+					}},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockAuditSvc := mocks.NewAuditService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAuditSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AuditSvc: mockAuditSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.ListAuditEvents(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/core/user"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// openMethods are the methods allowed to everyone, the reads and the writes
+// of the user's own discussions, comments, stars and saved searches.
+var openMethods = map[string]bool{
+	"GetAllAssets":             true,
+	"GetAssetByID":             true,
+	"GetAssetByVersion":        true,
+	"GetAssetVersionHistory":   true,
+	"GetAssetSchemaDiff":       true,
+	"GetAssetStargazers":       true,
+	"SearchAssets":             true,
+	"SuggestAssets":            true,
+	"GroupAssets":              true,
+	"GetGraph":                 true,
+	"GetGraphV2":               true,
+	"GetAllTypes":              true,
+	"GetType":                  true,
+	"GetTypeSchema":            true,
+	"ValidateTypeSchema":       true,
+	"GetAllTagsByAsset":        true,
+	"GetTagByAssetAndTemplate": true,
+	"GetTagHistory":            true,
+	"GetColumnTag":             true,
+	"GetBulkTagJob":            true,
+	"GetAllTagTemplates":       true,
+	"GetTagTemplate":           true,
+	"GetAllTeams":              true,
+	"GetTeam":                  true,
+	"GetMyTeamsAssets":         true,
+	"GetMyProfile":             true,
+	"GetUserStarredAssets":     true,
+	"GetMyStarredAssets":       true,
+	"GetMyStarredAsset":        true,
+	"StarAsset":                true,
+	"UnstarAsset":              true,
+	"GetAllDiscussions":        true,
+	"GetDiscussion":            true,
+	"GetMyDiscussions":         true,
+	"CreateDiscussion":         true,
+	"PatchDiscussion":          true,
+	"GetAllComments":           true,
+	"GetComment":               true,
+	"CreateComment":            true,
+	"UpdateComment":            true,
+	"DeleteComment":            true,
+	"GetMySavedSearches":       true,
+	"GetSavedSearchMatches":    true,
+	"CreateSavedSearch":        true,
+	"DeleteSavedSearch":        true,
+}
+
+// methodRoles are the roles required by the write methods. The methods of
+// the service neither open nor listed here are for the admins only.
+var methodRoles = map[string]authz.Role{
+	"UpsertAsset":      authz.RoleEditor,
+	"UpsertPatchAsset": authz.RoleEditor,
+	"CreateAssetProbe": authz.RoleEditor,
+	"CreateTagAsset":   authz.RoleEditor,
+	"UpdateTagAsset":   authz.RoleEditor,
+	"DeleteTagAsset":   authz.RoleEditor,
+	"CreateColumnTag":  authz.RoleEditor,
+	"UpdateColumnTag":  authz.RoleEditor,
+	"DeleteColumnTag":  authz.RoleEditor,
+	"BulkTagAssets":    authz.RoleEditor,
+	"BulkUntagAssets":  authz.RoleEditor,
+
+	"DeleteAsset": authz.RoleOwner,
+
+	"DeleteAssets":       authz.RoleAdmin,
+	"SyncAssets":         authz.RoleAdmin,
+	"CreateType":         authz.RoleAdmin,
+	"UpdateType":         authz.RoleAdmin,
+	"DeleteType":         authz.RoleAdmin,
+	"UpsertTypeSchema":   authz.RoleAdmin,
+	"DeleteTypeSchema":   authz.RoleAdmin,
+	"CreateTagTemplate":  authz.RoleAdmin,
+	"UpdateTagTemplate":  authz.RoleAdmin,
+	"DeleteTagTemplate":  authz.RoleAdmin,
+	"MigrateTagTemplate": authz.RoleAdmin,
+	"CreatePolicy":       authz.RoleAdmin,
+	"GetAllPolicies":     authz.RoleAdmin,
+	"DeletePolicy":       authz.RoleAdmin,
+
+	"CreateServiceAccount":  authz.RoleAdmin,
+	"GetAllServiceAccounts": authz.RoleAdmin,
+	"DeleteServiceAccount":  authz.RoleAdmin,
+	"CreateAPIToken":        authz.RoleAdmin,
+	"GetAllAPITokens":       authz.RoleAdmin,
+	"RevokeAPIToken":        authz.RoleAdmin,
+	"CreateTeam":            authz.RoleAdmin,
+	"UpdateTeam":            authz.RoleAdmin,
+	"DeleteTeam":            authz.RoleAdmin,
+	"ListAuditEvents":       authz.RoleAdmin,
+}
+
+// Authorize checks the user in the context has the role required by the
+// method on each asset written by the request. It is called by the
+// authorization interceptor, before the request is handled.
+func (server *APIServer) Authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	if server.authzService == nil || !server.authzService.Enabled() {
+		return nil
+	}
+
+	// the methods of the other services, like the health checks, are open
+	if path.Dir(fullMethod) != "/"+compassv1beta1.CompassService_ServiceDesc.ServiceName {
+		return nil
+	}
+	method := path.Base(fullMethod)
+	if openMethods[method] {
+		return nil
+	}
+	role, ok := methodRoles[method]
+	if !ok {
+		role = authz.RoleAdmin
+	}
+
+	resources, err := server.authzResources(ctx, req)
+	if err != nil {
+		return internalServerError(server.logger, err.Error())
+	}
+
+	subject := user.FromContext(ctx).Email
+	for _, res := range resources {
+		if err := server.authzService.Authorize(ctx, subject, role, res); err != nil {
+			if errors.As(err, new(authz.ForbiddenError)) {
+				return status.Error(codes.PermissionDenied, err.Error())
+			}
+			return internalServerError(server.logger, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// assetViewer returns the viewer the assets are read for, from the groups of
+// the user in the context. The admins see the restricted assets too.
+func (server *APIServer) assetViewer(ctx context.Context) (*asset.Viewer, error) {
+	usr := user.FromContext(ctx)
+	viewer := &asset.Viewer{Groups: usr.Groups}
+	if server.authzService == nil || !server.authzService.Enabled() {
+		return viewer, nil
+	}
+
+	err := server.authzService.Authorize(ctx, usr.Email, authz.RoleAdmin, authz.Resource{})
+	switch {
+	case err == nil:
+		viewer.Unrestricted = true
+	case !errors.As(err, new(authz.ForbiddenError)):
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return viewer, nil
+}
+
+// authzResources returns the assets written by the request. Requests not
+// targeting specific assets are authorized on all of them.
+func (server *APIServer) authzResources(ctx context.Context, req interface{}) ([]authz.Resource, error) {
+	switch req := req.(type) {
+	case *compassv1beta1.UpsertAssetRequest:
+		ast := req.GetAsset()
+		return server.upsertResources(ctx, ast.GetUrn(), ast.GetType(), ast.GetService())
+
+	case *compassv1beta1.UpsertPatchAssetRequest:
+		ast := req.GetAsset()
+		return server.upsertResources(ctx, ast.GetUrn(), ast.GetType(), ast.GetService())
+
+	case *compassv1beta1.DeleteAssetRequest:
+		return server.assetResources(ctx, req.GetId())
+
+	case *compassv1beta1.CreateAssetProbeRequest:
+		return server.assetResources(ctx, req.GetAssetUrn())
+
+	case *compassv1beta1.BulkTagAssetsRequest:
+		if req.GetQueryExpr() != "" {
+			break
+		}
+		return server.assetResources(ctx, req.GetAssets()...)
+
+	case *compassv1beta1.BulkUntagAssetsRequest:
+		if req.GetQueryExpr() != "" {
+			break
+		}
+		return server.assetResources(ctx, req.GetAssets()...)
+
+	case interface{ GetAssetId() string }:
+		return server.assetResources(ctx, req.GetAssetId())
+	}
+
+	return []authz.Resource{{}}, nil
+}
+
+// upsertResources returns the asset as upserted along with the stored one,
+// when it exists, owned by the same users.
+func (server *APIServer) upsertResources(ctx context.Context, urn, typ, service string) ([]authz.Resource, error) {
+	res := authz.Resource{URN: urn, Type: typ, Service: service}
+
+	stored, err := server.assetService.GetAssetByIDWithoutProbes(ctx, urn)
+	if errors.As(err, new(asset.NotFoundError)) {
+		return []authz.Resource{res}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	storedRes := assetToResource(stored)
+	res.Owners = storedRes.Owners
+	if storedRes.Type == res.Type && storedRes.Service == res.Service {
+		return []authz.Resource{res}, nil
+	}
+	return []authz.Resource{res, storedRes}, nil
+}
+
+// assetResources returns the assets given by their ids or URNs. An asset
+// that does not exist is only known by the given value.
+func (server *APIServer) assetResources(ctx context.Context, ids ...string) ([]authz.Resource, error) {
+	resources := make([]authz.Resource, 0, len(ids))
+	for _, id := range ids {
+		ast, err := server.assetService.GetAssetByIDWithoutProbes(ctx, id)
+		if errors.As(err, new(asset.NotFoundError)) {
+			resources = append(resources, authz.Resource{URN: id})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, assetToResource(ast))
+	}
+	return resources, nil
+}
+
+func assetToResource(ast asset.Asset) authz.Resource {
+	owners := make([]string, 0, len(ast.Owners))
+	for _, o := range ast.Owners {
+		if o.Email != "" {
+			owners = append(owners, o.Email)
+		}
+	}
+	return authz.Resource{
+		URN:     ast.URN,
+		Type:    ast.Type.String(),
+		Service: ast.Service,
+		Owners:  owners,
+	}
+}
@@ -0,0 +1,205 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorize(t *testing.T) {
+	const (
+		userEmail     = "test@test.com"
+		methodPrefix  = "/gotocompany.compass.v1beta1.CompassService/"
+		tableURN      = "urn:bigquery:orders"
+		ownerEmail    = "owner@test.com"
+		unknownAsset  = "urn:bigquery:unknown"
+		tableAssetID  = "4dc2f4ec-4c4e-4f7e-a3b5-2f0d52c5dd3c"
+		templateURN   = "governance"
+		serviceTopics = "kafka"
+	)
+	stored := asset.Asset{
+		ID:      tableAssetID,
+		URN:     tableURN,
+		Type:    "table",
+		Service: "bigquery",
+		Owners:  []user.User{{Email: ownerEmail}},
+	}
+	storedRes := authz.Resource{URN: tableURN, Type: "table", Service: "bigquery", Owners: []string{ownerEmail}}
+
+	type testCase struct {
+		Description  string
+		Method       string
+		FullMethod   string
+		Request      interface{}
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AuthzService, *mocks.AssetService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should allow all methods if authorization is disabled",
+			Method:       "DeleteAssets",
+			Request:      &compassv1beta1.DeleteAssetsRequest{},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(false)
+			},
+		},
+		{
+			Description:  "should allow read methods",
+			Method:       "GetAssetByID",
+			Request:      &compassv1beta1.GetAssetByIDRequest{Id: tableAssetID},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+			},
+		},
+		{
+			Description:  "should allow validating a type schema",
+			Method:       "ValidateTypeSchema",
+			Request:      &compassv1beta1.ValidateTypeSchemaRequest{},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+			},
+		},
+		{
+			Description:  "should allow methods of other services",
+			FullMethod:   "/grpc.health.v1.Health/Check",
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+			},
+		},
+		{
+			Description:  "should authorize methods not listed for admins only",
+			Method:       "UnlistedMethod",
+			Request:      &compassv1beta1.GetAllAssetsRequest{},
+			ExpectStatus: codes.PermissionDenied,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleAdmin, authz.Resource{}).
+					Return(authz.ForbiddenError{Subject: userEmail, Role: authz.RoleAdmin})
+			},
+		},
+		{
+			Description:  "should authorize admin methods on all assets",
+			Method:       "CreateTagTemplate",
+			Request:      &compassv1beta1.CreateTagTemplateRequest{},
+			ExpectStatus: codes.PermissionDenied,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleAdmin, authz.Resource{}).
+					Return(authz.ForbiddenError{Subject: userEmail, Role: authz.RoleAdmin})
+			},
+		},
+		{
+			Description:  "should authorize upsert of new asset on the requested asset",
+			Method:       "UpsertAsset",
+			Request:      &compassv1beta1.UpsertAssetRequest{Asset: &compassv1beta1.UpsertAssetRequest_Asset{Urn: unknownAsset, Type: "topic", Service: serviceTopics}},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, ast *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				ast.EXPECT().GetAssetByIDWithoutProbes(ctx, unknownAsset).Return(asset.Asset{}, asset.NotFoundError{URN: unknownAsset})
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleEditor, authz.Resource{URN: unknownAsset, Type: "topic", Service: serviceTopics}).Return(nil)
+			},
+		},
+		{
+			Description:  "should authorize upsert moving asset to another service on both services",
+			Method:       "UpsertPatchAsset",
+			Request:      &compassv1beta1.UpsertPatchAssetRequest{Asset: &compassv1beta1.UpsertPatchAssetRequest_Asset{Urn: tableURN, Type: "table", Service: serviceTopics}},
+			ExpectStatus: codes.PermissionDenied,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, ast *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				ast.EXPECT().GetAssetByIDWithoutProbes(ctx, tableURN).Return(stored, nil)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleEditor, authz.Resource{URN: tableURN, Type: "table", Service: serviceTopics, Owners: []string{ownerEmail}}).Return(nil)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleEditor, storedRes).
+					Return(authz.ForbiddenError{Subject: userEmail, Role: authz.RoleEditor, Resource: storedRes})
+			},
+		},
+		{
+			Description:  "should authorize delete of asset as owner",
+			Method:       "DeleteAsset",
+			Request:      &compassv1beta1.DeleteAssetRequest{Id: tableAssetID},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, ast *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				ast.EXPECT().GetAssetByIDWithoutProbes(ctx, tableAssetID).Return(stored, nil)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleOwner, storedRes).Return(nil)
+			},
+		},
+		{
+			Description:  "should authorize tagging on the tagged asset",
+			Method:       "CreateTagAsset",
+			Request:      &compassv1beta1.CreateTagAssetRequest{AssetId: tableAssetID, TemplateUrn: templateURN},
+			ExpectStatus: codes.PermissionDenied,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, ast *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				ast.EXPECT().GetAssetByIDWithoutProbes(ctx, tableAssetID).Return(stored, nil)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleEditor, storedRes).
+					Return(authz.ForbiddenError{Subject: userEmail, Role: authz.RoleEditor, Resource: storedRes})
+			},
+		},
+		{
+			Description:  "should authorize bulk tagging by query on all assets",
+			Method:       "BulkTagAssets",
+			Request:      &compassv1beta1.BulkTagAssetsRequest{TemplateUrn: templateURN, QueryExpr: `service == "bigquery"`},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, _ *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				as.EXPECT().Authorize(ctx, userEmail, authz.RoleEditor, authz.Resource{}).Return(nil)
+			},
+		},
+		{
+			Description:  "should return internal server error if asset can not be fetched",
+			Method:       "DeleteColumnTag",
+			Request:      &compassv1beta1.DeleteColumnTagRequest{AssetId: tableAssetID, Column: "id", TemplateUrn: templateURN},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AuthzService, ast *mocks.AssetService) {
+				as.EXPECT().Enabled().Return(true)
+				ast.EXPECT().GetAssetByIDWithoutProbes(ctx, tableAssetID).Return(asset.Asset{}, errors.New("some error"))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockAuthzSvc := mocks.NewAuthzService(t)
+			mockAssetSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAuthzSvc, mockAssetSvc)
+			}
+
+			handler := NewAPIServer(APIServerDeps{AuthzSvc: mockAuthzSvc, AssetSvc: mockAssetSvc, Logger: log.NewNoop()})
+
+			fullMethod := methodPrefix + tc.Method
+			if tc.FullMethod != "" {
+				fullMethod = tc.FullMethod
+			}
+			err := handler.Authorize(ctx, fullMethod, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestMethodsClassified(t *testing.T) {
+	for _, m := range compassv1beta1.CompassService_ServiceDesc.Methods {
+		_, restricted := methodRoles[m.MethodName]
+		if openMethods[m.MethodName] == restricted {
+			t.Errorf("method %s must be either open or given a role", m.MethodName)
+		}
+	}
+}
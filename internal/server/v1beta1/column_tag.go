@@ -0,0 +1,193 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/goto/compass/core/tag"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errEmptyColumn = errors.New("column is empty")
+
+// CreateColumnTag handles the creation of tags on a column of an asset
+func (server *APIServer) CreateColumnTag(ctx context.Context, req *compassv1beta1.CreateColumnTagRequest) (*compassv1beta1.CreateColumnTagResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if err := validateColumnTagKey(req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn()); err != nil {
+		return nil, err
+	}
+	if req.GetTagValues() == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty tag values")
+	}
+
+	tagDomain := tag.Tag{
+		AssetID:     req.GetAssetId(),
+		Column:      req.GetColumn(),
+		TemplateURN: req.GetTemplateUrn(),
+		TagValues:   tagValuesFromProto(req.GetTagValues()),
+	}
+
+	err = server.tagService.CreateTag(ctx, &tagDomain)
+	if errors.As(err, new(tag.DuplicateError)) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.As(err, new(tag.TemplateNotFoundError)) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.As(err, new(tag.ValidationError)) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, internalServerError(server.logger, fmt.Sprintf("error creating column tag: %s", err.Error()))
+	}
+
+	tagPB, err := tagToProto(tagDomain)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateColumnTagResponse{
+		Data: tagPB,
+	}, nil
+}
+
+// GetColumnTag handles get tag by column and template requests
+func (server *APIServer) GetColumnTag(ctx context.Context, req *compassv1beta1.GetColumnTagRequest) (*compassv1beta1.GetColumnTagResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if err := validateColumnTagKey(req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn()); err != nil {
+		return nil, err
+	}
+
+	if err := server.checkAssetVisible(ctx, req.GetAssetId()); err != nil {
+		return nil, err
+	}
+
+	tg, err := server.tagService.FindColumnTag(ctx, req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn())
+	if err != nil {
+		if errors.As(err, new(tag.NotFoundError)) || errors.As(err, new(tag.TemplateNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, fmt.Sprintf("error finding a tag with column and template: %s", err.Error()))
+	}
+
+	tagPB, err := tagToProto(tg)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.GetColumnTagResponse{
+		Data: tagPB,
+	}, nil
+}
+
+// UpdateColumnTag handles the update of tags on a column of an asset
+func (server *APIServer) UpdateColumnTag(ctx context.Context, req *compassv1beta1.UpdateColumnTagRequest) (*compassv1beta1.UpdateColumnTagResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if err := validateColumnTagKey(req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn()); err != nil {
+		return nil, err
+	}
+	if req.GetTagValues() == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty tag values")
+	}
+
+	tagDomain := tag.Tag{
+		AssetID:     req.GetAssetId(),
+		Column:      req.GetColumn(),
+		TemplateURN: req.GetTemplateUrn(),
+		TagValues:   tagValuesFromProto(req.GetTagValues()),
+	}
+
+	err = server.tagService.UpdateTag(ctx, &tagDomain)
+	if err != nil {
+		if errors.As(err, new(tag.NotFoundError)) || errors.As(err, new(tag.TemplateNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.As(err, new(tag.ValidationError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, fmt.Sprintf("error updating a column's tag: %s", err.Error()))
+	}
+
+	tagPB, err := tagToProto(tagDomain)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.UpdateColumnTagResponse{
+		Data: tagPB,
+	}, nil
+}
+
+// DeleteColumnTag handles delete tag by column and template requests
+func (server *APIServer) DeleteColumnTag(ctx context.Context, req *compassv1beta1.DeleteColumnTagRequest) (*compassv1beta1.DeleteColumnTagResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if err := validateColumnTagKey(req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn()); err != nil {
+		return nil, err
+	}
+
+	err = server.tagService.DeleteColumnTag(ctx, req.GetAssetId(), req.GetColumn(), req.GetTemplateUrn())
+	if err != nil {
+		if errors.As(err, new(tag.TemplateNotFoundError)) || errors.As(err, new(tag.NotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, fmt.Sprintf("error deleting a column tag: %s", err.Error()))
+	}
+
+	return &compassv1beta1.DeleteColumnTagResponse{}, nil
+}
+
+func validateColumnTagKey(assetID, column, templateURN string) error {
+	if assetID == "" {
+		return status.Error(codes.InvalidArgument, errEmptyAssetID.Error())
+	}
+	if column == "" {
+		return status.Error(codes.InvalidArgument, errEmptyColumn.Error())
+	}
+	if templateURN == "" {
+		return status.Error(codes.InvalidArgument, errEmptyTemplateURN.Error())
+	}
+	return nil
+}
+
+func tagValuesFromProto(pbs []*compassv1beta1.TagValue) []tag.TagValue {
+	var tagValues []tag.TagValue
+	for _, tvPB := range pbs {
+		tagValues = append(tagValues, tagValueFromProto(tvPB))
+	}
+	return tagValues
+}
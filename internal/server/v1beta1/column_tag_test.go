@@ -0,0 +1,415 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+const sampleColumn = "user_id"
+
+var (
+	sampleColumnTag = tag.Tag{
+		AssetID:     assetID,
+		Column:      sampleColumn,
+		TemplateURN: sampleTag.TemplateURN,
+		TagValues:   sampleTag.TagValues,
+	}
+	sampleColumnTagPB = &compassv1beta1.Tag{
+		AssetId:     assetID,
+		Column:      sampleColumn,
+		TemplateUrn: sampleTagPB.GetTemplateUrn(),
+		TagValues:   sampleTagPB.GetTagValues(),
+	}
+)
+
+func TestCreateColumnTag(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.CreateColumnTagRequest{
+			AssetId:     assetID,
+			Column:      sampleColumn,
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			TagValues:   sampleTagPB.GetTagValues(),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateColumnTagRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.CreateColumnTagResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description: `should return invalid argument if column is empty`,
+			Request: &compassv1beta1.CreateColumnTagRequest{
+				AssetId:     assetID,
+				TemplateUrn: sampleTagPB.GetTemplateUrn(),
+				TagValues:   sampleTagPB.GetTagValues(),
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description: `should return invalid argument if tag values is empty`,
+			Request: &compassv1beta1.CreateColumnTagRequest{
+				AssetId:     assetID,
+				Column:      sampleColumn,
+				TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if the column is not a column of the asset`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().CreateTag(ctx, &sampleColumnTag).Return(tag.ValidationError{Err: errors.New("no such column")})
+			},
+		},
+		{
+			Description:  `should return already exist if the column is already tagged`,
+			Request:      validRequest,
+			ExpectStatus: codes.AlreadyExists,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().CreateTag(ctx, &sampleColumnTag).Return(tag.DuplicateError{})
+			},
+		},
+		{
+			Description:  `should return internal server error if found error during insert`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().CreateTag(ctx, &sampleColumnTag).Return(errors.New("unexpected error during insert"))
+			},
+		},
+		{
+			Description:  `should return ok and the tag of the column if found no error`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().CreateTag(ctx, &sampleColumnTag).Return(nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateColumnTagResponse) error {
+				expected := &compassv1beta1.CreateColumnTagResponse{
+					Data: sampleColumnTagPB,
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockTagSvc := mocks.NewTagService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  log.NewNoop(),
+			})
+
+			got, err := handler.CreateColumnTag(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestGetColumnTag(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.GetColumnTagRequest{
+			AssetId:     assetID,
+			Column:      sampleColumn,
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.GetColumnTagRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.GetColumnTagResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description: `should return invalid argument if template urn is empty`,
+			Request: &compassv1beta1.GetColumnTagRequest{
+				AssetId: assetID,
+				Column:  sampleColumn,
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.TagService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
+		{
+			Description:  `should return not found if the column is not tagged`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService) {
+				ts.EXPECT().FindColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).
+					Return(tag.Tag{}, tag.NotFoundError{AssetID: assetID, Column: sampleColumn})
+			},
+		},
+		{
+			Description:  `should return internal server error if found unexpected error`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService) {
+				ts.EXPECT().FindColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).
+					Return(tag.Tag{}, errors.New("unexpected error"))
+			},
+		},
+		{
+			Description:  `should return ok and the tag of the column if found`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService) {
+				ts.EXPECT().FindColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).
+					Return(sampleColumnTag, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetColumnTagResponse) error {
+				expected := &compassv1beta1.GetColumnTagResponse{
+					Data: sampleColumnTagPB,
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockAssetSvc := mocks.NewAssetService(t)
+			mockTagSvc := mocks.NewTagService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAssetSvc, mockTagSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
+
+			handler := NewAPIServer(APIServerDeps{
+				AssetSvc: mockAssetSvc,
+				TagSvc:   mockTagSvc,
+				UserSvc:  mockUserSvc,
+				Logger:   log.NewNoop(),
+			})
+
+			got, err := handler.GetColumnTag(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateColumnTag(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.UpdateColumnTagRequest{
+			AssetId:     assetID,
+			Column:      sampleColumn,
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			TagValues:   sampleTagPB.GetTagValues(),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.UpdateColumnTagRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+	}
+
+	testCases := []testCase{
+		{
+			Description: `should return invalid argument if asset id is empty`,
+			Request: &compassv1beta1.UpdateColumnTagRequest{
+				Column:      sampleColumn,
+				TemplateUrn: sampleTagPB.GetTemplateUrn(),
+				TagValues:   sampleTagPB.GetTagValues(),
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if the column is not tagged`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().UpdateTag(ctx, &sampleColumnTag).Return(tag.NotFoundError{AssetID: assetID, Column: sampleColumn})
+			},
+		},
+		{
+			Description:  `should return invalid argument if there is validation error`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().UpdateTag(ctx, &sampleColumnTag).Return(tag.ValidationError{Err: errors.New("validation error")})
+			},
+		},
+		{
+			Description:  `should return ok if the tag of the column is updated`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().UpdateTag(ctx, &sampleColumnTag).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockTagSvc := mocks.NewTagService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  log.NewNoop(),
+			})
+
+			_, err := handler.UpdateColumnTag(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+		})
+	}
+}
+
+func TestDeleteColumnTag(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.DeleteColumnTagRequest{
+			AssetId:     assetID,
+			Column:      sampleColumn,
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.DeleteColumnTagRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+	}
+
+	testCases := []testCase{
+		{
+			Description: `should return invalid argument if column is empty`,
+			Request: &compassv1beta1.DeleteColumnTagRequest{
+				AssetId:     assetID,
+				TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if template does not exist`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().DeleteColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).Return(tag.TemplateNotFoundError{})
+			},
+		},
+		{
+			Description:  `should return internal server error found unexpected error`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().DeleteColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).Return(errors.New("unexpected error"))
+			},
+		},
+		{
+			Description:  `should return ok if delete success`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().DeleteColumnTag(ctx, assetID, sampleColumn, sampleTagPB.GetTemplateUrn()).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockTagSvc := mocks.NewTagService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  log.NewNoop(),
+			})
+
+			_, err := handler.DeleteColumnTag(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+		})
+	}
+}
@@ -28,11 +28,17 @@ func (server *APIServer) GetGraph(ctx context.Context, req *compassv1beta1.GetGr
 		withAttributes = *req.WithAttributes
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	lineage, err := server.assetService.GetLineage(ctx, req.GetUrn(), asset.LineageQuery{
 		Level:          int(req.GetLevel()),
 		Direction:      direction,
 		WithAttributes: withAttributes,
 		IncludeDeleted: req.GetIncludeDeleted(),
+		Viewer:         viewer,
 	})
 	if err != nil {
 		return nil, internalServerError(server.logger, err.Error())
@@ -168,11 +174,17 @@ func (server *APIServer) resolveLineageV2(
 	coverage asset.LineageCoverage,
 	withAttributes bool,
 ) (asset.Lineage, asset.LineageType, error) {
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return asset.Lineage{}, "", err
+	}
+
 	baseQuery := asset.LineageQuery{
 		Level:          int(req.GetLevel()),
 		Direction:      direction,
 		WithAttributes: withAttributes,
 		IncludeDeleted: req.GetIncludeDeleted(),
+		Viewer:         viewer,
 	}
 
 	if req != nil && req.ColumnName != nil {
@@ -60,7 +60,7 @@ func TestGetLineageGraph(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true}).Return(lineage, nil)
+			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, Viewer: &asset.Viewer{}}).Return(lineage, nil)
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -165,7 +165,7 @@ func TestGetLineageGraph(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true}).Return(asset.Lineage{}, fmt.Errorf("failed to get lineage"))
+			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, Viewer: &asset.Viewer{}}).Return(asset.Lineage{}, fmt.Errorf("failed to get lineage"))
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -226,7 +226,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true}).Return(lineage, nil)
+			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, Viewer: &asset.Viewer{}}).Return(lineage, nil)
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -302,7 +302,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockSvc.AssertExpectations(t)
 
 			mockSvc.EXPECT().GetAssetByID(ctx, nodeURN).Return(assetDetail, nil)
-			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, AssetDetail: assetDetail}).Return(lineage, nil)
+			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, AssetDetail: assetDetail, Viewer: &asset.Viewer{}}).Return(lineage, nil)
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -384,7 +384,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, TargetColumn: "column-1"}).Return(lineage, nil)
+			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, TargetColumn: "column-1", Viewer: &asset.Viewer{}}).Return(lineage, nil)
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -526,7 +526,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true}).Return(asset.Lineage{}, fmt.Errorf("failed to get lineage"))
+			mockSvc.EXPECT().GetLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, Viewer: &asset.Viewer{}}).Return(asset.Lineage{}, fmt.Errorf("failed to get lineage"))
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -622,7 +622,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockSvc.AssertExpectations(t)
 
 			mockSvc.EXPECT().GetAssetByID(ctx, nodeURN).Return(assetDetail, nil)
-			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, AssetDetail: assetDetail}).Return(asset.Lineage{}, fmt.Errorf("failed to get column lineage"))
+			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, AssetDetail: assetDetail, Viewer: &asset.Viewer{}}).Return(asset.Lineage{}, fmt.Errorf("failed to get column lineage"))
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -646,7 +646,7 @@ func TestGetLineageGraphV2(t *testing.T) {
 			defer mockUserSvc.AssertExpectations(t)
 			defer mockSvc.AssertExpectations(t)
 
-			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, TargetColumn: "column-1"}).Return(asset.Lineage{}, fmt.Errorf("failed to get column lineage"))
+			mockSvc.EXPECT().GetColumnLineage(ctx, nodeURN, asset.LineageQuery{Level: level, Direction: direction, WithAttributes: true, TargetColumn: "column-1", Viewer: &asset.Viewer{}}).Return(asset.Lineage{}, fmt.Errorf("failed to get column lineage"))
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
 
 			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: logger})
@@ -73,6 +73,53 @@ func (_c *AssetService_AddProbe_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// CreateType provides a mock function with given fields: ctx, def
+func (_m *AssetService) CreateType(ctx context.Context, def *asset.TypeDefinition) error {
+	ret := _m.Called(ctx, def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeDefinition) error); ok {
+		r0 = rf(ctx, def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssetService_CreateType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateType'
+type AssetService_CreateType_Call struct {
+	*mock.Call
+}
+
+// CreateType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - def *asset.TypeDefinition
+func (_e *AssetService_Expecter) CreateType(ctx interface{}, def interface{}) *AssetService_CreateType_Call {
+	return &AssetService_CreateType_Call{Call: _e.mock.On("CreateType", ctx, def)}
+}
+
+func (_c *AssetService_CreateType_Call) Run(run func(ctx context.Context, def *asset.TypeDefinition)) *AssetService_CreateType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeDefinition))
+	})
+	return _c
+}
+
+func (_c *AssetService_CreateType_Call) Return(_a0 error) *AssetService_CreateType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AssetService_CreateType_Call) RunAndReturn(run func(context.Context, *asset.TypeDefinition) error) *AssetService_CreateType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteAsset provides a mock function with given fields: ctx, id
 func (_m *AssetService) DeleteAsset(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -236,6 +283,100 @@ func (_c *AssetService_DeleteAssetsByServicesAndUpdatedAt_Call) RunAndReturn(run
 	return _c
 }
 
+// DeleteType provides a mock function with given fields: ctx, name
+func (_m *AssetService) DeleteType(ctx context.Context, name asset.Type) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssetService_DeleteType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteType'
+type AssetService_DeleteType_Call struct {
+	*mock.Call
+}
+
+// DeleteType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name asset.Type
+func (_e *AssetService_Expecter) DeleteType(ctx interface{}, name interface{}) *AssetService_DeleteType_Call {
+	return &AssetService_DeleteType_Call{Call: _e.mock.On("DeleteType", ctx, name)}
+}
+
+func (_c *AssetService_DeleteType_Call) Run(run func(ctx context.Context, name asset.Type)) *AssetService_DeleteType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *AssetService_DeleteType_Call) Return(_a0 error) *AssetService_DeleteType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AssetService_DeleteType_Call) RunAndReturn(run func(context.Context, asset.Type) error) *AssetService_DeleteType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTypeSchema provides a mock function with given fields: ctx, typ
+func (_m *AssetService) DeleteTypeSchema(ctx context.Context, typ asset.Type) error {
+	ret := _m.Called(ctx, typ)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTypeSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) error); ok {
+		r0 = rf(ctx, typ)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssetService_DeleteTypeSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTypeSchema'
+type AssetService_DeleteTypeSchema_Call struct {
+	*mock.Call
+}
+
+// DeleteTypeSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - typ asset.Type
+func (_e *AssetService_Expecter) DeleteTypeSchema(ctx interface{}, typ interface{}) *AssetService_DeleteTypeSchema_Call {
+	return &AssetService_DeleteTypeSchema_Call{Call: _e.mock.On("DeleteTypeSchema", ctx, typ)}
+}
+
+func (_c *AssetService_DeleteTypeSchema_Call) Run(run func(ctx context.Context, typ asset.Type)) *AssetService_DeleteTypeSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *AssetService_DeleteTypeSchema_Call) Return(_a0 error) *AssetService_DeleteTypeSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AssetService_DeleteTypeSchema_Call) RunAndReturn(run func(context.Context, asset.Type) error) *AssetService_DeleteTypeSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllAssets provides a mock function with given fields: ctx, flt, withTotal
 func (_m *AssetService) GetAllAssets(ctx context.Context, flt asset.Filter, withTotal bool) ([]asset.Asset, uint32, error) {
 	ret := _m.Called(ctx, flt, withTotal)
@@ -651,6 +792,179 @@ func (_c *AssetService_GetLineage_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// GetSchemaDiff provides a mock function with given fields: ctx, urn, fromVersion, toVersion
+func (_m *AssetService) GetSchemaDiff(ctx context.Context, urn string, fromVersion string, toVersion string) (asset.SchemaDiff, error) {
+	ret := _m.Called(ctx, urn, fromVersion, toVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchemaDiff")
+	}
+
+	var r0 asset.SchemaDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (asset.SchemaDiff, error)); ok {
+		return rf(ctx, urn, fromVersion, toVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) asset.SchemaDiff); ok {
+		r0 = rf(ctx, urn, fromVersion, toVersion)
+	} else {
+		r0 = ret.Get(0).(asset.SchemaDiff)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, urn, fromVersion, toVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssetService_GetSchemaDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchemaDiff'
+type AssetService_GetSchemaDiff_Call struct {
+	*mock.Call
+}
+
+// GetSchemaDiff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - fromVersion string
+//   - toVersion string
+func (_e *AssetService_Expecter) GetSchemaDiff(ctx interface{}, urn interface{}, fromVersion interface{}, toVersion interface{}) *AssetService_GetSchemaDiff_Call {
+	return &AssetService_GetSchemaDiff_Call{Call: _e.mock.On("GetSchemaDiff", ctx, urn, fromVersion, toVersion)}
+}
+
+func (_c *AssetService_GetSchemaDiff_Call) Run(run func(ctx context.Context, urn string, fromVersion string, toVersion string)) *AssetService_GetSchemaDiff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AssetService_GetSchemaDiff_Call) Return(_a0 asset.SchemaDiff, _a1 error) *AssetService_GetSchemaDiff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssetService_GetSchemaDiff_Call) RunAndReturn(run func(context.Context, string, string, string) (asset.SchemaDiff, error)) *AssetService_GetSchemaDiff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTypeDefinition provides a mock function with given fields: ctx, name
+func (_m *AssetService) GetTypeDefinition(ctx context.Context, name asset.Type) (asset.TypeDefinition, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTypeDefinition")
+	}
+
+	var r0 asset.TypeDefinition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) (asset.TypeDefinition, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) asset.TypeDefinition); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(asset.TypeDefinition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.Type) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssetService_GetTypeDefinition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTypeDefinition'
+type AssetService_GetTypeDefinition_Call struct {
+	*mock.Call
+}
+
+// GetTypeDefinition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name asset.Type
+func (_e *AssetService_Expecter) GetTypeDefinition(ctx interface{}, name interface{}) *AssetService_GetTypeDefinition_Call {
+	return &AssetService_GetTypeDefinition_Call{Call: _e.mock.On("GetTypeDefinition", ctx, name)}
+}
+
+func (_c *AssetService_GetTypeDefinition_Call) Run(run func(ctx context.Context, name asset.Type)) *AssetService_GetTypeDefinition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *AssetService_GetTypeDefinition_Call) Return(_a0 asset.TypeDefinition, _a1 error) *AssetService_GetTypeDefinition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssetService_GetTypeDefinition_Call) RunAndReturn(run func(context.Context, asset.Type) (asset.TypeDefinition, error)) *AssetService_GetTypeDefinition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTypeSchema provides a mock function with given fields: ctx, typ
+func (_m *AssetService) GetTypeSchema(ctx context.Context, typ asset.Type) (asset.TypeSchema, error) {
+	ret := _m.Called(ctx, typ)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTypeSchema")
+	}
+
+	var r0 asset.TypeSchema
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) (asset.TypeSchema, error)); ok {
+		return rf(ctx, typ)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.Type) asset.TypeSchema); ok {
+		r0 = rf(ctx, typ)
+	} else {
+		r0 = ret.Get(0).(asset.TypeSchema)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.Type) error); ok {
+		r1 = rf(ctx, typ)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssetService_GetTypeSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTypeSchema'
+type AssetService_GetTypeSchema_Call struct {
+	*mock.Call
+}
+
+// GetTypeSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - typ asset.Type
+func (_e *AssetService_Expecter) GetTypeSchema(ctx interface{}, typ interface{}) *AssetService_GetTypeSchema_Call {
+	return &AssetService_GetTypeSchema_Call{Call: _e.mock.On("GetTypeSchema", ctx, typ)}
+}
+
+func (_c *AssetService_GetTypeSchema_Call) Run(run func(ctx context.Context, typ asset.Type)) *AssetService_GetTypeSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.Type))
+	})
+	return _c
+}
+
+func (_c *AssetService_GetTypeSchema_Call) Return(_a0 asset.TypeSchema, _a1 error) *AssetService_GetTypeSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssetService_GetTypeSchema_Call) RunAndReturn(run func(context.Context, asset.Type) (asset.TypeSchema, error)) *AssetService_GetTypeSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTypes provides a mock function with given fields: ctx, flt
 func (_m *AssetService) GetTypes(ctx context.Context, flt asset.Filter) (map[asset.Type]int, error) {
 	ret := _m.Called(ctx, flt)
@@ -1040,6 +1354,53 @@ func (_c *AssetService_SyncAssets_Call) RunAndReturn(run func(context.Context, [
 	return _c
 }
 
+// UpdateType provides a mock function with given fields: ctx, def
+func (_m *AssetService) UpdateType(ctx context.Context, def *asset.TypeDefinition) error {
+	ret := _m.Called(ctx, def)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeDefinition) error); ok {
+		r0 = rf(ctx, def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssetService_UpdateType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateType'
+type AssetService_UpdateType_Call struct {
+	*mock.Call
+}
+
+// UpdateType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - def *asset.TypeDefinition
+func (_e *AssetService_Expecter) UpdateType(ctx interface{}, def interface{}) *AssetService_UpdateType_Call {
+	return &AssetService_UpdateType_Call{Call: _e.mock.On("UpdateType", ctx, def)}
+}
+
+func (_c *AssetService_UpdateType_Call) Run(run func(ctx context.Context, def *asset.TypeDefinition)) *AssetService_UpdateType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeDefinition))
+	})
+	return _c
+}
+
+func (_c *AssetService_UpdateType_Call) Return(_a0 error) *AssetService_UpdateType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AssetService_UpdateType_Call) RunAndReturn(run func(context.Context, *asset.TypeDefinition) error) *AssetService_UpdateType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertAsset provides a mock function with given fields: ctx, ast, upstreams, downstreams, isUpdateOnly
 func (_m *AssetService) UpsertAsset(ctx context.Context, ast *asset.Asset, upstreams []string, downstreams []string, isUpdateOnly bool) (string, error) {
 	ret := _m.Called(ctx, ast, upstreams, downstreams, isUpdateOnly)
@@ -1278,6 +1639,110 @@ func (_c *AssetService_UpsertPatchAssetWithoutLineage_Call) RunAndReturn(run fun
 	return _c
 }
 
+// UpsertTypeSchema provides a mock function with given fields: ctx, ts
+func (_m *AssetService) UpsertTypeSchema(ctx context.Context, ts *asset.TypeSchema) error {
+	ret := _m.Called(ctx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertTypeSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *asset.TypeSchema) error); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssetService_UpsertTypeSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertTypeSchema'
+type AssetService_UpsertTypeSchema_Call struct {
+	*mock.Call
+}
+
+// UpsertTypeSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ts *asset.TypeSchema
+func (_e *AssetService_Expecter) UpsertTypeSchema(ctx interface{}, ts interface{}) *AssetService_UpsertTypeSchema_Call {
+	return &AssetService_UpsertTypeSchema_Call{Call: _e.mock.On("UpsertTypeSchema", ctx, ts)}
+}
+
+func (_c *AssetService_UpsertTypeSchema_Call) Run(run func(ctx context.Context, ts *asset.TypeSchema)) *AssetService_UpsertTypeSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*asset.TypeSchema))
+	})
+	return _c
+}
+
+func (_c *AssetService_UpsertTypeSchema_Call) Return(_a0 error) *AssetService_UpsertTypeSchema_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AssetService_UpsertTypeSchema_Call) RunAndReturn(run func(context.Context, *asset.TypeSchema) error) *AssetService_UpsertTypeSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateAssetsAgainstSchema provides a mock function with given fields: ctx, ts
+func (_m *AssetService) ValidateAssetsAgainstSchema(ctx context.Context, ts asset.TypeSchema) (asset.SchemaValidationReport, error) {
+	ret := _m.Called(ctx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAssetsAgainstSchema")
+	}
+
+	var r0 asset.SchemaValidationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, asset.TypeSchema) (asset.SchemaValidationReport, error)); ok {
+		return rf(ctx, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, asset.TypeSchema) asset.SchemaValidationReport); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		r0 = ret.Get(0).(asset.SchemaValidationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, asset.TypeSchema) error); ok {
+		r1 = rf(ctx, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssetService_ValidateAssetsAgainstSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAssetsAgainstSchema'
+type AssetService_ValidateAssetsAgainstSchema_Call struct {
+	*mock.Call
+}
+
+// ValidateAssetsAgainstSchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ts asset.TypeSchema
+func (_e *AssetService_Expecter) ValidateAssetsAgainstSchema(ctx interface{}, ts interface{}) *AssetService_ValidateAssetsAgainstSchema_Call {
+	return &AssetService_ValidateAssetsAgainstSchema_Call{Call: _e.mock.On("ValidateAssetsAgainstSchema", ctx, ts)}
+}
+
+func (_c *AssetService_ValidateAssetsAgainstSchema_Call) Run(run func(ctx context.Context, ts asset.TypeSchema)) *AssetService_ValidateAssetsAgainstSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(asset.TypeSchema))
+	})
+	return _c
+}
+
+func (_c *AssetService_ValidateAssetsAgainstSchema_Call) Return(_a0 asset.SchemaValidationReport, _a1 error) *AssetService_ValidateAssetsAgainstSchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssetService_ValidateAssetsAgainstSchema_Call) RunAndReturn(run func(context.Context, asset.TypeSchema) (asset.SchemaValidationReport, error)) *AssetService_ValidateAssetsAgainstSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAssetService creates a new instance of AssetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAssetService(t interface {
@@ -0,0 +1,144 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/goto/compass/core/audit"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditService is an autogenerated mock type for the AuditService type
+type AuditService struct {
+	mock.Mock
+}
+
+type AuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditService) EXPECT() *AuditService_Expecter {
+	return &AuditService_Expecter{mock: &_m.Mock}
+}
+
+// GetEvents provides a mock function with given fields: ctx, flt
+func (_m *AuditService) GetEvents(ctx context.Context, flt audit.Filter) ([]audit.Event, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []audit.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) ([]audit.Event, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Event); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditService_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type AuditService_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt audit.Filter
+func (_e *AuditService_Expecter) GetEvents(ctx interface{}, flt interface{}) *AuditService_GetEvents_Call {
+	return &AuditService_GetEvents_Call{Call: _e.mock.On("GetEvents", ctx, flt)}
+}
+
+func (_c *AuditService_GetEvents_Call) Run(run func(ctx context.Context, flt audit.Filter)) *AuditService_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(audit.Filter))
+	})
+	return _c
+}
+
+func (_c *AuditService_GetEvents_Call) Return(_a0 []audit.Event, _a1 error) *AuditService_GetEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditService_GetEvents_Call) RunAndReturn(run func(context.Context, audit.Filter) ([]audit.Event, error)) *AuditService_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, e
+func (_m *AuditService) Record(ctx context.Context, e *audit.Event) error {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *audit.Event) error); ok {
+		r0 = rf(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type AuditService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e *audit.Event
+func (_e *AuditService_Expecter) Record(ctx interface{}, e interface{}) *AuditService_Record_Call {
+	return &AuditService_Record_Call{Call: _e.mock.On("Record", ctx, e)}
+}
+
+func (_c *AuditService_Record_Call) Run(run func(ctx context.Context, e *audit.Event)) *AuditService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*audit.Event))
+	})
+	return _c
+}
+
+func (_c *AuditService_Record_Call) Return(_a0 error) *AuditService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditService_Record_Call) RunAndReturn(run func(context.Context, *audit.Event) error) *AuditService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditService creates a new instance of AuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditService {
+	mock := &AuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,352 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	authz "github.com/goto/compass/core/authz"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthzService is an autogenerated mock type for the AuthzService type
+type AuthzService struct {
+	mock.Mock
+}
+
+type AuthzService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthzService) EXPECT() *AuthzService_Expecter {
+	return &AuthzService_Expecter{mock: &_m.Mock}
+}
+
+// Authorize provides a mock function with given fields: ctx, subject, role, res
+func (_m *AuthzService) Authorize(ctx context.Context, subject string, role authz.Role, res authz.Resource) error {
+	ret := _m.Called(ctx, subject, role, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, authz.Role, authz.Resource) error); ok {
+		r0 = rf(ctx, subject, role, res)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthzService_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type AuthzService_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - role authz.Role
+//   - res authz.Resource
+func (_e *AuthzService_Expecter) Authorize(ctx interface{}, subject interface{}, role interface{}, res interface{}) *AuthzService_Authorize_Call {
+	return &AuthzService_Authorize_Call{Call: _e.mock.On("Authorize", ctx, subject, role, res)}
+}
+
+func (_c *AuthzService_Authorize_Call) Run(run func(ctx context.Context, subject string, role authz.Role, res authz.Resource)) *AuthzService_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(authz.Role), args[3].(authz.Resource))
+	})
+	return _c
+}
+
+func (_c *AuthzService_Authorize_Call) Return(_a0 error) *AuthzService_Authorize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthzService_Authorize_Call) RunAndReturn(run func(context.Context, string, authz.Role, authz.Resource) error) *AuthzService_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePolicy provides a mock function with given fields: ctx, p
+func (_m *AuthzService) CreatePolicy(ctx context.Context, p *authz.Policy) (string, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicy")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *authz.Policy) (string, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *authz.Policy) string); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *authz.Policy) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthzService_CreatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePolicy'
+type AuthzService_CreatePolicy_Call struct {
+	*mock.Call
+}
+
+// CreatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p *authz.Policy
+func (_e *AuthzService_Expecter) CreatePolicy(ctx interface{}, p interface{}) *AuthzService_CreatePolicy_Call {
+	return &AuthzService_CreatePolicy_Call{Call: _e.mock.On("CreatePolicy", ctx, p)}
+}
+
+func (_c *AuthzService_CreatePolicy_Call) Run(run func(ctx context.Context, p *authz.Policy)) *AuthzService_CreatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*authz.Policy))
+	})
+	return _c
+}
+
+func (_c *AuthzService_CreatePolicy_Call) Return(_a0 string, _a1 error) *AuthzService_CreatePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthzService_CreatePolicy_Call) RunAndReturn(run func(context.Context, *authz.Policy) (string, error)) *AuthzService_CreatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePolicy provides a mock function with given fields: ctx, id
+func (_m *AuthzService) DeletePolicy(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthzService_DeletePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePolicy'
+type AuthzService_DeletePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AuthzService_Expecter) DeletePolicy(ctx interface{}, id interface{}) *AuthzService_DeletePolicy_Call {
+	return &AuthzService_DeletePolicy_Call{Call: _e.mock.On("DeletePolicy", ctx, id)}
+}
+
+func (_c *AuthzService_DeletePolicy_Call) Run(run func(ctx context.Context, id string)) *AuthzService_DeletePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthzService_DeletePolicy_Call) Return(_a0 error) *AuthzService_DeletePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthzService_DeletePolicy_Call) RunAndReturn(run func(context.Context, string) error) *AuthzService_DeletePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enabled provides a mock function with given fields:
+func (_m *AuthzService) Enabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AuthzService_Enabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enabled'
+type AuthzService_Enabled_Call struct {
+	*mock.Call
+}
+
+// Enabled is a helper method to define mock.On call
+func (_e *AuthzService_Expecter) Enabled() *AuthzService_Enabled_Call {
+	return &AuthzService_Enabled_Call{Call: _e.mock.On("Enabled")}
+}
+
+func (_c *AuthzService_Enabled_Call) Run(run func()) *AuthzService_Enabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AuthzService_Enabled_Call) Return(_a0 bool) *AuthzService_Enabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthzService_Enabled_Call) RunAndReturn(run func() bool) *AuthzService_Enabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx, flt
+func (_m *AuthzService) GetPolicies(ctx context.Context, flt authz.Filter) ([]authz.Policy, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 []authz.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, authz.Filter) ([]authz.Policy, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, authz.Filter) []authz.Policy); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]authz.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, authz.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthzService_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type AuthzService_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt authz.Filter
+func (_e *AuthzService_Expecter) GetPolicies(ctx interface{}, flt interface{}) *AuthzService_GetPolicies_Call {
+	return &AuthzService_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx, flt)}
+}
+
+func (_c *AuthzService_GetPolicies_Call) Run(run func(ctx context.Context, flt authz.Filter)) *AuthzService_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(authz.Filter))
+	})
+	return _c
+}
+
+func (_c *AuthzService_GetPolicies_Call) Return(_a0 []authz.Policy, _a1 error) *AuthzService_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthzService_GetPolicies_Call) RunAndReturn(run func(context.Context, authz.Filter) ([]authz.Policy, error)) *AuthzService_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicyByID provides a mock function with given fields: ctx, id
+func (_m *AuthzService) GetPolicyByID(ctx context.Context, id string) (authz.Policy, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicyByID")
+	}
+
+	var r0 authz.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (authz.Policy, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) authz.Policy); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(authz.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthzService_GetPolicyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicyByID'
+type AuthzService_GetPolicyByID_Call struct {
+	*mock.Call
+}
+
+// GetPolicyByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AuthzService_Expecter) GetPolicyByID(ctx interface{}, id interface{}) *AuthzService_GetPolicyByID_Call {
+	return &AuthzService_GetPolicyByID_Call{Call: _e.mock.On("GetPolicyByID", ctx, id)}
+}
+
+func (_c *AuthzService_GetPolicyByID_Call) Run(run func(ctx context.Context, id string)) *AuthzService_GetPolicyByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthzService_GetPolicyByID_Call) Return(_a0 authz.Policy, _a1 error) *AuthzService_GetPolicyByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthzService_GetPolicyByID_Call) RunAndReturn(run func(context.Context, string) (authz.Policy, error)) *AuthzService_GetPolicyByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthzService creates a new instance of AuthzService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthzService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthzService {
+	mock := &AuthzService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,321 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	savedsearch "github.com/goto/compass/core/savedsearch"
+)
+
+// SavedSearchService is an autogenerated mock type for the SavedSearchService type
+type SavedSearchService struct {
+	mock.Mock
+}
+
+type SavedSearchService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedSearchService) EXPECT() *SavedSearchService_Expecter {
+	return &SavedSearchService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, ss
+func (_m *SavedSearchService) Create(ctx context.Context, ss *savedsearch.SavedSearch) (string, error) {
+	ret := _m.Called(ctx, ss)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *savedsearch.SavedSearch) (string, error)); ok {
+		return rf(ctx, ss)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *savedsearch.SavedSearch) string); ok {
+		r0 = rf(ctx, ss)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *savedsearch.SavedSearch) error); ok {
+		r1 = rf(ctx, ss)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SavedSearchService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ss *savedsearch.SavedSearch
+func (_e *SavedSearchService_Expecter) Create(ctx interface{}, ss interface{}) *SavedSearchService_Create_Call {
+	return &SavedSearchService_Create_Call{Call: _e.mock.On("Create", ctx, ss)}
+}
+
+func (_c *SavedSearchService_Create_Call) Run(run func(ctx context.Context, ss *savedsearch.SavedSearch)) *SavedSearchService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*savedsearch.SavedSearch))
+	})
+	return _c
+}
+
+func (_c *SavedSearchService_Create_Call) Return(_a0 string, _a1 error) *SavedSearchService_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchService_Create_Call) RunAndReturn(run func(context.Context, *savedsearch.SavedSearch) (string, error)) *SavedSearchService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, userID, id
+func (_m *SavedSearchService) Delete(ctx context.Context, userID string, id string) error {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedSearchService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SavedSearchService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *SavedSearchService_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *SavedSearchService_Delete_Call {
+	return &SavedSearchService_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
+}
+
+func (_c *SavedSearchService_Delete_Call) Run(run func(ctx context.Context, userID string, id string)) *SavedSearchService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchService_Delete_Call) Return(_a0 error) *SavedSearchService_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedSearchService_Delete_Call) RunAndReturn(run func(context.Context, string, string) error) *SavedSearchService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, userID, id
+func (_m *SavedSearchService) GetByID(ctx context.Context, userID string, id string) (savedsearch.SavedSearch, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 savedsearch.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (savedsearch.SavedSearch, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) savedsearch.SavedSearch); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		r0 = ret.Get(0).(savedsearch.SavedSearch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type SavedSearchService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *SavedSearchService_Expecter) GetByID(ctx interface{}, userID interface{}, id interface{}) *SavedSearchService_GetByID_Call {
+	return &SavedSearchService_GetByID_Call{Call: _e.mock.On("GetByID", ctx, userID, id)}
+}
+
+func (_c *SavedSearchService_GetByID_Call) Run(run func(ctx context.Context, userID string, id string)) *SavedSearchService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchService_GetByID_Call) Return(_a0 savedsearch.SavedSearch, _a1 error) *SavedSearchService_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchService_GetByID_Call) RunAndReturn(run func(context.Context, string, string) (savedsearch.SavedSearch, error)) *SavedSearchService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *SavedSearchService) GetByUserID(ctx context.Context, userID string) ([]savedsearch.SavedSearch, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []savedsearch.SavedSearch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]savedsearch.SavedSearch, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []savedsearch.SavedSearch); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]savedsearch.SavedSearch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchService_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type SavedSearchService_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *SavedSearchService_Expecter) GetByUserID(ctx interface{}, userID interface{}) *SavedSearchService_GetByUserID_Call {
+	return &SavedSearchService_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userID)}
+}
+
+func (_c *SavedSearchService_GetByUserID_Call) Run(run func(ctx context.Context, userID string)) *SavedSearchService_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchService_GetByUserID_Call) Return(_a0 []savedsearch.SavedSearch, _a1 error) *SavedSearchService_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchService_GetByUserID_Call) RunAndReturn(run func(context.Context, string) ([]savedsearch.SavedSearch, error)) *SavedSearchService_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMatches provides a mock function with given fields: ctx, userID, id, flt
+func (_m *SavedSearchService) GetMatches(ctx context.Context, userID string, id string, flt savedsearch.Filter) ([]savedsearch.Match, error) {
+	ret := _m.Called(ctx, userID, id, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatches")
+	}
+
+	var r0 []savedsearch.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, savedsearch.Filter) ([]savedsearch.Match, error)); ok {
+		return rf(ctx, userID, id, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, savedsearch.Filter) []savedsearch.Match); ok {
+		r0 = rf(ctx, userID, id, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]savedsearch.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, savedsearch.Filter) error); ok {
+		r1 = rf(ctx, userID, id, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchService_GetMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatches'
+type SavedSearchService_GetMatches_Call struct {
+	*mock.Call
+}
+
+// GetMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+//   - flt savedsearch.Filter
+func (_e *SavedSearchService_Expecter) GetMatches(ctx interface{}, userID interface{}, id interface{}, flt interface{}) *SavedSearchService_GetMatches_Call {
+	return &SavedSearchService_GetMatches_Call{Call: _e.mock.On("GetMatches", ctx, userID, id, flt)}
+}
+
+func (_c *SavedSearchService_GetMatches_Call) Run(run func(ctx context.Context, userID string, id string, flt savedsearch.Filter)) *SavedSearchService_GetMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(savedsearch.Filter))
+	})
+	return _c
+}
+
+func (_c *SavedSearchService_GetMatches_Call) Return(_a0 []savedsearch.Match, _a1 error) *SavedSearchService_GetMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchService_GetMatches_Call) RunAndReturn(run func(context.Context, string, string, savedsearch.Filter) ([]savedsearch.Match, error)) *SavedSearchService_GetMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedSearchService creates a new instance of SavedSearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedSearchService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedSearchService {
+	mock := &SavedSearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
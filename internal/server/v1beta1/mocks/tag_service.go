@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
@@ -70,6 +70,55 @@ func (_c *TagService_CreateTag_Call) RunAndReturn(run func(context.Context, *tag
 	return _c
 }
 
+// DeleteColumnTag provides a mock function with given fields: ctx, assetID, column, templateURN
+func (_m *TagService) DeleteColumnTag(ctx context.Context, assetID string, column string, templateURN string) error {
+	ret := _m.Called(ctx, assetID, column, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteColumnTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_DeleteColumnTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteColumnTag'
+type TagService_DeleteColumnTag_Call struct {
+	*mock.Call
+}
+
+// DeleteColumnTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - column string
+//   - templateURN string
+func (_e *TagService_Expecter) DeleteColumnTag(ctx interface{}, assetID interface{}, column interface{}, templateURN interface{}) *TagService_DeleteColumnTag_Call {
+	return &TagService_DeleteColumnTag_Call{Call: _e.mock.On("DeleteColumnTag", ctx, assetID, column, templateURN)}
+}
+
+func (_c *TagService_DeleteColumnTag_Call) Run(run func(ctx context.Context, assetID string, column string, templateURN string)) *TagService_DeleteColumnTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_DeleteColumnTag_Call) Return(_a0 error) *TagService_DeleteColumnTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_DeleteColumnTag_Call) RunAndReturn(run func(context.Context, string, string, string) error) *TagService_DeleteColumnTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteTag provides a mock function with given fields: ctx, assetID, templateURN
 func (_m *TagService) DeleteTag(ctx context.Context, assetID string, templateURN string) error {
 	ret := _m.Called(ctx, assetID, templateURN)
@@ -118,6 +167,65 @@ func (_c *TagService_DeleteTag_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// FindColumnTag provides a mock function with given fields: ctx, assetID, column, templateURN
+func (_m *TagService) FindColumnTag(ctx context.Context, assetID string, column string, templateURN string) (tag.Tag, error) {
+	ret := _m.Called(ctx, assetID, column, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindColumnTag")
+	}
+
+	var r0 tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (tag.Tag, error)); ok {
+		return rf(ctx, assetID, column, templateURN)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) tag.Tag); ok {
+		r0 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r0 = ret.Get(0).(tag.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_FindColumnTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindColumnTag'
+type TagService_FindColumnTag_Call struct {
+	*mock.Call
+}
+
+// FindColumnTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - column string
+//   - templateURN string
+func (_e *TagService_Expecter) FindColumnTag(ctx interface{}, assetID interface{}, column interface{}, templateURN interface{}) *TagService_FindColumnTag_Call {
+	return &TagService_FindColumnTag_Call{Call: _e.mock.On("FindColumnTag", ctx, assetID, column, templateURN)}
+}
+
+func (_c *TagService_FindColumnTag_Call) Run(run func(ctx context.Context, assetID string, column string, templateURN string)) *TagService_FindColumnTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_FindColumnTag_Call) Return(_a0 tag.Tag, _a1 error) *TagService_FindColumnTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_FindColumnTag_Call) RunAndReturn(run func(context.Context, string, string, string) (tag.Tag, error)) *TagService_FindColumnTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTagByAssetIDAndTemplateURN provides a mock function with given fields: ctx, assetID, templateURN
 func (_m *TagService) FindTagByAssetIDAndTemplateURN(ctx context.Context, assetID string, templateURN string) (tag.Tag, error) {
 	ret := _m.Called(ctx, assetID, templateURN)
@@ -176,6 +284,123 @@ func (_c *TagService_FindTagByAssetIDAndTemplateURN_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetBulkTagJob provides a mock function with given fields: ctx, id
+func (_m *TagService) GetBulkTagJob(ctx context.Context, id string) (tag.BulkTagJob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBulkTagJob")
+	}
+
+	var r0 tag.BulkTagJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (tag.BulkTagJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) tag.BulkTagJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(tag.BulkTagJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_GetBulkTagJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBulkTagJob'
+type TagService_GetBulkTagJob_Call struct {
+	*mock.Call
+}
+
+// GetBulkTagJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TagService_Expecter) GetBulkTagJob(ctx interface{}, id interface{}) *TagService_GetBulkTagJob_Call {
+	return &TagService_GetBulkTagJob_Call{Call: _e.mock.On("GetBulkTagJob", ctx, id)}
+}
+
+func (_c *TagService_GetBulkTagJob_Call) Run(run func(ctx context.Context, id string)) *TagService_GetBulkTagJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_GetBulkTagJob_Call) Return(_a0 tag.BulkTagJob, _a1 error) *TagService_GetBulkTagJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_GetBulkTagJob_Call) RunAndReturn(run func(context.Context, string) (tag.BulkTagJob, error)) *TagService_GetBulkTagJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagHistory provides a mock function with given fields: ctx, assetID, templateURN
+func (_m *TagService) GetTagHistory(ctx context.Context, assetID string, templateURN string) ([]tag.History, error) {
+	ret := _m.Called(ctx, assetID, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagHistory")
+	}
+
+	var r0 []tag.History
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]tag.History, error)); ok {
+		return rf(ctx, assetID, templateURN)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []tag.History); ok {
+		r0 = rf(ctx, assetID, templateURN)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tag.History)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, assetID, templateURN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_GetTagHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagHistory'
+type TagService_GetTagHistory_Call struct {
+	*mock.Call
+}
+
+// GetTagHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - templateURN string
+func (_e *TagService_Expecter) GetTagHistory(ctx interface{}, assetID interface{}, templateURN interface{}) *TagService_GetTagHistory_Call {
+	return &TagService_GetTagHistory_Call{Call: _e.mock.On("GetTagHistory", ctx, assetID, templateURN)}
+}
+
+func (_c *TagService_GetTagHistory_Call) Run(run func(ctx context.Context, assetID string, templateURN string)) *TagService_GetTagHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_GetTagHistory_Call) Return(_a0 []tag.History, _a1 error) *TagService_GetTagHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_GetTagHistory_Call) RunAndReturn(run func(context.Context, string, string) ([]tag.History, error)) *TagService_GetTagHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTagsByAssetID provides a mock function with given fields: ctx, assetID
 func (_m *TagService) GetTagsByAssetID(ctx context.Context, assetID string) ([]tag.Tag, error) {
 	ret := _m.Called(ctx, assetID)
@@ -235,6 +460,234 @@ func (_c *TagService_GetTagsByAssetID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// PlanBulkTag provides a mock function with given fields: ctx, req
+func (_m *TagService) PlanBulkTag(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanBulkTag")
+	}
+
+	var r0 tag.BulkTagReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) tag.BulkTagReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(tag.BulkTagReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.BulkTagRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_PlanBulkTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanBulkTag'
+type TagService_PlanBulkTag_Call struct {
+	*mock.Call
+}
+
+// PlanBulkTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req tag.BulkTagRequest
+func (_e *TagService_Expecter) PlanBulkTag(ctx interface{}, req interface{}) *TagService_PlanBulkTag_Call {
+	return &TagService_PlanBulkTag_Call{Call: _e.mock.On("PlanBulkTag", ctx, req)}
+}
+
+func (_c *TagService_PlanBulkTag_Call) Run(run func(ctx context.Context, req tag.BulkTagRequest)) *TagService_PlanBulkTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.BulkTagRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_PlanBulkTag_Call) Return(_a0 tag.BulkTagReport, _a1 error) *TagService_PlanBulkTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_PlanBulkTag_Call) RunAndReturn(run func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)) *TagService_PlanBulkTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanTemplateMigration provides a mock function with given fields: ctx, migration
+func (_m *TagService) PlanTemplateMigration(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error) {
+	ret := _m.Called(ctx, migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanTemplateMigration")
+	}
+
+	var r0 tag.MigrationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)); ok {
+		return rf(ctx, migration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) tag.MigrationReport); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Get(0).(tag.MigrationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.TemplateMigration) error); ok {
+		r1 = rf(ctx, migration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_PlanTemplateMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanTemplateMigration'
+type TagService_PlanTemplateMigration_Call struct {
+	*mock.Call
+}
+
+// PlanTemplateMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration tag.TemplateMigration
+func (_e *TagService_Expecter) PlanTemplateMigration(ctx interface{}, migration interface{}) *TagService_PlanTemplateMigration_Call {
+	return &TagService_PlanTemplateMigration_Call{Call: _e.mock.On("PlanTemplateMigration", ctx, migration)}
+}
+
+func (_c *TagService_PlanTemplateMigration_Call) Run(run func(ctx context.Context, migration tag.TemplateMigration)) *TagService_PlanTemplateMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TemplateMigration))
+	})
+	return _c
+}
+
+func (_c *TagService_PlanTemplateMigration_Call) Return(_a0 tag.MigrationReport, _a1 error) *TagService_PlanTemplateMigration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_PlanTemplateMigration_Call) RunAndReturn(run func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)) *TagService_PlanTemplateMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartBulkTag provides a mock function with given fields: ctx, req
+func (_m *TagService) StartBulkTag(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartBulkTag")
+	}
+
+	var r0 tag.BulkTagReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) tag.BulkTagReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(tag.BulkTagReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.BulkTagRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_StartBulkTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartBulkTag'
+type TagService_StartBulkTag_Call struct {
+	*mock.Call
+}
+
+// StartBulkTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req tag.BulkTagRequest
+func (_e *TagService_Expecter) StartBulkTag(ctx interface{}, req interface{}) *TagService_StartBulkTag_Call {
+	return &TagService_StartBulkTag_Call{Call: _e.mock.On("StartBulkTag", ctx, req)}
+}
+
+func (_c *TagService_StartBulkTag_Call) Run(run func(ctx context.Context, req tag.BulkTagRequest)) *TagService_StartBulkTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.BulkTagRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_StartBulkTag_Call) Return(_a0 tag.BulkTagReport, _a1 error) *TagService_StartBulkTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_StartBulkTag_Call) RunAndReturn(run func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)) *TagService_StartBulkTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTemplateMigration provides a mock function with given fields: ctx, migration
+func (_m *TagService) StartTemplateMigration(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error) {
+	ret := _m.Called(ctx, migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTemplateMigration")
+	}
+
+	var r0 tag.MigrationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)); ok {
+		return rf(ctx, migration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) tag.MigrationReport); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Get(0).(tag.MigrationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.TemplateMigration) error); ok {
+		r1 = rf(ctx, migration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_StartTemplateMigration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTemplateMigration'
+type TagService_StartTemplateMigration_Call struct {
+	*mock.Call
+}
+
+// StartTemplateMigration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration tag.TemplateMigration
+func (_e *TagService_Expecter) StartTemplateMigration(ctx interface{}, migration interface{}) *TagService_StartTemplateMigration_Call {
+	return &TagService_StartTemplateMigration_Call{Call: _e.mock.On("StartTemplateMigration", ctx, migration)}
+}
+
+func (_c *TagService_StartTemplateMigration_Call) Run(run func(ctx context.Context, migration tag.TemplateMigration)) *TagService_StartTemplateMigration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TemplateMigration))
+	})
+	return _c
+}
+
+func (_c *TagService_StartTemplateMigration_Call) Return(_a0 tag.MigrationReport, _a1 error) *TagService_StartTemplateMigration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_StartTemplateMigration_Call) RunAndReturn(run func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)) *TagService_StartTemplateMigration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTag provides a mock function with given fields: ctx, _a1
 func (_m *TagService) UpdateTag(ctx context.Context, _a1 *tag.Tag) error {
 	ret := _m.Called(ctx, _a1)
@@ -0,0 +1,305 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	team "github.com/goto/compass/core/team"
+)
+
+// TeamService is an autogenerated mock type for the TeamService type
+type TeamService struct {
+	mock.Mock
+}
+
+type TeamService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamService) EXPECT() *TeamService_Expecter {
+	return &TeamService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTeam provides a mock function with given fields: ctx, t
+func (_m *TeamService) CreateTeam(ctx context.Context, t *team.Team) (string, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeam")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) (string, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) string); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *team.Team) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamService_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type TeamService_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *team.Team
+func (_e *TeamService_Expecter) CreateTeam(ctx interface{}, t interface{}) *TeamService_CreateTeam_Call {
+	return &TeamService_CreateTeam_Call{Call: _e.mock.On("CreateTeam", ctx, t)}
+}
+
+func (_c *TeamService_CreateTeam_Call) Run(run func(ctx context.Context, t *team.Team)) *TeamService_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*team.Team))
+	})
+	return _c
+}
+
+func (_c *TeamService_CreateTeam_Call) Return(_a0 string, _a1 error) *TeamService_CreateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamService_CreateTeam_Call) RunAndReturn(run func(context.Context, *team.Team) (string, error)) *TeamService_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, id
+func (_m *TeamService) DeleteTeam(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamService_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type TeamService_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TeamService_Expecter) DeleteTeam(ctx interface{}, id interface{}) *TeamService_DeleteTeam_Call {
+	return &TeamService_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, id)}
+}
+
+func (_c *TeamService_DeleteTeam_Call) Run(run func(ctx context.Context, id string)) *TeamService_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamService_DeleteTeam_Call) Return(_a0 error) *TeamService_DeleteTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamService_DeleteTeam_Call) RunAndReturn(run func(context.Context, string) error) *TeamService_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamByID provides a mock function with given fields: ctx, id
+func (_m *TeamService) GetTeamByID(ctx context.Context, id string) (team.Team, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamByID")
+	}
+
+	var r0 team.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (team.Team, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) team.Team); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(team.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamService_GetTeamByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamByID'
+type TeamService_GetTeamByID_Call struct {
+	*mock.Call
+}
+
+// GetTeamByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TeamService_Expecter) GetTeamByID(ctx interface{}, id interface{}) *TeamService_GetTeamByID_Call {
+	return &TeamService_GetTeamByID_Call{Call: _e.mock.On("GetTeamByID", ctx, id)}
+}
+
+func (_c *TeamService_GetTeamByID_Call) Run(run func(ctx context.Context, id string)) *TeamService_GetTeamByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamService_GetTeamByID_Call) Return(_a0 team.Team, _a1 error) *TeamService_GetTeamByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamService_GetTeamByID_Call) RunAndReturn(run func(context.Context, string) (team.Team, error)) *TeamService_GetTeamByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeams provides a mock function with given fields: ctx, flt
+func (_m *TeamService) GetTeams(ctx context.Context, flt team.Filter) ([]team.Team, error) {
+	ret := _m.Called(ctx, flt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeams")
+	}
+
+	var r0 []team.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, team.Filter) ([]team.Team, error)); ok {
+		return rf(ctx, flt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, team.Filter) []team.Team); ok {
+		r0 = rf(ctx, flt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]team.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, team.Filter) error); ok {
+		r1 = rf(ctx, flt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamService_GetTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeams'
+type TeamService_GetTeams_Call struct {
+	*mock.Call
+}
+
+// GetTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - flt team.Filter
+func (_e *TeamService_Expecter) GetTeams(ctx interface{}, flt interface{}) *TeamService_GetTeams_Call {
+	return &TeamService_GetTeams_Call{Call: _e.mock.On("GetTeams", ctx, flt)}
+}
+
+func (_c *TeamService_GetTeams_Call) Run(run func(ctx context.Context, flt team.Filter)) *TeamService_GetTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(team.Filter))
+	})
+	return _c
+}
+
+func (_c *TeamService_GetTeams_Call) Return(_a0 []team.Team, _a1 error) *TeamService_GetTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamService_GetTeams_Call) RunAndReturn(run func(context.Context, team.Filter) ([]team.Team, error)) *TeamService_GetTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeam provides a mock function with given fields: ctx, t
+func (_m *TeamService) UpdateTeam(ctx context.Context, t *team.Team) error {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *team.Team) error); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamService_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type TeamService_UpdateTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *team.Team
+func (_e *TeamService_Expecter) UpdateTeam(ctx interface{}, t interface{}) *TeamService_UpdateTeam_Call {
+	return &TeamService_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, t)}
+}
+
+func (_c *TeamService_UpdateTeam_Call) Run(run func(ctx context.Context, t *team.Team)) *TeamService_UpdateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*team.Team))
+	})
+	return _c
+}
+
+func (_c *TeamService_UpdateTeam_Call) Return(_a0 error) *TeamService_UpdateTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamService_UpdateTeam_Call) RunAndReturn(run func(context.Context, *team.Team) error) *TeamService_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamService creates a new instance of TeamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamService {
+	mock := &TeamService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
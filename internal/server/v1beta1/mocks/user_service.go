@@ -1,4 +1,4 @@
-// Code generated by mockery v2.53.4. DO NOT EDIT.
+// Code generated by mockery v2.43.2. DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	user "github.com/goto/compass/core/user"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -21,6 +23,389 @@ func (_m *UserService) EXPECT() *UserService_Expecter {
 	return &UserService_Expecter{mock: &_m.Mock}
 }
 
+// CreateAPIToken provides a mock function with given fields: ctx, t
+func (_m *UserService) CreateAPIToken(ctx context.Context, t *user.APIToken) (string, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.APIToken) (string, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *user.APIToken) string); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *user.APIToken) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type UserService_CreateAPIToken_Call struct {
+	*mock.Call
+}
+
+// CreateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t *user.APIToken
+func (_e *UserService_Expecter) CreateAPIToken(ctx interface{}, t interface{}) *UserService_CreateAPIToken_Call {
+	return &UserService_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, t)}
+}
+
+func (_c *UserService_CreateAPIToken_Call) Run(run func(ctx context.Context, t *user.APIToken)) *UserService_CreateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.APIToken))
+	})
+	return _c
+}
+
+func (_c *UserService_CreateAPIToken_Call) Return(_a0 string, _a1 error) *UserService_CreateAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_CreateAPIToken_Call) RunAndReturn(run func(context.Context, *user.APIToken) (string, error)) *UserService_CreateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateServiceAccount provides a mock function with given fields: ctx, sa
+func (_m *UserService) CreateServiceAccount(ctx context.Context, sa *user.ServiceAccount) (string, error) {
+	ret := _m.Called(ctx, sa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *user.ServiceAccount) (string, error)); ok {
+		return rf(ctx, sa)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *user.ServiceAccount) string); ok {
+		r0 = rf(ctx, sa)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *user.ServiceAccount) error); ok {
+		r1 = rf(ctx, sa)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type UserService_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sa *user.ServiceAccount
+func (_e *UserService_Expecter) CreateServiceAccount(ctx interface{}, sa interface{}) *UserService_CreateServiceAccount_Call {
+	return &UserService_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount", ctx, sa)}
+}
+
+func (_c *UserService_CreateServiceAccount_Call) Run(run func(ctx context.Context, sa *user.ServiceAccount)) *UserService_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*user.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *UserService_CreateServiceAccount_Call) Return(_a0 string, _a1 error) *UserService_CreateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_CreateServiceAccount_Call) RunAndReturn(run func(context.Context, *user.ServiceAccount) (string, error)) *UserService_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteServiceAccount(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserService_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type UserService_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UserService_Expecter) DeleteServiceAccount(ctx interface{}, id interface{}) *UserService_DeleteServiceAccount_Call {
+	return &UserService_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount", ctx, id)}
+}
+
+func (_c *UserService_DeleteServiceAccount_Call) Run(run func(ctx context.Context, id string)) *UserService_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_DeleteServiceAccount_Call) Return(_a0 error) *UserService_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserService_DeleteServiceAccount_Call) RunAndReturn(run func(context.Context, string) error) *UserService_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPITokens provides a mock function with given fields: ctx, serviceAccountID
+func (_m *UserService) GetAPITokens(ctx context.Context, serviceAccountID string) ([]user.APIToken, error) {
+	ret := _m.Called(ctx, serviceAccountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPITokens")
+	}
+
+	var r0 []user.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]user.APIToken, error)); ok {
+		return rf(ctx, serviceAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []user.APIToken); ok {
+		r0 = rf(ctx, serviceAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPITokens'
+type UserService_GetAPITokens_Call struct {
+	*mock.Call
+}
+
+// GetAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+func (_e *UserService_Expecter) GetAPITokens(ctx interface{}, serviceAccountID interface{}) *UserService_GetAPITokens_Call {
+	return &UserService_GetAPITokens_Call{Call: _e.mock.On("GetAPITokens", ctx, serviceAccountID)}
+}
+
+func (_c *UserService_GetAPITokens_Call) Run(run func(ctx context.Context, serviceAccountID string)) *UserService_GetAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetAPITokens_Call) Return(_a0 []user.APIToken, _a1 error) *UserService_GetAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetAPITokens_Call) RunAndReturn(run func(context.Context, string) ([]user.APIToken, error)) *UserService_GetAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceAccountByID provides a mock function with given fields: ctx, id
+func (_m *UserService) GetServiceAccountByID(ctx context.Context, id string) (user.ServiceAccount, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccountByID")
+	}
+
+	var r0 user.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.ServiceAccount, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.ServiceAccount); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(user.ServiceAccount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetServiceAccountByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceAccountByID'
+type UserService_GetServiceAccountByID_Call struct {
+	*mock.Call
+}
+
+// GetServiceAccountByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *UserService_Expecter) GetServiceAccountByID(ctx interface{}, id interface{}) *UserService_GetServiceAccountByID_Call {
+	return &UserService_GetServiceAccountByID_Call{Call: _e.mock.On("GetServiceAccountByID", ctx, id)}
+}
+
+func (_c *UserService_GetServiceAccountByID_Call) Run(run func(ctx context.Context, id string)) *UserService_GetServiceAccountByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetServiceAccountByID_Call) Return(_a0 user.ServiceAccount, _a1 error) *UserService_GetServiceAccountByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetServiceAccountByID_Call) RunAndReturn(run func(context.Context, string) (user.ServiceAccount, error)) *UserService_GetServiceAccountByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceAccounts provides a mock function with given fields: ctx
+func (_m *UserService) GetServiceAccounts(ctx context.Context) ([]user.ServiceAccount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetServiceAccounts")
+	}
+
+	var r0 []user.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]user.ServiceAccount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []user.ServiceAccount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetServiceAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceAccounts'
+type UserService_GetServiceAccounts_Call struct {
+	*mock.Call
+}
+
+// GetServiceAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserService_Expecter) GetServiceAccounts(ctx interface{}) *UserService_GetServiceAccounts_Call {
+	return &UserService_GetServiceAccounts_Call{Call: _e.mock.On("GetServiceAccounts", ctx)}
+}
+
+func (_c *UserService_GetServiceAccounts_Call) Run(run func(ctx context.Context)) *UserService_GetServiceAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserService_GetServiceAccounts_Call) Return(_a0 []user.ServiceAccount, _a1 error) *UserService_GetServiceAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetServiceAccounts_Call) RunAndReturn(run func(context.Context) ([]user.ServiceAccount, error)) *UserService_GetServiceAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIToken provides a mock function with given fields: ctx, serviceAccountID, id
+func (_m *UserService) RevokeAPIToken(ctx context.Context, serviceAccountID string, id string) error {
+	ret := _m.Called(ctx, serviceAccountID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, serviceAccountID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserService_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
+type UserService_RevokeAPIToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID string
+//   - id string
+func (_e *UserService_Expecter) RevokeAPIToken(ctx interface{}, serviceAccountID interface{}, id interface{}) *UserService_RevokeAPIToken_Call {
+	return &UserService_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", ctx, serviceAccountID, id)}
+}
+
+func (_c *UserService_RevokeAPIToken_Call) Run(run func(ctx context.Context, serviceAccountID string, id string)) *UserService_RevokeAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserService_RevokeAPIToken_Call) Return(_a0 error) *UserService_RevokeAPIToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserService_RevokeAPIToken_Call) RunAndReturn(run func(context.Context, string, string) error) *UserService_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateUser provides a mock function with given fields: ctx, email
 func (_m *UserService) ValidateUser(ctx context.Context, email string) (string, error) {
 	ret := _m.Called(ctx, email)
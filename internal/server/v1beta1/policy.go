@@ -0,0 +1,128 @@
+package handlersv1beta1
+
+//go:generate mockery --name=AuthzService -r --case underscore --with-expecter --structname AuthzService --filename authz_service.go --output=./mocks
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/goto/compass/core/authz"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuthzService interface {
+	Enabled() bool
+	Authorize(ctx context.Context, subject string, role authz.Role, res authz.Resource) error
+	CreatePolicy(ctx context.Context, p *authz.Policy) (string, error)
+	GetPolicyByID(ctx context.Context, id string) (authz.Policy, error)
+	GetPolicies(ctx context.Context, flt authz.Filter) ([]authz.Policy, error)
+	DeletePolicy(ctx context.Context, id string) error
+}
+
+func (server *APIServer) CreatePolicy(ctx context.Context, req *compassv1beta1.CreatePolicyRequest) (*compassv1beta1.CreatePolicyResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	p := authz.Policy{
+		Subject: req.GetSubject(),
+		Role:    authz.Role(req.GetRole()),
+		Scope: authz.Scope{
+			Kind:  authz.ScopeKind(req.GetScope().GetKind()),
+			Value: req.GetScope().GetValue(),
+		},
+		CreatedBy: userID,
+	}
+	id, err := server.authzService.CreatePolicy(ctx, &p)
+	if err != nil {
+		if isPolicyInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(authz.DuplicateError)) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	created, err := server.authzService.GetPolicyByID(ctx, id)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreatePolicyResponse{
+		Data: policyToProto(created),
+	}, nil
+}
+
+func (server *APIServer) GetAllPolicies(ctx context.Context, req *compassv1beta1.GetAllPoliciesRequest) (*compassv1beta1.GetAllPoliciesResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	policies, err := server.authzService.GetPolicies(ctx, authz.Filter{
+		Subject: strings.TrimSpace(req.GetSubject()),
+	})
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	policiesPB := make([]*compassv1beta1.Policy, 0, len(policies))
+	for _, p := range policies {
+		policiesPB = append(policiesPB, policyToProto(p))
+	}
+
+	return &compassv1beta1.GetAllPoliciesResponse{
+		Data: policiesPB,
+	}, nil
+}
+
+func (server *APIServer) DeletePolicy(ctx context.Context, req *compassv1beta1.DeletePolicyRequest) (*compassv1beta1.DeletePolicyResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.authzService.DeletePolicy(ctx, req.GetId()); err != nil {
+		if errors.As(err, new(authz.InvalidError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(authz.NotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.DeletePolicyResponse{}, nil
+}
+
+func isPolicyInvalidArgument(err error) bool {
+	return errors.Is(err, authz.ErrEmptySubject) ||
+		errors.Is(err, authz.ErrInvalidRole) ||
+		errors.Is(err, authz.ErrInvalidScope)
+}
+
+func policyToProto(p authz.Policy) *compassv1beta1.Policy {
+	return &compassv1beta1.Policy{
+		Id:      p.ID,
+		Subject: p.Subject,
+		Role:    p.Role.String(),
+		Scope: &compassv1beta1.PolicyScope{
+			Kind:  string(p.Scope.Kind),
+			Value: p.Scope.Value,
+		},
+		CreatedBy: p.CreatedBy,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+}
@@ -0,0 +1,202 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreatePolicy(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		now       = time.Now().UTC()
+		validReq  = &compassv1beta1.CreatePolicyRequest{
+			Subject: "user@example.com",
+			Role:    "editor",
+			Scope:   &compassv1beta1.PolicyScope{Kind: "service", Value: "bigquery"},
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreatePolicyRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AuthzService)
+		PostCheck    func(resp *compassv1beta1.CreatePolicyResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if role is unknown",
+			Request:      &compassv1beta1.CreatePolicyRequest{Subject: "user@example.com", Role: "writer", Scope: &compassv1beta1.PolicyScope{Kind: "global"}},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return invalid argument if scope is missing",
+			Request:      &compassv1beta1.CreatePolicyRequest{Subject: "user@example.com", Role: "editor"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return invalid argument if scope has no value",
+			Request:      &compassv1beta1.CreatePolicyRequest{Subject: "user@example.com", Role: "editor", Scope: &compassv1beta1.PolicyScope{Kind: "type"}},
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				svc.EXPECT().CreatePolicy(ctx, mock.Anything).Return("", authz.ErrInvalidScope)
+			},
+		},
+		{
+			Description:  "should return already exists if user has role on scope",
+			Request:      validReq,
+			ExpectStatus: codes.AlreadyExists,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				svc.EXPECT().CreatePolicy(ctx, mock.Anything).Return("", authz.DuplicateError{})
+			},
+		},
+		{
+			Description:  "should return internal server error if failed to create policy",
+			Request:      validReq,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				svc.EXPECT().CreatePolicy(ctx, mock.Anything).Return("", errors.New("some error"))
+			},
+		},
+		{
+			Description:  "should return created policy",
+			Request:      validReq,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				expected := &authz.Policy{
+					Subject:   "user@example.com",
+					Role:      authz.RoleEditor,
+					Scope:     authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"},
+					CreatedBy: userID,
+				}
+				svc.EXPECT().CreatePolicy(ctx, expected).Return("policy-1", nil)
+
+				created := *expected
+				created.ID = "policy-1"
+				created.CreatedAt = now
+				created.UpdatedAt = now
+				svc.EXPECT().GetPolicyByID(ctx, "policy-1").Return(created, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreatePolicyResponse) error {
+				expected := &compassv1beta1.CreatePolicyResponse{
+					Data: &compassv1beta1.Policy{
+						Id:        "policy-1",
+						Subject:   "user@example.com",
+						Role:      "editor",
+						Scope:     &compassv1beta1.PolicyScope{Kind: "service", Value: "bigquery"},
+						CreatedBy: userID,
+						CreatedAt: timestamppb.New(now),
+						UpdatedAt: timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockAuthzSvc := mocks.NewAuthzService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAuthzSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AuthzSvc: mockAuthzSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreatePolicy(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestDeletePolicy(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		policyID  = uuid.NewString()
+	)
+	type testCase struct {
+		Description  string
+		ID           string
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AuthzService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if id is not uuid",
+			ID:           "policy-1",
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return not found if policy does not exist",
+			ID:           policyID,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				svc.EXPECT().DeletePolicy(ctx, policyID).Return(authz.NotFoundError{ID: policyID})
+			},
+		},
+		{
+			Description:  "should delete policy",
+			ID:           policyID,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.AuthzService) {
+				svc.EXPECT().DeletePolicy(ctx, policyID).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockAuthzSvc := mocks.NewAuthzService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAuthzSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AuthzSvc: mockAuthzSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.DeletePolicy(ctx, &compassv1beta1.DeletePolicyRequest{Id: tc.ID})
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package handlersv1beta1
+
+//go:generate mockery --name=SavedSearchService -r --case underscore --with-expecter --structname SavedSearchService --filename saved_search_service.go --output=./mocks
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SavedSearchService interface {
+	Create(ctx context.Context, ss *savedsearch.SavedSearch) (string, error)
+	GetByID(ctx context.Context, userID, id string) (savedsearch.SavedSearch, error)
+	GetByUserID(ctx context.Context, userID string) ([]savedsearch.SavedSearch, error)
+	Delete(ctx context.Context, userID, id string) error
+	GetMatches(ctx context.Context, userID, id string, flt savedsearch.Filter) ([]savedsearch.Match, error)
+}
+
+func (server *APIServer) CreateSavedSearch(ctx context.Context, req *compassv1beta1.CreateSavedSearchRequest) (*compassv1beta1.CreateSavedSearchResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := savedsearch.SavedSearch{
+		UserID:     userID,
+		Name:       req.GetName(),
+		Text:       req.GetText(),
+		Filters:    filterConfigFromValues(req.GetFilter()),
+		Queries:    req.GetQuery(),
+		Flags:      getSearchFlagsFromFlags(req.GetFlags()),
+		WebhookURL: strings.TrimSpace(req.GetWebhookUrl()),
+	}
+	id, err := server.savedSearchService.Create(ctx, &ss)
+	if err != nil {
+		if isSavedSearchInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	created, err := server.savedSearchService.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateSavedSearchResponse{
+		Data: savedSearchToProto(created),
+	}, nil
+}
+
+func (server *APIServer) GetMySavedSearches(ctx context.Context, _ *compassv1beta1.GetMySavedSearchesRequest) (*compassv1beta1.GetMySavedSearchesResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	searches, err := server.savedSearchService.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	searchesPB := make([]*compassv1beta1.SavedSearch, 0, len(searches))
+	for _, ss := range searches {
+		searchesPB = append(searchesPB, savedSearchToProto(ss))
+	}
+
+	return &compassv1beta1.GetMySavedSearchesResponse{
+		Data: searchesPB,
+	}, nil
+}
+
+func (server *APIServer) DeleteSavedSearch(ctx context.Context, req *compassv1beta1.DeleteSavedSearchRequest) (*compassv1beta1.DeleteSavedSearchResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.savedSearchService.Delete(ctx, userID, req.GetId()); err != nil {
+		if isSavedSearchInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(savedsearch.NotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.DeleteSavedSearchResponse{}, nil
+}
+
+func (server *APIServer) GetSavedSearchMatches(ctx context.Context, req *compassv1beta1.GetSavedSearchMatchesRequest) (*compassv1beta1.GetSavedSearchMatchesResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := server.savedSearchService.GetMatches(ctx, userID, req.GetId(), savedsearch.Filter{
+		Size:   int(req.GetSize()),
+		Offset: int(req.GetOffset()),
+	})
+	if err != nil {
+		if isSavedSearchInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(savedsearch.NotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	matchesPB := make([]*compassv1beta1.SavedSearchMatch, 0, len(matches))
+	for _, m := range matches {
+		astPB, err := assetToProto(m.Asset, false)
+		if err != nil {
+			return nil, internalServerError(server.logger, err.Error())
+		}
+		matchesPB = append(matchesPB, &compassv1beta1.SavedSearchMatch{
+			Asset:     astPB,
+			MatchedAt: timestamppb.New(m.MatchedAt),
+		})
+	}
+
+	return &compassv1beta1.GetSavedSearchMatchesResponse{
+		Data: matchesPB,
+	}, nil
+}
+
+func isSavedSearchInvalidArgument(err error) bool {
+	return errors.Is(err, savedsearch.ErrEmptyUserID) ||
+		errors.Is(err, savedsearch.ErrEmptyName) ||
+		errors.Is(err, savedsearch.ErrEmptyQuery) ||
+		errors.Is(err, savedsearch.ErrInvalidWebhookURL) ||
+		errors.As(err, new(savedsearch.InvalidError))
+}
+
+func savedSearchToProto(ss savedsearch.SavedSearch) *compassv1beta1.SavedSearch {
+	var lastRunAtPB *timestamppb.Timestamp
+	if ss.LastRunAt != nil {
+		lastRunAtPB = timestamppb.New(*ss.LastRunAt)
+	}
+
+	return &compassv1beta1.SavedSearch{
+		Id:         ss.ID,
+		Name:       ss.Name,
+		Text:       ss.Text,
+		Filter:     filterValuesFromConfig(ss.Filters),
+		Query:      ss.Queries,
+		Flags:      searchFlagsToProto(ss.Flags),
+		WebhookUrl: ss.WebhookURL,
+		LastRunAt:  lastRunAtPB,
+		CreatedAt:  timestamppb.New(ss.CreatedAt),
+		UpdatedAt:  timestamppb.New(ss.UpdatedAt),
+	}
+}
+
+// filterValuesFromConfig is the reverse of filterConfigFromValues
+func filterValuesFromConfig(flt asset.SearchFilter) map[string]string {
+	if len(flt) == 0 {
+		return nil
+	}
+
+	fltMap := make(map[string]string, len(flt))
+	for key, values := range flt {
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		fltMap[key] = strings.Join(sorted, ",")
+	}
+	return fltMap
+}
+
+func searchFlagsToProto(flags asset.SearchFlags) *compassv1beta1.SearchFlags {
+	return &compassv1beta1.SearchFlags{
+		IsColumnSearch:  flags.IsColumnSearch,
+		DisableFuzzy:    flags.DisableFuzzy,
+		EnableHighlight: flags.EnableHighlight,
+		EnableSemantic:  flags.EnableSemantic,
+		EnableExplain:   flags.EnableExplain,
+	}
+}
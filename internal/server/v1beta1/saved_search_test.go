@@ -0,0 +1,240 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateSavedSearch(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		now       = time.Now().UTC()
+		validReq  = &compassv1beta1.CreateSavedSearchRequest{
+			Name:       "pii tables",
+			Text:       "customer",
+			Filter:     map[string]string{"type": "table", "labels.pii": "true"},
+			Flags:      &compassv1beta1.SearchFlags{DisableFuzzy: true},
+			WebhookUrl: " http://example.com/hook ",
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateSavedSearchRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.SavedSearchService)
+		PostCheck    func(resp *compassv1beta1.CreateSavedSearchResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if saved search is invalid",
+			Request:      &compassv1beta1.CreateSavedSearchRequest{Name: "empty"},
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, svc *mocks.SavedSearchService) {
+				svc.EXPECT().Create(ctx, mock.Anything).Return("", savedsearch.ErrEmptyQuery)
+			},
+		},
+		{
+			Description:  "should return internal server error if failed to create saved search",
+			Request:      validReq,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, svc *mocks.SavedSearchService) {
+				svc.EXPECT().Create(ctx, mock.Anything).Return("", errors.New("some error"))
+			},
+		},
+		{
+			Description:  "should return created saved search",
+			Request:      validReq,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.SavedSearchService) {
+				expected := &savedsearch.SavedSearch{
+					UserID:     userID,
+					Name:       "pii tables",
+					Text:       "customer",
+					Filters:    asset.SearchFilter{"type": {"table"}, "labels.pii": {"true"}},
+					Flags:      asset.SearchFlags{DisableFuzzy: true},
+					WebhookURL: "http://example.com/hook",
+				}
+				svc.EXPECT().Create(ctx, expected).Return("ss-1", nil)
+
+				created := *expected
+				created.ID = "ss-1"
+				created.CreatedAt = now
+				created.UpdatedAt = now
+				svc.EXPECT().GetByID(ctx, userID, "ss-1").Return(created, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateSavedSearchResponse) error {
+				expected := &compassv1beta1.CreateSavedSearchResponse{
+					Data: &compassv1beta1.SavedSearch{
+						Id:         "ss-1",
+						Name:       "pii tables",
+						Text:       "customer",
+						Filter:     map[string]string{"type": "table", "labels.pii": "true"},
+						Flags:      &compassv1beta1.SearchFlags{DisableFuzzy: true},
+						WebhookUrl: "http://example.com/hook",
+						CreatedAt:  timestamppb.New(now),
+						UpdatedAt:  timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockSavedSearchSvc := mocks.NewSavedSearchService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSavedSearchSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{SavedSearchSvc: mockSavedSearchSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreateSavedSearch(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+	)
+	type testCase struct {
+		Description  string
+		ExpectStatus codes.Code
+		Err          error
+	}
+
+	testCases := []testCase{
+		{Description: "should return invalid argument if id is invalid", ExpectStatus: codes.InvalidArgument, Err: savedsearch.InvalidError{ID: "ss-1"}},
+		{Description: "should return not found if saved search does not exist", ExpectStatus: codes.NotFound, Err: savedsearch.NotFoundError{ID: "ss-1"}},
+		{Description: "should return internal server error if failed to delete", ExpectStatus: codes.Internal, Err: errors.New("some error")},
+		{Description: "should delete saved search", ExpectStatus: codes.OK},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockSavedSearchSvc := mocks.NewSavedSearchService(t)
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockSavedSearchSvc.EXPECT().Delete(ctx, userID, "ss-1").Return(tc.Err)
+
+			handler := NewAPIServer(APIServerDeps{SavedSearchSvc: mockSavedSearchSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.DeleteSavedSearch(ctx, &compassv1beta1.DeleteSavedSearchRequest{Id: "ss-1"})
+			if code := status.Code(err); code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestGetSavedSearchMatches(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		matchedAt = time.Now().UTC()
+	)
+	type testCase struct {
+		Description  string
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.SavedSearchService)
+		PostCheck    func(resp *compassv1beta1.GetSavedSearchMatchesResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return not found if saved search belongs to another user",
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, svc *mocks.SavedSearchService) {
+				svc.EXPECT().GetMatches(ctx, userID, "ss-1", savedsearch.Filter{Size: 10, Offset: 5}).Return(nil, savedsearch.NotFoundError{ID: "ss-1"})
+			},
+		},
+		{
+			Description:  "should return matches",
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.SavedSearchService) {
+				svc.EXPECT().GetMatches(ctx, userID, "ss-1", savedsearch.Filter{Size: 10, Offset: 5}).Return([]savedsearch.Match{
+					{Asset: asset.Asset{ID: "1", URN: "asset-urn-1", Type: "table"}, MatchedAt: matchedAt},
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetSavedSearchMatchesResponse) error {
+				expected := &compassv1beta1.GetSavedSearchMatchesResponse{
+					Data: []*compassv1beta1.SavedSearchMatch{
+						{
+							Asset:     &compassv1beta1.Asset{Id: "1", Urn: "asset-urn-1", Type: "table"},
+							MatchedAt: timestamppb.New(matchedAt),
+						},
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockSavedSearchSvc := mocks.NewSavedSearchService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSavedSearchSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{SavedSearchSvc: mockSavedSearchSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.GetSavedSearchMatches(ctx, &compassv1beta1.GetSavedSearchMatchesRequest{Id: "ss-1", Size: 10, Offset: 5})
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
@@ -17,6 +17,11 @@ func (server *APIServer) SearchAssets(ctx context.Context, req *compassv1beta1.S
 		return nil, err
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	text := strings.TrimSpace(req.GetText())
 	rankBy := strings.TrimSpace(req.GetRankby())
 
@@ -29,6 +34,7 @@ func (server *APIServer) SearchAssets(ctx context.Context, req *compassv1beta1.S
 		Flags:         getSearchFlagsFromFlags(req.GetFlags()),
 		Offset:        int(req.GetOffset()),
 		IncludeFields: req.GetIncludeFields(),
+		Viewer:        viewer,
 	}
 
 	results, err := server.assetService.SearchAssets(ctx, cfg)
@@ -37,16 +43,19 @@ func (server *APIServer) SearchAssets(ctx context.Context, req *compassv1beta1.S
 	}
 
 	assetsPB := []*compassv1beta1.Asset{}
+	detailsPB := []*compassv1beta1.SearchResultDetail{}
 	for _, sr := range results {
 		assetPB, err := assetToProto(sr.ToAsset(), false)
 		if err != nil {
 			return nil, internalServerError(server.logger, fmt.Sprintf("error converting assets to proto: %s", err.Error()))
 		}
 		assetsPB = append(assetsPB, assetPB)
+		detailsPB = append(detailsPB, searchResultDetailToProto(sr))
 	}
 
 	return &compassv1beta1.SearchAssetsResponse{
-		Data: assetsPB,
+		Data:    assetsPB,
+		Details: detailsPB,
 	}, nil
 }
 
@@ -60,11 +69,17 @@ func (server *APIServer) GroupAssets(ctx context.Context, req *compassv1beta1.Gr
 		return nil, status.Error(codes.InvalidArgument, "'group_by' must be specified")
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := asset.GroupConfig{
 		GroupBy:        req.GetGroupby(),
 		Filters:        filterConfigFromValues(req.GetFilter()),
 		IncludedFields: req.GetIncludeFields(),
 		Size:           int(req.GetSize()),
+		Viewer:         viewer,
 	}
 
 	results, err := server.assetService.GroupAssets(ctx, cfg)
@@ -112,8 +127,14 @@ func (server *APIServer) SuggestAssets(ctx context.Context, req *compassv1beta1.
 		return nil, status.Error(codes.InvalidArgument, "'text' must be specified")
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := asset.SearchConfig{
-		Text: text,
+		Text:   text,
+		Viewer: viewer,
 	}
 
 	suggestions, err := server.assetService.SuggestAssets(ctx, cfg)
@@ -142,5 +163,40 @@ func getSearchFlagsFromFlags(inputFlags *compassv1beta1.SearchFlags) asset.Searc
 		EnableHighlight: inputFlags.GetEnableHighlight(),
 		DisableFuzzy:    inputFlags.GetDisableFuzzy(),
 		IsColumnSearch:  inputFlags.GetIsColumnSearch(),
+		EnableSemantic:  inputFlags.GetEnableSemantic(),
+		EnableExplain:   inputFlags.GetEnableExplain(),
+	}
+}
+
+func searchResultDetailToProto(sr asset.SearchResult) *compassv1beta1.SearchResultDetail {
+	var highlightsPB map[string]*compassv1beta1.SearchHighlight
+	if len(sr.Highlights) > 0 {
+		highlightsPB = make(map[string]*compassv1beta1.SearchHighlight, len(sr.Highlights))
+		for field, fragments := range sr.Highlights {
+			highlightsPB[field] = &compassv1beta1.SearchHighlight{Fragments: fragments}
+		}
+	}
+
+	return &compassv1beta1.SearchResultDetail{
+		Id:             sr.ID,
+		Score:          sr.Score,
+		Highlights:     highlightsPB,
+		MatchedColumns: sr.MatchedColumns,
+		Explanation:    searchExplanationToProto(sr.Explanation),
+	}
+}
+
+func searchExplanationToProto(e *asset.SearchExplanation) *compassv1beta1.SearchExplanation {
+	if e == nil {
+		return nil
+	}
+
+	explanationPB := &compassv1beta1.SearchExplanation{
+		Value:       e.Value,
+		Description: e.Description,
+	}
+	for i := range e.Details {
+		explanationPB.Details = append(explanationPB.Details, searchExplanationToProto(&e.Details[i]))
 	}
+	return explanationPB
 }
@@ -64,6 +64,7 @@ func TestSearch(t *testing.T) {
 						"service":        {"kafka", "rabbitmq"},
 						"data.landscape": {"th"},
 					},
+					Viewer: &asset.Viewer{},
 				}
 
 				as.EXPECT().SearchAssets(ctx, cfg).Return([]asset.SearchResult{}, nil)
@@ -95,6 +96,7 @@ func TestSearch(t *testing.T) {
 						"data.columns.name": "timestamp",
 						"owners.email":      "john.doe@email.com",
 					},
+					Viewer: &asset.Viewer{},
 				}
 
 				as.EXPECT().SearchAssets(ctx, cfg).Return([]asset.SearchResult{}, nil)
@@ -112,6 +114,7 @@ func TestSearch(t *testing.T) {
 					Filters: make(map[string][]string),
 					Queries: map[string]string(nil),
 					Offset:  10,
+					Viewer:  &asset.Viewer{},
 				}
 
 				as.EXPECT().SearchAssets(ctx, cfg).Return([]asset.SearchResult{}, nil)
@@ -127,6 +130,7 @@ func TestSearch(t *testing.T) {
 					Text:    "test",
 					Filters: make(map[string][]string),
 					Queries: map[string]string(nil),
+					Viewer:  &asset.Viewer{},
 				}
 				response := []asset.SearchResult{
 					{
@@ -156,6 +160,9 @@ func TestSearch(t *testing.T) {
 							},
 						},
 					},
+					Details: []*compassv1beta1.SearchResultDetail{
+						{Id: "test-resource"},
+					},
 				}
 
 				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
@@ -164,6 +171,72 @@ func TestSearch(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			Description: "should return score, highlights and explanation of the matched documents",
+			Request: &compassv1beta1.SearchAssetsRequest{
+				Text: "test",
+				Flags: &compassv1beta1.SearchFlags{
+					EnableHighlight: true,
+					EnableExplain:   true,
+				},
+			},
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				cfg := asset.SearchConfig{
+					Text:    "test",
+					Filters: make(map[string][]string),
+					Queries: map[string]string(nil),
+					Flags: asset.SearchFlags{
+						EnableHighlight: true,
+						EnableExplain:   true,
+					},
+					Viewer: &asset.Viewer{},
+				}
+				response := []asset.SearchResult{
+					{
+						Type:    "table",
+						ID:      "test-resource",
+						Service: "test-service",
+						Score:   12.5,
+						Highlights: map[string][]string{
+							"data.columns.name": {"<em>test</em>_id"},
+						},
+						MatchedColumns: []string{"test_id"},
+						Explanation: &asset.SearchExplanation{
+							Value:       12.5,
+							Description: "sum of:",
+							Details: []asset.SearchExplanation{
+								{Value: 12.5, Description: "weight(data.columns.name:test)"},
+							},
+						},
+					},
+				}
+				as.EXPECT().SearchAssets(ctx, cfg).Return(response, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.SearchAssetsResponse) error {
+				expected := []*compassv1beta1.SearchResultDetail{
+					{
+						Id:    "test-resource",
+						Score: 12.5,
+						Highlights: map[string]*compassv1beta1.SearchHighlight{
+							"data.columns.name": {Fragments: []string{"<em>test</em>_id"}},
+						},
+						MatchedColumns: []string{"test_id"},
+						Explanation: &compassv1beta1.SearchExplanation{
+							Value:       12.5,
+							Description: "sum of:",
+							Details: []*compassv1beta1.SearchExplanation{
+								{Value: 12.5, Description: "weight(data.columns.name:test)"},
+							},
+						},
+					},
+				}
+
+				if diff := cmp.Diff(resp.Details, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected details to be %+v, was %+v", expected, resp.Details)
+				}
+				return nil
+			},
+		},
 		{
 			Description: "should return the requested number of assets",
 			Request: &compassv1beta1.SearchAssetsRequest{
@@ -176,6 +249,7 @@ func TestSearch(t *testing.T) {
 					MaxResults: 10,
 					Filters:    make(map[string][]string),
 					Queries:    map[string]string(nil),
+					Viewer:     &asset.Viewer{},
 				}
 
 				var results []asset.SearchResult
@@ -265,7 +339,8 @@ func TestSuggest(t *testing.T) {
 			},
 			Setup: func(ctx context.Context, as *mocks.AssetService) {
 				cfg := asset.SearchConfig{
-					Text: "test",
+					Text:   "test",
+					Viewer: &asset.Viewer{},
 				}
 				as.EXPECT().SuggestAssets(ctx, cfg).Return([]string{}, fmt.Errorf("service unavailable"))
 			},
@@ -278,7 +353,8 @@ func TestSuggest(t *testing.T) {
 			},
 			Setup: func(ctx context.Context, as *mocks.AssetService) {
 				cfg := asset.SearchConfig{
-					Text: "test",
+					Text:   "test",
+					Viewer: &asset.Viewer{},
 				}
 				response := []string{
 					"test",
@@ -385,6 +461,7 @@ func TestGroupAssets(t *testing.T) {
 						"service":        {"kafka", "rabbitmq"},
 						"data.landscape": {"th"},
 					},
+					Viewer: &asset.Viewer{},
 				}
 				as.EXPECT().GroupAssets(ctx, cfg).Return([]asset.GroupResult{}, nil)
 			},
@@ -409,6 +486,7 @@ func TestGroupAssets(t *testing.T) {
 						"data.landscape": {"th"},
 					},
 					IncludedFields: []string{"data.columns.name", "owners.email"},
+					Viewer:         &asset.Viewer{},
 				}
 				as.EXPECT().GroupAssets(ctx, cfg).Return([]asset.GroupResult{}, nil)
 			},
@@ -422,6 +500,7 @@ func TestGroupAssets(t *testing.T) {
 				cfg := asset.GroupConfig{
 					GroupBy: []string{"resource"},
 					Filters: make(map[string][]string),
+					Viewer:  &asset.Viewer{},
 				}
 				response := []asset.GroupResult{
 					{
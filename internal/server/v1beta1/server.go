@@ -24,6 +24,10 @@ type APIServer struct {
 	tagService         TagService
 	tagTemplateService TagTemplateService
 	userService        UserService
+	savedSearchService SavedSearchService
+	authzService       AuthzService
+	teamService        TeamService
+	auditService       AuditService
 	logger             log.Logger
 
 	assetUpdateCounter metric.Int64Counter
@@ -38,6 +42,10 @@ type APIServerDeps struct {
 	TagSvc         TagService
 	TagTemplateSvc TagTemplateService
 	UserSvc        UserService
+	SavedSearchSvc SavedSearchService
+	AuthzSvc       AuthzService
+	TeamSvc        TeamService
+	AuditSvc       AuditService
 	Logger         log.Logger
 }
 
@@ -55,6 +63,10 @@ func NewAPIServer(d APIServerDeps) *APIServer {
 		tagService:         d.TagSvc,
 		tagTemplateService: d.TagTemplateSvc,
 		userService:        d.UserSvc,
+		savedSearchService: d.SavedSearchSvc,
+		authzService:       d.AuthzSvc,
+		teamService:        d.TeamSvc,
+		auditService:       d.AuditSvc,
 		logger:             d.Logger,
 
 		assetUpdateCounter: assetUpdateCounter,
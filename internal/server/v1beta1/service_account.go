@@ -0,0 +1,209 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *APIServer) CreateServiceAccount(ctx context.Context, req *compassv1beta1.CreateServiceAccountRequest) (*compassv1beta1.CreateServiceAccountResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	sa := user.ServiceAccount{
+		Name:        req.GetName(),
+		Description: strings.TrimSpace(req.GetDescription()),
+		CreatedBy:   userID,
+	}
+	id, err := server.userService.CreateServiceAccount(ctx, &sa)
+	if err != nil {
+		if errors.Is(err, user.ErrInvalidServiceAccountName) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(user.ServiceAccountExistsError)) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	created, err := server.userService.GetServiceAccountByID(ctx, id)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateServiceAccountResponse{
+		Data: serviceAccountToProto(created),
+	}, nil
+}
+
+func (server *APIServer) GetAllServiceAccounts(ctx context.Context, _ *compassv1beta1.GetAllServiceAccountsRequest) (*compassv1beta1.GetAllServiceAccountsResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	accounts, err := server.userService.GetServiceAccounts(ctx)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	accountsPB := make([]*compassv1beta1.ServiceAccount, 0, len(accounts))
+	for _, sa := range accounts {
+		accountsPB = append(accountsPB, serviceAccountToProto(sa))
+	}
+
+	return &compassv1beta1.GetAllServiceAccountsResponse{
+		Data: accountsPB,
+	}, nil
+}
+
+func (server *APIServer) DeleteServiceAccount(ctx context.Context, req *compassv1beta1.DeleteServiceAccountRequest) (*compassv1beta1.DeleteServiceAccountResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.userService.DeleteServiceAccount(ctx, req.GetId()); err != nil {
+		if errors.As(err, new(user.ServiceAccountNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.DeleteServiceAccountResponse{}, nil
+}
+
+func (server *APIServer) CreateAPIToken(ctx context.Context, req *compassv1beta1.CreateAPITokenRequest) (*compassv1beta1.CreateAPITokenResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	t := user.APIToken{
+		ServiceAccountID: req.GetServiceAccountId(),
+		Name:             strings.TrimSpace(req.GetName()),
+		CreatedBy:        userID,
+	}
+	for _, scope := range req.GetScopes() {
+		t.Scopes = append(t.Scopes, user.TokenScope(scope))
+	}
+	if req.GetExpiresAt() != nil {
+		t.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	token, err := server.userService.CreateAPIToken(ctx, &t)
+	if err != nil {
+		if errors.Is(err, user.ErrEmptyTokenScopes) ||
+			errors.Is(err, user.ErrTokenExpiryInPast) ||
+			errors.As(err, new(user.InvalidTokenScopeError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(user.ServiceAccountNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateAPITokenResponse{
+		Data:  apiTokenToProto(t),
+		Token: token,
+	}, nil
+}
+
+func (server *APIServer) GetAllAPITokens(ctx context.Context, req *compassv1beta1.GetAllAPITokensRequest) (*compassv1beta1.GetAllAPITokensResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	tokens, err := server.userService.GetAPITokens(ctx, req.GetServiceAccountId())
+	if err != nil {
+		if errors.As(err, new(user.ServiceAccountNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	tokensPB := make([]*compassv1beta1.APIToken, 0, len(tokens))
+	for _, t := range tokens {
+		tokensPB = append(tokensPB, apiTokenToProto(t))
+	}
+
+	return &compassv1beta1.GetAllAPITokensResponse{
+		Data: tokensPB,
+	}, nil
+}
+
+func (server *APIServer) RevokeAPIToken(ctx context.Context, req *compassv1beta1.RevokeAPITokenRequest) (*compassv1beta1.RevokeAPITokenResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.userService.RevokeAPIToken(ctx, req.GetServiceAccountId(), req.GetId()); err != nil {
+		if errors.As(err, new(user.APITokenNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.RevokeAPITokenResponse{}, nil
+}
+
+func serviceAccountToProto(sa user.ServiceAccount) *compassv1beta1.ServiceAccount {
+	return &compassv1beta1.ServiceAccount{
+		Id:          sa.ID,
+		Name:        sa.Name,
+		Description: sa.Description,
+		Email:       sa.Email,
+		CreatedBy:   sa.CreatedBy,
+		CreatedAt:   timestamppb.New(sa.CreatedAt),
+		UpdatedAt:   timestamppb.New(sa.UpdatedAt),
+	}
+}
+
+func apiTokenToProto(t user.APIToken) *compassv1beta1.APIToken {
+	scopes := make([]string, 0, len(t.Scopes))
+	for _, scope := range t.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	var createdAtPB *timestamppb.Timestamp
+	if !t.CreatedAt.IsZero() {
+		createdAtPB = timestamppb.New(t.CreatedAt)
+	}
+
+	return &compassv1beta1.APIToken{
+		Id:               t.ID,
+		ServiceAccountId: t.ServiceAccountID,
+		Name:             t.Name,
+		Scopes:           scopes,
+		ExpiresAt:        timestamppb.New(t.ExpiresAt),
+		CreatedBy:        t.CreatedBy,
+		CreatedAt:        createdAtPB,
+	}
+}
@@ -0,0 +1,298 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateServiceAccount(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		saID      = uuid.NewString()
+		now       = time.Now().UTC()
+		validReq  = &compassv1beta1.CreateServiceAccountRequest{
+			Name:        "ingestion-bq",
+			Description: "BigQuery ingestion",
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateServiceAccountRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.UserService)
+		PostCheck    func(resp *compassv1beta1.CreateServiceAccountResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if name is invalid",
+			Request:      &compassv1beta1.CreateServiceAccountRequest{Name: "Ingestion BQ"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return already exists if name is taken",
+			Request:      validReq,
+			ExpectStatus: codes.AlreadyExists,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateServiceAccount(ctx, mock.Anything).Return("", user.ServiceAccountExistsError{Name: "ingestion-bq"})
+			},
+		},
+		{
+			Description:  "should return internal server error if failed to create service account",
+			Request:      validReq,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateServiceAccount(ctx, mock.Anything).Return("", errors.New("some error"))
+			},
+		},
+		{
+			Description:  "should return created service account",
+			Request:      validReq,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateServiceAccount(ctx, &user.ServiceAccount{
+					Name:        "ingestion-bq",
+					Description: "BigQuery ingestion",
+					CreatedBy:   userID,
+				}).Return(saID, nil)
+				svc.EXPECT().GetServiceAccountByID(ctx, saID).Return(user.ServiceAccount{
+					ID:          saID,
+					Name:        "ingestion-bq",
+					Description: "BigQuery ingestion",
+					Email:       "ingestion-bq@" + user.ServiceAccountEmailDomain,
+					CreatedBy:   userID,
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateServiceAccountResponse) error {
+				expected := &compassv1beta1.CreateServiceAccountResponse{
+					Data: &compassv1beta1.ServiceAccount{
+						Id:          saID,
+						Name:        "ingestion-bq",
+						Description: "BigQuery ingestion",
+						Email:       "ingestion-bq@" + user.ServiceAccountEmailDomain,
+						CreatedBy:   userID,
+						CreatedAt:   timestamppb.New(now),
+						UpdatedAt:   timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockUserSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreateServiceAccount(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateAPIToken(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		saID      = uuid.NewString()
+		expiresAt = time.Now().Add(24 * time.Hour).UTC()
+		validReq  = &compassv1beta1.CreateAPITokenRequest{
+			ServiceAccountId: saID,
+			Name:             "daily",
+			Scopes:           []string{"write"},
+			ExpiresAt:        timestamppb.New(expiresAt),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateAPITokenRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.UserService)
+		PostCheck    func(resp *compassv1beta1.CreateAPITokenResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if scope is unknown",
+			Request:      &compassv1beta1.CreateAPITokenRequest{ServiceAccountId: saID, Scopes: []string{"admin"}},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return invalid argument if there is no scope",
+			Request:      &compassv1beta1.CreateAPITokenRequest{ServiceAccountId: saID},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return invalid argument if expiry is in the past",
+			Request:      validReq,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateAPIToken(ctx, mock.Anything).Return("", user.ErrTokenExpiryInPast)
+			},
+		},
+		{
+			Description:  "should return not found if service account does not exist",
+			Request:      validReq,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateAPIToken(ctx, mock.Anything).Return("", user.ServiceAccountNotFoundError{ID: saID})
+			},
+		},
+		{
+			Description:  "should return created token along with its secret",
+			Request:      validReq,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().CreateAPIToken(ctx, &user.APIToken{
+					ServiceAccountID: saID,
+					Name:             "daily",
+					Scopes:           []user.TokenScope{user.TokenScopeWrite},
+					ExpiresAt:        expiresAt,
+					CreatedBy:        userID,
+				}).Run(func(_ context.Context, t *user.APIToken) {
+					t.ID = "token-1"
+				}).Return("compass_secret", nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateAPITokenResponse) error {
+				expected := &compassv1beta1.CreateAPITokenResponse{
+					Data: &compassv1beta1.APIToken{
+						Id:               "token-1",
+						ServiceAccountId: saID,
+						Name:             "daily",
+						Scopes:           []string{"write"},
+						ExpiresAt:        timestamppb.New(expiresAt),
+						CreatedBy:        userID,
+					},
+					Token: "compass_secret",
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockUserSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreateAPIToken(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestRevokeAPIToken(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		saID      = uuid.NewString()
+		tokenID   = uuid.NewString()
+	)
+	type testCase struct {
+		Description  string
+		ID           string
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.UserService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if id is not uuid",
+			ID:           "token-1",
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return not found if token does not exist",
+			ID:           tokenID,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().RevokeAPIToken(ctx, saID, tokenID).Return(user.APITokenNotFoundError{ID: tokenID})
+			},
+		},
+		{
+			Description:  "should revoke token",
+			ID:           tokenID,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.UserService) {
+				svc.EXPECT().RevokeAPIToken(ctx, saID, tokenID).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockUserSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.RevokeAPIToken(ctx, &compassv1beta1.RevokeAPITokenRequest{ServiceAccountId: saID, Id: tc.ID})
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
 	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,9 +17,10 @@ import (
 )
 
 var (
-	errEmptyAssetID     = errors.New("asset id is empty")
-	errNilTagService    = errors.New("tag service is nil")
-	errEmptyTemplateURN = errors.New("template urn is empty")
+	errEmptyAssetID      = errors.New("asset id is empty")
+	errNilTagService     = errors.New("tag service is nil")
+	errEmptyTemplateURN  = errors.New("template urn is empty")
+	errEmptyBulkTagJobID = errors.New("bulk tagging job id is empty")
 )
 
 type TagService interface {
@@ -26,8 +28,16 @@ type TagService interface {
 	CreateTag(ctx context.Context, tag *tag.Tag) error
 	GetTagsByAssetID(ctx context.Context, assetID string) ([]tag.Tag, error)
 	FindTagByAssetIDAndTemplateURN(ctx context.Context, assetID, templateURN string) (tag.Tag, error)
+	FindColumnTag(ctx context.Context, assetID, column, templateURN string) (tag.Tag, error)
 	DeleteTag(ctx context.Context, assetID, templateURN string) error
+	DeleteColumnTag(ctx context.Context, assetID, column, templateURN string) error
 	UpdateTag(ctx context.Context, tag *tag.Tag) error
+	PlanTemplateMigration(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error)
+	StartTemplateMigration(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error)
+	PlanBulkTag(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error)
+	StartBulkTag(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error)
+	GetBulkTagJob(ctx context.Context, id string) (tag.BulkTagJob, error)
+	GetTagHistory(ctx context.Context, assetID, templateURN string) ([]tag.History, error)
 }
 
 // GetTagByAssetAndTemplate handles get tag by asset requests
@@ -48,6 +58,10 @@ func (server *APIServer) GetTagByAssetAndTemplate(ctx context.Context, req *comp
 		return nil, status.Error(codes.InvalidArgument, errEmptyTemplateURN.Error())
 	}
 
+	if err := server.checkAssetVisible(ctx, req.GetAssetId()); err != nil {
+		return nil, err
+	}
+
 	tg, err := server.tagService.FindTagByAssetIDAndTemplateURN(ctx, req.GetAssetId(), req.GetTemplateUrn())
 	if err != nil {
 		if errors.As(err, new(tag.NotFoundError)) || errors.As(err, new(tag.TemplateNotFoundError)) {
@@ -224,6 +238,10 @@ func (server *APIServer) GetAllTagsByAsset(ctx context.Context, req *compassv1be
 		return nil, status.Error(codes.InvalidArgument, errEmptyAssetID.Error())
 	}
 
+	if err := server.checkAssetVisible(ctx, req.GetAssetId()); err != nil {
+		return nil, err
+	}
+
 	tags, err := server.tagService.GetTagsByAssetID(ctx, req.GetAssetId())
 	if err != nil {
 		return nil, internalServerError(server.logger, fmt.Sprintf("error getting asset tags: %s", err.Error()))
@@ -243,6 +261,188 @@ func (server *APIServer) GetAllTagsByAsset(ctx context.Context, req *compassv1be
 	}, nil
 }
 
+// GetTagHistory handles get history of tag by asset and template requests
+func (server *APIServer) GetTagHistory(ctx context.Context, req *compassv1beta1.GetTagHistoryRequest) (*compassv1beta1.GetTagHistoryResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if req.GetAssetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, errEmptyAssetID.Error())
+	}
+	if req.GetTemplateUrn() == "" {
+		return nil, status.Error(codes.InvalidArgument, errEmptyTemplateURN.Error())
+	}
+
+	if err := server.checkAssetVisible(ctx, req.GetAssetId()); err != nil {
+		return nil, err
+	}
+
+	history, err := server.tagService.GetTagHistory(ctx, req.GetAssetId(), req.GetTemplateUrn())
+	if err != nil {
+		return nil, internalServerError(server.logger, fmt.Sprintf("error getting tag history: %s", err.Error()))
+	}
+
+	historyPB := make([]*compassv1beta1.TagHistory, 0, len(history))
+	for _, h := range history {
+		historyPB = append(historyPB, tagHistoryToProto(h))
+	}
+
+	return &compassv1beta1.GetTagHistoryResponse{
+		Data: historyPB,
+	}, nil
+}
+
+// MigrateTagTemplate handles the migration of the tags written against an older version of the template
+func (server *APIServer) MigrateTagTemplate(ctx context.Context, req *compassv1beta1.MigrateTagTemplateRequest) (*compassv1beta1.MigrateTagTemplateResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if req.GetTemplateUrn() == "" {
+		return nil, status.Error(codes.InvalidArgument, errEmptyTemplateURN.Error())
+	}
+
+	migration := tag.TemplateMigration{TemplateURN: req.GetTemplateUrn()}
+	for _, mPB := range req.GetMappings() {
+		migration.Mappings = append(migration.Mappings, tag.FieldValueMapping{
+			FieldID: uint(mPB.GetFieldId()),
+			Values:  mPB.GetValues(),
+		})
+	}
+
+	migrate := server.tagService.StartTemplateMigration
+	if req.GetDryRun() {
+		migrate = server.tagService.PlanTemplateMigration
+	}
+	report, err := migrate(ctx, migration)
+	if err != nil {
+		if errors.As(err, new(tag.TemplateNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.As(err, new(tag.ValidationError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, fmt.Sprintf("error migrating tags of template: %s", err.Error()))
+	}
+
+	return &compassv1beta1.MigrateTagTemplateResponse{
+		Data: tagMigrationReportToProto(report),
+	}, nil
+}
+
+// BulkTagAssets handles the tagging of many assets with a template
+func (server *APIServer) BulkTagAssets(ctx context.Context, req *compassv1beta1.BulkTagAssetsRequest) (*compassv1beta1.BulkTagAssetsResponse, error) {
+	var tagValues []tag.TagValue
+	for _, tvPB := range req.GetTagValues() {
+		tagValues = append(tagValues, tagValueFromProto(tvPB))
+	}
+
+	report, err := server.bulkTag(ctx, tag.BulkTagRequest{
+		TemplateURN: req.GetTemplateUrn(),
+		Assets:      req.GetAssets(),
+		QueryExpr:   req.GetQueryExpr(),
+		TagValues:   tagValues,
+	}, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	return &compassv1beta1.BulkTagAssetsResponse{
+		Data: bulkTagReportToProto(report),
+	}, nil
+}
+
+// BulkUntagAssets handles the removal of the tags of a template from many assets
+func (server *APIServer) BulkUntagAssets(ctx context.Context, req *compassv1beta1.BulkUntagAssetsRequest) (*compassv1beta1.BulkUntagAssetsResponse, error) {
+	report, err := server.bulkTag(ctx, tag.BulkTagRequest{
+		TemplateURN: req.GetTemplateUrn(),
+		Assets:      req.GetAssets(),
+		QueryExpr:   req.GetQueryExpr(),
+		Remove:      true,
+	}, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	return &compassv1beta1.BulkUntagAssetsResponse{
+		Data: bulkTagReportToProto(report),
+	}, nil
+}
+
+// GetBulkTagJob handles the requests of the outcome of a bulk tagging, only
+// the user starting it is told it exists
+func (server *APIServer) GetBulkTagJob(ctx context.Context, req *compassv1beta1.GetBulkTagJobRequest) (*compassv1beta1.GetBulkTagJobResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if server.tagService == nil {
+		return nil, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, errEmptyBulkTagJobID.Error())
+	}
+
+	job, err := server.tagService.GetBulkTagJob(ctx, req.GetId())
+	if err != nil {
+		if errors.As(err, new(tag.BulkTagJobNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, fmt.Sprintf("error getting bulk tagging job: %s", err.Error()))
+	}
+	if job.CreatedBy != "" && job.CreatedBy != user.FromContext(ctx).Email {
+		return nil, status.Error(codes.NotFound, tag.BulkTagJobNotFoundError{ID: req.GetId()}.Error())
+	}
+
+	return &compassv1beta1.GetBulkTagJobResponse{
+		Data: bulkTagJobToProto(job),
+	}, nil
+}
+
+func (server *APIServer) bulkTag(ctx context.Context, req tag.BulkTagRequest, dryRun bool) (tag.BulkTagReport, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return tag.BulkTagReport{}, err
+	}
+
+	if server.tagService == nil {
+		return tag.BulkTagReport{}, internalServerError(server.logger, errNilTagService.Error())
+	}
+
+	if req.TemplateURN == "" {
+		return tag.BulkTagReport{}, status.Error(codes.InvalidArgument, errEmptyTemplateURN.Error())
+	}
+
+	bulkTag := server.tagService.StartBulkTag
+	if dryRun {
+		bulkTag = server.tagService.PlanBulkTag
+	}
+	report, err := bulkTag(ctx, req)
+	if err != nil {
+		if errors.As(err, new(tag.TemplateNotFoundError)) {
+			return tag.BulkTagReport{}, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.As(err, new(tag.ValidationError)) {
+			return tag.BulkTagReport{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return tag.BulkTagReport{}, internalServerError(server.logger, fmt.Sprintf("error bulk tagging assets: %s", err.Error()))
+	}
+	return report, nil
+}
+
 // tagToProto convert domain to protobuf
 func tagToProto(t tag.Tag) (*compassv1beta1.Tag, error) {
 	var tagValuesPB []*compassv1beta1.TagValue
@@ -260,6 +460,9 @@ func tagToProto(t tag.Tag) (*compassv1beta1.Tag, error) {
 		TagValues:           tagValuesPB,
 		TemplateDisplayName: t.TemplateDisplayName,
 		TemplateDescription: t.TemplateDescription,
+		TemplateVersion:     uint32(t.TemplateVersion),
+		InheritedFrom:       t.InheritedFrom,
+		Column:              t.Column,
 	}, nil
 }
 
@@ -279,6 +482,9 @@ func tagFromProto(pb *compassv1beta1.Tag) tag.Tag {
 		TagValues:           tagValues,
 		TemplateDisplayName: pb.GetTemplateDisplayName(),
 		TemplateDescription: pb.GetTemplateDescription(),
+		TemplateVersion:     uint(pb.GetTemplateVersion()),
+		InheritedFrom:       pb.GetInheritedFrom(),
+		Column:              pb.GetColumn(),
 	}
 }
 
@@ -347,3 +553,69 @@ func tagValueFromProto(pb *compassv1beta1.TagValue) tag.TagValue {
 		UpdatedAt:        updatedAt,
 	}
 }
+
+// tagMigrationReportToProto converts domain to protobuf
+func tagMigrationReportToProto(r tag.MigrationReport) *compassv1beta1.TagMigrationReport {
+	var failuresPB []*compassv1beta1.TagMigrationFailure
+	for _, f := range r.Failures {
+		failuresPB = append(failuresPB, &compassv1beta1.TagMigrationFailure{
+			AssetId: f.AssetID,
+			Reason:  f.Reason,
+		})
+	}
+
+	return &compassv1beta1.TagMigrationReport{
+		TemplateUrn:     r.TemplateURN,
+		TemplateVersion: uint32(r.TemplateVersion),
+		Tags:            uint32(r.Tags),
+		Migrated:        uint32(len(r.MigratedAssetIDs)),
+		Failures:        failuresPB,
+	}
+}
+
+// bulkTagReportToProto converts domain to protobuf
+func bulkTagReportToProto(r tag.BulkTagReport) *compassv1beta1.BulkTagReport {
+	var failuresPB []*compassv1beta1.BulkTagFailure
+	for _, f := range r.Failures {
+		failuresPB = append(failuresPB, &compassv1beta1.BulkTagFailure{
+			Asset:  f.Asset,
+			Reason: f.Reason,
+		})
+	}
+
+	return &compassv1beta1.BulkTagReport{
+		TemplateUrn:       r.TemplateURN,
+		Assets:            uint32(r.Assets),
+		SucceededAssetIds: r.SucceededAssetIDs,
+		Failures:          failuresPB,
+		JobId:             r.JobID,
+	}
+}
+
+func bulkTagJobToProto(j tag.BulkTagJob) *compassv1beta1.BulkTagJob {
+	return &compassv1beta1.BulkTagJob{
+		Id:          j.ID,
+		TemplateUrn: j.TemplateURN,
+		Remove:      j.Remove,
+		Status:      string(j.Status),
+		Report:      bulkTagReportToProto(j.Report),
+		Error:       j.Error,
+		CreatedBy:   j.CreatedBy,
+		CreatedAt:   timestamppb.New(j.CreatedAt),
+		UpdatedAt:   timestamppb.New(j.UpdatedAt),
+	}
+}
+
+// tagHistoryToProto converts domain to protobuf
+func tagHistoryToProto(h tag.History) *compassv1beta1.TagHistory {
+	return &compassv1beta1.TagHistory{
+		AssetId:     h.AssetID,
+		Column:      h.Column,
+		TemplateUrn: h.TemplateURN,
+		Action:      string(h.Action),
+		Actor:       h.Actor,
+		Before:      h.Before,
+		After:       h.After,
+		CreatedAt:   timestamppb.New(h.CreatedAt),
+	}
+}
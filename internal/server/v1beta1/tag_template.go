@@ -75,6 +75,7 @@ func (server *APIServer) CreateTagTemplate(ctx context.Context, req *compassv1be
 		DisplayName: req.GetDisplayName(),
 		Description: req.GetDescription(),
 		Fields:      templateFields,
+		Propagation: tagPropagationRuleFromProto(req.GetPropagation()),
 	}
 	err = server.tagTemplateService.CreateTemplate(ctx, &template)
 	if errors.As(err, new(tag.DuplicateTemplateError)) {
@@ -135,6 +136,7 @@ func (server *APIServer) UpdateTagTemplate(ctx context.Context, req *compassv1be
 		DisplayName: req.GetDisplayName(),
 		Description: req.GetDescription(),
 		Fields:      templateFields,
+		Propagation: tagPropagationRuleFromProto(req.GetPropagation()),
 	}
 	if err = server.tagTemplateService.UpdateTemplate(ctx, req.TemplateUrn, &template); err != nil {
 		if errors.As(err, new(tag.TemplateNotFoundError)) {
@@ -190,6 +192,8 @@ func tagTemplateToProto(t tag.Template) *compassv1beta1.TagTemplate {
 		DisplayName: t.DisplayName,
 		Description: t.Description,
 		Fields:      templateFieldsPB,
+		Version:     uint32(t.Version),
+		Propagation: tagPropagationRuleToProto(t.Propagation),
 		CreatedAt:   createdAtPB,
 		UpdatedAt:   updatedAtPB,
 	}
@@ -219,11 +223,37 @@ func tagTemplateFromProto(pb *compassv1beta1.TagTemplate) tag.Template {
 		DisplayName: pb.GetDisplayName(),
 		Description: pb.GetDescription(),
 		Fields:      fields,
+		Version:     uint(pb.GetVersion()),
+		Propagation: tagPropagationRuleFromProto(pb.GetPropagation()),
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
 }
 
+// tagPropagationRuleToProto convert domain to protobuf
+func tagPropagationRuleToProto(r *tag.PropagationRule) *compassv1beta1.TagPropagationRule {
+	if r == nil {
+		return nil
+	}
+
+	return &compassv1beta1.TagPropagationRule{
+		Depth:      uint32(r.Depth),
+		Conditions: r.Conditions,
+	}
+}
+
+// tagPropagationRuleFromProto converts proto to tag.PropagationRule
+func tagPropagationRuleFromProto(pb *compassv1beta1.TagPropagationRule) *tag.PropagationRule {
+	if pb == nil {
+		return nil
+	}
+
+	return &tag.PropagationRule{
+		Depth:      int(pb.GetDepth()),
+		Conditions: pb.GetConditions(),
+	}
+}
+
 // tagTemplateFieldToProto convert domain to protobuf
 func tagTemplateFieldToProto(f tag.Field) *compassv1beta1.TagTemplateField {
 	var createdAtPB *timestamppb.Timestamp
@@ -244,6 +274,10 @@ func tagTemplateFieldToProto(f tag.Field) *compassv1beta1.TagTemplateField {
 		DataType:    f.DataType,
 		Options:     f.Options,
 		Required:    f.Required,
+		Multiple:    f.Multiple,
+		Min:         f.Min,
+		Max:         f.Max,
+		Pattern:     f.Pattern,
 		CreatedAt:   createdAtPB,
 		UpdatedAt:   updatedAtPB,
 	}
@@ -269,6 +303,10 @@ func tagTemplateFieldFromProto(pb *compassv1beta1.TagTemplateField) tag.Field {
 		DataType:    pb.GetDataType(),
 		Options:     pb.GetOptions(),
 		Required:    pb.GetRequired(),
+		Multiple:    pb.GetMultiple(),
+		Min:         pb.Min,
+		Max:         pb.Max,
+		Pattern:     pb.GetPattern(),
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/tag"
 	"github.com/goto/compass/core/user"
 	"github.com/goto/compass/internal/server/v1beta1/mocks"
@@ -90,7 +91,7 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 		Description  string
 		Request      *compassv1beta1.GetTagByAssetAndTemplateRequest
 		ExpectStatus codes.Code
-		Setup        func(context.Context, *mocks.TagService, *mocks.TagTemplateService)
+		Setup        func(context.Context, *mocks.AssetService, *mocks.TagService, *mocks.TagTemplateService)
 		PostCheck    func(resp *compassv1beta1.GetTagByAssetAndTemplateResponse) error
 	}
 
@@ -111,6 +112,20 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 			},
 			ExpectStatus: codes.InvalidArgument,
 		},
+		{
+			Description: `should return not found if asset is restricted to groups of others`,
+			Request: &compassv1beta1.GetTagByAssetAndTemplateRequest{
+				AssetId:     assetID,
+				TemplateUrn: sampleTemplate.URN,
+			},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.TagService, _ *mocks.TagTemplateService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description: `should return not found if template does not exist`,
 			Request: &compassv1beta1.GetTagByAssetAndTemplateRequest{
@@ -118,7 +133,7 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 				TemplateUrn: sampleTemplate.URN,
 			},
 			ExpectStatus: codes.NotFound,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, tts *mocks.TagTemplateService) {
 				ts.EXPECT().FindTagByAssetIDAndTemplateURN(ctx, assetID, sampleTemplate.URN).Return(tag.Tag{}, tag.TemplateNotFoundError{URN: sampleTemplate.URN})
 			},
 		},
@@ -129,7 +144,7 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 				TemplateUrn: sampleTemplate.URN,
 			},
 			ExpectStatus: codes.NotFound,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, tts *mocks.TagTemplateService) {
 				ts.EXPECT().FindTagByAssetIDAndTemplateURN(ctx, assetID, sampleTemplate.URN).Return(tag.Tag{}, tag.NotFoundError{
 					AssetID:  assetID,
 					Template: sampleTemplate.URN,
@@ -143,7 +158,7 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 				TemplateUrn: sampleTemplate.URN,
 			},
 			ExpectStatus: codes.Internal,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, tts *mocks.TagTemplateService) {
 				ts.EXPECT().FindTagByAssetIDAndTemplateURN(ctx, assetID, sampleTemplate.URN).Return(tag.Tag{}, errors.New("unexpected error"))
 			},
 		},
@@ -154,7 +169,7 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 				TemplateUrn: sampleTemplate.URN,
 			},
 			ExpectStatus: codes.OK,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, tts *mocks.TagTemplateService) {
 				ts.EXPECT().FindTagByAssetIDAndTemplateURN(ctx, assetID, sampleTemplate.URN).Return(sampleTag, nil)
 			},
 			PostCheck: func(resp *compassv1beta1.GetTagByAssetAndTemplateResponse) error {
@@ -189,18 +204,22 @@ func TestGetTagByAssetAndTemplate(t *testing.T) {
 			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
 			logger := log.NewNoop()
 			mockUserSvc := new(mocks.UserService)
+			mockAssetSvc := new(mocks.AssetService)
 			mockTagSvc := new(mocks.TagService)
 			mockTemplateSvc := new(mocks.TagTemplateService)
 			if tc.Setup != nil {
-				tc.Setup(ctx, mockTagSvc, mockTemplateSvc)
+				tc.Setup(ctx, mockAssetSvc, mockTagSvc, mockTemplateSvc)
 			}
 			defer mockUserSvc.AssertExpectations(t)
+			defer mockAssetSvc.AssertExpectations(t)
 			defer mockTagSvc.AssertExpectations(t)
 			defer mockTemplateSvc.AssertExpectations(t)
 
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
 
 			handler := NewAPIServer(APIServerDeps{
+				AssetSvc:       mockAssetSvc,
 				TagSvc:         mockTagSvc,
 				TagTemplateSvc: mockTemplateSvc,
 				UserSvc:        mockUserSvc,
@@ -597,7 +616,7 @@ func TestGetAllTagsByAsset(t *testing.T) {
 		Description  string
 		Request      *compassv1beta1.GetAllTagsByAssetRequest
 		ExpectStatus codes.Code
-		Setup        func(context.Context, *mocks.TagService, *mocks.TagTemplateService)
+		Setup        func(context.Context, *mocks.AssetService, *mocks.TagService, *mocks.TagTemplateService)
 		PostCheck    func(resp *compassv1beta1.GetAllTagsByAssetResponse) error
 	}
 
@@ -609,11 +628,22 @@ func TestGetAllTagsByAsset(t *testing.T) {
 			},
 			ExpectStatus: codes.InvalidArgument,
 		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.TagService, _ *mocks.TagTemplateService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description:  `should return internal server error if found unexpected error`,
 			Request:      validRequest,
 			ExpectStatus: codes.Internal,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, _ *mocks.TagTemplateService) {
 				ts.EXPECT().GetTagsByAssetID(ctx, sampleTagPB.AssetId).Return(nil, errors.New("unexpected error"))
 			},
 		},
@@ -621,7 +651,7 @@ func TestGetAllTagsByAsset(t *testing.T) {
 			Description:  `should return ok and tags for the specified asset`,
 			Request:      validRequest,
 			ExpectStatus: codes.OK,
-			Setup: func(ctx context.Context, ts *mocks.TagService, tts *mocks.TagTemplateService) {
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService, _ *mocks.TagTemplateService) {
 				ts.EXPECT().GetTagsByAssetID(ctx, sampleTagPB.AssetId).Return([]tag.Tag{sampleTag}, nil)
 			},
 			PostCheck: func(resp *compassv1beta1.GetAllTagsByAssetResponse) error {
@@ -641,18 +671,22 @@ func TestGetAllTagsByAsset(t *testing.T) {
 			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
 			logger := log.NewNoop()
 			mockUserSvc := new(mocks.UserService)
+			mockAssetSvc := new(mocks.AssetService)
 			mockTagSvc := new(mocks.TagService)
 			mockTemplateSvc := new(mocks.TagTemplateService)
 			if tc.Setup != nil {
-				tc.Setup(ctx, mockTagSvc, mockTemplateSvc)
+				tc.Setup(ctx, mockAssetSvc, mockTagSvc, mockTemplateSvc)
 			}
 			defer mockUserSvc.AssertExpectations(t)
+			defer mockAssetSvc.AssertExpectations(t)
 			defer mockTagSvc.AssertExpectations(t)
 			defer mockTemplateSvc.AssertExpectations(t)
 
 			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
 
 			handler := NewAPIServer(APIServerDeps{
+				AssetSvc:       mockAssetSvc,
 				TagSvc:         mockTagSvc,
 				TagTemplateSvc: mockTemplateSvc,
 				UserSvc:        mockUserSvc,
@@ -675,6 +709,612 @@ func TestGetAllTagsByAsset(t *testing.T) {
 	}
 }
 
+func TestGetTagHistory(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		createdAt    = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+		validRequest = &compassv1beta1.GetTagHistoryRequest{
+			AssetId:     assetID,
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.GetTagHistoryRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.GetTagHistoryResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if asset id is empty`,
+			Request:      &compassv1beta1.GetTagHistoryRequest{TemplateUrn: sampleTagPB.GetTemplateUrn()},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if template urn is empty`,
+			Request:      &compassv1beta1.GetTagHistoryRequest{AssetId: assetID},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if asset is restricted to groups of others`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService, _ *mocks.TagService) {
+				as.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
+		{
+			Description:  `should return internal server error if found unexpected error`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService) {
+				ts.EXPECT().GetTagHistory(ctx, assetID, sampleTagPB.GetTemplateUrn()).Return(nil, errors.New("unexpected error"))
+			},
+		},
+		{
+			Description:  `should return ok and the history of the tag`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, _ *mocks.AssetService, ts *mocks.TagService) {
+				ts.EXPECT().GetTagHistory(ctx, assetID, sampleTagPB.GetTemplateUrn()).Return([]tag.History{
+					{
+						AssetID:     assetID,
+						TemplateURN: sampleTagPB.GetTemplateUrn(),
+						Action:      tag.HistoryActionUpdate,
+						Actor:       userEmail,
+						Before:      map[string]string{"classification": "Public"},
+						After:       map[string]string{"classification": "Restricted"},
+						CreatedAt:   createdAt,
+					},
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetTagHistoryResponse) error {
+				expected := &compassv1beta1.GetTagHistoryResponse{
+					Data: []*compassv1beta1.TagHistory{
+						{
+							AssetId:     assetID,
+							TemplateUrn: sampleTagPB.GetTemplateUrn(),
+							Action:      "update",
+							Actor:       userEmail,
+							Before:      map[string]string{"classification": "Public"},
+							After:       map[string]string{"classification": "Restricted"},
+							CreatedAt:   timestamppb.New(createdAt),
+						},
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			logger := log.NewNoop()
+			mockUserSvc := new(mocks.UserService)
+			mockAssetSvc := new(mocks.AssetService)
+			mockTagSvc := new(mocks.TagService)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockAssetSvc, mockTagSvc)
+			}
+			defer mockUserSvc.AssertExpectations(t)
+			defer mockAssetSvc.AssertExpectations(t)
+			defer mockTagSvc.AssertExpectations(t)
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+			mockAssetSvc.EXPECT().GetAssetByID(ctx, assetID).Return(asset.Asset{ID: assetID}, nil).Maybe()
+
+			handler := NewAPIServer(APIServerDeps{
+				AssetSvc: mockAssetSvc,
+				TagSvc:   mockTagSvc,
+				UserSvc:  mockUserSvc,
+				Logger:   logger,
+			})
+
+			got, err := handler.GetTagHistory(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestMigrateTagTemplate(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		migration = tag.TemplateMigration{
+			TemplateURN: sampleTagPB.GetTemplateUrn(),
+			Mappings: []tag.FieldValueMapping{
+				{FieldID: 1, Values: map[string]string{"Restricted": "Internal"}},
+			},
+		}
+		report = tag.MigrationReport{
+			TemplateURN:     sampleTagPB.GetTemplateUrn(),
+			TemplateVersion: 2,
+			Tags:            2,
+			Failures:        []tag.MigrationFailure{{AssetID: assetID, Reason: "invalid value"}},
+		}
+		validRequest = &compassv1beta1.MigrateTagTemplateRequest{
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			Mappings: []*compassv1beta1.TagValueMapping{
+				{FieldId: 1, Values: map[string]string{"Restricted": "Internal"}},
+			},
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.MigrateTagTemplateRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.MigrateTagTemplateResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if template urn is empty`,
+			Request:      &compassv1beta1.MigrateTagTemplateRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if template does not exist`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartTemplateMigration(ctx, migration).Return(tag.MigrationReport{}, tag.TemplateNotFoundError{})
+			},
+		},
+		{
+			Description:  `should return invalid argument if mapping is not valid`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartTemplateMigration(ctx, migration).Return(tag.MigrationReport{}, tag.ValidationError{Err: errors.New("invalid")})
+			},
+		},
+		{
+			Description:  `should return internal server error found unexpected error`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartTemplateMigration(ctx, migration).Return(tag.MigrationReport{}, errors.New("unexpected error"))
+			},
+		},
+		{
+			Description: `should only plan the migration on dry run`,
+			Request: &compassv1beta1.MigrateTagTemplateRequest{
+				TemplateUrn: validRequest.GetTemplateUrn(),
+				Mappings:    validRequest.GetMappings(),
+				DryRun:      true,
+			},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().PlanTemplateMigration(ctx, migration).Return(report, nil)
+			},
+		},
+		{
+			Description:  `should return the report of the migration if started`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartTemplateMigration(ctx, migration).Return(report, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.MigrateTagTemplateResponse) error {
+				expected := &compassv1beta1.MigrateTagTemplateResponse{
+					Data: &compassv1beta1.TagMigrationReport{
+						TemplateUrn:     sampleTagPB.GetTemplateUrn(),
+						TemplateVersion: 2,
+						Tags:            2,
+						Failures: []*compassv1beta1.TagMigrationFailure{
+							{AssetId: assetID, Reason: "invalid value"},
+						},
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			logger := log.NewNoop()
+			mockUserSvc := new(mocks.UserService)
+			mockTagSvc := new(mocks.TagService)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			defer mockUserSvc.AssertExpectations(t)
+			defer mockTagSvc.AssertExpectations(t)
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  logger,
+			})
+
+			got, err := handler.MigrateTagTemplate(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestBulkTagAssets(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		bulkReq   = tag.BulkTagRequest{
+			TemplateURN: sampleTagPB.GetTemplateUrn(),
+			QueryExpr:   `service == "bigquery"`,
+			TagValues:   []tag.TagValue{{FieldID: 1, FieldValue: "Public"}},
+		}
+		report = tag.BulkTagReport{
+			TemplateURN:       sampleTagPB.GetTemplateUrn(),
+			Assets:            2,
+			SucceededAssetIDs: []string{assetID},
+			Failures:          []tag.BulkTagFailure{{Asset: "some-urn", Reason: "asset not found"}},
+			JobID:             "job-id",
+		}
+		validRequest = &compassv1beta1.BulkTagAssetsRequest{
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			QueryExpr:   `service == "bigquery"`,
+			TagValues:   []*compassv1beta1.TagValue{{FieldId: 1, FieldValue: structpb.NewStringValue("Public")}},
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.BulkTagAssetsRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.BulkTagAssetsResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if template urn is empty`,
+			Request:      &compassv1beta1.BulkTagAssetsRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if template does not exist`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, tag.TemplateNotFoundError{})
+			},
+		},
+		{
+			Description:  `should return invalid argument if query expression is not valid`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, tag.ValidationError{Err: errors.New("invalid")})
+			},
+		},
+		{
+			Description:  `should return internal server error found unexpected error`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, errors.New("unexpected error"))
+			},
+		},
+		{
+			Description: `should only plan the bulk tagging on dry run`,
+			Request: &compassv1beta1.BulkTagAssetsRequest{
+				TemplateUrn: validRequest.GetTemplateUrn(),
+				QueryExpr:   validRequest.GetQueryExpr(),
+				TagValues:   validRequest.GetTagValues(),
+				DryRun:      true,
+			},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().PlanBulkTag(ctx, bulkReq).Return(report, nil)
+			},
+		},
+		{
+			Description:  `should return the report of the bulk tagging if started`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(report, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.BulkTagAssetsResponse) error {
+				expected := &compassv1beta1.BulkTagAssetsResponse{
+					Data: &compassv1beta1.BulkTagReport{
+						TemplateUrn:       sampleTagPB.GetTemplateUrn(),
+						Assets:            2,
+						SucceededAssetIds: []string{assetID},
+						Failures: []*compassv1beta1.BulkTagFailure{
+							{Asset: "some-urn", Reason: "asset not found"},
+						},
+						JobId: "job-id",
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			logger := log.NewNoop()
+			mockUserSvc := new(mocks.UserService)
+			mockTagSvc := new(mocks.TagService)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			defer mockUserSvc.AssertExpectations(t)
+			defer mockTagSvc.AssertExpectations(t)
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  logger,
+			})
+
+			got, err := handler.BulkTagAssets(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestBulkUntagAssets(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		bulkReq   = tag.BulkTagRequest{
+			TemplateURN: sampleTagPB.GetTemplateUrn(),
+			Assets:      []string{assetID},
+			Remove:      true,
+		}
+		validRequest = &compassv1beta1.BulkUntagAssetsRequest{
+			TemplateUrn: sampleTagPB.GetTemplateUrn(),
+			Assets:      []string{assetID},
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.BulkUntagAssetsRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if template urn is empty`,
+			Request:      &compassv1beta1.BulkUntagAssetsRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if assets are not valid`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, tag.ValidationError{Err: errors.New("invalid")})
+			},
+		},
+		{
+			Description: `should only plan the bulk untagging on dry run`,
+			Request: &compassv1beta1.BulkUntagAssetsRequest{
+				TemplateUrn: validRequest.GetTemplateUrn(),
+				Assets:      validRequest.GetAssets(),
+				DryRun:      true,
+			},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().PlanBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, nil)
+			},
+		},
+		{
+			Description:  `should remove the tags of the template if started`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().StartBulkTag(ctx, bulkReq).Return(tag.BulkTagReport{}, nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			logger := log.NewNoop()
+			mockUserSvc := new(mocks.UserService)
+			mockTagSvc := new(mocks.TagService)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			defer mockUserSvc.AssertExpectations(t)
+			defer mockTagSvc.AssertExpectations(t)
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  logger,
+			})
+
+			_, err := handler.BulkUntagAssets(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestGetBulkTagJob(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		jobID     = uuid.NewString()
+		createdAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		job       = tag.BulkTagJob{
+			ID:          jobID,
+			TemplateURN: sampleTagPB.GetTemplateUrn(),
+			Status:      tag.BulkTagJobDone,
+			Report: tag.BulkTagReport{
+				TemplateURN:       sampleTagPB.GetTemplateUrn(),
+				Assets:            1,
+				SucceededAssetIDs: []string{assetID},
+			},
+			CreatedBy: userEmail,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.GetBulkTagJobRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TagService)
+		PostCheck    func(resp *compassv1beta1.GetBulkTagJobResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if id is empty`,
+			Request:      &compassv1beta1.GetBulkTagJobRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if the job does not exist`,
+			Request:      &compassv1beta1.GetBulkTagJobRequest{Id: jobID},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().GetBulkTagJob(ctx, jobID).Return(tag.BulkTagJob{}, tag.BulkTagJobNotFoundError{ID: jobID})
+			},
+		},
+		{
+			Description:  `should return not found if the job was started by another user`,
+			Request:      &compassv1beta1.GetBulkTagJobRequest{Id: jobID},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				other := job
+				other.CreatedBy = "other@test.com"
+				ts.EXPECT().GetBulkTagJob(ctx, jobID).Return(other, nil)
+			},
+		},
+		{
+			Description:  `should return internal server error found unexpected error`,
+			Request:      &compassv1beta1.GetBulkTagJobRequest{Id: jobID},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().GetBulkTagJob(ctx, jobID).Return(tag.BulkTagJob{}, errors.New("unexpected error"))
+			},
+		},
+		{
+			Description:  `should return the job along with its report`,
+			Request:      &compassv1beta1.GetBulkTagJobRequest{Id: jobID},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TagService) {
+				ts.EXPECT().GetBulkTagJob(ctx, jobID).Return(job, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetBulkTagJobResponse) error {
+				expected := &compassv1beta1.GetBulkTagJobResponse{
+					Data: &compassv1beta1.BulkTagJob{
+						Id:          jobID,
+						TemplateUrn: sampleTagPB.GetTemplateUrn(),
+						Status:      "done",
+						Report: &compassv1beta1.BulkTagReport{
+							TemplateUrn:       sampleTagPB.GetTemplateUrn(),
+							Assets:            1,
+							SucceededAssetIds: []string{assetID},
+						},
+						CreatedBy: userEmail,
+						CreatedAt: timestamppb.New(createdAt),
+						UpdatedAt: timestamppb.New(createdAt),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			logger := log.NewNoop()
+			mockUserSvc := new(mocks.UserService)
+			mockTagSvc := new(mocks.TagService)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTagSvc)
+			}
+			defer mockUserSvc.AssertExpectations(t)
+			defer mockTagSvc.AssertExpectations(t)
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{
+				TagSvc:  mockTagSvc,
+				UserSvc: mockUserSvc,
+				Logger:  logger,
+			})
+
+			got, err := handler.GetBulkTagJob(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
 func TestTagToProto(t *testing.T) {
 	type testCase struct {
 		Title       string
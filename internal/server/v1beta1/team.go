@@ -0,0 +1,265 @@
+package handlersv1beta1
+
+//go:generate mockery --name=TeamService -r --case underscore --with-expecter --structname TeamService --filename team_service.go --output=./mocks
+import (
+	"context"
+	"errors"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TeamService interface {
+	CreateTeam(ctx context.Context, t *team.Team) (string, error)
+	GetTeamByID(ctx context.Context, id string) (team.Team, error)
+	GetTeams(ctx context.Context, flt team.Filter) ([]team.Team, error)
+	UpdateTeam(ctx context.Context, t *team.Team) error
+	DeleteTeam(ctx context.Context, id string) error
+}
+
+func (server *APIServer) CreateTeam(ctx context.Context, req *compassv1beta1.CreateTeamRequest) (*compassv1beta1.CreateTeamResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	t := team.Team{
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		OnCall:       req.GetOnCall(),
+		SlackChannel: req.GetSlackChannel(),
+		Members:      membersFromEmails(req.GetMembers()),
+		CreatedBy:    userID,
+	}
+	id, err := server.teamService.CreateTeam(ctx, &t)
+	if err != nil {
+		if isTeamInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.As(err, new(team.DuplicateError)) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	created, err := server.teamService.GetTeamByID(ctx, id)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateTeamResponse{
+		Data: teamToProto(created),
+	}, nil
+}
+
+func (server *APIServer) GetAllTeams(ctx context.Context, _ *compassv1beta1.GetAllTeamsRequest) (*compassv1beta1.GetAllTeamsResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	teams, err := server.teamService.GetTeams(ctx, team.Filter{})
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.GetAllTeamsResponse{
+		Data: teamsToProto(teams),
+	}, nil
+}
+
+func (server *APIServer) GetTeam(ctx context.Context, req *compassv1beta1.GetTeamRequest) (*compassv1beta1.GetTeamResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	t, err := server.teamService.GetTeamByID(ctx, req.GetId())
+	if err != nil {
+		return nil, server.teamLookupError(err)
+	}
+
+	return &compassv1beta1.GetTeamResponse{
+		Data: teamToProto(t),
+	}, nil
+}
+
+func (server *APIServer) UpdateTeam(ctx context.Context, req *compassv1beta1.UpdateTeamRequest) (*compassv1beta1.UpdateTeamResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	t := team.Team{
+		ID:           req.GetId(),
+		Description:  req.GetDescription(),
+		OnCall:       req.GetOnCall(),
+		SlackChannel: req.GetSlackChannel(),
+		Members:      membersFromEmails(req.GetMembers()),
+	}
+	if err := server.teamService.UpdateTeam(ctx, &t); err != nil {
+		if isTeamInvalidArgument(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, server.teamLookupError(err)
+	}
+
+	updated, err := server.teamService.GetTeamByID(ctx, req.GetId())
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.UpdateTeamResponse{
+		Data: teamToProto(updated),
+	}, nil
+}
+
+func (server *APIServer) DeleteTeam(ctx context.Context, req *compassv1beta1.DeleteTeamRequest) (*compassv1beta1.DeleteTeamResponse, error) {
+	if _, err := server.ValidateUserInCtx(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.teamService.DeleteTeam(ctx, req.GetId()); err != nil {
+		return nil, server.teamLookupError(err)
+	}
+
+	return &compassv1beta1.DeleteTeamResponse{}, nil
+}
+
+func (server *APIServer) GetMyProfile(ctx context.Context, _ *compassv1beta1.GetMyProfileRequest) (*compassv1beta1.GetMyProfileResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := server.teamService.GetTeams(ctx, team.Filter{MemberID: userID})
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	usr := user.FromContext(ctx)
+	return &compassv1beta1.GetMyProfileResponse{
+		User: userToFullProto(user.User{
+			ID:       userID,
+			Email:    usr.Email,
+			Provider: usr.Provider,
+		}),
+		Teams: teamsToProto(teams),
+	}, nil
+}
+
+func (server *APIServer) GetMyTeamsAssets(ctx context.Context, req *compassv1beta1.GetMyTeamsAssetsRequest) (*compassv1beta1.GetMyTeamsAssetsResponse, error) {
+	userID, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	teams, err := server.teamService.GetTeams(ctx, team.Filter{MemberID: userID})
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+	if len(teams) == 0 {
+		return &compassv1beta1.GetMyTeamsAssetsResponse{}, nil
+	}
+
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assets, _, err := server.assetService.GetAllAssets(ctx, asset.Filter{
+		Size:       int(req.GetSize()),
+		Offset:     int(req.GetOffset()),
+		OwnerTeams: team.Names(teams),
+		Viewer:     viewer,
+	}, false)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	assetsPB := make([]*compassv1beta1.Asset, 0, len(assets))
+	for _, a := range assets {
+		ap, err := assetToProto(a, false)
+		if err != nil {
+			return nil, internalServerError(server.logger, err.Error())
+		}
+		assetsPB = append(assetsPB, ap)
+	}
+
+	return &compassv1beta1.GetMyTeamsAssetsResponse{
+		Data: assetsPB,
+	}, nil
+}
+
+func (server *APIServer) teamLookupError(err error) error {
+	if errors.As(err, new(team.InvalidError)) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.As(err, new(team.NotFoundError)) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return internalServerError(server.logger, err.Error())
+}
+
+func isTeamInvalidArgument(err error) bool {
+	return errors.Is(err, team.ErrInvalidName) ||
+		errors.Is(err, team.ErrInvalidSlackChannel) ||
+		errors.Is(err, team.ErrEmptyMemberEmail)
+}
+
+func membersFromEmails(emails []string) []user.User {
+	members := make([]user.User, 0, len(emails))
+	for _, email := range emails {
+		members = append(members, user.User{Email: email})
+	}
+	return members
+}
+
+func teamsToProto(teams []team.Team) []*compassv1beta1.Team {
+	teamsPB := make([]*compassv1beta1.Team, 0, len(teams))
+	for _, t := range teams {
+		teamsPB = append(teamsPB, teamToProto(t))
+	}
+	return teamsPB
+}
+
+func teamToProto(t team.Team) *compassv1beta1.Team {
+	members := make([]*compassv1beta1.User, 0, len(t.Members))
+	for _, m := range t.Members {
+		members = append(members, userToProto(m))
+	}
+
+	return &compassv1beta1.Team{
+		Id:           t.ID,
+		Name:         t.Name,
+		Description:  t.Description,
+		OnCall:       t.OnCall,
+		SlackChannel: t.SlackChannel,
+		Members:      members,
+		CreatedBy:    t.CreatedBy,
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+		UpdatedAt:    timestamppb.New(t.UpdatedAt),
+	}
+}
@@ -0,0 +1,328 @@
+package handlersv1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/server/v1beta1/mocks"
+	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateTeam(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		teamID    = uuid.NewString()
+		now       = time.Now().UTC()
+		validReq  = &compassv1beta1.CreateTeamRequest{
+			Name:         "data-platform",
+			OnCall:       "oncall@example.com",
+			SlackChannel: "#data-platform",
+			Members:      []string{"user@example.com"},
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateTeamRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TeamService)
+		PostCheck    func(resp *compassv1beta1.CreateTeamResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if name is invalid",
+			Request:      &compassv1beta1.CreateTeamRequest{Name: "Data Platform"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return invalid argument if slack channel is invalid",
+			Request:      &compassv1beta1.CreateTeamRequest{Name: "data-platform", SlackChannel: "data-platform"},
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				svc.EXPECT().CreateTeam(ctx, mock.Anything).Return("", team.ErrInvalidSlackChannel)
+			},
+		},
+		{
+			Description:  "should return already exists if team name is taken",
+			Request:      validReq,
+			ExpectStatus: codes.AlreadyExists,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				svc.EXPECT().CreateTeam(ctx, mock.Anything).Return("", team.DuplicateError{Name: "data-platform"})
+			},
+		},
+		{
+			Description:  "should return internal server error if failed to create team",
+			Request:      validReq,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				svc.EXPECT().CreateTeam(ctx, mock.Anything).Return("", errors.New("some error"))
+			},
+		},
+		{
+			Description:  "should return created team",
+			Request:      validReq,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				expected := &team.Team{
+					Name:         "data-platform",
+					OnCall:       "oncall@example.com",
+					SlackChannel: "#data-platform",
+					Members:      []user.User{{Email: "user@example.com"}},
+					CreatedBy:    userID,
+				}
+				svc.EXPECT().CreateTeam(ctx, expected).Return(teamID, nil)
+
+				created := *expected
+				created.ID = teamID
+				created.Members = []user.User{{ID: "member-1", Email: "user@example.com"}}
+				created.CreatedAt = now
+				created.UpdatedAt = now
+				svc.EXPECT().GetTeamByID(ctx, teamID).Return(created, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateTeamResponse) error {
+				expected := &compassv1beta1.CreateTeamResponse{
+					Data: &compassv1beta1.Team{
+						Id:           teamID,
+						Name:         "data-platform",
+						OnCall:       "oncall@example.com",
+						SlackChannel: "#data-platform",
+						Members:      []*compassv1beta1.User{{Id: "member-1", Email: "user@example.com"}},
+						CreatedBy:    userID,
+						CreatedAt:    timestamppb.New(now),
+						UpdatedAt:    timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockTeamSvc := mocks.NewTeamService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTeamSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{TeamSvc: mockTeamSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreateTeam(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetTeam(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+		teamID    = uuid.NewString()
+	)
+	type testCase struct {
+		Description  string
+		ID           string
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TeamService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return invalid argument if id is not uuid",
+			ID:           "invalid",
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  "should return not found if team does not exist",
+			ID:           teamID,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				svc.EXPECT().GetTeamByID(ctx, teamID).Return(team.Team{}, team.NotFoundError{ID: teamID})
+			},
+		},
+		{
+			Description:  "should return team",
+			ID:           teamID,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, svc *mocks.TeamService) {
+				svc.EXPECT().GetTeamByID(ctx, teamID).Return(team.Team{ID: teamID, Name: "data-platform"}, nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockTeamSvc := mocks.NewTeamService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTeamSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{TeamSvc: mockTeamSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.GetTeam(ctx, &compassv1beta1.GetTeamRequest{Id: tc.ID})
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestGetMyProfile(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+	)
+
+	ctx := user.NewContext(context.Background(), user.User{Email: userEmail, Provider: "shield"})
+
+	mockUserSvc := mocks.NewUserService(t)
+	mockTeamSvc := mocks.NewTeamService(t)
+	mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+	mockTeamSvc.EXPECT().GetTeams(ctx, team.Filter{MemberID: userID}).Return([]team.Team{
+		{ID: "team-1", Name: "data-platform"},
+	}, nil)
+
+	handler := NewAPIServer(APIServerDeps{TeamSvc: mockTeamSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+	got, err := handler.GetMyProfile(ctx, &compassv1beta1.GetMyProfileRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &compassv1beta1.GetMyProfileResponse{
+		User: userToFullProto(user.User{ID: userID, Email: userEmail, Provider: "shield"}),
+		Teams: []*compassv1beta1.Team{
+			teamToProto(team.Team{ID: "team-1", Name: "data-platform"}),
+		},
+	}
+	if diff := cmp.Diff(got, expected, protocmp.Transform()); diff != "" {
+		t.Errorf("expected response to be %+v, was %+v", expected, got)
+	}
+}
+
+func TestGetMyTeamsAssets(t *testing.T) {
+	var (
+		userEmail = "test@test.com"
+		userID    = uuid.NewString()
+	)
+	type testCase struct {
+		Description  string
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.TeamService, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.GetMyTeamsAssetsResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  "should return internal server error if failed to fetch teams",
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, ts *mocks.TeamService, _ *mocks.AssetService) {
+				ts.EXPECT().GetTeams(ctx, team.Filter{MemberID: userID}).Return(nil, errors.New("some error"))
+			},
+		},
+		{
+			Description:  "should return empty list if user has no teams",
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TeamService, _ *mocks.AssetService) {
+				ts.EXPECT().GetTeams(ctx, team.Filter{MemberID: userID}).Return([]team.Team{}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetMyTeamsAssetsResponse) error {
+				if len(resp.GetData()) != 0 {
+					return fmt.Errorf("expected no assets, got %d", len(resp.GetData()))
+				}
+				return nil
+			},
+		},
+		{
+			Description:  "should return assets owned by the user's teams",
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, ts *mocks.TeamService, as *mocks.AssetService) {
+				ts.EXPECT().GetTeams(ctx, team.Filter{MemberID: userID}).Return([]team.Team{
+					{ID: "team-1", Name: "data-platform"},
+					{ID: "team-2", Name: "payments"},
+				}, nil)
+				as.EXPECT().GetAllAssets(ctx, asset.Filter{
+					Size:       10,
+					OwnerTeams: []string{"data-platform", "payments"},
+					Viewer:     &asset.Viewer{},
+				}, false).Return([]asset.Asset{
+					{ID: "asset-1", URN: "urn:orders", OwnerTeams: []string{"payments"}},
+				}, 0, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetMyTeamsAssetsResponse) error {
+				expected := &compassv1beta1.GetMyTeamsAssetsResponse{
+					Data: []*compassv1beta1.Asset{
+						{Id: "asset-1", Urn: "urn:orders", OwnerTeams: []string{"payments"}},
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+
+			mockUserSvc := mocks.NewUserService(t)
+			mockTeamSvc := mocks.NewTeamService(t)
+			mockAssetSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockTeamSvc, mockAssetSvc)
+			}
+
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{TeamSvc: mockTeamSvc, AssetSvc: mockAssetSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.GetMyTeamsAssets(ctx, &compassv1beta1.GetMyTeamsAssetsRequest{Size: 10})
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %s instead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package handlersv1beta1
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/goto/compass/core/asset"
 	compassv1beta1 "github.com/goto/compass/proto/gotocompany/compass/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (server *APIServer) GetAllTypes(ctx context.Context, req *compassv1beta1.GetAllTypesRequest) (*compassv1beta1.GetAllTypesResponse, error) {
@@ -31,6 +35,11 @@ func (server *APIServer) GetAllTypes(ctx context.Context, req *compassv1beta1.Ge
 		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
 	}
 
+	flt.Viewer, err = server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	typesNameMap, err := server.assetService.GetTypes(ctx, flt)
 	if err != nil {
 		return nil, internalServerError(server.logger, fmt.Sprintf("error fetching types: %s", err.Error()))
@@ -38,14 +47,297 @@ func (server *APIServer) GetAllTypes(ctx context.Context, req *compassv1beta1.Ge
 
 	results := []*compassv1beta1.Type{}
 	for _, typName := range asset.GetSupportedTypes() {
-		count := typesNameMap[typName]
-		results = append(results, &compassv1beta1.Type{
-			Name:  typName.String(),
-			Count: uint32(count),
-		})
+		typePB := &compassv1beta1.Type{Name: typName.String()}
+		if def, ok := asset.GetTypeDefinition(typName); ok {
+			typePB = typeDefinitionToProto(def)
+		}
+		typePB.Count = uint32(typesNameMap[typName])
+		results = append(results, typePB)
 	}
 
 	return &compassv1beta1.GetAllTypesResponse{
 		Data: results,
 	}, nil
 }
+
+func (server *APIServer) CreateType(ctx context.Context, req *compassv1beta1.CreateTypeRequest) (*compassv1beta1.CreateTypeResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	def := asset.TypeDefinition{
+		Name:           asset.Type(req.GetName()),
+		DisplayName:    req.GetDisplayName(),
+		Icon:           req.GetIcon(),
+		Description:    req.GetDescription(),
+		RequiredFields: req.GetRequiredFields(),
+		SearchBoost:    req.GetSearchBoost(),
+	}
+	if err := server.assetService.CreateType(ctx, &def); err != nil {
+		switch {
+		case errors.As(err, new(asset.InvalidTypeError)):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, new(asset.TypeExistsError)):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.CreateTypeResponse{
+		Data: typeDefinitionToProto(def),
+	}, nil
+}
+
+func (server *APIServer) GetType(ctx context.Context, req *compassv1beta1.GetTypeRequest) (*compassv1beta1.GetTypeResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	def, err := server.assetService.GetTypeDefinition(ctx, asset.Type(req.GetName()))
+	if err != nil {
+		if errors.As(err, new(asset.TypeNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.GetTypeResponse{
+		Data: typeDefinitionToProto(def),
+	}, nil
+}
+
+func (server *APIServer) UpdateType(ctx context.Context, req *compassv1beta1.UpdateTypeRequest) (*compassv1beta1.UpdateTypeResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	def := asset.TypeDefinition{
+		Name:           asset.Type(req.GetName()),
+		DisplayName:    req.GetDisplayName(),
+		Icon:           req.GetIcon(),
+		Description:    req.GetDescription(),
+		RequiredFields: req.GetRequiredFields(),
+		SearchBoost:    req.GetSearchBoost(),
+	}
+	if err := server.assetService.UpdateType(ctx, &def); err != nil {
+		switch {
+		case errors.As(err, new(asset.InvalidTypeError)):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.As(err, new(asset.TypeNotFoundError)):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.UpdateTypeResponse{
+		Data: typeDefinitionToProto(def),
+	}, nil
+}
+
+func (server *APIServer) DeleteType(ctx context.Context, req *compassv1beta1.DeleteTypeRequest) (*compassv1beta1.DeleteTypeResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.assetService.DeleteType(ctx, asset.Type(req.GetName())); err != nil {
+		switch {
+		case errors.As(err, new(asset.TypeNotFoundError)):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.As(err, new(asset.TypeInUseError)):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.DeleteTypeResponse{}, nil
+}
+
+func (server *APIServer) UpsertTypeSchema(ctx context.Context, req *compassv1beta1.UpsertTypeSchemaRequest) (*compassv1beta1.UpsertTypeSchemaResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	schema, err := json.Marshal(req.GetSchema().AsMap())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ts := asset.TypeSchema{
+		Type:   asset.Type(req.GetType()),
+		Schema: schema,
+		Mode:   asset.SchemaMode(req.GetMode()),
+	}
+	if err := server.assetService.UpsertTypeSchema(ctx, &ts); err != nil {
+		if errors.Is(err, asset.ErrUnknownType) || errors.As(err, new(asset.InvalidTypeSchemaError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	tsPB, err := typeSchemaToProto(ts)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.UpsertTypeSchemaResponse{
+		Data: tsPB,
+	}, nil
+}
+
+func (server *APIServer) GetTypeSchema(ctx context.Context, req *compassv1beta1.GetTypeSchemaRequest) (*compassv1beta1.GetTypeSchemaResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	ts, err := server.assetService.GetTypeSchema(ctx, asset.Type(req.GetType()))
+	if err != nil {
+		if errors.As(err, new(asset.TypeSchemaNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	tsPB, err := typeSchemaToProto(ts)
+	if err != nil {
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.GetTypeSchemaResponse{
+		Data: tsPB,
+	}, nil
+}
+
+func (server *APIServer) DeleteTypeSchema(ctx context.Context, req *compassv1beta1.DeleteTypeSchemaRequest) (*compassv1beta1.DeleteTypeSchemaResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	if err := server.assetService.DeleteTypeSchema(ctx, asset.Type(req.GetType())); err != nil {
+		if errors.As(err, new(asset.TypeSchemaNotFoundError)) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	return &compassv1beta1.DeleteTypeSchemaResponse{}, nil
+}
+
+func (server *APIServer) ValidateTypeSchema(ctx context.Context, req *compassv1beta1.ValidateTypeSchemaRequest) (*compassv1beta1.ValidateTypeSchemaResponse, error) {
+	_, err := server.ValidateUserInCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, bodyParserErrorMsg(err))
+	}
+
+	schema, err := json.Marshal(req.GetSchema().AsMap())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := server.assetService.ValidateAssetsAgainstSchema(ctx, asset.TypeSchema{
+		Type:   asset.Type(req.GetType()),
+		Schema: schema,
+	})
+	if err != nil {
+		if errors.Is(err, asset.ErrUnknownType) || errors.As(err, new(asset.InvalidTypeSchemaError)) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, internalServerError(server.logger, err.Error())
+	}
+
+	violations := make([]*compassv1beta1.ValidateTypeSchemaResponse_Violation, 0, len(report.Violations))
+	for _, v := range report.Violations {
+		violations = append(violations, &compassv1beta1.ValidateTypeSchemaResponse_Violation{
+			Urn:    v.URN,
+			Errors: v.Errors,
+		})
+	}
+
+	return &compassv1beta1.ValidateTypeSchemaResponse{
+		Checked:    uint32(report.Checked),
+		Invalid:    uint32(report.Invalid),
+		Violations: violations,
+	}, nil
+}
+
+func typeDefinitionToProto(def asset.TypeDefinition) *compassv1beta1.Type {
+	var createdAt, updatedAt *timestamppb.Timestamp
+	if !def.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(def.CreatedAt)
+	}
+	if !def.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(def.UpdatedAt)
+	}
+
+	return &compassv1beta1.Type{
+		Name:           def.Name.String(),
+		DisplayName:    def.DisplayName,
+		Icon:           def.Icon,
+		Description:    def.Description,
+		RequiredFields: def.RequiredFields,
+		SearchBoost:    def.SearchBoost,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+func typeSchemaToProto(ts asset.TypeSchema) (*compassv1beta1.TypeSchema, error) {
+	schema := new(structpb.Struct)
+	if err := schema.UnmarshalJSON(ts.Schema); err != nil {
+		return nil, fmt.Errorf("unmarshal schema of type %s: %w", ts.Type, err)
+	}
+
+	var createdAt, updatedAt *timestamppb.Timestamp
+	if !ts.CreatedAt.IsZero() {
+		createdAt = timestamppb.New(ts.CreatedAt)
+	}
+	if !ts.UpdatedAt.IsZero() {
+		updatedAt = timestamppb.New(ts.UpdatedAt)
+	}
+
+	return &compassv1beta1.TypeSchema{
+		Type:      ts.Type.String(),
+		Schema:    schema,
+		Mode:      string(ts.Mode),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
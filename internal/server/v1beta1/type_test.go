@@ -2,10 +2,13 @@ package handlersv1beta1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/user"
@@ -15,6 +18,9 @@ import (
 	"github.com/goto/salt/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGetTypes(t *testing.T) {
@@ -24,6 +30,7 @@ func TestGetTypes(t *testing.T) {
 	)
 	type testCase struct {
 		Description  string
+		Groups       []string
 		ExpectStatus codes.Code
 		Setup        func(tc *testCase, ctx context.Context, as *mocks.AssetService)
 		PostCheck    func(resp *compassv1beta1.GetAllTypesResponse) error
@@ -34,21 +41,30 @@ func TestGetTypes(t *testing.T) {
 			Description:  "should return internal server error if failing to fetch types",
 			ExpectStatus: codes.Internal,
 			Setup: func(tc *testCase, ctx context.Context, as *mocks.AssetService) {
-				as.EXPECT().GetTypes(ctx, asset.Filter{}).Return(map[asset.Type]int{}, errors.New("failed to fetch type"))
+				as.EXPECT().GetTypes(ctx, asset.Filter{Viewer: &asset.Viewer{}}).Return(map[asset.Type]int{}, errors.New("failed to fetch type"))
 			},
 		},
 		{
 			Description:  "should return internal server error if failing to fetch counts",
 			ExpectStatus: codes.Internal,
 			Setup: func(tc *testCase, ctx context.Context, as *mocks.AssetService) {
-				as.EXPECT().GetTypes(ctx, asset.Filter{}).Return(map[asset.Type]int{}, errors.New("failed to fetch assets count"))
+				as.EXPECT().GetTypes(ctx, asset.Filter{Viewer: &asset.Viewer{}}).Return(map[asset.Type]int{}, errors.New("failed to fetch assets count"))
+			},
+		},
+		{
+			Description:  "should count the assets visible to the groups of the user",
+			Groups:       []string{"finance"},
+			ExpectStatus: codes.OK,
+			Setup: func(tc *testCase, ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypes(ctx, asset.Filter{Viewer: &asset.Viewer{Groups: []string{"finance"}}}).
+					Return(map[asset.Type]int{asset.Type("table"): 1}, nil)
 			},
 		},
 		{
 			Description:  "should return all valid types with its asset count",
 			ExpectStatus: codes.OK,
 			Setup: func(tc *testCase, ctx context.Context, as *mocks.AssetService) {
-				as.EXPECT().GetTypes(ctx, asset.Filter{}).Return(map[asset.Type]int{
+				as.EXPECT().GetTypes(ctx, asset.Filter{Viewer: &asset.Viewer{}}).Return(map[asset.Type]int{
 					asset.Type("table"): 10,
 					asset.Type("topic"): 30,
 					asset.Type("job"):   15,
@@ -119,7 +135,7 @@ func TestGetTypes(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.Description, func(t *testing.T) {
-			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail, Groups: tc.Groups})
 
 			mockUserSvc := new(mocks.UserService)
 			mockSvc := new(mocks.AssetService)
@@ -149,3 +165,690 @@ func TestGetTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestUpsertTypeSchema(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		schemaPB, _  = structpb.NewStruct(map[string]interface{}{"type": "object", "required": []interface{}{"owner_team"}})
+		validRequest = &compassv1beta1.UpsertTypeSchemaRequest{
+			Type:   "table",
+			Schema: schemaPB,
+			Mode:   "warn",
+		}
+		ts = asset.TypeSchema{
+			Type:   "table",
+			Schema: json.RawMessage(`{"required":["owner_team"],"type":"object"}`),
+			Mode:   asset.SchemaModeWarn,
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.UpsertTypeSchemaRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.UpsertTypeSchemaResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if schema is empty`,
+			Request:      &compassv1beta1.UpsertTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if mode is unknown`,
+			Request:      &compassv1beta1.UpsertTypeSchemaRequest{Type: "table", Schema: schemaPB, Mode: "strict"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if type is unknown`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpsertTypeSchema(ctx, &ts).Return(asset.ErrUnknownType)
+			},
+		},
+		{
+			Description:  `should return invalid argument if schema is invalid`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpsertTypeSchema(ctx, &ts).Return(asset.InvalidTypeSchemaError{Type: "table", Err: errors.New("invalid")})
+			},
+		},
+		{
+			Description:  `should return internal server error if upsert fails`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpsertTypeSchema(ctx, &ts).Return(errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok and the schema if found no error`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpsertTypeSchema(ctx, &ts).Return(nil)
+			},
+			PostCheck: func(resp *compassv1beta1.UpsertTypeSchemaResponse) error {
+				expected := &compassv1beta1.UpsertTypeSchemaResponse{
+					Data: &compassv1beta1.TypeSchema{
+						Type:   "table",
+						Schema: schemaPB,
+						Mode:   "warn",
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.UpsertTypeSchema(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestGetTypeSchema(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		now       = time.Now().UTC()
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.GetTypeSchemaRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.GetTypeSchemaResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if type is empty`,
+			Request:      &compassv1beta1.GetTypeSchemaRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if type has no schema`,
+			Request:      &compassv1beta1.GetTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeSchema(ctx, asset.Type("table")).Return(asset.TypeSchema{}, asset.TypeSchemaNotFoundError{Type: "table"})
+			},
+		},
+		{
+			Description:  `should return internal server error if fetching fails`,
+			Request:      &compassv1beta1.GetTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeSchema(ctx, asset.Type("table")).Return(asset.TypeSchema{}, errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok and the schema if found no error`,
+			Request:      &compassv1beta1.GetTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeSchema(ctx, asset.Type("table")).Return(asset.TypeSchema{
+					Type:      "table",
+					Schema:    json.RawMessage(`{"type": "object"}`),
+					Mode:      asset.SchemaModeEnforce,
+					CreatedAt: now,
+					UpdatedAt: now,
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetTypeSchemaResponse) error {
+				expected := &compassv1beta1.GetTypeSchemaResponse{
+					Data: &compassv1beta1.TypeSchema{
+						Type:      "table",
+						Schema:    &structpb.Struct{Fields: map[string]*structpb.Value{"type": structpb.NewStringValue("object")}},
+						Mode:      "enforce",
+						CreatedAt: timestamppb.New(now),
+						UpdatedAt: timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.GetTypeSchema(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteTypeSchema(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.DeleteTypeSchemaRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if type is empty`,
+			Request:      &compassv1beta1.DeleteTypeSchemaRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if type has no schema`,
+			Request:      &compassv1beta1.DeleteTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteTypeSchema(ctx, asset.Type("table")).Return(asset.TypeSchemaNotFoundError{Type: "table"})
+			},
+		},
+		{
+			Description:  `should return internal server error if deleting fails`,
+			Request:      &compassv1beta1.DeleteTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteTypeSchema(ctx, asset.Type("table")).Return(errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok if found no error`,
+			Request:      &compassv1beta1.DeleteTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteTypeSchema(ctx, asset.Type("table")).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.DeleteTypeSchema(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestValidateTypeSchema(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		schemaPB, _  = structpb.NewStruct(map[string]interface{}{"type": "object"})
+		validRequest = &compassv1beta1.ValidateTypeSchemaRequest{Type: "table", Schema: schemaPB}
+		ts           = asset.TypeSchema{Type: "table", Schema: json.RawMessage(`{"type":"object"}`)}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.ValidateTypeSchemaRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.ValidateTypeSchemaResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if schema is empty`,
+			Request:      &compassv1beta1.ValidateTypeSchemaRequest{Type: "table"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if schema is invalid`,
+			Request:      validRequest,
+			ExpectStatus: codes.InvalidArgument,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().ValidateAssetsAgainstSchema(ctx, ts).
+					Return(asset.SchemaValidationReport{}, asset.InvalidTypeSchemaError{Type: "table", Err: errors.New("invalid")})
+			},
+		},
+		{
+			Description:  `should return internal server error if validation fails`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().ValidateAssetsAgainstSchema(ctx, ts).Return(asset.SchemaValidationReport{}, errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok and the assets violating the schema`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().ValidateAssetsAgainstSchema(ctx, ts).Return(asset.SchemaValidationReport{
+					Checked: 2,
+					Invalid: 1,
+					Violations: []asset.SchemaViolation{
+						{URN: "invalid-urn", Errors: []string{"(root): owner_team is required"}},
+					},
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.ValidateTypeSchemaResponse) error {
+				expected := &compassv1beta1.ValidateTypeSchemaResponse{
+					Checked: 2,
+					Invalid: 1,
+					Violations: []*compassv1beta1.ValidateTypeSchemaResponse_Violation{
+						{Urn: "invalid-urn", Errors: []string{"(root): owner_team is required"}},
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.ValidateTypeSchema(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestCreateType(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.CreateTypeRequest{
+			Name:           "pipeline",
+			DisplayName:    "Pipeline",
+			Icon:           "pipeline.svg",
+			RequiredFields: []string{"owner_team"},
+			SearchBoost:    1.5,
+		}
+		def = asset.TypeDefinition{
+			Name:           "pipeline",
+			DisplayName:    "Pipeline",
+			Icon:           "pipeline.svg",
+			RequiredFields: []string{"owner_team"},
+			SearchBoost:    1.5,
+		}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.CreateTypeRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.CreateTypeResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if name has invalid characters`,
+			Request:      &compassv1beta1.CreateTypeRequest{Name: "Pipeline"},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return invalid argument if search boost is negative`,
+			Request:      &compassv1beta1.CreateTypeRequest{Name: "pipeline", SearchBoost: -1},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return already exists if type is already registered`,
+			Request:      validRequest,
+			ExpectStatus: codes.AlreadyExists,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().CreateType(ctx, &def).Return(asset.TypeExistsError{Type: "pipeline"})
+			},
+		},
+		{
+			Description:  `should return internal server error if creating fails`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().CreateType(ctx, &def).Return(errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok and the type if found no error`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().CreateType(ctx, &def).Return(nil)
+			},
+			PostCheck: func(resp *compassv1beta1.CreateTypeResponse) error {
+				expected := &compassv1beta1.CreateTypeResponse{
+					Data: &compassv1beta1.Type{
+						Name:           "pipeline",
+						DisplayName:    "Pipeline",
+						Icon:           "pipeline.svg",
+						RequiredFields: []string{"owner_team"},
+						SearchBoost:    1.5,
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.CreateType(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestGetType(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+		now       = time.Now().UTC()
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.GetTypeRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+		PostCheck    func(resp *compassv1beta1.GetTypeResponse) error
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return not found if type is not registered`,
+			Request:      &compassv1beta1.GetTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeDefinition(ctx, asset.Type("pipeline")).Return(asset.TypeDefinition{}, asset.TypeNotFoundError{Type: "pipeline"})
+			},
+		},
+		{
+			Description:  `should return internal server error if fetching fails`,
+			Request:      &compassv1beta1.GetTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeDefinition(ctx, asset.Type("pipeline")).Return(asset.TypeDefinition{}, errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok and the type if found no error`,
+			Request:      &compassv1beta1.GetTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().GetTypeDefinition(ctx, asset.Type("pipeline")).Return(asset.TypeDefinition{
+					Name:        "pipeline",
+					DisplayName: "Pipeline",
+					Description: "data pipelines",
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				}, nil)
+			},
+			PostCheck: func(resp *compassv1beta1.GetTypeResponse) error {
+				expected := &compassv1beta1.GetTypeResponse{
+					Data: &compassv1beta1.Type{
+						Name:        "pipeline",
+						DisplayName: "Pipeline",
+						Description: "data pipelines",
+						CreatedAt:   timestamppb.New(now),
+						UpdatedAt:   timestamppb.New(now),
+					},
+				}
+				if diff := cmp.Diff(resp, expected, protocmp.Transform()); diff != "" {
+					return fmt.Errorf("expected response to be %+v, was %+v", expected, resp)
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			got, err := handler.GetType(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+				return
+			}
+			if tc.PostCheck != nil {
+				if err := tc.PostCheck(got); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateType(t *testing.T) {
+	var (
+		userID       = uuid.NewString()
+		userEmail    = "test@test.com"
+		validRequest = &compassv1beta1.UpdateTypeRequest{Name: "pipeline", DisplayName: "Data Pipeline"}
+		def          = asset.TypeDefinition{Name: "pipeline", DisplayName: "Data Pipeline"}
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.UpdateTypeRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if a required field is empty`,
+			Request:      &compassv1beta1.UpdateTypeRequest{Name: "pipeline", RequiredFields: []string{""}},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if type is not registered`,
+			Request:      validRequest,
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpdateType(ctx, &def).Return(asset.TypeNotFoundError{Type: "pipeline"})
+			},
+		},
+		{
+			Description:  `should return internal server error if updating fails`,
+			Request:      validRequest,
+			ExpectStatus: codes.Internal,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpdateType(ctx, &def).Return(errors.New("unknown error"))
+			},
+		},
+		{
+			Description:  `should return ok if found no error`,
+			Request:      validRequest,
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().UpdateType(ctx, &def).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.UpdateType(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
+
+func TestDeleteType(t *testing.T) {
+	var (
+		userID    = uuid.NewString()
+		userEmail = "test@test.com"
+	)
+	type testCase struct {
+		Description  string
+		Request      *compassv1beta1.DeleteTypeRequest
+		ExpectStatus codes.Code
+		Setup        func(context.Context, *mocks.AssetService)
+	}
+
+	testCases := []testCase{
+		{
+			Description:  `should return invalid argument if name is empty`,
+			Request:      &compassv1beta1.DeleteTypeRequest{},
+			ExpectStatus: codes.InvalidArgument,
+		},
+		{
+			Description:  `should return not found if type is not registered`,
+			Request:      &compassv1beta1.DeleteTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteType(ctx, asset.Type("pipeline")).Return(asset.TypeNotFoundError{Type: "pipeline"})
+			},
+		},
+		{
+			Description:  `should return failed precondition if type still has assets`,
+			Request:      &compassv1beta1.DeleteTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.FailedPrecondition,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteType(ctx, asset.Type("pipeline")).Return(asset.TypeInUseError{Type: "pipeline", Count: 3})
+			},
+		},
+		{
+			Description:  `should return ok if found no error`,
+			Request:      &compassv1beta1.DeleteTypeRequest{Name: "pipeline"},
+			ExpectStatus: codes.OK,
+			Setup: func(ctx context.Context, as *mocks.AssetService) {
+				as.EXPECT().DeleteType(ctx, asset.Type("pipeline")).Return(nil)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			ctx := user.NewContext(context.Background(), user.User{Email: userEmail})
+			mockUserSvc := mocks.NewUserService(t)
+			mockSvc := mocks.NewAssetService(t)
+			if tc.Setup != nil {
+				tc.Setup(ctx, mockSvc)
+			}
+			mockUserSvc.EXPECT().ValidateUser(ctx, userEmail).Return(userID, nil)
+
+			handler := NewAPIServer(APIServerDeps{AssetSvc: mockSvc, UserSvc: mockUserSvc, Logger: log.NewNoop()})
+
+			_, err := handler.DeleteType(ctx, tc.Request)
+			code := status.Code(err)
+			if code != tc.ExpectStatus {
+				t.Errorf("expected handler to return Code %s, returned Code %sinstead", tc.ExpectStatus.String(), code.String())
+			}
+		})
+	}
+}
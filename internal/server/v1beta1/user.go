@@ -19,6 +19,13 @@ import (
 
 type UserService interface {
 	ValidateUser(ctx context.Context, email string) (string, error)
+	CreateServiceAccount(ctx context.Context, sa *user.ServiceAccount) (string, error)
+	GetServiceAccountByID(ctx context.Context, id string) (user.ServiceAccount, error)
+	GetServiceAccounts(ctx context.Context) ([]user.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, id string) error
+	CreateAPIToken(ctx context.Context, t *user.APIToken) (string, error)
+	GetAPITokens(ctx context.Context, serviceAccountID string) ([]user.APIToken, error)
+	RevokeAPIToken(ctx context.Context, serviceAccountID, id string) error
 }
 
 func (server *APIServer) GetUserStarredAssets(ctx context.Context, req *compassv1beta1.GetUserStarredAssetsRequest) (*compassv1beta1.GetUserStarredAssetsResponse, error) {
@@ -27,9 +34,15 @@ func (server *APIServer) GetUserStarredAssets(ctx context.Context, req *compassv
 		return nil, err
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	starFilter := star.Filter{
 		Size:   int(req.GetSize()),
 		Offset: int(req.GetOffset()),
+		Viewer: viewer,
 	}
 
 	starredAssets, err := server.starService.GetStarredAssetsByUserID(ctx, starFilter, req.GetUserId())
@@ -64,9 +77,15 @@ func (server *APIServer) GetMyStarredAssets(ctx context.Context, req *compassv1b
 		return nil, err
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	starFilter := star.Filter{
 		Size:   int(req.GetSize()),
 		Offset: int(req.GetOffset()),
+		Viewer: viewer,
 	}
 
 	starredAssets, err := server.starService.GetStarredAssetsByUserID(ctx, starFilter, userID)
@@ -112,6 +131,14 @@ func (server *APIServer) GetMyStarredAsset(ctx context.Context, req *compassv1be
 		return nil, internalServerError(server.logger, err.Error())
 	}
 
+	viewer, err := server.assetViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !viewer.CanSee(ast.Visibility) {
+		return nil, status.Error(codes.NotFound, star.NotFoundError{AssetID: req.GetAssetId(), UserID: userID}.Error())
+	}
+
 	astPB, err := assetToProto(ast, false)
 	if err != nil {
 		return nil, internalServerError(server.logger, err.Error())
@@ -248,7 +275,7 @@ func (server *APIServer) buildGetDiscussionsFilter(req *compassv1beta1.GetMyDisc
 
 // userToProto transforms struct with some fields only to proto
 func userToProto(u user.User) *compassv1beta1.User {
-	if u == (user.User{}) {
+	if u.IsZero() {
 		return nil
 	}
 	return &compassv1beta1.User{
@@ -259,7 +286,7 @@ func userToProto(u user.User) *compassv1beta1.User {
 
 // userToFullProto transforms struct with all fields to proto
 func userToFullProto(u user.User) *compassv1beta1.User {
-	if u == (user.User{}) {
+	if u.IsZero() {
 		return nil
 	}
 	var createdAtPB *timestamppb.Timestamp
@@ -42,28 +42,28 @@ func TestGetUserStarredAssets(t *testing.T) {
 			Description:  "should return internal server error if failed to fetch starred",
 			ExpectStatus: codes.Internal,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, errors.New("failed to fetch starred"))
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, errors.New("failed to fetch starred"))
 			},
 		},
 		{
 			Description:  "should return invalid argument if star repository return invalid error",
 			ExpectStatus: codes.InvalidArgument,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, star.InvalidError{})
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, star.InvalidError{})
 			},
 		},
 		{
 			Description:  "should return not found if starred not found",
 			ExpectStatus: codes.NotFound,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, star.NotFoundError{})
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, star.NotFoundError{})
 			},
 		},
 		{
 			Description:  "should return starred assets of a user if no error",
 			ExpectStatus: codes.OK,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return([]asset.Asset{
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return([]asset.Asset{
 					{ID: "1", URN: "asset-urn-1", Type: "asset-type"},
 					{ID: "2", URN: "asset-urn-2", Type: "asset-type"},
 					{ID: "3", URN: "asset-urn-3", Type: "asset-type"},
@@ -154,28 +154,28 @@ func TestGetMyStarredAssets(t *testing.T) {
 			Description:  "should return internal server error if failed to fetch starred",
 			ExpectStatus: codes.Internal,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, errors.New("failed to fetch starred"))
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, errors.New("failed to fetch starred"))
 			},
 		},
 		{
 			Description:  "should return invalid argument if star repository return invalid error",
 			ExpectStatus: codes.InvalidArgument,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, star.InvalidError{})
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, star.InvalidError{})
 			},
 		},
 		{
 			Description:  "should return not found if starred not found",
 			ExpectStatus: codes.NotFound,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return(nil, star.NotFoundError{})
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return(nil, star.NotFoundError{})
 			},
 		},
 		{
 			Description:  "should return starred assets of a user if no error",
 			ExpectStatus: codes.OK,
 			Setup: func(ctx context.Context, ss *mocks.StarService) {
-				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size}, userID).Return([]asset.Asset{
+				ss.EXPECT().GetStarredAssetsByUserID(ctx, star.Filter{Offset: offset, Size: size, Viewer: &asset.Viewer{}}, userID).Return([]asset.Asset{
 					{ID: "1", URN: "asset-urn-1", Type: "asset-type"},
 					{ID: "2", URN: "asset-urn-2", Type: "asset-type"},
 					{ID: "3", URN: "asset-urn-3", Type: "asset-type"},
@@ -283,6 +283,16 @@ func TestGetMyStarredAsset(t *testing.T) {
 				ss.EXPECT().GetStarredAssetByUserID(ctx, userID, assetID).Return(asset.Asset{}, star.NotFoundError{})
 			},
 		},
+		{
+			Description:  "should return not found if the starred asset is restricted to groups of others",
+			ExpectStatus: codes.NotFound,
+			Setup: func(ctx context.Context, ss *mocks.StarService) {
+				ss.EXPECT().GetStarredAssetByUserID(ctx, userID, assetID).Return(asset.Asset{
+					ID:         assetID,
+					Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+				}, nil)
+			},
+		},
 		{
 			Description:  "should return internal server error if failed to fetch a starred asset",
 			ExpectStatus: codes.Internal,
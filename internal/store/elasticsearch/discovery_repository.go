@@ -9,6 +9,8 @@ import (
 	"io"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/goto/compass/core/asset"
@@ -24,18 +26,36 @@ type DiscoveryRepository struct {
 	logger                    log.Logger
 	requestTimeout            time.Duration
 	columnSearchExclusionList []string
+	embedder                  asset.Embedder
+	semanticCfg               SemanticConfig
+	routedTypes               []asset.Type
+	// embeddingMapped holds the indices and aliases the embedding field is
+	// known to be mapped in
+	embeddingMapped sync.Map
+	// claimedIndices holds the indices of the routed types known to hold all
+	// the assets of their type and service
+	claimedIndices sync.Map
+	// unroutedUnaliased is set once the indices of the types no longer
+	// routed are left out of the searches
+	unroutedUnaliased atomic.Bool
 }
 
-func NewDiscoveryRepository(cli *Client, logger log.Logger, requestTimeout time.Duration, colSearchExclusionList []string) *DiscoveryRepository {
-	return &DiscoveryRepository{
+func NewDiscoveryRepository(cli *Client, logger log.Logger, requestTimeout time.Duration, colSearchExclusionList []string, opts ...DiscoveryRepositoryOption) *DiscoveryRepository {
+	repo := &DiscoveryRepository{
 		cli:                       cli,
 		logger:                    logger,
 		requestTimeout:            requestTimeout,
 		columnSearchExclusionList: colSearchExclusionList,
 	}
+
+	for _, opt := range opts {
+		opt(repo)
+	}
+
+	return repo
 }
 
-func (repo *DiscoveryRepository) createIndexIfNotExists(ctx context.Context, discoveryOp, indexName, alias string) error {
+func (repo *DiscoveryRepository) createIndexIfNotExists(ctx context.Context, discoveryOp, indexName string, typ asset.Type, aliases []string) error {
 	idxExists, err := repo.cli.indexExists(ctx, discoveryOp, indexName)
 	if err != nil {
 		return asset.DiscoveryError{
@@ -45,8 +65,18 @@ func (repo *DiscoveryRepository) createIndexIfNotExists(ctx context.Context, dis
 		}
 	}
 
+	if idxExists {
+		// indices created before semantic search was enabled lack the
+		// embedding field, the assets are still indexed if it can not be
+		// added
+		if err := repo.ensureEmbeddingMapping(ctx, indexName); err != nil {
+			repo.logger.Warn("failed to map embedding field, sync the service to recreate the index",
+				"index", indexName, "err", err)
+		}
+	}
+
 	if !idxExists {
-		if err := repo.cli.CreateIdx(ctx, discoveryOp, indexName, alias); err != nil {
+		if err := repo.cli.createIdx(ctx, discoveryOp, indexName, aliases, repo.indexMapping(typ)); err != nil {
 			var de asset.DiscoveryError
 			if ok := errors.As(err, &de); ok {
 				if de.ESCode == "resource_already_exists_exception" {
@@ -74,15 +104,73 @@ func (repo *DiscoveryRepository) Upsert(ctx context.Context, ast asset.Asset) er
 		return fmt.Errorf("type [%s] is invalid: %w", ast.Type, asset.ErrUnknownType)
 	}
 
-	if err := repo.createIndexIfNotExists(ctx, "Upsert", ast.Service, defaultSearchIndex); err != nil {
+	if err := repo.createIndexIfNotExists(ctx, "Upsert", repo.indexName(ast), ast.Type, repo.indexAliases(ast.Type)); err != nil {
 		return err
 	}
+	// the assets are still indexed if the indices can not be rearranged,
+	// it is attempted again on the next upsert
+	if err := repo.unaliasUnroutedIndices(ctx); err != nil {
+		repo.logger.Warn("failed to unalias the indices of unrouted types", "err", err)
+	}
+	if repo.isRoutedType(ast.Type) {
+		if err := repo.claimRoutedAssets(ctx, ast.Service, ast.Type); err != nil {
+			repo.logger.Warn("failed to move assets to the index of their type",
+				"service", ast.Service, "type", ast.Type, "err", err)
+		}
+	}
 
 	return repo.indexAsset(ctx, ast)
 }
 
-func (repo *DiscoveryRepository) SyncAssets(ctx context.Context, indexName string) (func() error, error) {
+// SyncAssets recreates the indices of the service, the assets of the service
+// are meant to be upserted again before calling the returned cleanup. Until
+// then, searches are served by backups of the indices.
+func (repo *DiscoveryRepository) SyncAssets(ctx context.Context, service string) (func() error, error) {
+	if err := repo.unaliasUnroutedIndices(ctx); err != nil {
+		return nil, err
+	}
+
+	indices := repo.serviceIndices(service)
+	cleanupFns := make([]func() error, 0, len(indices))
+	for _, idx := range indices {
+		cleanupFn, err := repo.syncIndex(ctx, idx)
+		if err != nil {
+			return nil, err
+		}
+		cleanupFns = append(cleanupFns, cleanupFn)
+	}
+
+	return func() error {
+		for _, cleanupFn := range cleanupFns {
+			if err := cleanupFn(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func (repo *DiscoveryRepository) syncIndex(ctx context.Context, idx serviceIndex) (func() error, error) {
+	indexName := idx.name
 	backupIndexName := fmt.Sprintf("%+v-bak", indexName)
+	aliases := repo.indexAliases(idx.typ)
+
+	// the index of a routed type does not exist until the routing is
+	// configured, there is nothing to back up then
+	if idx.typ != "" {
+		exists, err := repo.cli.indexExists(ctx, "SyncAssets", indexName)
+		if err != nil {
+			return nil, asset.DiscoveryError{
+				Op:    "IndexExists",
+				Index: indexName,
+				Err:   err,
+			}
+		}
+		if !exists {
+			err := repo.createIndexIfNotExists(ctx, "SyncAssets", indexName, idx.typ, aliases)
+			return func() error { return nil }, err
+		}
+	}
 
 	err := repo.updateIndexSettings(ctx, indexName, `{"settings":{"index.blocks.write":true}}`)
 	if err != nil {
@@ -94,9 +182,10 @@ func (repo *DiscoveryRepository) SyncAssets(ctx context.Context, indexName strin
 		return nil, err
 	}
 
-	err = repo.updateAlias(ctx, backupIndexName, defaultSearchIndex)
-	if err != nil {
-		return nil, err
+	for _, alias := range aliases {
+		if err := repo.updateAlias(ctx, backupIndexName, alias); err != nil {
+			return nil, err
+		}
 	}
 
 	err = repo.deleteByIndexName(ctx, indexName)
@@ -104,15 +193,16 @@ func (repo *DiscoveryRepository) SyncAssets(ctx context.Context, indexName strin
 		return nil, err
 	}
 
-	err = repo.createIndexIfNotExists(ctx, "SyncAssets", indexName, "")
+	err = repo.createIndexIfNotExists(ctx, "SyncAssets", indexName, idx.typ, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	cleanupFn := func() error {
-		err = repo.updateAlias(ctx, indexName, defaultSearchIndex)
-		if err != nil {
-			return err
+		for _, alias := range aliases {
+			if err := repo.updateAlias(ctx, indexName, alias); err != nil {
+				return err
+			}
 		}
 
 		err = repo.deleteByIndexName(ctx, backupIndexName)
@@ -135,7 +225,7 @@ func (repo *DiscoveryRepository) DeleteByID(ctx context.Context, assetID string)
 		return asset.ErrEmptyID
 	}
 
-	return repo.deleteWithQuery(ctx, "DeleteByID", fmt.Sprintf(`{"query":{"term":{"_id": %q}}}`, assetID))
+	return repo.deleteWithQuery(ctx, defaultSearchIndex, "DeleteByID", fmt.Sprintf(`{"query":{"term":{"_id": %q}}}`, assetID))
 }
 
 func (repo *DiscoveryRepository) DeleteByURN(ctx context.Context, assetURN string) error {
@@ -143,7 +233,7 @@ func (repo *DiscoveryRepository) DeleteByURN(ctx context.Context, assetURN strin
 		return asset.ErrEmptyURN
 	}
 
-	return repo.deleteWithQuery(ctx, "DeleteByURN", fmt.Sprintf(`{"query":{"term":{"urn.keyword": %q}}}`, assetURN))
+	return repo.deleteWithQuery(ctx, defaultSearchIndex, "DeleteByURN", fmt.Sprintf(`{"query":{"term":{"urn.keyword": %q}}}`, assetURN))
 }
 
 func (repo *DiscoveryRepository) SoftDeleteByURN(ctx context.Context, params asset.SoftDeleteAssetParams) error {
@@ -164,7 +254,7 @@ func (repo *DiscoveryRepository) DeleteByQueryExpr(ctx context.Context, queryExp
 		return err
 	}
 
-	return repo.deleteWithQuery(ctx, "DeleteByQueryExpr", esQuery)
+	return repo.deleteWithQuery(ctx, defaultSearchIndex, "DeleteByQueryExpr", esQuery)
 }
 
 func (repo *DiscoveryRepository) DeleteByIsDeletedAndServicesAndUpdatedAt(
@@ -210,7 +300,7 @@ func (repo *DiscoveryRepository) DeleteByIsDeletedAndServicesAndUpdatedAt(
 			}
 		}`, thresholdUpdatedAt, isDeleted)
 	}
-	return repo.deleteWithQuery(ctx, "DeleteByIsDeletedAndServicesAndUpdatedAt", query)
+	return repo.deleteWithQuery(ctx, defaultSearchIndex, "DeleteByIsDeletedAndServicesAndUpdatedAt", query)
 }
 
 func (repo *DiscoveryRepository) SoftDeleteAssets(ctx context.Context, assets []asset.Asset, doUpdateVersion bool) error {
@@ -244,7 +334,7 @@ func (repo *DiscoveryRepository) bulkSoftDeleteAssets(
 			}
 		}
 
-		meta := fmt.Sprintf(`{ "update": { "_index": %q, "_id": %q } }`, a.Service, a.ID)
+		meta := fmt.Sprintf(`{ "update": { "_index": %q, "_id": %q } }`, repo.indexName(a), a.ID)
 		update := map[string]interface{}{
 			"doc": map[string]interface{}{
 				"is_deleted":   true,
@@ -284,7 +374,7 @@ func (repo *DiscoveryRepository) sendBulkUpdate(ctx context.Context, bulkOps []s
 	return nil
 }
 
-func (repo *DiscoveryRepository) deleteWithQuery(ctx context.Context, discoveryOp, qry string) (err error) {
+func (repo *DiscoveryRepository) deleteWithQuery(ctx context.Context, index, discoveryOp, qry string) (err error) {
 	defer func(start time.Time) {
 		const op = "delete_by_query"
 		repo.cli.instrumentOp(ctx, instrumentParams{
@@ -297,7 +387,7 @@ func (repo *DiscoveryRepository) deleteWithQuery(ctx context.Context, discoveryO
 
 	deleteByQ := repo.cli.client.DeleteByQuery
 	res, err := deleteByQ(
-		[]string{defaultSearchIndex},
+		[]string{index},
 		strings.NewReader(qry),
 		deleteByQ.WithContext(ctx),
 		deleteByQ.WithRefresh(true),
@@ -408,7 +498,7 @@ func (repo *DiscoveryRepository) indexAsset(ctx context.Context, ast asset.Asset
 		})
 	}(time.Now())
 
-	body, err := encodeBodyRequest(ast)
+	body, err := encodeBodyRequest(repo.toIndexedAsset(ctx, ast))
 	if err != nil {
 		return asset.DiscoveryError{
 			Op:  "EncodeAsset",
@@ -417,9 +507,10 @@ func (repo *DiscoveryRepository) indexAsset(ctx context.Context, ast asset.Asset
 		}
 	}
 
+	indexName := repo.indexName(ast)
 	index := repo.cli.client.Index
 	resp, err := index(
-		ast.Service,
+		indexName,
 		body,
 		index.WithDocumentID(url.PathEscape(ast.ID)),
 		index.WithContext(ctx),
@@ -428,7 +519,7 @@ func (repo *DiscoveryRepository) indexAsset(ctx context.Context, ast asset.Asset
 		return asset.DiscoveryError{
 			Op:    "IndexDoc",
 			ID:    ast.ID,
-			Index: ast.Service,
+			Index: indexName,
 			Err:   err,
 		}
 	}
@@ -439,7 +530,7 @@ func (repo *DiscoveryRepository) indexAsset(ctx context.Context, ast asset.Asset
 		return asset.DiscoveryError{
 			Op:     "IndexDoc",
 			ID:     ast.ID,
-			Index:  ast.Service,
+			Index:  indexName,
 			ESCode: code,
 			Err:    errors.New(reason),
 		}
@@ -448,6 +539,102 @@ func (repo *DiscoveryRepository) indexAsset(ctx context.Context, ast asset.Asset
 	return nil
 }
 
+// indexedAsset is the document stored in the index for an asset.
+type indexedAsset struct {
+	asset.Asset
+	Embedding []float32 `json:"embedding,omitempty"`
+}
+
+// toIndexedAsset attaches the embedding of the asset when semantic search is
+// enabled. Failing to embed is not fatal, the asset stays searchable by
+// keywords and is embedded on its next update.
+func (repo *DiscoveryRepository) toIndexedAsset(ctx context.Context, ast asset.Asset) indexedAsset {
+	doc := indexedAsset{Asset: ast}
+	if repo.embedder == nil {
+		return doc
+	}
+
+	text := asset.EmbeddingText(ast)
+	if text == "" {
+		return doc
+	}
+
+	vec, err := repo.embedder.Embed(ctx, text)
+	if err != nil {
+		repo.logger.Warn("failed to embed asset", "id", ast.ID, "urn", ast.URN, "err", err)
+		return doc
+	}
+	if !isZeroVector(vec) {
+		doc.Embedding = vec
+	}
+
+	return doc
+}
+
+// ensureEmbeddingMapping adds the embedding field to the mapping of the
+// indices behind the target when semantic search is enabled. Fields can be
+// added to the mapping of an existing index, unless the embeddings were
+// already indexed before and mapped as plain floats.
+func (repo *DiscoveryRepository) ensureEmbeddingMapping(ctx context.Context, target string) error {
+	if repo.embedder == nil {
+		return nil
+	}
+	if _, ok := repo.embeddingMapped.Load(target); ok {
+		return nil
+	}
+
+	body := fmt.Sprintf(`{"properties":{%q:{"type":"dense_vector","dims":%d}}}`, embeddingField, repo.embedder.Dims())
+	putMapping := repo.cli.client.Indices.PutMapping
+	resp, err := putMapping(strings.NewReader(body),
+		putMapping.WithIndex(target),
+		putMapping.WithContext(ctx))
+	if err != nil {
+		return asset.DiscoveryError{
+			Op:    "PutMapping",
+			Index: target,
+			Err:   err,
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		code, reason := errorCodeAndReason(resp)
+		return asset.DiscoveryError{
+			Op:     "PutMapping",
+			Index:  target,
+			ESCode: code,
+			Err:    errors.New(reason),
+		}
+	}
+
+	repo.embeddingMapped.Store(target, struct{}{})
+	return nil
+}
+
+// indexMapping returns the mapping of the index storing assets of the type.
+// Only the index of a routed type has the properties specific to its type.
+func (repo *DiscoveryRepository) indexMapping(typ asset.Type) string {
+	mapping := serviceIndexMapping
+	if repo.embedder != nil {
+		mapping = buildServiceIndexMapping(repo.embedder.Dims())
+	}
+	if repo.isRoutedType(typ) {
+		mapping = withTypeProperties(mapping, typ)
+	}
+	return mapping
+}
+
+// isZeroVector reports whether vec has no magnitude, such a vector can not
+// be compared with cosine similarity.
+func isZeroVector(vec []float32) bool {
+	for _, v := range vec {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func encodeBodyRequest(body interface{}) (io.Reader, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(body); err != nil {
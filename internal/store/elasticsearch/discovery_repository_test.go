@@ -12,6 +12,7 @@ import (
 	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/user"
 	store "github.com/goto/compass/internal/store/elasticsearch"
+	"github.com/goto/compass/internal/testutils"
 	"github.com/goto/compass/pkg/queryexpr"
 	"github.com/goto/salt/log"
 	"github.com/olivere/elastic/v7"
@@ -809,6 +810,176 @@ func TestDiscoveryRepository_SyncAssets(t *testing.T) {
 	})
 }
 
+func TestDiscoveryRepository_TypeIndices(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = "routing-test"
+		table   = asset.Asset{ID: "table-1", URN: "urn:table-1", Name: "orders", Type: asset.Type("table"), Service: service}
+		topic   = asset.Asset{ID: "topic-1", URN: "urn:topic-1", Name: "orders", Type: asset.Type("topic"), Service: service}
+	)
+
+	cli, err := esTestServer.NewClient()
+	require.NoError(t, err)
+	esClient, err := store.NewClient(
+		log.NewNoop(),
+		store.Config{},
+		store.WithClient(cli),
+	)
+	require.NoError(t, err)
+
+	repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"},
+		store.WithTypeIndices([]string{"table"}))
+
+	t.Run("should store routed type in its own index", func(t *testing.T) {
+		require.NoError(t, repo.Upsert(ctx, table))
+		require.NoError(t, repo.Upsert(ctx, topic))
+
+		res, err := cli.Indices.Exists([]string{"routing-test__table"})
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+
+		res, err = cli.Get("routing-test__table", "table-1")
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+
+		res, err = cli.Get("routing-test", "topic-1")
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+
+		res, err = cli.Get("routing-test", "table-1")
+		require.NoError(t, err)
+		assert.Equal(t, 404, res.StatusCode)
+	})
+
+	t.Run("should search routed types only in their indices", func(t *testing.T) {
+		_, err := cli.Indices.Refresh(cli.Indices.Refresh.WithIndex("universe"))
+		require.NoError(t, err)
+
+		results, err := repo.Search(ctx, asset.SearchConfig{
+			Text:    "orders",
+			Filters: map[string][]string{"type": {"table"}, "service": {service}},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "table-1", results[0].ID)
+
+		results, err = repo.Search(ctx, asset.SearchConfig{
+			Text:    "orders",
+			Filters: map[string][]string{"service": {service}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("should sync every index of the service", func(t *testing.T) {
+		cleanupFn, err := repo.SyncAssets(ctx, service)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Upsert(ctx, table))
+		require.NoError(t, repo.Upsert(ctx, topic))
+		require.NoError(t, cleanupFn())
+
+		for _, idx := range []string{"routing-test", "routing-test__table"} {
+			res, err := cli.Indices.Exists([]string{idx})
+			require.NoError(t, err)
+			assert.Equal(t, 200, res.StatusCode)
+
+			res, err = cli.Indices.Exists([]string{idx + "-bak"})
+			require.NoError(t, err)
+			assert.Equal(t, 404, res.StatusCode)
+		}
+
+		res, err := cli.Indices.ExistsAlias([]string{"universe__table"}, cli.Indices.ExistsAlias.WithIndex("routing-test__table"))
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+	})
+}
+
+func TestDiscoveryRepository_TypeIndicesChange(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		service = "rerouting-test"
+		table1  = asset.Asset{ID: "rerouted-1", URN: "urn:rerouted-1", Name: "payments", Type: asset.Type("table"), Service: service}
+		table2  = asset.Asset{ID: "rerouted-2", URN: "urn:rerouted-2", Name: "payments", Type: asset.Type("table"), Service: service}
+	)
+
+	cli, err := esTestServer.NewClient()
+	require.NoError(t, err)
+	esClient, err := store.NewClient(
+		log.NewNoop(),
+		store.Config{},
+		store.WithClient(cli),
+	)
+	require.NoError(t, err)
+
+	search := func(t *testing.T, repo *store.DiscoveryRepository) []asset.SearchResult {
+		t.Helper()
+
+		_, err := cli.Indices.Refresh(cli.Indices.Refresh.WithIndex("universe"))
+		require.NoError(t, err)
+
+		results, err := repo.Search(ctx, asset.SearchConfig{
+			Text:    "payments",
+			Filters: map[string][]string{"service": {service}},
+		})
+		require.NoError(t, err)
+		return results
+	}
+
+	t.Run("should move the assets of a newly routed type to its index", func(t *testing.T) {
+		repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"})
+		require.NoError(t, repo.Upsert(ctx, table1))
+
+		routedRepo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"},
+			store.WithTypeIndices([]string{"table"}))
+		require.NoError(t, routedRepo.Upsert(ctx, table2))
+
+		res, err := cli.Get("rerouting-test__table", "rerouted-1")
+		require.NoError(t, err)
+		assert.Equal(t, 200, res.StatusCode)
+
+		res, err = cli.Get("rerouting-test", "rerouted-1")
+		require.NoError(t, err)
+		assert.Equal(t, 404, res.StatusCode)
+
+		assert.Len(t, search(t, routedRepo), 2)
+	})
+
+	t.Run("should unalias the index of a type no longer routed", func(t *testing.T) {
+		repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"})
+		require.NoError(t, repo.Upsert(ctx, table1))
+
+		for _, alias := range []string{"universe", "universe__table"} {
+			res, err := cli.Indices.ExistsAlias([]string{alias}, cli.Indices.ExistsAlias.WithIndex("rerouting-test__table"))
+			require.NoError(t, err)
+			assert.Equal(t, 404, res.StatusCode)
+		}
+
+		results := search(t, repo)
+		require.Len(t, results, 1)
+		assert.Equal(t, "rerouted-1", results[0].ID)
+	})
+}
+
+func TestDiscoveryRepository_Behavior(t *testing.T) {
+	cli, err := esTestServer.NewClient()
+	require.NoError(t, err)
+	esClient, err := store.NewClient(
+		log.NewNoop(),
+		store.Config{},
+		store.WithClient(cli),
+	)
+	require.NoError(t, err)
+
+	testutils.RunDiscoveryRepositoryTests(t, testutils.DiscoveryRepositoryHarness{
+		Repo: store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"}),
+		Refresh: func(t *testing.T) {
+			_, err := cli.Indices.Refresh(cli.Indices.Refresh.WithIgnoreUnavailable(true))
+			require.NoError(t, err)
+		},
+	})
+}
+
 func TestDiscoveryRepository_DeleteByIsDeletedAndServicesAndUpdatedAt(t *testing.T) {
 	ctx := context.Background()
 	bigqueryService := "bigquery-test"
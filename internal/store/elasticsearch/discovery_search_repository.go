@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,6 +22,15 @@ const (
 	defaultMinScore                    = 0.01
 	defaultFunctionScoreQueryScoreMode = "sum"
 	suggesterName                      = "name-phrase-suggest"
+	suggestSize                        = 5
+	// restrictedSuggestSize is the number of suggestions fetched for the
+	// viewers not allowed to see all assets, before leaving out the ones
+	// of the assets they can not see
+	restrictedSuggestSize = 50
+
+	// maxExplanationDepth limits how deep the score explanation of a
+	// search result is summarised
+	maxExplanationDepth = 4
 )
 
 // Search the asset store
@@ -53,7 +64,7 @@ func (repo *DiscoveryRepository) Search(ctx context.Context, cfg asset.SearchCon
 		})
 	}(time.Now())
 
-	query, err := repo.buildQuery(cfg)
+	query, err := repo.buildQuery(cfg, repo.queryEmbedding(ctx, cfg))
 	if err != nil {
 		return nil, asset.DiscoveryError{Op: "Search", Err: fmt.Errorf("build query: %w", err)}
 	}
@@ -62,11 +73,12 @@ func (repo *DiscoveryRepository) Search(ctx context.Context, cfg asset.SearchCon
 
 	res, err := search(
 		search.WithBody(query),
-		search.WithIndex(defaultSearchIndex),
+		search.WithIndex(repo.searchIndices(cfg.Filters)...),
 		search.WithSize(maxResults),
 		search.WithFrom(offset),
 		search.WithIgnoreUnavailable(true),
 		search.WithSourceIncludes(returnedAssetFieldsResult...),
+		search.WithExplain(cfg.Flags.EnableExplain),
 		search.WithContext(ctx),
 		search.WithTimeout(repo.requestTimeout),
 	)
@@ -189,7 +201,7 @@ func (repo *DiscoveryRepository) Suggest(ctx context.Context, config asset.Searc
 		return nil, asset.DiscoveryError{Op: "Suggest", Err: fmt.Errorf("decode search response: %w", err)}
 	}
 
-	results, err = toSuggestions(response)
+	results, err = toSuggestions(response, config.Viewer)
 	if err != nil {
 		return nil, asset.DiscoveryError{Op: "Suggest", Err: fmt.Errorf("map response to suggestion: %w", err)}
 	}
@@ -227,6 +239,7 @@ func (repo *DiscoveryRepository) buildColumnQuery(query *elastic.BoolQuery, cfg
 		[]string{
 			"data.columns.name^10",
 			"data.columns.description",
+			"data.columns.tags.*",
 		}...,
 	).Type("phrase")
 
@@ -235,6 +248,7 @@ func (repo *DiscoveryRepository) buildColumnQuery(query *elastic.BoolQuery, cfg
 		[]string{
 			"data.columns.name^5",
 			"data.columns.description",
+			"data.columns.tags.*",
 		}...,
 	)
 
@@ -243,6 +257,7 @@ func (repo *DiscoveryRepository) buildColumnQuery(query *elastic.BoolQuery, cfg
 		[]string{
 			"data.columns.name^5",
 			"data.columns.description",
+			"data.columns.tags.*",
 		}...,
 	).Operator("and")
 
@@ -264,6 +279,7 @@ func (repo *DiscoveryRepository) buildColumnQuery(query *elastic.BoolQuery, cfg
 			Order("score").
 			Field("data.columns.name").
 			Field("data.columns.description").
+			Field("data.columns.tags.*").
 			HighlightQuery(
 				elastic.NewBoolQuery().
 					Should(highlightQuery...),
@@ -273,7 +289,35 @@ func (repo *DiscoveryRepository) buildColumnQuery(query *elastic.BoolQuery, cfg
 	return nil
 }
 
-func (repo *DiscoveryRepository) buildQuery(cfg asset.SearchConfig) (io.Reader, error) {
+// queryEmbedding returns the embedding of the search text when semantic
+// search is requested and enabled, nil otherwise. Searches fall back to
+// keywords only if the text can not be embedded or the embedding field can
+// not be mapped.
+func (repo *DiscoveryRepository) queryEmbedding(ctx context.Context, cfg asset.SearchConfig) []float32 {
+	if repo.embedder == nil || !cfg.Flags.EnableSemantic || cfg.Flags.IsColumnSearch || strings.TrimSpace(cfg.Text) == "" {
+		return nil
+	}
+
+	// the similarity can not be computed on the indices lacking the mapping
+	// of the embedding field
+	if err := repo.ensureEmbeddingMapping(ctx, defaultSearchIndex); err != nil {
+		repo.logger.Warn("embedding field is not mapped, searching by keywords only", "err", err)
+		return nil
+	}
+
+	vec, err := repo.embedder.Embed(ctx, cfg.Text)
+	if err != nil {
+		repo.logger.Warn("failed to embed search text, searching by keywords only", "err", err)
+		return nil
+	}
+	if isZeroVector(vec) {
+		return nil
+	}
+
+	return vec
+}
+
+func (repo *DiscoveryRepository) buildQuery(cfg asset.SearchConfig, queryVector []float32) (io.Reader, error) {
 	boolQuery := elastic.NewBoolQuery()
 	var highlightQuery *elastic.Highlight
 	field := ""
@@ -289,6 +333,9 @@ func (repo *DiscoveryRepository) buildQuery(cfg asset.SearchConfig) (io.Reader,
 		} else {
 			field = "name"
 			buildTextQuery(boolQuery, cfg)
+			if len(queryVector) > 0 {
+				boolQuery.Should(buildSemanticQuery(queryVector, repo.semanticCfg))
+			}
 			highlightQuery = buildHighlightQuery(cfg)
 		}
 	}
@@ -305,8 +352,9 @@ func (repo *DiscoveryRepository) buildQuery(cfg asset.SearchConfig) (io.Reader,
 	}
 
 	buildFilterTermQueries(boolQuery, cfg.Filters)
+	buildVisibilityFilter(boolQuery, cfg.Viewer)
 	buildMustMatchQueries(boolQuery, cfg)
-	query := buildFunctionScoreQuery(boolQuery, cfg.RankBy, cfg.Text, field)
+	query := buildFunctionScoreQuery(boolQuery, cfg.RankBy, cfg.Text, field, cfg.TypeBoosts)
 
 	body, err := elastic.NewSearchRequest().
 		Query(query).
@@ -321,13 +369,20 @@ func (repo *DiscoveryRepository) buildQuery(cfg asset.SearchConfig) (io.Reader,
 }
 
 func buildSuggestQuery(cfg asset.SearchConfig) (io.Reader, error) {
+	// completion suggesters can not be filtered by a query, the suggestions
+	// of the assets the viewer can not see are left out from the response
+	size := suggestSize
+	if cfg.Viewer.IsRestricted() {
+		size = restrictedSuggestSize
+	}
 	suggester := elastic.NewCompletionSuggester(suggesterName).
 		Field("name.suggest").
 		SkipDuplicates(true).
-		Size(5).
+		Size(size).
 		Text(cfg.Text)
 	src, err := elastic.NewSearchSource().
 		Suggester(suggester).
+		FetchSourceContext(elastic.NewFetchSourceContext(true).Include("visibility")).
 		Source()
 	if err != nil {
 		return nil, fmt.Errorf("error building search source %w", err)
@@ -363,6 +418,20 @@ func buildTextQuery(q *elastic.BoolQuery, cfg asset.SearchConfig) {
 	}
 }
 
+// buildSemanticQuery scores assets by the cosine similarity of their
+// embedding to the query vector. Being a should clause, its score adds up
+// with the keyword relevance, and assets that are semantically close match
+// even without sharing a keyword with the search text.
+func buildSemanticQuery(queryVector []float32, cfg SemanticConfig) elastic.Query {
+	// cosine similarity is shifted by 1 since scores can not be negative
+	script := elastic.NewScript(fmt.Sprintf("params.weight * (cosineSimilarity(params.query_vector, '%s') + 1.0)", embeddingField)).
+		Param("query_vector", queryVector).
+		Param("weight", cfg.Weight)
+
+	return elastic.NewScriptScoreQuery(elastic.NewExistsQuery(embeddingField), script).
+		MinScore(cfg.Weight * (cfg.MinSimilarity + 1.0))
+}
+
 func convertQueryValue(value string) any {
 	var v interface{}
 	switch value {
@@ -428,6 +497,28 @@ func buildFilterTermQueries(q *elastic.BoolQuery, filters map[string][]string) {
 	}
 }
 
+// buildVisibilityFilter leaves out the assets restricted to groups the
+// viewer is not a member of, for them to neither be counted nor returned
+func buildVisibilityFilter(q *elastic.BoolQuery, viewer *asset.Viewer) {
+	if !viewer.IsRestricted() {
+		return
+	}
+
+	visible := elastic.NewBoolQuery().
+		Should(elastic.NewBoolQuery().MustNot(
+			elastic.NewTermQuery("visibility.kind.keyword", asset.VisibilityRestricted),
+		)).
+		MinimumShouldMatch("1")
+	if len(viewer.Groups) > 0 {
+		groups := make([]interface{}, len(viewer.Groups))
+		for i, g := range viewer.Groups {
+			groups[i] = g
+		}
+		visible.Should(elastic.NewTermsQuery("visibility.groups.keyword", groups...))
+	}
+	q.Filter(visible)
+}
+
 func buildFilterExistsQueries(q *elastic.BoolQuery, fields []string) {
 	if len(fields) == 0 {
 		return
@@ -438,7 +529,7 @@ func buildFilterExistsQueries(q *elastic.BoolQuery, fields []string) {
 	}
 }
 
-func buildFunctionScoreQuery(query elastic.Query, rankBy, text, field string) elastic.Query {
+func buildFunctionScoreQuery(query elastic.Query, rankBy, text, field string, typeBoosts map[asset.Type]float64) elastic.Query {
 	fs := elastic.NewFunctionScoreQuery().
 		Query(query).
 		ScoreMode(defaultFunctionScoreQueryScoreMode).
@@ -471,6 +562,13 @@ func buildFunctionScoreQuery(query elastic.Query, rankBy, text, field string) el
 		)
 	}
 
+	// default boost of the registered types, sorted for a stable query
+	for _, typ := range slices.Sorted(maps.Keys(typeBoosts)) {
+		if boost := typeBoosts[typ]; boost > 0 {
+			fs = fs.Add(elastic.NewTermQuery("type.keyword", typ.String()), elastic.NewWeightFactorFunction(boost))
+		}
+	}
+
 	// always consider query_count, but rankBy (if provided) should have higher weight
 	fs = fs.AddScoreFunc(
 		elastic.NewFieldValueFactorFunction().
@@ -509,22 +607,149 @@ func toSearchResults(hits []searchHit) []asset.SearchResult {
 			}
 		}
 
+		var score float64
+		if hit.Score != nil {
+			score = *hit.Score
+		}
+		highlights := toHighlights(hit.HighLight)
+
 		results[i] = asset.SearchResult{
-			Type:        r.Type.String(),
-			ID:          id,
-			URN:         r.URN,
-			Description: r.Description,
-			Title:       r.Name,
-			Service:     r.Service,
-			Labels:      r.Labels,
-			Data:        data,
-			IsDeleted:   r.IsDeleted,
+			Type:           r.Type.String(),
+			ID:             id,
+			URN:            r.URN,
+			Description:    r.Description,
+			Title:          r.Name,
+			Service:        r.Service,
+			Labels:         r.Labels,
+			Data:           data,
+			IsDeleted:      r.IsDeleted,
+			Score:          score,
+			Highlights:     highlights,
+			MatchedColumns: matchedColumns(r.Data, highlights),
+			Explanation:    toSearchExplanation(hit.Explanation, maxExplanationDepth),
 		}
 	}
 	return results
 }
 
-func toSuggestions(response searchResponse) ([]string, error) {
+func toHighlights(hl map[string]interface{}) map[string][]string {
+	if len(hl) == 0 {
+		return nil
+	}
+
+	highlights := make(map[string][]string, len(hl))
+	for field, raw := range hl {
+		rawFragments, ok := raw.([]interface{})
+		if !ok {
+			continue
+		}
+		fragments := make([]string, 0, len(rawFragments))
+		for _, f := range rawFragments {
+			if s, ok := f.(string); ok {
+				fragments = append(fragments, s)
+			}
+		}
+		highlights[field] = fragments
+	}
+	return highlights
+}
+
+// matchedColumns resolves the highlighted column fragments back to the
+// columns of the asset. Fragments are matched against the column values with
+// the highlight tags removed, which requires data.columns to be part of the
+// returned fields. Columns are also matched on the values of their tags.
+func matchedColumns(data map[string]interface{}, highlights map[string][]string) []string {
+	if len(highlights) == 0 {
+		return nil
+	}
+	columns, ok := data["columns"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var matched []string
+	for _, c := range columns {
+		col, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := col["name"].(string)
+		if name == "" {
+			continue
+		}
+		description, _ := col["description"].(string)
+		if containsFragment(name, highlights["data.columns.name"]) ||
+			containsFragment(description, highlights["data.columns.description"]) ||
+			hasHighlightedTag(col, highlights) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+// hasHighlightedTag reports whether a value of a tag of the column is
+// highlighted, by template and field urn.
+func hasHighlightedTag(col map[string]interface{}, highlights map[string][]string) bool {
+	tags, _ := col["tags"].(map[string]interface{})
+	for templateURN, t := range tags {
+		values, _ := t.(map[string]interface{})
+		for fieldURN, v := range values {
+			fragments := highlights["data.columns.tags."+templateURN+"."+fieldURN]
+			switch v := v.(type) {
+			case string:
+				if containsFragment(v, fragments) {
+					return true
+				}
+			case []interface{}:
+				for _, elem := range v {
+					if s, ok := elem.(string); ok && containsFragment(s, fragments) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+var highlightTagsReplacer = strings.NewReplacer("<em>", "", "</em>", "")
+
+func containsFragment(value string, fragments []string) bool {
+	if value == "" {
+		return false
+	}
+	for _, f := range fragments {
+		if f = highlightTagsReplacer.Replace(f); f != "" && strings.Contains(value, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// toSearchExplanation summarises the explanation returned by ES by dropping
+// the details that do not contribute to the score and the ones deeper than
+// the given depth.
+func toSearchExplanation(e *searchExplanation, depth int) *asset.SearchExplanation {
+	if e == nil || depth <= 0 {
+		return nil
+	}
+
+	summary := &asset.SearchExplanation{
+		Value:       e.Value,
+		Description: e.Description,
+	}
+	for i := range e.Details {
+		if e.Details[i].Value == 0 {
+			continue
+		}
+		if d := toSearchExplanation(&e.Details[i], depth-1); d != nil {
+			summary.Details = append(summary.Details, *d)
+		}
+	}
+	return summary
+}
+
+func toSuggestions(response searchResponse, viewer *asset.Viewer) ([]string, error) {
 	suggests, exists := response.Suggest[suggesterName]
 	if !exists {
 		return nil, errors.New("suggester key does not exist")
@@ -533,6 +758,20 @@ func toSuggestions(response searchResponse) ([]string, error) {
 	var results []string
 	for _, s := range suggests {
 		for _, option := range s.Options {
+			if len(results) == suggestSize {
+				return results, nil
+			}
+			if viewer.IsRestricted() && len(option.Source) > 0 {
+				var src struct {
+					Visibility *asset.Visibility `json:"visibility"`
+				}
+				if err := json.Unmarshal(option.Source, &src); err != nil {
+					return nil, fmt.Errorf("decode suggestion source: %w", err)
+				}
+				if !viewer.CanSee(src.Visibility) {
+					continue
+				}
+			}
 			results = append(results, option.Text)
 		}
 	}
@@ -568,6 +807,7 @@ func buildGroupQuery(cfg asset.GroupConfig) (*strings.Reader, error) {
 
 	buildFilterExistsQueries(boolQuery, cfg.GroupBy)
 	buildFilterTermQueries(boolQuery, cfg.Filters)
+	buildVisibilityFilter(boolQuery, cfg.Viewer)
 
 	size := cfg.Size
 	if size <= 0 {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/internal/embedder"
 	store "github.com/goto/compass/internal/store/elasticsearch"
 	"github.com/goto/salt/log"
 	"github.com/stretchr/testify/assert"
@@ -37,10 +38,11 @@ func TestSearcherSearch(t *testing.T) {
 		require.NoError(t, err)
 		repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"})
 		type expectedRow struct {
-			Type    string
-			AssetID string
-			Data    map[string]interface{}
-			Service string
+			Type           string
+			AssetID        string
+			Data           map[string]interface{}
+			Service        string
+			MatchedColumns []string
 		}
 		type searchTest struct {
 			Description    string
@@ -687,8 +689,9 @@ func TestSearcherSearch(t *testing.T) {
 
 				Expected: []expectedRow{
 					{
-						Type:    "table",
-						AssetID: "us1-apple-invoice",
+						Type:           "table",
+						AssetID:        "us1-apple-invoice",
+						MatchedColumns: []string{"user_id", "item_id"},
 						Data: map[string]interface{}{
 							"_highlight": map[string]interface{}{
 								"data.columns.description": []interface{}{
@@ -704,8 +707,9 @@ func TestSearcherSearch(t *testing.T) {
 						},
 					},
 					{
-						Type:    "table",
-						AssetID: "au2-microsoft-invoice",
+						Type:           "table",
+						AssetID:        "au2-microsoft-invoice",
+						MatchedColumns: []string{"item_id"},
 						Data: map[string]interface{}{
 							"_highlight": map[string]interface{}{
 								"data.columns.description": []interface{}{
@@ -737,11 +741,56 @@ func TestSearcherSearch(t *testing.T) {
 					assert.Equal(t, res.Service, results[i].Service)
 					if test.Config.Flags.EnableHighlight {
 						assert.Equal(t, res.Data["_highlight"], results[i].Data["_highlight"])
+						assert.NotEmpty(t, results[i].Highlights)
+						assert.Equal(t, res.MatchedColumns, results[i].MatchedColumns)
 					}
 				}
 			})
 		}
 	})
+
+	t.Run("score and explanation", func(t *testing.T) {
+		cli, err := esTestServer.NewClient()
+		require.NoError(t, err)
+		esClient, err := store.NewClient(
+			log.NewNoop(),
+			store.Config{},
+			store.WithClient(cli),
+		)
+		require.NoError(t, err)
+
+		err = loadTestFixture(cli, esClient, "./testdata/search-test-fixture.json")
+		require.NoError(t, err)
+		repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"})
+
+		t.Run("should return score of results without explanation by default", func(t *testing.T) {
+			results, err := repo.Search(ctx, asset.SearchConfig{Text: "order"})
+			require.NoError(t, err)
+			require.NotEmpty(t, results)
+			for i, r := range results {
+				assert.Positive(t, r.Score)
+				assert.Nil(t, r.Explanation)
+				if i > 0 {
+					assert.LessOrEqual(t, r.Score, results[i-1].Score)
+				}
+			}
+		})
+
+		t.Run("should return explanation of the score if explain is enabled", func(t *testing.T) {
+			results, err := repo.Search(ctx, asset.SearchConfig{
+				Text:  "order",
+				Flags: asset.SearchFlags{EnableExplain: true},
+			})
+			require.NoError(t, err)
+			require.NotEmpty(t, results)
+			for _, r := range results {
+				require.NotNil(t, r.Explanation)
+				assert.InDelta(t, r.Score, r.Explanation.Value, 0.001)
+				assert.NotEmpty(t, r.Explanation.Description)
+				assert.NotEmpty(t, r.Explanation.Details)
+			}
+		})
+	})
 }
 
 func TestSearcherSuggest(t *testing.T) {
@@ -781,6 +830,152 @@ func TestSearcherSuggest(t *testing.T) {
 	})
 }
 
+func TestSearcherSemanticSearch(t *testing.T) {
+	ctx := context.TODO()
+	cli, err := esTestServer.NewClient()
+	require.NoError(t, err)
+	esClient, err := store.NewClient(
+		log.NewNoop(),
+		store.Config{},
+		store.WithClient(cli),
+	)
+	require.NoError(t, err)
+
+	repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"},
+		store.WithEmbedder(embedder.NewHashEmbedder(64), store.SemanticConfig{Weight: 5, MinSimilarity: 0.3}))
+
+	assets := []asset.Asset{
+		{
+			ID:          "semantic-orders",
+			URN:         "semantic-orders-urn",
+			Type:        asset.Type("table"),
+			Service:     "semantic-service",
+			Name:        "ord_v2",
+			Description: "purchases placed by customers",
+			Data: map[string]interface{}{
+				"columns": []interface{}{
+					map[string]interface{}{"name": "amt", "description": "total amount paid for the purchase"},
+				},
+			},
+		},
+		{
+			ID:          "semantic-weather",
+			URN:         "semantic-weather-urn",
+			Type:        asset.Type("table"),
+			Service:     "semantic-service",
+			Name:        "wthr",
+			Description: "daily forecast per city",
+		},
+	}
+	for _, ast := range assets {
+		require.NoError(t, repo.Upsert(ctx, ast))
+	}
+	_, err = cli.Indices.Refresh(cli.Indices.Refresh.WithIndex("semantic-service"))
+	require.NoError(t, err)
+
+	t.Run("should index embedding as dense vector", func(t *testing.T) {
+		res, err := cli.Indices.GetMapping(cli.Indices.GetMapping.WithIndex("semantic-service"))
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		var mapping map[string]struct {
+			Mappings struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"mappings"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&mapping))
+		assert.Equal(t, "dense_vector", mapping["semantic-service"].Mappings.Properties["embedding"]["type"])
+	})
+
+	t.Run("should rank semantically similar asset first", func(t *testing.T) {
+		results, err := repo.Search(ctx, asset.SearchConfig{
+			Text:    "amount customers paid",
+			Filters: map[string][]string{"service": {"semantic-service"}},
+			Flags:   asset.SearchFlags{EnableSemantic: true, DisableFuzzy: true},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, results)
+		assert.Equal(t, "semantic-orders", results[0].ID)
+		for _, r := range results {
+			assert.NotEqual(t, "semantic-weather", r.ID)
+		}
+	})
+
+	t.Run("should search by keywords only when semantic is not enabled", func(t *testing.T) {
+		results, err := repo.Search(ctx, asset.SearchConfig{
+			Text:    "wthr",
+			Filters: map[string][]string{"service": {"semantic-service"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "semantic-weather", results[0].ID)
+	})
+}
+
+func TestSearcherSemanticSearchOnExistingIndex(t *testing.T) {
+	ctx := context.TODO()
+	cli, err := esTestServer.NewClient()
+	require.NoError(t, err)
+	esClient, err := store.NewClient(
+		log.NewNoop(),
+		store.Config{},
+		store.WithClient(cli),
+	)
+	require.NoError(t, err)
+
+	// the index is created before semantic search is enabled
+	keywordRepo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"})
+	legacy := asset.Asset{
+		ID:          "semantic-legacy-weather",
+		URN:         "semantic-legacy-weather-urn",
+		Type:        asset.Type("table"),
+		Service:     "semantic-legacy-service",
+		Name:        "wthr",
+		Description: "daily forecast per city",
+	}
+	require.NoError(t, keywordRepo.Upsert(ctx, legacy))
+
+	repo := store.NewDiscoveryRepository(esClient, log.NewNoop(), time.Second*10, []string{"number", "id"},
+		store.WithEmbedder(embedder.NewHashEmbedder(64), store.SemanticConfig{Weight: 5, MinSimilarity: 0.3}))
+
+	t.Run("should search an index lacking the embedding mapping", func(t *testing.T) {
+		_, err := cli.Indices.Refresh(cli.Indices.Refresh.WithIndex("semantic-legacy-service"))
+		require.NoError(t, err)
+
+		results, err := repo.Search(ctx, asset.SearchConfig{
+			Text:    "wthr",
+			Filters: map[string][]string{"service": {"semantic-legacy-service"}},
+			Flags:   asset.SearchFlags{EnableSemantic: true, DisableFuzzy: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, legacy.ID, results[0].ID)
+	})
+
+	t.Run("should add the embedding mapping to the existing index", func(t *testing.T) {
+		require.NoError(t, repo.Upsert(ctx, asset.Asset{
+			ID:          "semantic-legacy-orders",
+			URN:         "semantic-legacy-orders-urn",
+			Type:        asset.Type("table"),
+			Service:     "semantic-legacy-service",
+			Name:        "ord_v2",
+			Description: "purchases placed by customers",
+		}))
+
+		res, err := cli.Indices.GetMapping(cli.Indices.GetMapping.WithIndex("semantic-legacy-service"))
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		var mapping map[string]struct {
+			Mappings struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"mappings"`
+		}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&mapping))
+		assert.Equal(t, "dense_vector", mapping["semantic-legacy-service"].Mappings.Properties["embedding"]["type"])
+	})
+}
+
 func loadTestFixture(cli *elasticsearch.Client, esClient *store.Client, filePath string) error {
 	testFixtureJSON, err := os.ReadFile(filePath)
 	if err != nil {
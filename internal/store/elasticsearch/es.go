@@ -27,16 +27,38 @@ const (
 )
 
 type Config struct {
-	Brokers        string        `mapstructure:"brokers" default:"http://localhost:9200"`
-	Username       string        `mapstructure:"username" default:""`
-	Password       string        `mapstructure:"password" default:""`
-	RequestTimeout time.Duration `mapstructure:"request_timeout" default:"10s"`
+	Brokers        string         `mapstructure:"brokers" default:"http://localhost:9200"`
+	Username       string         `mapstructure:"username" default:""`
+	Password       string         `mapstructure:"password" default:""`
+	RequestTimeout time.Duration  `mapstructure:"request_timeout" default:"10s"`
+	Semantic       SemanticConfig `mapstructure:"semantic"`
+	// TypeIndices are the asset types stored in an index of their own per
+	// service instead of the index shared by the other types of the service.
+	TypeIndices []string `mapstructure:"type_indices"`
+}
+
+// SemanticConfig tunes how embedding similarity is combined with keyword
+// relevance when semantic search is requested.
+type SemanticConfig struct {
+	// Weight multiplies the similarity score, which ranges from 0 to 2.
+	Weight float64 `mapstructure:"weight" default:"5"`
+	// MinSimilarity is the cosine similarity below which an asset is not
+	// considered a semantic match.
+	MinSimilarity float64 `mapstructure:"min_similarity" default:"0.3"`
 }
 
 type searchHit struct {
-	Index     string                 `json:"_index"`
-	Source    asset.Asset            `json:"_source"`
-	HighLight map[string]interface{} `json:"highlight"`
+	Index       string                 `json:"_index"`
+	Score       *float64               `json:"_score"`
+	Source      asset.Asset            `json:"_source"`
+	HighLight   map[string]interface{} `json:"highlight"`
+	Explanation *searchExplanation     `json:"_explanation"`
+}
+
+type searchExplanation struct {
+	Value       float64             `json:"value"`
+	Description string              `json:"description"`
+	Details     []searchExplanation `json:"details"`
 }
 
 type searchResponse struct {
@@ -174,7 +196,15 @@ func (c *Client) Init() (string, error) {
 	return fmt.Sprintf("%q (server version %s)", info.ClusterName, info.Version.Number), nil
 }
 
-func (c *Client) CreateIdx(ctx context.Context, discoveryOp, indexName, alias string) (err error) {
+func (c *Client) CreateIdx(ctx context.Context, discoveryOp, indexName, alias string) error {
+	var aliases []string
+	if alias != "" {
+		aliases = []string{alias}
+	}
+	return c.createIdx(ctx, discoveryOp, indexName, aliases, serviceIndexMapping)
+}
+
+func (c *Client) createIdx(ctx context.Context, discoveryOp, indexName string, aliases []string, mapping string) (err error) {
 	defer func(start time.Time) {
 		const op = "create_index"
 		c.instrumentOp(ctx, instrumentParams{
@@ -185,7 +215,7 @@ func (c *Client) CreateIdx(ctx context.Context, discoveryOp, indexName, alias st
 		})
 	}(time.Now())
 
-	indexSettings := buildTypeIndexSettings(alias)
+	indexSettings := buildTypeIndexSettings(aliases, mapping)
 	res, err := c.client.Indices.Create(
 		indexName,
 		c.client.Indices.Create.WithBody(strings.NewReader(indexSettings)),
@@ -211,16 +241,20 @@ func (c *Client) CreateIdx(ctx context.Context, discoveryOp, indexName, alias st
 	return nil
 }
 
-func buildTypeIndexSettings(alias string) string {
+func buildTypeIndexSettings(aliases []string, mapping string) string {
 	var aliasObj string
 
-	if len(alias) > 0 {
+	if len(aliases) > 0 {
+		entries := make([]string, len(aliases))
+		for i, alias := range aliases {
+			entries[i] = fmt.Sprintf(`%q: {}`, alias)
+		}
 		aliasObj = fmt.Sprintf(`"aliases": {
-			%q: {}
-		},`, alias)
+			%s
+		},`, strings.Join(entries, ",\n\t\t\t"))
 	}
 
-	return fmt.Sprintf(indexSettingsTemplate, serviceIndexMapping, aliasObj)
+	return fmt.Sprintf(indexSettingsTemplate, mapping, aliasObj)
 }
 
 // checks for the existence of an index
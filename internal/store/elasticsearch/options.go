@@ -1,6 +1,12 @@
 package elasticsearch
 
-import "github.com/elastic/go-elasticsearch/v7"
+import (
+	"slices"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/goto/compass/core/asset"
+)
 
 type ClientOption func(*Client)
 
@@ -9,3 +15,30 @@ func WithClient(cli *elasticsearch.Client) ClientOption {
 		c.client = cli
 	}
 }
+
+type DiscoveryRepositoryOption func(*DiscoveryRepository)
+
+// WithEmbedder enables semantic search: assets are indexed along with the
+// embedding of their text and searches can be ranked by similarity to it.
+func WithEmbedder(embedder asset.Embedder, cfg SemanticConfig) DiscoveryRepositoryOption {
+	return func(repo *DiscoveryRepository) {
+		repo.embedder = embedder
+		repo.semanticCfg = cfg
+	}
+}
+
+// WithTypeIndices routes the assets of the given types to an index per
+// service and type, with a mapping specific to the type. Searches filtered
+// on these types only query their indices.
+func WithTypeIndices(types []string) DiscoveryRepositoryOption {
+	return func(repo *DiscoveryRepository) {
+		for _, typ := range types {
+			typ = strings.TrimSpace(typ)
+			if typ == "" || slices.Contains(repo.routedTypes, asset.Type(typ)) {
+				continue
+			}
+			repo.routedTypes = append(repo.routedTypes, asset.Type(typ))
+		}
+		slices.Sort(repo.routedTypes)
+	}
+}
@@ -0,0 +1,233 @@
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/goto/compass/core/asset"
+)
+
+// typeIndexSeparator joins the service and the type in the name of the index
+// of a routed type, and the default search index and the type in the name of
+// the alias of the routed type. Unlike a dash, it is not expected in service
+// names, so the index can not be mistaken for the index of another service.
+const typeIndexSeparator = "__"
+
+// serviceIndex is one of the indices holding the assets of a service.
+type serviceIndex struct {
+	name string
+	// typ is only set for the index of a routed type
+	typ asset.Type
+}
+
+func (repo *DiscoveryRepository) isRoutedType(typ asset.Type) bool {
+	return slices.Contains(repo.routedTypes, typ)
+}
+
+// indexName returns the index storing the asset. Assets of a routed type
+// are stored in an index per service and type, the others share the index of
+// their service.
+func (repo *DiscoveryRepository) indexName(ast asset.Asset) string {
+	if repo.isRoutedType(ast.Type) {
+		return typeIndexName(ast.Service, ast.Type)
+	}
+	return ast.Service
+}
+
+// indexAliases returns the aliases of the index storing assets of the type.
+func (repo *DiscoveryRepository) indexAliases(typ asset.Type) []string {
+	if repo.isRoutedType(typ) {
+		return []string{defaultSearchIndex, typeIndexAlias(typ)}
+	}
+	return []string{defaultSearchIndex}
+}
+
+// serviceIndices returns the indices holding the assets of the service.
+func (repo *DiscoveryRepository) serviceIndices(service string) []serviceIndex {
+	indices := make([]serviceIndex, 0, len(repo.routedTypes)+1)
+	indices = append(indices, serviceIndex{name: service})
+	for _, typ := range repo.routedTypes {
+		indices = append(indices, serviceIndex{name: typeIndexName(service, typ), typ: typ})
+	}
+	return indices
+}
+
+// searchIndices returns the indices to search in. Only the aliases of the
+// routed types are searched when the type filter is limited to them,
+// otherwise every index is.
+func (repo *DiscoveryRepository) searchIndices(filters asset.SearchFilter) []string {
+	types := filters["type"]
+	if len(types) == 0 || len(repo.routedTypes) == 0 {
+		return []string{defaultSearchIndex}
+	}
+
+	indices := make([]string, 0, len(types))
+	for _, typ := range types {
+		if !repo.isRoutedType(asset.Type(typ)) {
+			return []string{defaultSearchIndex}
+		}
+		indices = append(indices, typeIndexAlias(asset.Type(typ)))
+	}
+	return indices
+}
+
+// claimRoutedAssets moves the assets of the routed type left in the index of
+// their service, from before the type was routed, to the index of the type,
+// for them not to be searched twice. The index of the type is aliased again
+// too, in case the type was routed before and its aliases were dropped.
+func (repo *DiscoveryRepository) claimRoutedAssets(ctx context.Context, service string, typ asset.Type) error {
+	indexName := typeIndexName(service, typ)
+	if _, ok := repo.claimedIndices.Load(indexName); ok {
+		return nil
+	}
+
+	// the aliases are held by the backup while the service is synced, the
+	// service index is recreated without the assets of the type then
+	syncing, err := repo.cli.indexExists(ctx, "Upsert", fmt.Sprintf("%+v-bak", indexName))
+	if err != nil {
+		return asset.DiscoveryError{Op: "IndexExists", Index: indexName, Err: err}
+	}
+	if syncing {
+		return nil
+	}
+
+	for _, alias := range repo.indexAliases(typ) {
+		if err := repo.updateAlias(ctx, indexName, alias); err != nil {
+			return err
+		}
+	}
+
+	exists, err := repo.cli.indexExists(ctx, "Upsert", service)
+	if err != nil {
+		return asset.DiscoveryError{Op: "IndexExists", Index: service, Err: err}
+	}
+	if exists {
+		query := fmt.Sprintf(`{"term":{"type.keyword":%q}}`, typ)
+		if err := repo.reindex(ctx, service, indexName, query); err != nil {
+			return err
+		}
+		if err := repo.deleteWithQuery(ctx, service, "Upsert", fmt.Sprintf(`{"query":%s}`, query)); err != nil {
+			return err
+		}
+	}
+
+	repo.claimedIndices.Store(indexName, struct{}{})
+	return nil
+}
+
+// unaliasUnroutedIndices leaves the indices of the types no longer routed out
+// of the searches, their assets are searched in the index of their service
+// once upserted again or synced.
+func (repo *DiscoveryRepository) unaliasUnroutedIndices(ctx context.Context) error {
+	if repo.unroutedUnaliased.Load() {
+		return nil
+	}
+
+	getAlias := repo.cli.client.Indices.GetAlias
+	res, err := getAlias(
+		getAlias.WithIndex("*"+typeIndexSeparator+"*"),
+		getAlias.WithContext(ctx),
+	)
+	if err != nil {
+		return asset.DiscoveryError{Op: "GetAlias", Err: err}
+	}
+	defer drainBody(res)
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		code, reason := errorCodeAndReason(res)
+		return asset.DiscoveryError{Op: "GetAlias", ESCode: code, Err: errors.New(reason)}
+	}
+
+	var indices map[string]struct {
+		Aliases map[string]json.RawMessage `json:"aliases"`
+	}
+	if res.StatusCode != http.StatusNotFound {
+		if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+			return asset.DiscoveryError{Op: "GetAlias", Err: fmt.Errorf("decode response: %w", err)}
+		}
+	}
+
+	type aliasAction struct {
+		Index string `json:"index"`
+		Alias string `json:"alias"`
+	}
+	var actions []map[string]aliasAction
+	typeAliasPrefix := typeIndexAlias("")
+	for index, idx := range indices {
+		for alias := range idx.Aliases {
+			typ, ok := strings.CutPrefix(alias, typeAliasPrefix)
+			if !ok || repo.isRoutedType(asset.Type(typ)) {
+				continue
+			}
+
+			actions = append(actions, map[string]aliasAction{"remove": {Index: index, Alias: alias}})
+			if _, ok := idx.Aliases[defaultSearchIndex]; ok {
+				actions = append(actions, map[string]aliasAction{"remove": {Index: index, Alias: defaultSearchIndex}})
+			}
+		}
+	}
+
+	if len(actions) > 0 {
+		if err := repo.updateAliases(ctx, map[string]interface{}{"actions": actions}); err != nil {
+			return err
+		}
+	}
+
+	repo.unroutedUnaliased.Store(true)
+	return nil
+}
+
+func (repo *DiscoveryRepository) reindex(ctx context.Context, source, dest, query string) error {
+	body := fmt.Sprintf(
+		`{"conflicts":"proceed","source":{"index":%q,"query":%s},"dest":{"index":%q,"op_type":"create"}}`,
+		source, query, dest,
+	)
+	reindexFn := repo.cli.client.Reindex
+	resp, err := reindexFn(strings.NewReader(body),
+		reindexFn.WithRefresh(true),
+		reindexFn.WithWaitForCompletion(true),
+		reindexFn.WithContext(ctx),
+	)
+	if err != nil {
+		return asset.DiscoveryError{Op: "Reindex", Index: source, Err: err}
+	}
+	defer drainBody(resp)
+
+	if resp.IsError() {
+		code, reason := errorCodeAndReason(resp)
+		return asset.DiscoveryError{Op: "Reindex", Index: source, ESCode: code, Err: errors.New(reason)}
+	}
+	return nil
+}
+
+func (repo *DiscoveryRepository) updateAliases(ctx context.Context, actions interface{}) error {
+	body, err := encodeBodyRequest(actions)
+	if err != nil {
+		return asset.DiscoveryError{Op: "UpdateAliases", Err: err}
+	}
+
+	updateAliasesFn := repo.cli.client.Indices.UpdateAliases
+	resp, err := updateAliasesFn(body, updateAliasesFn.WithContext(ctx))
+	if err != nil {
+		return asset.DiscoveryError{Op: "UpdateAliases", Err: err}
+	}
+	defer drainBody(resp)
+
+	if resp.IsError() {
+		code, reason := errorCodeAndReason(resp)
+		return asset.DiscoveryError{Op: "UpdateAliases", ESCode: code, Err: errors.New(reason)}
+	}
+	return nil
+}
+
+func typeIndexName(service string, typ asset.Type) string {
+	return service + typeIndexSeparator + typ.String()
+}
+
+func typeIndexAlias(typ asset.Type) string {
+	return defaultSearchIndex + typeIndexSeparator + typ.String()
+}
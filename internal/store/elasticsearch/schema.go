@@ -1,5 +1,15 @@
 package elasticsearch
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goto/compass/core/asset"
+)
+
+// embeddingField holds the dense vector of an asset used for semantic search
+const embeddingField = "embedding"
+
 // used as body to create index requests
 // aliases the index to defaultSearchIndex
 // and sets up the camelcase analyzer
@@ -86,6 +96,83 @@ var serviceIndexMapping = `{
 		},
 		"labels": {
 			"type": "object"
+		},
+		"tags": {
+			"type": "object"
 		}
 	}
 }`
+
+// buildServiceIndexMapping returns serviceIndexMapping with the embedding
+// field added when semantic search is enabled.
+func buildServiceIndexMapping(embeddingDims int) string {
+	if embeddingDims <= 0 {
+		return serviceIndexMapping
+	}
+
+	properties := fmt.Sprintf(`"properties": {
+		%q: {
+			"type": "dense_vector",
+			"dims": %d
+		},`, embeddingField, embeddingDims)
+	return strings.Replace(serviceIndexMapping, `"properties": {`, properties, 1)
+}
+
+// typeIndexProperties are the properties added to the mapping of the index of
+// a routed type. Columns of tables are mapped explicitly and their other
+// attributes, except for their tags, are not indexed, so that tables with many
+// heterogeneous columns do not bloat the mapping.
+var typeIndexProperties = map[asset.Type]string{
+	asset.Type("table"): `"data": {
+			"properties": {
+				"columns": {
+					"dynamic": false,
+					"properties": {
+						"name": {
+							"type": "text",
+							"fields": {
+								"keyword": {
+									"type": "keyword",
+									"ignore_above": 256.0
+								}
+							}
+						},
+						"description": {
+							"type": "text",
+							"fields": {
+								"keyword": {
+									"type": "keyword",
+									"ignore_above": 256.0
+								}
+							}
+						},
+						"data_type": {
+							"type": "text",
+							"fields": {
+								"keyword": {
+									"type": "keyword",
+									"ignore_above": 256.0
+								}
+							}
+						},
+						"tags": {
+							"type": "object",
+							"dynamic": true
+						}
+					}
+				}
+			}
+		},`,
+}
+
+// withTypeProperties returns the mapping with the properties specific to the
+// type added, if any.
+func withTypeProperties(mapping string, typ asset.Type) string {
+	properties, ok := typeIndexProperties[typ]
+	if !ok {
+		return mapping
+	}
+
+	return strings.Replace(mapping, `"properties": {`, `"properties": {
+		`+properties, 1)
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -10,25 +11,29 @@ import (
 	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/user"
 	"github.com/jmoiron/sqlx/types"
+	"github.com/lib/pq"
 	"github.com/r3labs/diff/v2"
 )
 
 type AssetModel struct {
-	ID          string     `db:"id"`
-	URN         string     `db:"urn"`
-	Type        string     `db:"type"`
-	Name        string     `db:"name"`
-	Service     string     `db:"service"`
-	Description string     `db:"description"`
-	Data        JSONMap    `db:"data"`
-	URL         string     `db:"url"`
-	Labels      JSONMap    `db:"labels"`
-	IsDeleted   bool       `db:"is_deleted"`
-	Version     string     `db:"version"`
-	UpdatedBy   UserModel  `db:"updated_by"`
-	CreatedAt   time.Time  `db:"created_at"`
-	UpdatedAt   time.Time  `db:"updated_at"`
-	RefreshedAt *time.Time `db:"refreshed_at"`
+	ID               string         `db:"id"`
+	URN              string         `db:"urn"`
+	Type             string         `db:"type"`
+	Name             string         `db:"name"`
+	Service          string         `db:"service"`
+	Description      string         `db:"description"`
+	Data             JSONMap        `db:"data"`
+	URL              string         `db:"url"`
+	Labels           JSONMap        `db:"labels"`
+	Visibility       sql.NullString `db:"visibility"`
+	VisibilityGroups pq.StringArray `db:"visibility_groups"`
+	OwnerTeams       pq.StringArray `db:"owner_teams"`
+	IsDeleted        bool           `db:"is_deleted"`
+	Version          string         `db:"version"`
+	UpdatedBy        UserModel      `db:"updated_by"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	RefreshedAt      *time.Time     `db:"refreshed_at"`
 	// version specific information
 	Changelog types.JSONText `db:"changelog"`
 	Owners    types.JSONText `db:"owners"`
@@ -45,6 +50,8 @@ func (a *AssetModel) toAsset(owners []user.User) asset.Asset {
 		Data:        a.Data,
 		URL:         a.URL,
 		Labels:      a.buildLabels(),
+		OwnerTeams:  a.buildOwnerTeams(),
+		Visibility:  a.buildVisibility(),
 		IsDeleted:   a.IsDeleted,
 		Owners:      owners,
 		Version:     a.Version,
@@ -126,6 +133,42 @@ func (a *AssetModel) buildLabels() map[string]string {
 	return result
 }
 
+func (a *AssetModel) buildOwnerTeams() []string {
+	if len(a.OwnerTeams) == 0 {
+		return nil
+	}
+	return a.OwnerTeams
+}
+
+// buildVisibility returns nil for the public assets
+func (a *AssetModel) buildVisibility() *asset.Visibility {
+	if a.Visibility.String != string(asset.VisibilityRestricted) {
+		return nil
+	}
+
+	return &asset.Visibility{
+		Kind:   asset.VisibilityRestricted,
+		Groups: a.VisibilityGroups,
+	}
+}
+
+// visibilityColumns returns the values of the visibility and
+// visibility_groups columns of the asset
+func visibilityColumns(ast *asset.Asset) (string, pq.StringArray) {
+	if !ast.Visibility.IsRestricted() {
+		return string(asset.VisibilityPublic), pq.StringArray{}
+	}
+	return string(asset.VisibilityRestricted), pq.StringArray(ast.Visibility.Groups)
+}
+
+// ownerTeamsColumn returns the value of the owner_teams column of the asset
+func ownerTeamsColumn(ast *asset.Asset) pq.StringArray {
+	if ast.OwnerTeams == nil {
+		return pq.StringArray{}
+	}
+	return pq.StringArray(ast.OwnerTeams)
+}
+
 type AssetProbeModel struct {
 	ID           string    `db:"id"`
 	AssetURN     string    `db:"asset_urn"`
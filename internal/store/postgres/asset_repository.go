@@ -18,6 +18,7 @@ import (
 	"github.com/goto/salt/log"
 	"github.com/jinzhu/copier"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/r3labs/diff/v2"
 )
 
@@ -161,6 +162,30 @@ func (r *AssetRepository) GetCountByQueryExpr(ctx context.Context, queryExpr que
 	return total, err
 }
 
+// GetIDsByQueryExpr retrieves the ids of the assets matching the query expr
+func (r *AssetRepository) GetIDsByQueryExpr(ctx context.Context, queryExpr queryexpr.ExprStr) ([]string, error) {
+	query, err := queryexpr.ValidateAndGetQueryFromExpr(queryExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := sq.Select("id").
+		From("assets").
+		Where(query).
+		OrderBy("id")
+	sqlQuery, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build ids query: %w", err)
+	}
+
+	var ids []string
+	if err := r.client.db.SelectContext(ctx, &ids, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("get asset ids: %w", err)
+	}
+
+	return ids, nil
+}
+
 func (r *AssetRepository) getCountByQuery(ctx context.Context, sqlQuery string) (uint32, error) {
 	builder := sq.Select("count(1)").
 		From("assets").
@@ -469,6 +494,13 @@ func (r *AssetRepository) Upsert(
 
 		// reset IsDeleted flag if asset is resync'd
 		ast.IsDeleted = false
+		// keep the visibility of the asset if not given, the ingestion
+		// pipelines do not know about it
+		if ast.Visibility == nil {
+			ast.Visibility = fetchedAsset.Visibility
+		} else if !ast.Visibility.IsRestricted() {
+			ast.Visibility = nil
+		}
 
 		fullChangelog, simplifiedChangelog, err := fetchedAsset.Diff(ast, assetConfig.ExcludedChangelogPaths)
 		if err != nil {
@@ -1023,6 +1055,12 @@ func (r *AssetRepository) GetProbesWithFilter(ctx context.Context, flt asset.Pro
 	if !flt.OlderThan.IsZero() {
 		stmt = stmt.Where(sq.LtOrEq{"timestamp": flt.OlderThan})
 	}
+	if flt.Viewer.IsRestricted() {
+		stmt = stmt.Where(sq.Expr(
+			"asset_urn NOT IN (SELECT urn FROM assets WHERE visibility = ? AND NOT visibility_groups && ?)",
+			asset.VisibilityRestricted, pq.StringArray(flt.Viewer.Groups),
+		))
+	}
 	if flt.MaxRows > 0 {
 		stmt = stmt.Column("RANK() OVER (PARTITION BY asset_urn ORDER BY timestamp desc) rank_number")
 		stmt = sq.Select(
@@ -1088,10 +1126,13 @@ func (r *AssetRepository) insert(ctx context.Context, tx *sqlx.Tx, ast *asset.As
 	}
 	ast.CreatedAt = currentTime
 	ast.UpdatedAt = currentTime
+	visibility, visibilityGroups := visibilityColumns(ast)
 	insertCTE := sq.Insert("assets").
 		Columns("urn", "type", "service", "name", "description", "data", "url", "labels",
+			"visibility", "visibility_groups", "owner_teams",
 			"created_at", "updated_by", "updated_at", "refreshed_at", "version", "is_deleted").
 		Values(ast.URN, ast.Type, ast.Service, ast.Name, ast.Description, ast.Data, ast.URL, ast.Labels,
+			visibility, visibilityGroups, ownerTeamsColumn(ast),
 			ast.CreatedAt, ast.UpdatedBy.ID, ast.UpdatedAt, currentTime, asset.BaseVersion, ast.IsDeleted).
 		Suffix("RETURNING *").
 		Prefix("WITH assets AS (").
@@ -1187,6 +1228,7 @@ func (r *AssetRepository) update(ctx context.Context, tx *sqlx.Tx, newAsset, old
 }
 
 func (r *AssetRepository) updateAsset(ctx context.Context, tx *sqlx.Tx, assetID string, newAsset *asset.Asset) (asset.Asset, error) {
+	visibility, visibilityGroups := visibilityColumns(newAsset)
 	updateCTE := sq.Update("assets").
 		Set("urn", newAsset.URN).
 		Set("type", newAsset.Type).
@@ -1196,6 +1238,9 @@ func (r *AssetRepository) updateAsset(ctx context.Context, tx *sqlx.Tx, assetID
 		Set("data", newAsset.Data).
 		Set("url", newAsset.URL).
 		Set("labels", newAsset.Labels).
+		Set("visibility", visibility).
+		Set("visibility_groups", visibilityGroups).
+		Set("owner_teams", ownerTeamsColumn(newAsset)).
 		Set("is_deleted", newAsset.IsDeleted).
 		Set("updated_at", newAsset.UpdatedAt).
 		Set("refreshed_at", *newAsset.RefreshedAt).
@@ -1397,6 +1442,85 @@ func (r *AssetRepository) getOwnersWithTx(ctx context.Context, tx *sqlx.Tx, asse
 	return userModels.toUsers(), nil
 }
 
+// GetOrphanedAssets returns the assets all the owners of which are inactive,
+// leaving out the deleted assets and the assets owned by a team.
+func (r *AssetRepository) GetOrphanedAssets(ctx context.Context) ([]user.OrphanedAsset, error) {
+	var rows []struct {
+		ID            string         `db:"id"`
+		URN           string         `db:"urn"`
+		Type          string         `db:"type"`
+		Service       string         `db:"service"`
+		Name          sql.NullString `db:"name"`
+		OwnerIDs      pq.StringArray `db:"owner_ids"`
+		OwnerEmails   pq.StringArray `db:"owner_emails"`
+		OwnerManagers pq.StringArray `db:"owner_managers"`
+	}
+	if err := r.client.db.SelectContext(ctx, &rows, `
+		SELECT
+			a.id, a.urn, a.type, a.service, a.name,
+			array_agg(u.id::text ORDER BY u.email) AS owner_ids,
+			array_agg(u.email ORDER BY u.email) AS owner_emails,
+			array_agg(COALESCE(u.manager_email, '') ORDER BY u.email) AS owner_managers
+		FROM assets a
+		JOIN asset_owners ao ON ao.asset_id = a.id
+		JOIN users u ON u.id = ao.user_id
+		WHERE a.is_deleted = false AND cardinality(a.owner_teams) = 0
+		GROUP BY a.id
+		HAVING bool_and(u.inactive)
+		ORDER BY a.urn`,
+	); err != nil {
+		return nil, fmt.Errorf("get orphaned assets: %w", err)
+	}
+
+	assets := make([]user.OrphanedAsset, 0, len(rows))
+	for _, row := range rows {
+		owners := make([]user.User, len(row.OwnerIDs))
+		for i := range row.OwnerIDs {
+			owners[i] = user.User{
+				ID:       row.OwnerIDs[i],
+				Email:    row.OwnerEmails[i],
+				Manager:  row.OwnerManagers[i],
+				Inactive: true,
+			}
+		}
+		assets = append(assets, user.OrphanedAsset{
+			ID:      row.ID,
+			URN:     row.URN,
+			Type:    row.Type,
+			Service: row.Service,
+			Name:    row.Name.String,
+			Owners:  owners,
+		})
+	}
+	return assets, nil
+}
+
+// ReplaceOwner gives the asset owned by the user to another user, who may
+// already own it.
+func (r *AssetRepository) ReplaceOwner(ctx context.Context, assetID, fromUserID, toUserID string) error {
+	if !isValidUUID(assetID) {
+		return asset.InvalidError{AssetID: assetID}
+	}
+
+	return r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO asset_owners (asset_id, user_id) VALUES ($1, $2)
+			ON CONFLICT (asset_id, user_id) DO NOTHING`,
+			assetID, toUserID,
+		); err != nil {
+			return fmt.Errorf("insert asset owner: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM asset_owners WHERE asset_id = $1 AND user_id = $2`,
+			assetID, fromUserID,
+		); err != nil {
+			return fmt.Errorf("delete asset owner: %w", err)
+		}
+		return nil
+	})
+}
+
 // insertOwners inserts relation of asset id and user id
 func (r *AssetRepository) insertOwners(ctx context.Context, execer sqlx.ExecerContext, assetID string, owners []user.User) error {
 	if len(owners) == 0 {
@@ -1574,6 +1698,9 @@ func (*AssetRepository) getAssetSQL() sq.SelectBuilder {
 		a.data as data,
 		COALESCE(a.url, '') as url,
 		a.labels as labels,
+		a.visibility as visibility,
+		a.visibility_groups as visibility_groups,
+		a.owner_teams as owner_teams,
 		a.is_deleted as is_deleted,
 		a.version as version,
 		a.created_at as created_at,
@@ -1643,6 +1770,17 @@ func (r *AssetRepository) BuildFilterQuery(builder sq.SelectBuilder, flt asset.F
 		builder = builder.Where(sq.Eq{"service": flt.Services})
 	}
 
+	if len(flt.OwnerTeams) > 0 {
+		builder = builder.Where(sq.Expr("owner_teams && ?", pq.StringArray(flt.OwnerTeams)))
+	}
+
+	if flt.Viewer.IsRestricted() {
+		builder = builder.Where(sq.Or{
+			sq.NotEq{"visibility": asset.VisibilityRestricted},
+			sq.Expr("visibility_groups && ?", pq.StringArray(flt.Viewer.Groups)),
+		})
+	}
+
 	if len(flt.QueryFields) > 0 && flt.Query != "" {
 		orClause := sq.Or{}
 
@@ -801,6 +801,34 @@ func (r *AssetRepositoryTestSuite) TestGetTypes() {
 	}
 }
 
+func (r *AssetRepositoryTestSuite) TestGetTypesWithViewer() {
+	r.BeforeTest("", "")
+
+	ast := asset.Asset{
+		URN:        "restricted-model-urn",
+		Name:       "restricted-model",
+		Type:       "model",
+		Service:    "optimus",
+		Data:       map[string]interface{}{},
+		Visibility: &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}},
+		UpdatedBy:  r.users[0],
+	}
+	_, _, err := r.repository.Upsert(r.ctx, &ast, false, asset.Config{})
+	r.Require().NoError(err)
+
+	r.Run("should not count restricted assets for non members", func() {
+		typeMap, err := r.repository.GetTypes(r.ctx, asset.Filter{Viewer: &asset.Viewer{Groups: []string{"hr"}}})
+		r.NoError(err)
+		r.Zero(typeMap[asset.Type("model")])
+	})
+
+	r.Run("should count restricted assets for members", func() {
+		typeMap, err := r.repository.GetTypes(r.ctx, asset.Filter{Viewer: &asset.Viewer{Groups: []string{"finance"}}})
+		r.NoError(err)
+		r.Equal(1, typeMap[asset.Type("model")])
+	})
+}
+
 func (r *AssetRepositoryTestSuite) TestGetCount() {
 	// populate assets
 	total := 12
@@ -854,6 +882,30 @@ func (r *AssetRepositoryTestSuite) TestGetCountByQueryExpr() {
 	})
 }
 
+func (r *AssetRepositoryTestSuite) TestGetIDsByQueryExpr() {
+	ast := asset.Asset{
+		URN:       uuid.NewString() + "urn-giqe-1",
+		Name:      "giqe-1",
+		Type:      "table",
+		Service:   "bigquery",
+		UpdatedBy: r.users[0],
+		Data:      map[string]interface{}{},
+	}
+	upserted, _, err := r.repository.Upsert(r.ctx, &ast, false, asset.Config{})
+	r.Require().NoError(err)
+
+	r.Run("should return ids of assets matching query expression", func() {
+		ids, err := r.repository.GetIDsByQueryExpr(r.ctx, asset.SelectAssetExpr{ExprStr: queryexpr.SQLExpr(fmt.Sprintf("urn == '%s'", ast.URN))})
+		r.Require().NoError(err)
+		r.Equal([]string{upserted.ID}, ids)
+	})
+
+	r.Run("should return error on identifier that is not an asset field", func() {
+		_, err := r.repository.GetIDsByQueryExpr(r.ctx, asset.SelectAssetExpr{ExprStr: queryexpr.SQLExpr("owner == 'john'")})
+		r.Error(err)
+	})
+}
+
 func (r *AssetRepositoryTestSuite) TestGetCountByIsDeletedAndServicesAndUpdatedAt() {
 	now := time.Now()
 	serviceA := "serviceA"
@@ -3962,6 +4014,39 @@ func (r *AssetRepositoryTestSuite) TestGetProbesWithFilter() {
 	}
 }
 
+func (r *AssetRepositoryTestSuite) TestGetOrphanedAssetsAndReplaceOwner() {
+	departed, manager, active := r.users[1], r.users[2], r.users[3]
+	for _, ast := range []asset.Asset{
+		{URN: "urn:orphaned", Type: asset.Type("table"), Service: "bigquery", Owners: []user.User{departed}},
+		{URN: "urn:co-owned", Type: asset.Type("table"), Service: "bigquery", Owners: []user.User{departed, active}},
+		{URN: "urn:team-owned", Type: asset.Type("table"), Service: "bigquery", Owners: []user.User{departed}, OwnerTeams: []string{"data-platform"}},
+	} {
+		ast.UpdatedBy = r.users[0]
+		_, _, err := r.repository.Upsert(r.ctx, &ast, false, asset.Config{})
+		r.Require().NoError(err)
+	}
+	r.Require().NoError(r.client.ExecQueries(r.ctx, []string{
+		fmt.Sprintf("UPDATE users SET inactive = true, manager_email = '%s' WHERE id = '%s'", manager.Email, departed.ID),
+	}))
+
+	orphaned, err := r.repository.GetOrphanedAssets(r.ctx)
+	r.Require().NoError(err)
+	r.Require().Len(orphaned, 1)
+	r.Equal("urn:orphaned", orphaned[0].URN)
+	r.Equal([]user.User{{ID: departed.ID, Email: departed.Email, Manager: manager.Email, Inactive: true}}, orphaned[0].Owners)
+
+	r.Require().NoError(r.repository.ReplaceOwner(r.ctx, orphaned[0].ID, departed.ID, manager.ID))
+
+	reassigned, err := r.repository.GetByID(r.ctx, orphaned[0].ID)
+	r.Require().NoError(err)
+	r.Require().Len(reassigned.Owners, 1)
+	r.Equal(manager.ID, reassigned.Owners[0].ID)
+
+	orphaned, err = r.repository.GetOrphanedAssets(r.ctx)
+	r.NoError(err)
+	r.Empty(orphaned)
+}
+
 func (r *AssetRepositoryTestSuite) insertProbes(t *testing.T) {
 	t.Helper()
 
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/lib/pq"
+)
+
+type AssetTypeModel struct {
+	Name           string         `db:"name"`
+	DisplayName    sql.NullString `db:"display_name"`
+	Icon           sql.NullString `db:"icon"`
+	Description    sql.NullString `db:"description"`
+	RequiredFields pq.StringArray `db:"required_fields"`
+	SearchBoost    float64        `db:"search_boost"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+}
+
+func (m AssetTypeModel) toTypeDefinition() asset.TypeDefinition {
+	return asset.TypeDefinition{
+		Name:           asset.Type(m.Name),
+		DisplayName:    m.DisplayName.String,
+		Icon:           m.Icon.String,
+		Description:    m.Description.String,
+		RequiredFields: m.RequiredFields,
+		SearchBoost:    m.SearchBoost,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// AssetTypeRepository is a type that manages the asset type registry in the
+// primary database
+type AssetTypeRepository struct {
+	client *Client
+}
+
+// GetAll fetch all the registered types, by name
+func (r *AssetTypeRepository) GetAll(ctx context.Context) ([]asset.TypeDefinition, error) {
+	var models []AssetTypeModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			name, display_name, icon, description, required_fields, search_boost, created_at, updated_at
+		FROM
+			asset_types
+		ORDER BY
+			name
+	`); err != nil {
+		return nil, fmt.Errorf("failed fetching asset types: %w", err)
+	}
+
+	defs := make([]asset.TypeDefinition, 0, len(models))
+	for _, m := range models {
+		defs = append(defs, m.toTypeDefinition())
+	}
+	return defs, nil
+}
+
+// Get fetch a registered type by its name
+func (r *AssetTypeRepository) Get(ctx context.Context, name asset.Type) (asset.TypeDefinition, error) {
+	var m AssetTypeModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			name, display_name, icon, description, required_fields, search_boost, created_at, updated_at
+		FROM
+			asset_types
+		WHERE
+			name = $1
+	`, name.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return asset.TypeDefinition{}, asset.TypeNotFoundError{Type: name}
+	}
+	if err != nil {
+		return asset.TypeDefinition{}, fmt.Errorf("failed fetching asset type: %w", err)
+	}
+
+	return m.toTypeDefinition(), nil
+}
+
+// Create registers a new type
+func (r *AssetTypeRepository) Create(ctx context.Context, def *asset.TypeDefinition) error {
+	if def == nil {
+		return errors.New("type definition is nil")
+	}
+
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		asset_types
+			(name, display_name, icon, description, required_fields, search_boost)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`, def.Name.String(), def.DisplayName, def.Icon, def.Description, pq.StringArray(def.RequiredFields), def.SearchBoost).
+		Scan(&def.CreatedAt, &def.UpdatedAt); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, errDuplicateKey) {
+			return asset.TypeExistsError{Type: def.Name}
+		}
+		return fmt.Errorf("failed to create asset type: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates a registered type
+func (r *AssetTypeRepository) Update(ctx context.Context, def *asset.TypeDefinition) error {
+	if def == nil {
+		return errors.New("type definition is nil")
+	}
+
+	err := r.client.db.QueryRowxContext(ctx, `
+		UPDATE
+			asset_types
+		SET
+			display_name = $2,
+			icon = $3,
+			description = $4,
+			required_fields = $5,
+			search_boost = $6,
+			updated_at = NOW()
+		WHERE
+			name = $1
+		RETURNING created_at, updated_at
+	`, def.Name.String(), def.DisplayName, def.Icon, def.Description, pq.StringArray(def.RequiredFields), def.SearchBoost).
+		Scan(&def.CreatedAt, &def.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return asset.TypeNotFoundError{Type: def.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update asset type: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a type from the registry
+func (r *AssetTypeRepository) Delete(ctx context.Context, name asset.Type) error {
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			asset_types
+		WHERE
+			name = $1
+	`, name.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete asset type: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting asset type: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return asset.TypeNotFoundError{Type: name}
+	}
+	return nil
+}
+
+// NewAssetTypeRepository initializes asset type repository
+func NewAssetTypeRepository(c *Client) (*AssetTypeRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &AssetTypeRepository{
+		client: c,
+	}, nil
+}
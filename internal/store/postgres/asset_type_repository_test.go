@@ -0,0 +1,118 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type AssetTypeRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.AssetTypeRepository
+}
+
+func (r *AssetTypeRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewAssetTypeRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AssetTypeRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AssetTypeRepositoryTestSuite) TestCreateAndGet() {
+	def := &asset.TypeDefinition{
+		Name:           "pipeline",
+		DisplayName:    "Pipeline",
+		Icon:           "pipeline.svg",
+		Description:    "data pipelines",
+		RequiredFields: []string{"owner_team", "schedule.interval"},
+		SearchBoost:    1.5,
+	}
+
+	r.Run("return not found error if type is not registered", func() {
+		_, err := r.repository.Get(r.ctx, "pipeline")
+		r.ErrorIs(err, asset.TypeNotFoundError{Type: "pipeline"})
+	})
+
+	r.Run("return type as created", func() {
+		r.Require().NoError(r.repository.Create(r.ctx, def))
+		r.False(def.CreatedAt.IsZero())
+
+		actual, err := r.repository.Get(r.ctx, "pipeline")
+		r.NoError(err)
+		r.Equal("Pipeline", actual.DisplayName)
+		r.Equal("pipeline.svg", actual.Icon)
+		r.Equal("data pipelines", actual.Description)
+		r.Equal([]string{"owner_team", "schedule.interval"}, actual.RequiredFields)
+		r.Equal(1.5, actual.SearchBoost)
+
+		all, err := r.repository.GetAll(r.ctx)
+		r.NoError(err)
+		r.Len(all, 1)
+	})
+
+	r.Run("return exists error if type is already registered", func() {
+		err := r.repository.Create(r.ctx, def)
+		r.ErrorIs(err, asset.TypeExistsError{Type: "pipeline"})
+	})
+}
+
+func (r *AssetTypeRepositoryTestSuite) TestUpdate() {
+	r.Run("return not found error if type is not registered", func() {
+		err := r.repository.Update(r.ctx, &asset.TypeDefinition{Name: "pipeline"})
+		r.ErrorIs(err, asset.TypeNotFoundError{Type: "pipeline"})
+	})
+
+	r.Run("update registered type", func() {
+		r.Require().NoError(r.repository.Create(r.ctx, &asset.TypeDefinition{Name: "pipeline", DisplayName: "Pipeline"}))
+
+		err := r.repository.Update(r.ctx, &asset.TypeDefinition{Name: "pipeline", DisplayName: "Data Pipeline", SearchBoost: 2})
+		r.NoError(err)
+
+		actual, err := r.repository.Get(r.ctx, "pipeline")
+		r.NoError(err)
+		r.Equal("Data Pipeline", actual.DisplayName)
+		r.Equal(2.0, actual.SearchBoost)
+	})
+}
+
+func (r *AssetTypeRepositoryTestSuite) TestDelete() {
+	r.Run("return not found error if type is not registered", func() {
+		err := r.repository.Delete(r.ctx, "pipeline")
+		r.ErrorIs(err, asset.TypeNotFoundError{Type: "pipeline"})
+	})
+
+	r.Run("delete registered type", func() {
+		r.Require().NoError(r.repository.Create(r.ctx, &asset.TypeDefinition{Name: "pipeline"}))
+
+		r.NoError(r.repository.Delete(r.ctx, "pipeline"))
+
+		_, err := r.repository.Get(r.ctx, "pipeline")
+		r.ErrorIs(err, asset.TypeNotFoundError{Type: "pipeline"})
+	})
+}
+
+func TestAssetTypeRepository(t *testing.T) {
+	suite.Run(t, &AssetTypeRepositoryTestSuite{})
+}
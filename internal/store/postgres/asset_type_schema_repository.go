@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/jmoiron/sqlx/types"
+)
+
+type AssetTypeSchemaModel struct {
+	Type      string         `db:"type"`
+	Schema    types.JSONText `db:"schema"`
+	Mode      string         `db:"mode"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (m AssetTypeSchemaModel) toTypeSchema() asset.TypeSchema {
+	return asset.TypeSchema{
+		Type:      asset.Type(m.Type),
+		Schema:    json.RawMessage(m.Schema),
+		Mode:      asset.SchemaMode(m.Mode),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// AssetTypeSchemaRepository is a type that manages the schemas of asset types
+// in the primary database
+type AssetTypeSchemaRepository struct {
+	client *Client
+}
+
+// Upsert registers the schema of a type, replacing the existing one
+func (r *AssetTypeSchemaRepository) Upsert(ctx context.Context, ts *asset.TypeSchema) error {
+	if ts == nil {
+		return errors.New("type schema is nil")
+	}
+
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		asset_type_schemas
+			(type, schema, mode)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (type) DO UPDATE SET
+			schema = EXCLUDED.schema,
+			mode = EXCLUDED.mode,
+			updated_at = NOW()
+		RETURNING created_at, updated_at
+	`, ts.Type.String(), types.JSONText(ts.Schema), string(ts.Mode)).Scan(&ts.CreatedAt, &ts.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to upsert asset type schema: %w", checkPostgresError(err))
+	}
+
+	return nil
+}
+
+// Get fetch the schema of a type
+func (r *AssetTypeSchemaRepository) Get(ctx context.Context, typ asset.Type) (asset.TypeSchema, error) {
+	var m AssetTypeSchemaModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			type, schema, mode, created_at, updated_at
+		FROM
+			asset_type_schemas
+		WHERE
+			type = $1
+	`, typ.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return asset.TypeSchema{}, asset.TypeSchemaNotFoundError{Type: typ}
+	}
+	if err != nil {
+		return asset.TypeSchema{}, fmt.Errorf("failed fetching asset type schema: %w", err)
+	}
+
+	return m.toTypeSchema(), nil
+}
+
+// Delete deletes the schema of a type
+func (r *AssetTypeSchemaRepository) Delete(ctx context.Context, typ asset.Type) error {
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			asset_type_schemas
+		WHERE
+			type = $1
+	`, typ.String())
+	if err != nil {
+		return fmt.Errorf("failed to delete asset type schema: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting asset type schema: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return asset.TypeSchemaNotFoundError{Type: typ}
+	}
+	return nil
+}
+
+// NewAssetTypeSchemaRepository initializes asset type schema repository
+func NewAssetTypeSchemaRepository(c *Client) (*AssetTypeSchemaRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &AssetTypeSchemaRepository{
+		client: c,
+	}, nil
+}
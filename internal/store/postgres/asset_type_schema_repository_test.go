@@ -0,0 +1,104 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type AssetTypeSchemaRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.AssetTypeSchemaRepository
+}
+
+func (r *AssetTypeSchemaRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewAssetTypeSchemaRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AssetTypeSchemaRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AssetTypeSchemaRepositoryTestSuite) TestUpsertAndGet() {
+	r.Run("return not found error if type has no schema", func() {
+		_, err := r.repository.Get(r.ctx, asset.Type("table"))
+		r.ErrorIs(err, asset.TypeSchemaNotFoundError{Type: asset.Type("table")})
+	})
+
+	r.Run("return schema as upserted", func() {
+		ts := &asset.TypeSchema{
+			Type:   asset.Type("table"),
+			Schema: json.RawMessage(`{"type": "object", "required": ["columns"]}`),
+			Mode:   asset.SchemaModeWarn,
+		}
+		r.Require().NoError(r.repository.Upsert(r.ctx, ts))
+		r.False(ts.CreatedAt.IsZero())
+
+		actual, err := r.repository.Get(r.ctx, asset.Type("table"))
+		r.NoError(err)
+		r.Equal(asset.Type("table"), actual.Type)
+		r.Equal(asset.SchemaModeWarn, actual.Mode)
+		r.JSONEq(`{"type": "object", "required": ["columns"]}`, string(actual.Schema))
+	})
+
+	r.Run("replace existing schema", func() {
+		ts := &asset.TypeSchema{
+			Type:   asset.Type("table"),
+			Schema: json.RawMessage(`{"type": "object"}`),
+			Mode:   asset.SchemaModeEnforce,
+		}
+		r.Require().NoError(r.repository.Upsert(r.ctx, ts))
+
+		actual, err := r.repository.Get(r.ctx, asset.Type("table"))
+		r.NoError(err)
+		r.Equal(asset.SchemaModeEnforce, actual.Mode)
+		r.JSONEq(`{"type": "object"}`, string(actual.Schema))
+		r.False(actual.UpdatedAt.Before(actual.CreatedAt))
+	})
+}
+
+func (r *AssetTypeSchemaRepositoryTestSuite) TestDelete() {
+	r.Run("return not found error if type has no schema", func() {
+		err := r.repository.Delete(r.ctx, asset.Type("topic"))
+		r.ErrorIs(err, asset.TypeSchemaNotFoundError{Type: asset.Type("topic")})
+	})
+
+	r.Run("delete schema of type", func() {
+		r.Require().NoError(r.repository.Upsert(r.ctx, &asset.TypeSchema{
+			Type:   asset.Type("topic"),
+			Schema: json.RawMessage(`{"type": "object"}`),
+			Mode:   asset.SchemaModeEnforce,
+		}))
+
+		r.NoError(r.repository.Delete(r.ctx, asset.Type("topic")))
+
+		_, err := r.repository.Get(r.ctx, asset.Type("topic"))
+		r.ErrorIs(err, asset.TypeSchemaNotFoundError{Type: asset.Type("topic")})
+	})
+}
+
+func TestAssetTypeSchemaRepository(t *testing.T) {
+	suite.Run(t, &AssetTypeSchemaRepositoryTestSuite{})
+}
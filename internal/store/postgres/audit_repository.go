@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/goto/compass/core/audit"
+	"github.com/lib/pq"
+)
+
+type AuditEventModel struct {
+	ID        string         `db:"id"`
+	Actor     string         `db:"actor"`
+	Method    string         `db:"method"`
+	TargetIDs pq.StringArray `db:"target_ids"`
+	Request   string         `db:"request"`
+	Result    string         `db:"result"`
+	Error     string         `db:"error"`
+	LatencyMS int64          `db:"latency_ms"`
+	CreatedAt time.Time      `db:"created_at"`
+}
+
+func (m AuditEventModel) toEvent() audit.Event {
+	return audit.Event{
+		ID:        m.ID,
+		Actor:     m.Actor,
+		Method:    m.Method,
+		TargetIDs: m.TargetIDs,
+		Request:   m.Request,
+		Result:    m.Result,
+		Error:     m.Error,
+		Latency:   time.Duration(m.LatencyMS) * time.Millisecond,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// AuditRepository is a type that appends the audit events to the primary
+// database, where they can not be updated nor deleted
+type AuditRepository struct {
+	client *Client
+}
+
+// Insert appends a record to the audit_events table
+func (r *AuditRepository) Insert(ctx context.Context, e *audit.Event) (string, error) {
+	if e == nil {
+		return "", errors.New("audit event is nil")
+	}
+
+	targetIDs := e.TargetIDs
+	if targetIDs == nil {
+		targetIDs = []string{}
+	}
+
+	var id string
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		audit_events
+			(actor, method, target_ids, request, result, error, latency_ms)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, e.Actor, e.Method, pq.StringArray(targetIDs), e.Request, e.Result, e.Error, e.Latency.Milliseconds()).Scan(&id); err != nil {
+		return "", fmt.Errorf("insert audit event: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetAll fetches the audit events matching the filter, the latest first
+func (r *AuditRepository) GetAll(ctx context.Context, flt audit.Filter) ([]audit.Event, error) {
+	builder := sq.Select("id", "actor", "method", "target_ids", "request", "result", "error", "latency_ms", "created_at").
+		From("audit_events").
+		OrderBy("created_at DESC", "id")
+
+	if flt.Actor != "" {
+		builder = builder.Where(sq.Expr("lower(actor) = lower(?)", flt.Actor))
+	}
+	if flt.Method != "" {
+		builder = builder.Where(sq.Or{sq.Eq{"method": flt.Method}, sq.Like{"method": "%/" + flt.Method}})
+	}
+	if flt.TargetID != "" {
+		builder = builder.Where(sq.Expr("target_ids @> ?", pq.StringArray{flt.TargetID}))
+	}
+	if flt.Result != "" {
+		builder = builder.Where(sq.Eq{"result": flt.Result})
+	}
+	if !flt.Since.IsZero() {
+		builder = builder.Where(sq.GtOrEq{"created_at": flt.Since.UTC()})
+	}
+	if !flt.Until.IsZero() {
+		builder = builder.Where(sq.Lt{"created_at": flt.Until.UTC()})
+	}
+	if flt.Size > 0 {
+		builder = builder.Limit(uint64(flt.Size))
+	}
+	if flt.Offset > 0 {
+		builder = builder.Offset(uint64(flt.Offset))
+	}
+
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get audit events query: %w", err)
+	}
+
+	var models []AuditEventModel
+	if err := r.client.db.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, fmt.Errorf("get audit events: %w", err)
+	}
+
+	events := make([]audit.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, m.toEvent())
+	}
+	return events, nil
+}
+
+// NewAuditRepository initializes audit repository clients
+func NewAuditRepository(c *Client) (*AuditRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &AuditRepository{
+		client: c,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/audit"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.AuditRepository
+}
+
+func (r *AuditRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewAuditRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AuditRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *AuditRepositoryTestSuite) TestInsertAndGetAll() {
+	events := []audit.Event{
+		{
+			Actor:     "john.doe@example.com",
+			Method:    "/gotocompany.compass.v1beta1.CompassService/UpsertAsset",
+			TargetIDs: []string{"urn:table"},
+			Request:   `{"asset":{"urn":"urn:table"}}`,
+			Result:    "OK",
+			Latency:   120 * time.Millisecond,
+		},
+		{
+			Actor:     "jane.doe@example.com",
+			Method:    "/gotocompany.compass.v1beta1.CompassService/DeleteAsset",
+			TargetIDs: []string{"asset-id"},
+			Result:    "PermissionDenied",
+			Error:     "owner role required",
+		},
+		{
+			Actor:  "compass-cleanup",
+			Method: "cleanup",
+			Result: "OK",
+		},
+	}
+	for i := range events {
+		id, err := r.repository.Insert(r.ctx, &events[i])
+		r.Require().NoError(err)
+		r.Require().NotEmpty(id)
+	}
+
+	r.Run("return all events, the latest first", func() {
+		actual, err := r.repository.GetAll(r.ctx, audit.Filter{})
+		r.NoError(err)
+		r.Require().Len(actual, 3)
+		r.Equal("cleanup", actual[0].Method)
+		r.Equal([]string{}, []string(actual[0].TargetIDs))
+		r.Equal("john.doe@example.com", actual[2].Actor)
+		r.Equal(120*time.Millisecond, actual[2].Latency)
+		r.Equal(events[0].Request, actual[2].Request)
+	})
+
+	r.Run("filter by actor, short method name, target and result", func() {
+		actual, err := r.repository.GetAll(r.ctx, audit.Filter{Actor: "John.Doe@example.com"})
+		r.NoError(err)
+		r.Len(actual, 1)
+
+		actual, err = r.repository.GetAll(r.ctx, audit.Filter{Method: "DeleteAsset"})
+		r.NoError(err)
+		r.Require().Len(actual, 1)
+		r.Equal("owner role required", actual[0].Error)
+
+		actual, err = r.repository.GetAll(r.ctx, audit.Filter{TargetID: "urn:table"})
+		r.NoError(err)
+		r.Len(actual, 1)
+
+		actual, err = r.repository.GetAll(r.ctx, audit.Filter{Result: "PermissionDenied"})
+		r.NoError(err)
+		r.Len(actual, 1)
+	})
+
+	r.Run("filter by time range and page", func() {
+		actual, err := r.repository.GetAll(r.ctx, audit.Filter{Since: time.Now().Add(time.Hour)})
+		r.NoError(err)
+		r.Empty(actual)
+
+		actual, err = r.repository.GetAll(r.ctx, audit.Filter{Until: time.Now().Add(time.Hour), Size: 1, Offset: 1})
+		r.NoError(err)
+		r.Len(actual, 1)
+	})
+
+	r.Run("refuse to update or delete events", func() {
+		err := r.client.ExecQueries(r.ctx, []string{"DELETE FROM audit_events"})
+		r.Error(err)
+
+		err = r.client.ExecQueries(r.ctx, []string{"UPDATE audit_events SET actor = 'someone@example.com'"})
+		r.Error(err)
+	})
+}
+
+func TestAuditRepository(t *testing.T) {
+	suite.Run(t, &AuditRepositoryTestSuite{})
+}
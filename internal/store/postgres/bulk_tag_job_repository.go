@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// BulkTagJobRepository is a type that manages the bulk tagging jobs in the
+// primary database
+type BulkTagJobRepository struct {
+	client *Client
+}
+
+type BulkTagJobModel struct {
+	ID          string         `db:"id"`
+	TemplateURN string         `db:"template_urn"`
+	Remove      bool           `db:"remove"`
+	Status      string         `db:"status"`
+	Report      types.JSONText `db:"report"`
+	Error       string         `db:"error"`
+	CreatedBy   string         `db:"created_by"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+func (m BulkTagJobModel) toBulkTagJob() (tag.BulkTagJob, error) {
+	var report tag.BulkTagReport
+	if err := m.Report.Unmarshal(&report); err != nil {
+		return tag.BulkTagJob{}, fmt.Errorf("unmarshal bulk tag job report: %w", err)
+	}
+
+	return tag.BulkTagJob{
+		ID:          m.ID,
+		TemplateURN: m.TemplateURN,
+		Remove:      m.Remove,
+		Status:      tag.BulkTagJobStatus(m.Status),
+		Report:      report,
+		Error:       m.Error,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}, nil
+}
+
+// Create inserts a new record in the bulk_tag_jobs table, setting the id and
+// the timestamps of the job
+func (r *BulkTagJobRepository) Create(ctx context.Context, job *tag.BulkTagJob) error {
+	if job == nil {
+		return errors.New("bulk tag job is nil")
+	}
+
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		bulk_tag_jobs
+			(template_urn, remove, status, created_by)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`, job.TemplateURN, job.Remove, job.Status, job.CreatedBy).Scan(&job.ID, &job.CreatedAt, &job.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to create bulk tag job: %w", checkPostgresError(err))
+	}
+
+	return nil
+}
+
+// Complete stores the status of the job along with its report or error
+func (r *BulkTagJobRepository) Complete(ctx context.Context, job tag.BulkTagJob) error {
+	if !isValidUUID(job.ID) {
+		return tag.BulkTagJobNotFoundError{ID: job.ID}
+	}
+
+	report, err := json.Marshal(job.Report)
+	if err != nil {
+		return fmt.Errorf("marshal bulk tag job report: %w", err)
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		UPDATE
+			bulk_tag_jobs
+		SET
+			status = $2, report = $3, error = $4, updated_at = NOW()
+		WHERE
+			id = $1
+	`, job.ID, job.Status, types.JSONText(report), job.Error)
+	if err != nil {
+		return fmt.Errorf("failed to complete bulk tag job: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed getting affected rows: %w", err)
+	}
+	if affected == 0 {
+		return tag.BulkTagJobNotFoundError{ID: job.ID}
+	}
+
+	return nil
+}
+
+// GetByID fetches a bulk tagging job by its id
+func (r *BulkTagJobRepository) GetByID(ctx context.Context, id string) (tag.BulkTagJob, error) {
+	if !isValidUUID(id) {
+		return tag.BulkTagJob{}, tag.BulkTagJobNotFoundError{ID: id}
+	}
+
+	var m BulkTagJobModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			id, template_urn, remove, status, report, error, created_by, created_at, updated_at
+		FROM
+			bulk_tag_jobs
+		WHERE
+			id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return tag.BulkTagJob{}, tag.BulkTagJobNotFoundError{ID: id}
+	}
+	if err != nil {
+		return tag.BulkTagJob{}, fmt.Errorf("failed fetching bulk tag job: %w", err)
+	}
+
+	return m.toBulkTagJob()
+}
+
+// NewBulkTagJobRepository initializes bulk tag job repository clients
+func NewBulkTagJobRepository(c *Client) (*BulkTagJobRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &BulkTagJobRepository{
+		client: c,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type BulkTagJobRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.BulkTagJobRepository
+}
+
+func (r *BulkTagJobRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewBulkTagJobRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *BulkTagJobRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *BulkTagJobRepositoryTestSuite) TestCreateAndGet() {
+	r.Run("return the job as created", func() {
+		job := tag.BulkTagJob{
+			TemplateURN: "governance_policy",
+			Remove:      true,
+			Status:      tag.BulkTagJobPending,
+			CreatedBy:   "user@example.com",
+		}
+		r.Require().NoError(r.repository.Create(r.ctx, &job))
+		r.NotEmpty(job.ID)
+
+		got, err := r.repository.GetByID(r.ctx, job.ID)
+		r.Require().NoError(err)
+		r.Equal(job.TemplateURN, got.TemplateURN)
+		r.True(got.Remove)
+		r.Equal(tag.BulkTagJobPending, got.Status)
+		r.Equal(job.CreatedBy, got.CreatedBy)
+		r.Empty(got.Report.SucceededAssetIDs)
+	})
+
+	r.Run("return BulkTagJobNotFoundError if the job does not exist", func() {
+		id := uuid.NewString()
+		_, err := r.repository.GetByID(r.ctx, id)
+		r.ErrorIs(err, tag.BulkTagJobNotFoundError{ID: id})
+
+		_, err = r.repository.GetByID(r.ctx, "invalid-id")
+		r.ErrorIs(err, tag.BulkTagJobNotFoundError{ID: "invalid-id"})
+	})
+}
+
+func (r *BulkTagJobRepositoryTestSuite) TestComplete() {
+	r.Run("store the report of the job", func() {
+		job := tag.BulkTagJob{TemplateURN: "governance_policy", Status: tag.BulkTagJobPending}
+		r.Require().NoError(r.repository.Create(r.ctx, &job))
+
+		report := tag.BulkTagReport{
+			TemplateURN:       "governance_policy",
+			Assets:            2,
+			SucceededAssetIDs: []string{"asset-a"},
+			Failures:          []tag.BulkTagFailure{{Asset: "urn:b", Reason: "asset not found"}},
+		}
+		err := r.repository.Complete(r.ctx, tag.BulkTagJob{ID: job.ID, Status: tag.BulkTagJobDone, Report: report})
+		r.Require().NoError(err)
+
+		got, err := r.repository.GetByID(r.ctx, job.ID)
+		r.Require().NoError(err)
+		r.Equal(tag.BulkTagJobDone, got.Status)
+		r.Equal(report, got.Report)
+		r.Empty(got.Error)
+	})
+
+	r.Run("store the error of the job", func() {
+		job := tag.BulkTagJob{TemplateURN: "governance_policy", Status: tag.BulkTagJobPending}
+		r.Require().NoError(r.repository.Create(r.ctx, &job))
+
+		err := r.repository.Complete(r.ctx, tag.BulkTagJob{ID: job.ID, Status: tag.BulkTagJobFailed, Error: "some error"})
+		r.Require().NoError(err)
+
+		got, err := r.repository.GetByID(r.ctx, job.ID)
+		r.Require().NoError(err)
+		r.Equal(tag.BulkTagJobFailed, got.Status)
+		r.Equal("some error", got.Error)
+	})
+
+	r.Run("return BulkTagJobNotFoundError if the job does not exist", func() {
+		id := uuid.NewString()
+		err := r.repository.Complete(r.ctx, tag.BulkTagJob{ID: id, Status: tag.BulkTagJobDone})
+		r.ErrorIs(err, tag.BulkTagJobNotFoundError{ID: id})
+	})
+}
+
+func TestBulkTagJobRepository(t *testing.T) {
+	suite.Run(t, &BulkTagJobRepositoryTestSuite{})
+}
@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/queryexpr"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// DiscoveryRepository implements asset.DiscoveryRepository with the full
+// text search of postgres. Assets are stored as documents in the
+// discovery_assets table, apart from the assets table, the same way they are
+// indexed in elasticsearch.
+type DiscoveryRepository struct {
+	client                    *Client
+	columnSearchExclusionList []string
+}
+
+func (r *DiscoveryRepository) Upsert(ctx context.Context, ast asset.Asset) error {
+	if ast.ID == "" {
+		return asset.ErrEmptyID
+	}
+	if !ast.Type.IsValid() {
+		return fmt.Errorf("type [%s] is invalid: %w", ast.Type, asset.ErrUnknownType)
+	}
+
+	doc, err := json.Marshal(ast)
+	if err != nil {
+		return asset.DiscoveryError{Op: "EncodeAsset", ID: ast.ID, Err: err}
+	}
+	data, err := marshalJSONObject(ast.Data)
+	if err != nil {
+		return asset.DiscoveryError{Op: "EncodeAsset", ID: ast.ID, Err: err}
+	}
+	labels, err := marshalJSONObject(ast.Labels)
+	if err != nil {
+		return asset.DiscoveryError{Op: "EncodeAsset", ID: ast.ID, Err: err}
+	}
+
+	if _, err := r.client.db.ExecContext(ctx, `
+		INSERT INTO
+		discovery_assets
+			(id, urn, type, service, name, description, url, data, labels, version, is_deleted,
+			 created_at, updated_at, refreshed_at, document, search_vector, indexed_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8::jsonb, $9::jsonb, $10, $11,
+			 $12, $13, $14, $15::jsonb,
+			 setweight(to_tsvector('simple', $2::text), 'A') ||
+			 setweight(to_tsvector('simple', $5::text), 'A') ||
+			 setweight(to_tsvector('simple', $6::text), 'B') ||
+			 setweight(jsonb_to_tsvector('simple', $15::jsonb, '["string"]'), 'D'),
+			 NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			urn = EXCLUDED.urn,
+			type = EXCLUDED.type,
+			service = EXCLUDED.service,
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			url = EXCLUDED.url,
+			data = EXCLUDED.data,
+			labels = EXCLUDED.labels,
+			version = EXCLUDED.version,
+			is_deleted = EXCLUDED.is_deleted,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			refreshed_at = EXCLUDED.refreshed_at,
+			document = EXCLUDED.document,
+			search_vector = EXCLUDED.search_vector,
+			indexed_at = EXCLUDED.indexed_at
+	`, ast.ID, ast.URN, ast.Type.String(), ast.Service, ast.Name, ast.Description, ast.URL,
+		data, labels, ast.Version, ast.IsDeleted,
+		ast.CreatedAt, ast.UpdatedAt, ast.RefreshedAt, string(doc),
+	); err != nil {
+		return asset.DiscoveryError{Op: "IndexDoc", ID: ast.ID, Err: checkPostgresError(err)}
+	}
+
+	return nil
+}
+
+// SyncAssets marks the start of a sync of the service. The assets of the
+// service stay searchable while they are upserted again, the returned cleanup
+// removes the ones which were not upserted since.
+func (r *DiscoveryRepository) SyncAssets(ctx context.Context, service string) (func() error, error) {
+	var startedAt time.Time
+	if err := r.client.db.GetContext(ctx, &startedAt, `SELECT NOW()`); err != nil {
+		return nil, asset.DiscoveryError{Op: "SyncAssets", Index: service, Err: err}
+	}
+
+	cleanupFn := func() error {
+		if _, err := r.client.db.ExecContext(ctx, `
+			DELETE FROM discovery_assets WHERE service = $1 AND indexed_at < $2
+		`, service, startedAt); err != nil {
+			return asset.DiscoveryError{Op: "SyncAssets", Index: service, Err: err}
+		}
+		return nil
+	}
+
+	return cleanupFn, nil
+}
+
+func (r *DiscoveryRepository) DeleteByID(ctx context.Context, assetID string) error {
+	if assetID == "" {
+		return asset.ErrEmptyID
+	}
+
+	return r.deleteWhere(ctx, "DeleteByID", "id = $1", assetID)
+}
+
+func (r *DiscoveryRepository) DeleteByURN(ctx context.Context, assetURN string) error {
+	if assetURN == "" {
+		return asset.ErrEmptyURN
+	}
+
+	return r.deleteWhere(ctx, "DeleteByURN", "urn = $1", assetURN)
+}
+
+func (r *DiscoveryRepository) SoftDeleteByURN(ctx context.Context, params asset.SoftDeleteAssetParams) error {
+	if params.URN == "" {
+		return asset.ErrEmptyURN
+	}
+
+	patch, err := softDeletePatch(params.UpdatedAt, &params.RefreshedAt, params.UpdatedBy, params.NewVersion)
+	if err != nil {
+		return asset.DiscoveryError{Op: "SoftDeleteByURN", Err: err}
+	}
+
+	if _, err := r.client.db.ExecContext(ctx, softDeleteQuery("urn"),
+		params.URN, params.UpdatedAt, params.RefreshedAt, params.NewVersion, patch,
+	); err != nil {
+		return asset.DiscoveryError{
+			Op:  "SoftDeleteDoc",
+			Err: fmt.Errorf("urn: %s: %w", params.URN, err),
+		}
+	}
+
+	return nil
+}
+
+// DeleteByQueryExpr deletes the assets matching the query expression, which
+// is translated to SQL regardless of the kind of expression given.
+func (r *DiscoveryRepository) DeleteByQueryExpr(ctx context.Context, queryExpr queryexpr.ExprStr) error {
+	if strings.TrimSpace(queryExpr.String()) == "" {
+		return asset.ErrEmptyQuery
+	}
+
+	whereCondition, err := queryexpr.ValidateAndGetQueryFromExpr(asset.DeleteAssetExpr{
+		ExprStr: queryexpr.SQLExpr(queryExpr.String()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.deleteWhere(ctx, "DeleteByQueryExpr", whereCondition)
+}
+
+func (r *DiscoveryRepository) DeleteByIsDeletedAndServicesAndUpdatedAt(
+	ctx context.Context,
+	isDeleted bool,
+	services []string,
+	expiryThreshold time.Time,
+) error {
+	if len(services) == 0 {
+		return asset.ErrEmptyServices
+	}
+	if expiryThreshold.IsZero() {
+		return asset.ErrExpiryThresholdTimeIsZero
+	}
+
+	if strings.TrimSpace(services[0]) == asset.AllServicesCleanupConfig {
+		return r.deleteWhere(ctx, "DeleteByIsDeletedAndServicesAndUpdatedAt",
+			"is_deleted = $1 AND updated_at < $2", isDeleted, expiryThreshold)
+	}
+
+	return r.deleteWhere(ctx, "DeleteByIsDeletedAndServicesAndUpdatedAt",
+		"is_deleted = $1 AND updated_at < $2 AND service = ANY($3::text[])",
+		isDeleted, expiryThreshold, pq.StringArray(services))
+}
+
+func (r *DiscoveryRepository) SoftDeleteAssets(ctx context.Context, assets []asset.Asset, doUpdateVersion bool) error {
+	if len(assets) == 0 {
+		return nil
+	}
+
+	return r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		for _, a := range assets {
+			newVersion := a.Version
+			if doUpdateVersion {
+				var err error
+				newVersion, err = asset.IncreaseMinorVersion(a.Version)
+				if err != nil {
+					return fmt.Errorf("error increase version for ID %s: %w", a.ID, err)
+				}
+			}
+
+			patch, err := softDeletePatch(a.UpdatedAt, a.RefreshedAt, a.UpdatedBy.ID, newVersion)
+			if err != nil {
+				return fmt.Errorf("marshal update doc for ID %s: %w", a.ID, err)
+			}
+
+			if _, err := tx.ExecContext(ctx, softDeleteQuery("id"),
+				a.ID, a.UpdatedAt, a.RefreshedAt, newVersion, patch,
+			); err != nil {
+				return asset.DiscoveryError{Op: "SoftDeleteAssets", ID: a.ID, Err: err}
+			}
+		}
+		return nil
+	})
+}
+
+func (r *DiscoveryRepository) deleteWhere(ctx context.Context, discoveryOp, whereCondition string, args ...interface{}) error {
+	if _, err := r.client.db.ExecContext(ctx, "DELETE FROM discovery_assets WHERE "+whereCondition, args...); err != nil {
+		return asset.DiscoveryError{
+			Op:  "DeleteDoc",
+			Err: fmt.Errorf("%s: %s: %w", discoveryOp, whereCondition, err),
+		}
+	}
+	return nil
+}
+
+// softDeleteQuery returns the statement soft deleting the assets matching the
+// given column, the document is patched the same way as the columns.
+func softDeleteQuery(column string) string {
+	return fmt.Sprintf(`
+		UPDATE discovery_assets
+		SET
+			is_deleted = true,
+			updated_at = $2,
+			refreshed_at = $3,
+			version = $4,
+			document = document || $5::jsonb
+		WHERE %s = $1
+	`, column)
+}
+
+func softDeletePatch(updatedAt time.Time, refreshedAt *time.Time, updatedBy, version string) (string, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"is_deleted":   true,
+		"updated_at":   updatedAt,
+		"refreshed_at": refreshedAt,
+		"updated_by":   user.User{ID: updatedBy},
+		"version":      version,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(patch), nil
+}
+
+// marshalJSONObject marshals a map, a nil map is marshalled as an empty object.
+func marshalJSONObject[M ~map[string]V, V any](m M) (string, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// NewDiscoveryRepository initializes discovery repository clients
+func NewDiscoveryRepository(c *Client, colSearchExclusionList []string) (*DiscoveryRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &DiscoveryRepository{
+		client:                    c,
+		columnSearchExclusionList: colSearchExclusionList,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDiscoveryRepository(t *testing.T) {
+	t.Run("should return error if client is nil", func(t *testing.T) {
+		_, err := postgres.NewDiscoveryRepository(nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestDiscoveryRepository(t *testing.T) {
+	client, err := newTestClient(t, log.NewNoop())
+	require.NoError(t, err)
+
+	repo, err := postgres.NewDiscoveryRepository(client, []string{"number", "id"})
+	require.NoError(t, err)
+
+	testutils.RunDiscoveryRepositoryTests(t, testutils.DiscoveryRepositoryHarness{Repo: repo})
+}
@@ -0,0 +1,751 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/jmoiron/sqlx/types"
+	"github.com/lib/pq"
+)
+
+const (
+	defaultDiscoveryMaxResults = 1000
+	defaultGroupsSize          = 1000
+	// groupAssetsSize is the number of assets returned per group, the same
+	// as the default size of the top hits of elasticsearch
+	groupAssetsSize = 3
+	suggestionsSize = 5
+
+	// exactMatchWeight makes sure the asset named after the search text is
+	// placed first
+	exactMatchWeight  = 50.0
+	rankByFieldWeight = 2.0
+	rankByValueWeight = 2.0
+
+	// assetVector is the search vector of the asset, weighting the urn and
+	// the name over the description and the rest of the document
+	assetVector = "search_vector"
+	// columnsVector is the search vector of the columns of the asset and
+	// the values of their tags
+	columnsVector = `(
+		setweight(to_tsvector('simple', jsonb_path_query_array(document, '$.data.columns[*].name')), 'A') ||
+		setweight(to_tsvector('simple', jsonb_path_query_array(document, '$.data.columns[*].tags.*.*')), 'B') ||
+		setweight(to_tsvector('simple', jsonb_path_query_array(document, '$.data.columns[*].description')), 'D')
+	)`
+)
+
+var (
+	defaultSearchFields = []string{
+		"id", "urn", "type", "service", "name", "description", "data", "labels",
+		"created_at", "updated_at", "is_deleted",
+	}
+	columnHighlightFields = []string{"data.columns.name", "data.columns.description", "data.columns.tags"}
+
+	searchTokenPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+)
+
+type discoveryDocumentModel struct {
+	ID              string         `db:"id"`
+	Document        types.JSONText `db:"document"`
+	TextScore       float64        `db:"text_score"`
+	ExactScore      float64        `db:"exact_score"`
+	RankScore       float64        `db:"rank_score"`
+	TypeScore       float64        `db:"type_score"`
+	PopularityScore float64        `db:"popularity_score"`
+}
+
+func (m discoveryDocumentModel) score() float64 {
+	return m.TextScore + m.ExactScore + m.RankScore + m.TypeScore + m.PopularityScore
+}
+
+// Search the assets with the full text search of postgres. Text is matched
+// by prefix, there is no fuzziness.
+func (r *DiscoveryRepository) Search(ctx context.Context, cfg asset.SearchConfig) ([]asset.SearchResult, error) {
+	maxResults := cfg.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultDiscoveryMaxResults
+	}
+	offset := cfg.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	includeFields := cfg.IncludeFields
+	if len(includeFields) == 0 {
+		includeFields = defaultSearchFields
+	}
+
+	q, err := r.buildSearchQuery(cfg)
+	if err != nil {
+		return nil, asset.DiscoveryError{Op: "Search", Err: fmt.Errorf("build query: %w", err)}
+	}
+	q.query = fmt.Sprintf(`
+		SELECT * FROM (%s) d
+		ORDER BY text_score + exact_score + rank_score + type_score + popularity_score DESC, id
+		LIMIT %s OFFSET %s`, q.query, q.arg(maxResults), q.arg(offset))
+
+	var models []discoveryDocumentModel
+	if err := r.client.db.SelectContext(ctx, &models, q.query, q.args...); err != nil {
+		return nil, asset.DiscoveryError{Op: "Search", Err: fmt.Errorf("execute search: %w", err)}
+	}
+
+	results := make([]asset.SearchResult, 0, len(models))
+	for _, m := range models {
+		result, err := r.toSearchResult(m, cfg, includeFields)
+		if err != nil {
+			return nil, asset.DiscoveryError{Op: "Search", Err: fmt.Errorf("decode search result: %w", err)}
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *DiscoveryRepository) Suggest(ctx context.Context, cfg asset.SearchConfig) ([]string, error) {
+	q := &sqlQuery{}
+	conditions := []string{fmt.Sprintf("lower(name) LIKE %s", q.arg(escapeLikePattern(strings.ToLower(cfg.Text))+"%"))}
+	conditions = append(conditions, visibilityConditions(q, cfg.Viewer)...)
+	q.query = fmt.Sprintf(`
+		SELECT DISTINCT name
+		FROM discovery_assets
+		WHERE %s
+		ORDER BY name
+		LIMIT %s`, strings.Join(conditions, " AND "), q.arg(suggestionsSize))
+
+	var suggestions []string
+	if err := r.client.db.SelectContext(ctx, &suggestions, q.query, q.args...); err != nil {
+		return nil, asset.DiscoveryError{Op: "Suggest", Err: fmt.Errorf("execute search: %w", err)}
+	}
+
+	return suggestions, nil
+}
+
+func (r *DiscoveryRepository) GroupAssets(ctx context.Context, cfg asset.GroupConfig) ([]asset.GroupResult, error) {
+	if len(cfg.GroupBy) == 0 || cfg.GroupBy[0] == "" {
+		return nil, asset.DiscoveryError{Op: "Group", Err: fmt.Errorf("group by field cannot be empty")}
+	}
+
+	size := cfg.Size
+	if size <= 0 {
+		size = defaultGroupsSize
+	}
+
+	q := &sqlQuery{}
+	conditions := []string{}
+	if _, ok := cfg.Filters["is_deleted"]; !ok {
+		conditions = append(conditions, "NOT is_deleted")
+	}
+	keys := make([]string, len(cfg.GroupBy))
+	for i, field := range cfg.GroupBy {
+		keys[i] = fmt.Sprintf("document #>> %s::text[]", q.arg(pq.StringArray(strings.Split(field, "."))))
+		conditions = append(conditions, keys[i]+" IS NOT NULL")
+	}
+	conditions = append(conditions, filterConditions(q, cfg.Filters)...)
+	conditions = append(conditions, visibilityConditions(q, cfg.Viewer)...)
+
+	groupKey := "ARRAY[" + strings.Join(keys, ", ") + "]"
+	q.query = fmt.Sprintf(`
+		SELECT keys, document
+		FROM (
+			SELECT
+				%[1]s AS keys,
+				document,
+				dense_rank() OVER (ORDER BY %[1]s) AS group_rank,
+				row_number() OVER (PARTITION BY %[1]s ORDER BY id) AS asset_rank
+			FROM discovery_assets
+			WHERE %[2]s
+		) g
+		WHERE group_rank <= %[3]s AND asset_rank <= %[4]s
+		ORDER BY group_rank, asset_rank`,
+		groupKey, strings.Join(conditions, " AND "), q.arg(size), q.arg(groupAssetsSize))
+
+	var rows []struct {
+		Keys     pq.StringArray `db:"keys"`
+		Document types.JSONText `db:"document"`
+	}
+	if err := r.client.db.SelectContext(ctx, &rows, q.query, q.args...); err != nil {
+		return nil, asset.DiscoveryError{Op: "Group", Err: fmt.Errorf("execute group query: %w", err)}
+	}
+
+	// the group by fields and is_deleted are always part of the response
+	includedFields := append(append([]string{}, cfg.GroupBy...), cfg.IncludedFields...)
+	includedFields = append(includedFields, "is_deleted")
+
+	var results []asset.GroupResult
+	for _, row := range rows {
+		var ast asset.Asset
+		if err := decodeDocument(row.Document, includedFields, &ast); err != nil {
+			return nil, asset.DiscoveryError{Op: "Group", Err: fmt.Errorf("decode group response: %w", err)}
+		}
+
+		if n := len(results); n > 0 && equalStrings(results[n-1].Fields, row.Keys) {
+			results[n-1].Assets = append(results[n-1].Assets, ast)
+			continue
+		}
+
+		fields := make([]asset.GroupField, len(cfg.GroupBy))
+		for i, field := range cfg.GroupBy {
+			fields[i] = asset.GroupField{Name: field, Value: row.Keys[i]}
+		}
+		results = append(results, asset.GroupResult{Fields: fields, Assets: []asset.Asset{ast}})
+	}
+
+	return results, nil
+}
+
+func (r *DiscoveryRepository) buildSearchQuery(cfg asset.SearchConfig) (*sqlQuery, error) {
+	isDeletedQuery, isDeletedQueryExist := cfg.Queries["is_deleted"]
+	isDeletedFilters, isDeletedFilterExist := cfg.Filters["is_deleted"]
+	if isDeletedQueryExist && isDeletedFilterExist && isDeletedQuery != isDeletedFilters[0] {
+		return nil, fmt.Errorf("conflicting is_deleted query and filter: query=%s, filter=%s", isDeletedQuery, isDeletedFilters[0])
+	}
+
+	q := &sqlQuery{}
+	textScore, exactScore := "0", "0"
+	var conditions []string
+	if !isDeletedQueryExist && !isDeletedFilterExist {
+		conditions = append(conditions, "NOT is_deleted")
+	}
+
+	if text := strings.TrimSpace(cfg.Text); text != "" {
+		vector, matchText := assetVector, text
+		exactMatch := fmt.Sprintf("name = %s::text", q.arg(text))
+		if cfg.Flags.IsColumnSearch {
+			vector, matchText = columnsVector, r.columnMatchText(text)
+			exactMatch = fmt.Sprintf(
+				`jsonb_path_exists(document, '$.data.columns[*] ? (@.name == $v)', jsonb_build_object('v', %s::text))`,
+				q.placeholder(),
+			)
+		}
+
+		exactScore = fmt.Sprintf("CASE WHEN %s THEN %v ELSE 0 END", exactMatch, exactMatchWeight)
+		if tsQuery := toTSQuery(matchText); tsQuery != "" {
+			query := fmt.Sprintf("to_tsquery('simple', %s)", q.arg(tsQuery))
+			textScore = fmt.Sprintf("ts_rank(%s, %s)", vector, query)
+			conditions = append(conditions, fmt.Sprintf("(%s @@ %s OR %s)", vector, query, exactMatch))
+		} else {
+			conditions = append(conditions, exactMatch)
+		}
+	}
+
+	conditions = append(conditions, filterConditions(q, cfg.Filters)...)
+	conditions = append(conditions, queryConditions(q, cfg.Queries)...)
+	conditions = append(conditions, visibilityConditions(q, cfg.Viewer)...)
+	if len(conditions) == 0 {
+		conditions = append(conditions, "true")
+	}
+
+	q.query = fmt.Sprintf(`
+		SELECT
+			id,
+			document,
+			(%s)::float8 AS text_score,
+			(%s)::float8 AS exact_score,
+			(%s)::float8 AS rank_score,
+			(%s)::float8 AS type_score,
+			(%s)::float8 AS popularity_score
+		FROM discovery_assets
+		WHERE %s`,
+		textScore, exactScore, rankByScore(q, cfg.RankBy), typeBoostScore(q, cfg.TypeBoosts),
+		numericFieldScore(q, "data.stats_metadata.query_count", 1.0),
+		strings.Join(conditions, " AND "),
+	)
+
+	return q, nil
+}
+
+// columnMatchText removes the excluded keywords from the text searched in
+// the columns.
+func (r *DiscoveryRepository) columnMatchText(text string) string {
+	matchString := text
+	for _, exclusionStr := range r.columnSearchExclusionList {
+		exclusionStr = strings.TrimSpace(exclusionStr)
+		if exclusionStr == "" || !strings.Contains(matchString, exclusionStr) {
+			continue
+		}
+		matchString = strings.ReplaceAll(matchString, fmt.Sprintf("_%s", exclusionStr), "")
+		matchString = strings.ReplaceAll(matchString, fmt.Sprintf(" %s", exclusionStr), "")
+		matchString = strings.ReplaceAll(matchString, fmt.Sprintf("-%s", exclusionStr), "")
+	}
+	if matchString == "" {
+		return text
+	}
+	return matchString
+}
+
+func (r *DiscoveryRepository) toSearchResult(m discoveryDocumentModel, cfg asset.SearchConfig, includeFields []string) (asset.SearchResult, error) {
+	var doc map[string]interface{}
+	if err := m.Document.Unmarshal(&doc); err != nil {
+		return asset.SearchResult{}, err
+	}
+	doc = includeDocumentFields(doc, includeFields)
+
+	var ast asset.Asset
+	if err := remarshal(doc, &ast); err != nil {
+		return asset.SearchResult{}, err
+	}
+
+	var highlights map[string][]string
+	if cfg.Flags.EnableHighlight && strings.TrimSpace(cfg.Text) != "" {
+		var fields []string
+		if cfg.Flags.IsColumnSearch {
+			fields = columnHighlightFields
+		}
+		highlights = highlightDocument(doc, searchTokens(cfg.Text), fields)
+	}
+
+	data := ast.Data
+	if len(highlights) > 0 {
+		if data == nil {
+			data = map[string]interface{}{}
+		}
+		// kept in data for the clients reading the highlights from there
+		rawHighlights := make(map[string]interface{}, len(highlights))
+		for field, fragments := range highlights {
+			rawFragments := make([]interface{}, len(fragments))
+			for i, f := range fragments {
+				rawFragments[i] = f
+			}
+			rawHighlights[field] = rawFragments
+		}
+		data["_highlight"] = rawHighlights
+	}
+
+	id := ast.ID
+	if id == "" {
+		id = ast.URN
+	}
+
+	result := asset.SearchResult{
+		ID:          id,
+		URN:         ast.URN,
+		Title:       ast.Name,
+		Type:        ast.Type.String(),
+		Service:     ast.Service,
+		Description: ast.Description,
+		Labels:      ast.Labels,
+		Data:        data,
+		IsDeleted:   ast.IsDeleted,
+		Score:       m.score(),
+		Highlights:  highlights,
+	}
+	if len(highlights) > 0 {
+		result.MatchedColumns = highlightedColumns(ast.Data, highlights)
+	}
+	if cfg.Flags.EnableExplain {
+		result.Explanation = explainScore(m)
+	}
+
+	return result, nil
+}
+
+func explainScore(m discoveryDocumentModel) *asset.SearchExplanation {
+	explanation := &asset.SearchExplanation{
+		Value:       m.score(),
+		Description: "sum of:",
+	}
+	for _, d := range []asset.SearchExplanation{
+		{Value: m.TextScore, Description: "text relevance"},
+		{Value: m.ExactScore, Description: "exact match"},
+		{Value: m.RankScore, Description: "rank by"},
+		{Value: m.TypeScore, Description: "type boost"},
+		{Value: m.PopularityScore, Description: "query count"},
+	} {
+		if d.Value != 0 {
+			explanation.Details = append(explanation.Details, d)
+		}
+	}
+	return explanation
+}
+
+// filterConditions matches the filters exactly, a filter with several values
+// matches any of them.
+func filterConditions(q *sqlQuery, filters asset.SearchFilter) []string {
+	var conditions []string
+	for field, rawValues := range filters {
+		if len(rawValues) < 1 {
+			continue
+		}
+
+		values := make([]interface{}, len(rawValues))
+		for i, v := range rawValues {
+			values[i] = v
+		}
+		if len(rawValues) == 1 {
+			values[0] = convertQueryValue(rawValues[0])
+		}
+		conditions = append(conditions, jsonPathEqualsAny(q, field, values))
+	}
+	return conditions
+}
+
+// visibilityConditions leaves out the assets restricted to groups the viewer
+// is not a member of, the same as the visibility filter of elasticsearch.
+func visibilityConditions(q *sqlQuery, viewer *asset.Viewer) []string {
+	if !viewer.IsRestricted() {
+		return nil
+	}
+
+	visible := fmt.Sprintf("document #>> '{visibility,kind}' IS DISTINCT FROM %s", q.arg(string(asset.VisibilityRestricted)))
+	if len(viewer.Groups) > 0 {
+		visible = fmt.Sprintf("(%s OR document -> 'visibility' -> 'groups' ?| %s::text[])", visible, q.arg(pq.StringArray(viewer.Groups)))
+	}
+	return []string{visible}
+}
+
+// queryConditions matches any of the words of the query in the field, or the
+// value exactly for a boolean.
+func queryConditions(q *sqlQuery, queries map[string]string) []string {
+	var conditions []string
+	for field, value := range queries {
+		v := convertQueryValue(value)
+		if _, ok := v.(string); !ok {
+			conditions = append(conditions, jsonPathEqualsAny(q, field, []interface{}{v}))
+			continue
+		}
+
+		tsQuery := toTSQuery(value)
+		if tsQuery == "" {
+			continue
+		}
+		conditions = append(conditions, fmt.Sprintf(
+			"to_tsvector('simple', jsonb_path_query_array(document, %s)) @@ to_tsquery('simple', %s)",
+			jsonPathLiteral(field, ""), q.arg(tsQuery),
+		))
+	}
+	return conditions
+}
+
+// rankByScore returns the score of the rank by param. A comma separated
+// rank by is made of field,value pairs scoring the assets having the value,
+// otherwise it is the name of a numeric field.
+func rankByScore(q *sqlQuery, rankBy string) string {
+	if rankBy == "" {
+		return "0"
+	}
+	if !strings.Contains(rankBy, ",") {
+		return numericFieldScore(q, rankBy, rankByFieldWeight)
+	}
+
+	splitRankBy := strings.Split(rankBy, ",")
+	var scores []string
+	for i := 0; i+1 < len(splitRankBy); i += 2 {
+		field := strings.TrimSpace(splitRankBy[i])
+		value := strings.TrimSpace(splitRankBy[i+1])
+		scores = append(scores, fmt.Sprintf("CASE WHEN %s THEN %v ELSE 0 END",
+			jsonPathEqualsAny(q, field, []interface{}{value}), rankByValueWeight))
+	}
+	if len(scores) == 0 {
+		return "0"
+	}
+	return strings.Join(scores, " + ")
+}
+
+// typeBoostScore returns the boost of the type of the assets.
+func typeBoostScore(q *sqlQuery, typeBoosts map[asset.Type]float64) string {
+	var scores []string
+	for _, typ := range slices.Sorted(maps.Keys(typeBoosts)) {
+		if boost := typeBoosts[typ]; boost > 0 {
+			scores = append(scores, fmt.Sprintf("CASE WHEN document->>'type' = %s THEN %v ELSE 0 END", q.arg(typ.String()), boost))
+		}
+	}
+	if len(scores) == 0 {
+		return "0"
+	}
+	return strings.Join(scores, " + ")
+}
+
+// numericFieldScore scores the numeric field with log1p, the same way as
+// the field value factor of elasticsearch does.
+func numericFieldScore(q *sqlQuery, field string, weight float64) string {
+	path := q.arg(pq.StringArray(strings.Split(field, ".")))
+	return fmt.Sprintf(
+		"CASE WHEN jsonb_typeof(document #> %[1]s::text[]) = 'number' THEN %[2]v * log(1 + greatest((document #>> %[1]s::text[])::float8, 0)) ELSE 0 END",
+		path, weight,
+	)
+}
+
+// jsonPathEqualsAny matches the documents with a value of the field equal to
+// any of the values. Arrays along the field are unwrapped.
+func jsonPathEqualsAny(q *sqlQuery, field string, values []interface{}) string {
+	vars := make(map[string]interface{}, len(values))
+	predicates := make([]string, len(values))
+	for i, v := range values {
+		name := fmt.Sprintf("v%d", i)
+		vars[name] = v
+		predicates[i] = "@ == $" + name
+	}
+	varsJSON, _ := json.Marshal(vars) //nolint:errchkjson
+
+	return fmt.Sprintf("jsonb_path_exists(document, %s, %s::jsonb)",
+		jsonPathLiteral(field, strings.Join(predicates, " || ")), q.arg(string(varsJSON)))
+}
+
+// jsonPathLiteral returns the SQL literal of the json path of the dotted
+// field, with an optional filter.
+func jsonPathLiteral(field, filter string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, key := range strings.Split(field, ".") {
+		sb.WriteString(`."`)
+		sb.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key))
+		sb.WriteString(`"`)
+	}
+	if filter != "" {
+		sb.WriteString(" ? (")
+		sb.WriteString(filter)
+		sb.WriteString(")")
+	}
+	return "'" + strings.ReplaceAll(sb.String(), "'", "''") + "'::jsonpath"
+}
+
+func convertQueryValue(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	default:
+		return value
+	}
+}
+
+// searchTokens splits the text into the lower cased words searched for.
+func searchTokens(text string) []string {
+	return searchTokenPattern.FindAllString(strings.ToLower(text), -1)
+}
+
+// toTSQuery returns a query matching any word of the text by prefix, empty if
+// the text has no words.
+func toTSQuery(text string) string {
+	tokens := searchTokens(text)
+	for i, t := range tokens {
+		tokens[i] = t + ":*"
+	}
+	return strings.Join(tokens, " | ")
+}
+
+// highlightDocument highlights the words of the string values of the
+// document starting with any of the tokens. Only the given fields, and the
+// fields nested in them, are highlighted if any.
+func highlightDocument(doc map[string]interface{}, tokens []string, fields []string) map[string][]string {
+	highlights := map[string][]string{}
+	var walk func(path string, v interface{})
+	walk = func(path string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				if path != "" {
+					k = path + "." + k
+				}
+				walk(k, child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(path, child)
+			}
+		case string:
+			if len(fields) > 0 && !slices.ContainsFunc(fields, func(f string) bool {
+				return path == f || strings.HasPrefix(path, f+".")
+			}) {
+				return
+			}
+			if fragment, ok := highlightText(v, tokens); ok {
+				highlights[path] = append(highlights[path], fragment)
+			}
+		}
+	}
+	walk("", doc)
+
+	if len(highlights) == 0 {
+		return nil
+	}
+	return highlights
+}
+
+func highlightText(text string, tokens []string) (string, bool) {
+	var highlighted bool
+	fragment := searchTokenPattern.ReplaceAllStringFunc(text, func(word string) string {
+		lower := strings.ToLower(word)
+		for _, t := range tokens {
+			if strings.HasPrefix(lower, t) {
+				highlighted = true
+				return "<em>" + word + "</em>"
+			}
+		}
+		return word
+	})
+	return fragment, highlighted
+}
+
+// highlightedColumns returns the names of the columns whose name,
+// description or values of tags were highlighted.
+func highlightedColumns(data map[string]interface{}, highlights map[string][]string) []string {
+	columns, ok := data["columns"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var matched []string
+	for _, c := range columns {
+		col, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := col["name"].(string)
+		if name == "" {
+			continue
+		}
+		description, _ := col["description"].(string)
+		if isHighlighted(name, highlights["data.columns.name"]) ||
+			isHighlighted(description, highlights["data.columns.description"]) ||
+			hasHighlightedTag(col, highlights) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+// hasHighlightedTag reports whether a value of a tag of the column was
+// highlighted, by template and field urn.
+func hasHighlightedTag(col map[string]interface{}, highlights map[string][]string) bool {
+	tags, _ := col["tags"].(map[string]interface{})
+	for templateURN, t := range tags {
+		values, _ := t.(map[string]interface{})
+		for fieldURN, v := range values {
+			fragments := highlights["data.columns.tags."+templateURN+"."+fieldURN]
+			switch v := v.(type) {
+			case string:
+				if isHighlighted(v, fragments) {
+					return true
+				}
+			case []interface{}:
+				for _, elem := range v {
+					if s, ok := elem.(string); ok && isHighlighted(s, fragments) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+var highlightTagsReplacer = strings.NewReplacer("<em>", "", "</em>", "")
+
+func isHighlighted(value string, fragments []string) bool {
+	if value == "" {
+		return false
+	}
+	for _, f := range fragments {
+		if highlightTagsReplacer.Replace(f) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// includeDocumentFields returns the document with only the given dotted
+// fields, fields nested in arrays of objects are kept in every object.
+func includeDocumentFields(doc map[string]interface{}, fields []string) map[string]interface{} {
+	included := map[string]interface{}{}
+	for _, field := range fields {
+		copyDocumentField(included, doc, strings.Split(field, "."))
+	}
+	return included
+}
+
+func copyDocumentField(dst, src map[string]interface{}, path []string) {
+	v, ok := src[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) == 1 {
+		dst[path[0]] = v
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		child, ok := dst[path[0]].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			dst[path[0]] = child
+		}
+		copyDocumentField(child, v, path[1:])
+	case []interface{}:
+		items, ok := dst[path[0]].([]interface{})
+		if !ok {
+			items = make([]interface{}, 0, len(v))
+			for _, item := range v {
+				if _, ok := item.(map[string]interface{}); ok {
+					items = append(items, map[string]interface{}{})
+				}
+			}
+			dst[path[0]] = items
+		}
+		i := 0
+		for _, item := range v {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			copyDocumentField(items[i].(map[string]interface{}), obj, path[1:])
+			i++
+		}
+	}
+}
+
+func decodeDocument(doc types.JSONText, fields []string, v interface{}) error {
+	var m map[string]interface{}
+	if err := doc.Unmarshal(&m); err != nil {
+		return err
+	}
+	return remarshal(includeDocumentFields(m, fields), v)
+}
+
+func remarshal(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, dst)
+}
+
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func equalStrings(fields []asset.GroupField, values []string) bool {
+	if len(fields) != len(values) {
+		return false
+	}
+	for i := range fields {
+		if fields[i].Value != values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sqlQuery is a query along with its positional args.
+type sqlQuery struct {
+	query string
+	args  []interface{}
+}
+
+// arg adds the arg and returns its placeholder.
+func (q *sqlQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return q.placeholder()
+}
+
+// placeholder returns the placeholder of the last arg.
+func (q *sqlQuery) placeholder() string {
+	return fmt.Sprintf("$%d", len(q.args))
+}
@@ -0,0 +1,25 @@
+CREATE TABLE saved_searches (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL,
+    text text NOT NULL DEFAULT '',
+    filters jsonb NOT NULL DEFAULT '{}',
+    queries jsonb NOT NULL DEFAULT '{}',
+    flags jsonb NOT NULL DEFAULT '{}',
+    webhook_url text NOT NULL DEFAULT '',
+    last_run_at timestamp,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
+
+CREATE INDEX saved_searches_idx_user_id ON saved_searches(user_id);
+
+CREATE TABLE saved_search_matches (
+    saved_search_id uuid NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    asset_id uuid NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    initial boolean NOT NULL DEFAULT false,
+    matched_at timestamp DEFAULT NOW(),
+    PRIMARY KEY (saved_search_id, asset_id)
+);
+
+CREATE INDEX saved_search_matches_idx_saved_search_id_matched_at ON saved_search_matches(saved_search_id, matched_at DESC);
@@ -0,0 +1,24 @@
+CREATE TABLE discovery_assets (
+    id text PRIMARY KEY,
+    urn text NOT NULL,
+    type text NOT NULL,
+    service text NOT NULL,
+    name text NOT NULL DEFAULT '',
+    description text NOT NULL DEFAULT '',
+    url text NOT NULL DEFAULT '',
+    data jsonb NOT NULL DEFAULT '{}',
+    labels jsonb NOT NULL DEFAULT '{}',
+    version text NOT NULL DEFAULT '',
+    is_deleted boolean NOT NULL DEFAULT false,
+    created_at timestamptz,
+    updated_at timestamptz,
+    refreshed_at timestamptz,
+    document jsonb NOT NULL,
+    search_vector tsvector NOT NULL,
+    indexed_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX discovery_assets_idx_urn ON discovery_assets(urn);
+CREATE INDEX discovery_assets_idx_service_indexed_at ON discovery_assets(service, indexed_at);
+CREATE INDEX discovery_assets_idx_name ON discovery_assets(lower(name) text_pattern_ops);
+CREATE INDEX discovery_assets_idx_search_vector ON discovery_assets USING GIN(search_vector);
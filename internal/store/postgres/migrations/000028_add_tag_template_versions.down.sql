@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tags
+DROP COLUMN template_version;
+
+ALTER TABLE tag_templates
+DROP COLUMN version;
+
+COMMIT;
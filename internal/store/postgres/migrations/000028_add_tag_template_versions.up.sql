@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tag_templates
+ADD COLUMN version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE tags
+ADD COLUMN template_version integer NOT NULL DEFAULT 1;
+
+COMMIT;
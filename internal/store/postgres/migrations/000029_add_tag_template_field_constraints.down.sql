@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tag_template_fields
+DROP COLUMN multiple,
+DROP COLUMN min,
+DROP COLUMN max,
+DROP COLUMN pattern;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tag_template_fields
+ADD COLUMN multiple boolean NOT NULL DEFAULT false,
+ADD COLUMN min double precision,
+ADD COLUMN max double precision,
+ADD COLUMN pattern text;
+
+COMMIT;
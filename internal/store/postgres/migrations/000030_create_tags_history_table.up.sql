@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS tags_history (
+  id bigserial PRIMARY KEY,
+  asset_id text NOT NULL,
+  template_urn text NOT NULL,
+  action text NOT NULL,
+  actor text,
+  before_values jsonb,
+  after_values jsonb,
+  created_at timestamp DEFAULT NOW()
+);
+
+CREATE INDEX tags_history_idx_asset_id_template_urn ON tags_history(asset_id, template_urn);
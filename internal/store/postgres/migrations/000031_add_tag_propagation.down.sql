@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tags
+DROP COLUMN inherited_from;
+
+ALTER TABLE tag_templates
+DROP COLUMN propagation;
+
+COMMIT;
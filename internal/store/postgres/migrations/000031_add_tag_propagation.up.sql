@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE tag_templates
+ADD COLUMN propagation jsonb;
+
+ALTER TABLE tags
+ADD COLUMN inherited_from text;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+DELETE FROM tags WHERE column_name <> '';
+
+DROP INDEX IF EXISTS tags_idx_asset_id_column_name_field_id;
+
+CREATE UNIQUE INDEX tags_idx_asset_id_field_id ON tags(asset_id,field_id);
+
+ALTER TABLE tags
+DROP COLUMN column_name;
+
+DELETE FROM tags_history WHERE column_name <> '';
+
+ALTER TABLE tags_history
+DROP COLUMN column_name;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE tags
+ADD COLUMN column_name text NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS tags_idx_asset_id_field_id;
+
+CREATE UNIQUE INDEX tags_idx_asset_id_column_name_field_id ON tags(asset_id,column_name,field_id);
+
+ALTER TABLE tags_history
+ADD COLUMN column_name text NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS asset_type_schemas (
+    type text PRIMARY KEY,
+    schema jsonb NOT NULL,
+    mode text NOT NULL,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
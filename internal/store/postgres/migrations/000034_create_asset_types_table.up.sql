@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS asset_types (
+    name text PRIMARY KEY,
+    display_name text,
+    icon text,
+    description text,
+    required_fields text[],
+    search_boost float8 NOT NULL DEFAULT 0,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS policies (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    subject text NOT NULL,
+    role text NOT NULL,
+    scope_kind text NOT NULL,
+    scope_value text NOT NULL DEFAULT '',
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX policies_idx_subject_role_scope ON policies(lower(subject), role, scope_kind, scope_value);
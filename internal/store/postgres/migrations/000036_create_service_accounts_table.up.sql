@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS service_accounts (
+    user_id uuid PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    name text NOT NULL UNIQUE,
+    description text NOT NULL DEFAULT '',
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    service_account_id uuid NOT NULL REFERENCES service_accounts(user_id) ON DELETE CASCADE,
+    name text NOT NULL DEFAULT '',
+    hash text NOT NULL UNIQUE,
+    scopes text[] NOT NULL,
+    expires_at timestamp NOT NULL,
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT NOW()
+);
+
+CREATE INDEX api_tokens_idx_service_account_id ON api_tokens(service_account_id);
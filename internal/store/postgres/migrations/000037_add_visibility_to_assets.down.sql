@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS assets_idx_visibility_groups;
+
+ALTER TABLE assets DROP COLUMN IF EXISTS visibility_groups;
+ALTER TABLE assets DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,4 @@
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS visibility text NOT NULL DEFAULT 'public';
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS visibility_groups text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS assets_idx_visibility_groups ON assets USING GIN (visibility_groups) WHERE visibility = 'restricted';
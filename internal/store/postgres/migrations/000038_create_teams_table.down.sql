@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS assets_idx_owner_teams;
+
+ALTER TABLE assets DROP COLUMN IF EXISTS owner_teams;
+
+DROP TABLE IF EXISTS team_members;
+
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS teams (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    description text NOT NULL DEFAULT '',
+    on_call text NOT NULL DEFAULT '',
+    slack_channel text NOT NULL DEFAULT '',
+    created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+    created_at timestamp DEFAULT NOW(),
+    updated_at timestamp DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id uuid NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX team_members_idx_user_id ON team_members(user_id);
+
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS owner_teams text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS assets_idx_owner_teams ON assets USING GIN (owner_teams);
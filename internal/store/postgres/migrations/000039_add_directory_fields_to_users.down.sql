@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS inactive;
+ALTER TABLE users DROP COLUMN IF EXISTS manager_email;
+ALTER TABLE users DROP COLUMN IF EXISTS name;
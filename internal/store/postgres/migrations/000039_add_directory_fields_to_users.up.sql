@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS name text;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS manager_email text;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS inactive boolean NOT NULL DEFAULT false;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    actor text NOT NULL DEFAULT '',
+    method text NOT NULL,
+    target_ids text[] NOT NULL DEFAULT '{}',
+    request text NOT NULL DEFAULT '',
+    result text NOT NULL DEFAULT '',
+    error text NOT NULL DEFAULT '',
+    latency_ms bigint NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_events_idx_created_at ON audit_events(created_at);
+CREATE INDEX audit_events_idx_actor ON audit_events(actor);
+CREATE INDEX audit_events_idx_target_ids ON audit_events USING GIN (target_ids);
+
+-- the audit log is append-only
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
@@ -0,0 +1,7 @@
+-- the buckets are refilled over time, they are not worth the write ahead log
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    key text PRIMARY KEY,
+    tokens double precision NOT NULL,
+    allowed boolean NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+ALTER TABLE saved_searches ADD COLUMN IF NOT EXISTS groups text[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS bulk_tag_jobs (
+    id uuid DEFAULT gen_random_uuid() PRIMARY KEY,
+    template_urn text NOT NULL,
+    remove boolean NOT NULL DEFAULT false,
+    status text NOT NULL,
+    report jsonb NOT NULL DEFAULT '{}',
+    error text NOT NULL DEFAULT '',
+    created_by text NOT NULL DEFAULT '',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
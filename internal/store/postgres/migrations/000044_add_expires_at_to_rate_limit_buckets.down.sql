@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS rate_limit_buckets_idx_expires_at;
+
+ALTER TABLE rate_limit_buckets DROP COLUMN IF EXISTS expires_at;
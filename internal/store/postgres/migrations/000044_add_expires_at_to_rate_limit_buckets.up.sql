@@ -0,0 +1,4 @@
+-- a bucket left alone until it expires is full again, the same as a new one
+ALTER TABLE rate_limit_buckets ADD COLUMN IF NOT EXISTS expires_at timestamptz NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_idx_expires_at ON rate_limit_buckets(expires_at);
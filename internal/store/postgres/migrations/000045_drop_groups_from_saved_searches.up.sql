@@ -0,0 +1,3 @@
+-- the groups of the owner of a saved search are resolved from its teams on
+-- each run
+ALTER TABLE saved_searches DROP COLUMN IF EXISTS groups;
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/authz"
+)
+
+type PolicyModel struct {
+	ID         string         `db:"id"`
+	Subject    string         `db:"subject"`
+	Role       string         `db:"role"`
+	ScopeKind  string         `db:"scope_kind"`
+	ScopeValue string         `db:"scope_value"`
+	CreatedBy  sql.NullString `db:"created_by"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+}
+
+func (m PolicyModel) toPolicy() authz.Policy {
+	return authz.Policy{
+		ID:      m.ID,
+		Subject: m.Subject,
+		Role:    authz.Role(m.Role),
+		Scope: authz.Scope{
+			Kind:  authz.ScopeKind(m.ScopeKind),
+			Value: m.ScopeValue,
+		},
+		CreatedBy: m.CreatedBy.String,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// PolicyRepository is a type that manages the authorization policies in the
+// primary database
+type PolicyRepository struct {
+	client *Client
+}
+
+// Create insert a new record in the policies table
+func (r *PolicyRepository) Create(ctx context.Context, p *authz.Policy) (string, error) {
+	if p == nil {
+		return "", errors.New("policy is nil")
+	}
+
+	var id string
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		policies
+			(subject, role, scope_kind, scope_value, created_by)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING id
+	`, p.Subject, p.Role.String(), string(p.Scope.Kind), p.Scope.Value,
+		sql.NullString{String: p.CreatedBy, Valid: p.CreatedBy != ""}).Scan(&id); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, errDuplicateKey) {
+			return "", authz.DuplicateError{Subject: p.Subject, Role: p.Role, Scope: p.Scope}
+		}
+		return "", fmt.Errorf("failed to create policy: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetByID fetch a policy by its id
+func (r *PolicyRepository) GetByID(ctx context.Context, id string) (authz.Policy, error) {
+	if !isValidUUID(id) {
+		return authz.Policy{}, authz.InvalidError{ID: id}
+	}
+
+	var m PolicyModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			id, subject, role, scope_kind, scope_value, created_by, created_at, updated_at
+		FROM
+			policies
+		WHERE
+			id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authz.Policy{}, authz.NotFoundError{ID: id}
+	}
+	if err != nil {
+		return authz.Policy{}, fmt.Errorf("failed fetching policy: %w", err)
+	}
+
+	return m.toPolicy(), nil
+}
+
+// GetAll fetch the policies, of a subject if given in the filter, ignoring
+// the case of its email
+func (r *PolicyRepository) GetAll(ctx context.Context, flt authz.Filter) ([]authz.Policy, error) {
+	var models []PolicyModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			id, subject, role, scope_kind, scope_value, created_by, created_at, updated_at
+		FROM
+			policies
+		WHERE
+			$1 = '' OR lower(subject) = lower($1)
+		ORDER BY
+			created_at
+	`, flt.Subject); err != nil {
+		return nil, fmt.Errorf("failed fetching policies: %w", err)
+	}
+
+	policies := make([]authz.Policy, 0, len(models))
+	for _, m := range models {
+		policies = append(policies, m.toPolicy())
+	}
+
+	return policies, nil
+}
+
+// Delete deletes a policy
+func (r *PolicyRepository) Delete(ctx context.Context, id string) error {
+	if !isValidUUID(id) {
+		return authz.InvalidError{ID: id}
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			policies
+		WHERE
+			id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete policy: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting policy: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return authz.NotFoundError{ID: id}
+	}
+	return nil
+}
+
+// NewPolicyRepository initializes policy repository
+func NewPolicyRepository(c *Client) (*PolicyRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &PolicyRepository{
+		client: c,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/compass/core/authz"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type PolicyRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.PolicyRepository
+}
+
+func (r *PolicyRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewPolicyRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *PolicyRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *PolicyRepositoryTestSuite) TestCreateAndGet() {
+	p := &authz.Policy{
+		Subject: "user@example.com",
+		Role:    authz.RoleEditor,
+		Scope:   authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"},
+	}
+
+	r.Run("return policy as created", func() {
+		id, err := r.repository.Create(r.ctx, p)
+		r.Require().NoError(err)
+
+		actual, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal(id, actual.ID)
+		r.Equal(p.Subject, actual.Subject)
+		r.Equal(p.Role, actual.Role)
+		r.Equal(p.Scope, actual.Scope)
+		r.Empty(actual.CreatedBy)
+		r.False(actual.CreatedAt.IsZero())
+	})
+
+	r.Run("return duplicate error if subject already has role on scope", func() {
+		_, err := r.repository.Create(r.ctx, &authz.Policy{
+			Subject: "User@example.com",
+			Role:    authz.RoleEditor,
+			Scope:   authz.Scope{Kind: authz.ScopeKindService, Value: "bigquery"},
+		})
+		r.ErrorAs(err, new(authz.DuplicateError))
+	})
+
+	r.Run("return invalid error if id is not uuid", func() {
+		_, err := r.repository.GetByID(r.ctx, "invalid")
+		r.ErrorIs(err, authz.InvalidError{ID: "invalid"})
+	})
+
+	r.Run("return not found error if policy does not exist", func() {
+		_, err := r.repository.GetByID(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e")
+		r.ErrorIs(err, authz.NotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+}
+
+func (r *PolicyRepositoryTestSuite) TestGetAll() {
+	for _, p := range []authz.Policy{
+		{Subject: "user@example.com", Role: authz.RoleEditor, Scope: authz.Scope{Kind: authz.ScopeKindType, Value: "table"}},
+		{Subject: "user@example.com", Role: authz.RoleOwner, Scope: authz.Scope{Kind: authz.ScopeKindAsset, Value: "urn:bigquery:orders"}},
+		{Subject: "admin@example.com", Role: authz.RoleAdmin, Scope: authz.Scope{Kind: authz.ScopeKindGlobal}},
+	} {
+		_, err := r.repository.Create(r.ctx, &p)
+		r.Require().NoError(err)
+	}
+
+	r.Run("return all policies without subject", func() {
+		actual, err := r.repository.GetAll(r.ctx, authz.Filter{})
+		r.NoError(err)
+		r.Len(actual, 3)
+	})
+
+	r.Run("return policies of subject ignoring case", func() {
+		actual, err := r.repository.GetAll(r.ctx, authz.Filter{Subject: "USER@example.com"})
+		r.NoError(err)
+		r.Len(actual, 2)
+		for _, p := range actual {
+			r.Equal("user@example.com", p.Subject)
+		}
+	})
+}
+
+func (r *PolicyRepositoryTestSuite) TestDelete() {
+	r.Run("return not found error if policy does not exist", func() {
+		err := r.repository.Delete(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e")
+		r.ErrorIs(err, authz.NotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+
+	r.Run("delete policy", func() {
+		id, err := r.repository.Create(r.ctx, &authz.Policy{
+			Subject: "user@example.com",
+			Role:    authz.RoleViewer,
+			Scope:   authz.Scope{Kind: authz.ScopeKindGlobal},
+		})
+		r.Require().NoError(err)
+
+		r.NoError(r.repository.Delete(r.ctx, id))
+
+		_, err = r.repository.GetByID(r.ctx, id)
+		r.ErrorIs(err, authz.NotFoundError{ID: id})
+	})
+}
+
+func TestPolicyRepository(t *testing.T) {
+	suite.Run(t, &PolicyRepositoryTestSuite{})
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/pkg/ratelimit"
+)
+
+// refilledTokens are the tokens of a bucket refilled since it was last taken
+// from, $2 being the burst and $3 the rate of the limit
+const refilledTokens = `LEAST($2::double precision, b.tokens + EXTRACT(EPOCH FROM NOW() - b.updated_at) * $3::double precision)`
+
+// refillExpiry is when a bucket taken from now is full again at the latest
+const refillExpiry = `NOW() + make_interval(secs => $2::double precision / $3::double precision)`
+
+// RateLimitRepository is a type that keeps the token buckets of the rate
+// limits in the primary database, shared by the instances of the server
+type RateLimitRepository struct {
+	client *Client
+}
+
+// Take takes a token from the bucket of the key in a single statement, for
+// the concurrent takes of the instances to be serialized by the row lock
+func (r *RateLimitRepository) Take(ctx context.Context, key string, limit ratelimit.Limit) (time.Duration, bool, error) {
+	var (
+		allowed bool
+		tokens  float64
+	)
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		rate_limit_buckets AS b
+			(key, tokens, allowed, updated_at, expires_at)
+		VALUES
+			($1, $2::double precision - 1, TRUE, NOW(), `+refillExpiry+`)
+		ON CONFLICT (key) DO UPDATE SET
+			allowed = `+refilledTokens+` >= 1,
+			tokens = CASE WHEN `+refilledTokens+` >= 1 THEN `+refilledTokens+` - 1 ELSE `+refilledTokens+` END,
+			updated_at = NOW(),
+			expires_at = `+refillExpiry+`
+		RETURNING allowed, tokens
+	`, key, limit.Burst, limit.Rate).Scan(&allowed, &tokens); err != nil {
+		return 0, false, fmt.Errorf("take rate limit token: %w", err)
+	}
+
+	if allowed {
+		return 0, true, nil
+	}
+	return limit.RetryAfter(tokens), false, nil
+}
+
+// Prune deletes the buckets left alone until they expired, which are full
+// again, and returns how many were deleted
+func (r *RateLimitRepository) Prune(ctx context.Context) (int64, error) {
+	res, err := r.client.db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE expires_at < NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("prune rate limit buckets: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("prune rate limit buckets: rows affected: %w", err)
+	}
+	return n, nil
+}
+
+// NewRateLimitRepository initializes rate limit repository clients
+func NewRateLimitRepository(c *Client) (*RateLimitRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &RateLimitRepository{
+		client: c,
+	}, nil
+}
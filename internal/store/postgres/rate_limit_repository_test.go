@@ -0,0 +1,86 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/compass/pkg/ratelimit"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.RateLimitRepository
+}
+
+func (r *RateLimitRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewRateLimitRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *RateLimitRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *RateLimitRepositoryTestSuite) TestTake() {
+	// the rate is too low for the bucket to be refilled during the test
+	limit := ratelimit.Limit{Rate: 0.001, Burst: 2}
+
+	r.Run("allow the burst then refuse with the time to wait", func() {
+		for i := 0; i < 2; i++ {
+			_, allowed, err := r.repository.Take(r.ctx, "0/john.doe@example.com/UpsertPatchAsset", limit)
+			r.Require().NoError(err)
+			r.True(allowed)
+		}
+
+		retryAfter, allowed, err := r.repository.Take(r.ctx, "0/john.doe@example.com/UpsertPatchAsset", limit)
+		r.Require().NoError(err)
+		r.False(allowed)
+		r.Greater(retryAfter.Seconds(), 900.0)
+	})
+
+	r.Run("keep a bucket per key", func() {
+		_, allowed, err := r.repository.Take(r.ctx, "0/jane.doe@example.com/UpsertPatchAsset", limit)
+		r.Require().NoError(err)
+		r.True(allowed)
+	})
+}
+
+func (r *RateLimitRepositoryTestSuite) TestPrune() {
+	_, _, err := r.repository.Take(r.ctx, "0/john.doe@example.com/GetAllAssets", ratelimit.Limit{Rate: 0.001, Burst: 1})
+	r.Require().NoError(err)
+	_, _, err = r.repository.Take(r.ctx, "0/jane.doe@example.com/GetAllAssets", ratelimit.Limit{Rate: 1000, Burst: 1})
+	r.Require().NoError(err)
+	time.Sleep(10 * time.Millisecond)
+
+	pruned, err := r.repository.Prune(r.ctx)
+	r.NoError(err)
+	r.Equal(int64(1), pruned)
+
+	_, allowed, err := r.repository.Take(r.ctx, "0/john.doe@example.com/GetAllAssets", ratelimit.Limit{Rate: 0.001, Burst: 1})
+	r.NoError(err)
+	r.False(allowed, "bucket still refilling is kept")
+}
+
+func TestRateLimitRepository(t *testing.T) {
+	suite.Run(t, &RateLimitRepositoryTestSuite{})
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/jmoiron/sqlx/types"
+)
+
+type SavedSearchModel struct {
+	ID         string         `db:"id"`
+	UserID     string         `db:"user_id"`
+	Name       string         `db:"name"`
+	Text       string         `db:"text"`
+	Filters    types.JSONText `db:"filters"`
+	Queries    types.JSONText `db:"queries"`
+	Flags      types.JSONText `db:"flags"`
+	WebhookURL string         `db:"webhook_url"`
+	LastRunAt  *time.Time     `db:"last_run_at"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+}
+
+// savedSearchFlags is the stored form of asset.SearchFlags
+type savedSearchFlags struct {
+	EnableHighlight bool `json:"enable_highlight,omitempty"`
+	DisableFuzzy    bool `json:"disable_fuzzy,omitempty"`
+	IsColumnSearch  bool `json:"is_column_search,omitempty"`
+	EnableSemantic  bool `json:"enable_semantic,omitempty"`
+	EnableExplain   bool `json:"enable_explain,omitempty"`
+}
+
+func (m SavedSearchModel) toSavedSearch() (savedsearch.SavedSearch, error) {
+	var (
+		filters asset.SearchFilter
+		queries map[string]string
+		flags   savedSearchFlags
+	)
+	if err := m.Filters.Unmarshal(&filters); err != nil {
+		return savedsearch.SavedSearch{}, fmt.Errorf("unmarshal saved search filters: %w", err)
+	}
+	if err := m.Queries.Unmarshal(&queries); err != nil {
+		return savedsearch.SavedSearch{}, fmt.Errorf("unmarshal saved search queries: %w", err)
+	}
+	if err := m.Flags.Unmarshal(&flags); err != nil {
+		return savedsearch.SavedSearch{}, fmt.Errorf("unmarshal saved search flags: %w", err)
+	}
+
+	return savedsearch.SavedSearch{
+		ID:      m.ID,
+		UserID:  m.UserID,
+		Name:    m.Name,
+		Text:    m.Text,
+		Filters: filters,
+		Queries: queries,
+		Flags: asset.SearchFlags{
+			EnableHighlight: flags.EnableHighlight,
+			DisableFuzzy:    flags.DisableFuzzy,
+			IsColumnSearch:  flags.IsColumnSearch,
+			EnableSemantic:  flags.EnableSemantic,
+			EnableExplain:   flags.EnableExplain,
+		},
+		WebhookURL: m.WebhookURL,
+		LastRunAt:  m.LastRunAt,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}, nil
+}
+
+func newSavedSearchModel(ss *savedsearch.SavedSearch) (SavedSearchModel, error) {
+	filters := ss.Filters
+	if filters == nil {
+		filters = asset.SearchFilter{}
+	}
+	queries := ss.Queries
+	if queries == nil {
+		queries = map[string]string{}
+	}
+
+	filtersJSON, err := json.Marshal(filters)
+	if err != nil {
+		return SavedSearchModel{}, fmt.Errorf("marshal saved search filters: %w", err)
+	}
+	queriesJSON, err := json.Marshal(queries)
+	if err != nil {
+		return SavedSearchModel{}, fmt.Errorf("marshal saved search queries: %w", err)
+	}
+	flagsJSON, err := json.Marshal(savedSearchFlags{
+		EnableHighlight: ss.Flags.EnableHighlight,
+		DisableFuzzy:    ss.Flags.DisableFuzzy,
+		IsColumnSearch:  ss.Flags.IsColumnSearch,
+		EnableSemantic:  ss.Flags.EnableSemantic,
+		EnableExplain:   ss.Flags.EnableExplain,
+	})
+	if err != nil {
+		return SavedSearchModel{}, fmt.Errorf("marshal saved search flags: %w", err)
+	}
+
+	return SavedSearchModel{
+		ID:         ss.ID,
+		UserID:     ss.UserID,
+		Name:       ss.Name,
+		Text:       ss.Text,
+		Filters:    filtersJSON,
+		Queries:    queriesJSON,
+		Flags:      flagsJSON,
+		WebhookURL: ss.WebhookURL,
+		LastRunAt:  ss.LastRunAt,
+		CreatedAt:  ss.CreatedAt,
+		UpdatedAt:  ss.UpdatedAt,
+	}, nil
+}
+
+type SavedSearchMatchModel struct {
+	AssetModel
+	MatchedAt time.Time `db:"matched_at"`
+}
+
+func (m SavedSearchMatchModel) toMatch() savedsearch.Match {
+	return savedsearch.Match{
+		Asset:     m.AssetModel.toAsset(nil),
+		MatchedAt: m.MatchedAt,
+	}
+}
@@ -0,0 +1,257 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/lib/pq"
+)
+
+// SavedSearchRepository is a type that manages saved search operation to the primary database
+type SavedSearchRepository struct {
+	client *Client
+}
+
+// Create insert a new record in the saved_searches table
+func (r *SavedSearchRepository) Create(ctx context.Context, ss *savedsearch.SavedSearch) (string, error) {
+	if ss.UserID == "" {
+		return "", savedsearch.ErrEmptyUserID
+	}
+
+	m, err := newSavedSearchModel(ss)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		saved_searches
+			(user_id, name, text, filters, queries, flags, webhook_url)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, m.UserID, m.Name, m.Text, m.Filters, m.Queries, m.Flags, m.WebhookURL).Scan(&id); err != nil {
+		return "", fmt.Errorf("failed to create saved search: %w", checkPostgresError(err))
+	}
+
+	return id, nil
+}
+
+// GetByID fetch a saved search by its id
+func (r *SavedSearchRepository) GetByID(ctx context.Context, id string) (savedsearch.SavedSearch, error) {
+	if !isValidUUID(id) {
+		return savedsearch.SavedSearch{}, savedsearch.InvalidError{ID: id}
+	}
+
+	var m SavedSearchModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			id, user_id, name, text, filters, queries, flags, webhook_url, last_run_at, created_at, updated_at
+		FROM
+			saved_searches
+		WHERE
+			id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return savedsearch.SavedSearch{}, savedsearch.NotFoundError{ID: id}
+	}
+	if err != nil {
+		return savedsearch.SavedSearch{}, fmt.Errorf("failed fetching saved search: %w", err)
+	}
+
+	return m.toSavedSearch()
+}
+
+// GetAllByUserID fetch the saved searches of a user, most recent first
+func (r *SavedSearchRepository) GetAllByUserID(ctx context.Context, userID string) ([]savedsearch.SavedSearch, error) {
+	if userID == "" {
+		return nil, savedsearch.ErrEmptyUserID
+	}
+
+	var models []SavedSearchModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			id, user_id, name, text, filters, queries, flags, webhook_url, last_run_at, created_at, updated_at
+		FROM
+			saved_searches
+		WHERE
+			user_id = $1
+		ORDER BY
+			created_at DESC
+	`, userID); err != nil {
+		return nil, fmt.Errorf("failed fetching saved searches by user: %w", err)
+	}
+
+	searches := make([]savedsearch.SavedSearch, 0, len(models))
+	for _, m := range models {
+		ss, err := m.toSavedSearch()
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, ss)
+	}
+
+	return searches, nil
+}
+
+// Delete deletes a saved search of a user along with its matches
+func (r *SavedSearchRepository) Delete(ctx context.Context, userID, id string) error {
+	if userID == "" {
+		return savedsearch.ErrEmptyUserID
+	}
+	if !isValidUUID(id) {
+		return savedsearch.InvalidError{ID: id}
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			saved_searches
+		WHERE
+			id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting saved search: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return savedsearch.NotFoundError{ID: id}
+	}
+	return nil
+}
+
+// InsertMatches records the given assets as matching the saved search and
+// returns the ids of the assets that were not recorded before. Assets that
+// no longer exist are skipped.
+func (r *SavedSearchRepository) InsertMatches(ctx context.Context, id string, assetIDs []string, initial bool) ([]string, error) {
+	if len(assetIDs) == 0 {
+		return nil, nil
+	}
+
+	var inserted []string
+	if err := r.client.db.SelectContext(ctx, &inserted, `
+		INSERT INTO
+		saved_search_matches
+			(saved_search_id, asset_id, initial)
+		SELECT
+			$1, a.id, $3
+		FROM
+			assets a
+		WHERE
+			a.id = ANY($2::uuid[])
+		ON CONFLICT (saved_search_id, asset_id) DO NOTHING
+		RETURNING asset_id
+	`, id, pq.StringArray(assetIDs), initial); err != nil {
+		return nil, fmt.Errorf("failed to insert saved search matches: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// GetMatches fetch the assets that started matching a saved search after
+// its first run, most recent first
+func (r *SavedSearchRepository) GetMatches(ctx context.Context, id string, flt savedsearch.Filter) ([]savedsearch.Match, error) {
+	if !isValidUUID(id) {
+		return nil, savedsearch.InvalidError{ID: id}
+	}
+
+	limit := DEFAULT_MAX_RESULT_SIZE
+	if flt.Size > 0 {
+		limit = flt.Size
+	}
+	offset := 0
+	if flt.Offset > 0 {
+		offset = flt.Offset
+	}
+
+	var models []SavedSearchMatchModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			a.id,
+			a.urn,
+			a.type,
+			a.service,
+			a.name,
+			a.description,
+			a.data,
+			a.labels,
+			a.version,
+			a.created_at,
+			a.updated_at,
+			m.matched_at
+		FROM
+			saved_search_matches m
+		INNER JOIN
+			assets a ON m.asset_id = a.id
+		WHERE
+			m.saved_search_id = $1 AND NOT m.initial
+		ORDER BY
+			m.matched_at DESC
+		LIMIT $2
+		OFFSET $3
+	`, id, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed fetching saved search matches: %w", err)
+	}
+
+	matches := make([]savedsearch.Match, 0, len(models))
+	for _, m := range models {
+		matches = append(matches, m.toMatch())
+	}
+
+	return matches, nil
+}
+
+// UpdateLastRunAt records when the saved search was last run
+func (r *SavedSearchRepository) UpdateLastRunAt(ctx context.Context, id string, lastRunAt time.Time) error {
+	if _, err := r.client.db.ExecContext(ctx, `
+		UPDATE
+			saved_searches
+		SET
+			last_run_at = $2
+		WHERE
+			id = $1
+	`, id, lastRunAt.UTC()); err != nil {
+		return fmt.Errorf("failed to update saved search last run: %w", err)
+	}
+
+	return nil
+}
+
+// GetDueIDs returns the IDs of the saved searches never run or last run
+// before the given time
+func (r *SavedSearchRepository) GetDueIDs(ctx context.Context, since time.Time) ([]string, error) {
+	var ids []string
+	if err := r.client.db.SelectContext(ctx, &ids, `
+		SELECT
+			id
+		FROM
+			saved_searches
+		WHERE
+			last_run_at IS NULL OR last_run_at < $1
+		ORDER BY
+			last_run_at NULLS FIRST
+	`, since.UTC()); err != nil {
+		return nil, fmt.Errorf("failed to get due saved searches: %w", err)
+	}
+
+	return ids, nil
+}
+
+// NewSavedSearchRepository initializes saved search repository clients
+func NewSavedSearchRepository(c *Client) (*SavedSearchRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &SavedSearchRepository{
+		client: c,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type SavedSearchRepositoryTestSuite struct {
+	suite.Suite
+	ctx             context.Context
+	client          *postgres.Client
+	repository      *postgres.SavedSearchRepository
+	userRepository  *postgres.UserRepository
+	assetRepository *postgres.AssetRepository
+}
+
+func (r *SavedSearchRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewSavedSearchRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+	r.userRepository, err = postgres.NewUserRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+	r.assetRepository, err = postgres.NewAssetRepository(r.client, r.userRepository, postgres.AssetRepositoryConfig{
+		DefaultGetMaxSize: postgres.DEFAULT_MAX_RESULT_SIZE,
+		Logger:            logger,
+	})
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *SavedSearchRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *SavedSearchRepositoryTestSuite) createSavedSearch(userID string) string {
+	id, err := r.repository.Create(r.ctx, &savedsearch.SavedSearch{
+		UserID:     userID,
+		Name:       "pii assets",
+		Text:       "customer",
+		Filters:    asset.SearchFilter{"labels.pii": {"true"}},
+		Queries:    map[string]string{"service": "bigquery"},
+		Flags:      asset.SearchFlags{DisableFuzzy: true},
+		WebhookURL: "http://example.com/hook",
+	})
+	r.Require().NoError(err)
+	return id
+}
+
+func (r *SavedSearchRepositoryTestSuite) TestCreateAndGet() {
+	r.Run("return ErrEmptyUserID if user id is empty", func() {
+		_, err := r.repository.Create(r.ctx, &savedsearch.SavedSearch{Name: "a"})
+		r.ErrorIs(err, savedsearch.ErrEmptyUserID)
+	})
+
+	r.Run("return saved search as created", func() {
+		userID, err := createUser(r.userRepository, "user@gotocompany.com")
+		r.NoError(err)
+
+		id := r.createSavedSearch(userID)
+
+		ss, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal(userID, ss.UserID)
+		r.Equal("pii assets", ss.Name)
+		r.Equal(asset.SearchFilter{"labels.pii": {"true"}}, ss.Filters)
+		r.Equal(map[string]string{"service": "bigquery"}, ss.Queries)
+		r.Equal(asset.SearchFlags{DisableFuzzy: true}, ss.Flags)
+		r.Equal("http://example.com/hook", ss.WebhookURL)
+		r.Nil(ss.LastRunAt)
+
+		all, err := r.repository.GetAllByUserID(r.ctx, userID)
+		r.NoError(err)
+		r.Len(all, 1)
+		r.Equal(id, all[0].ID)
+	})
+
+	r.Run("return not found error if saved search does not exist", func() {
+		id := uuid.NewString()
+		_, err := r.repository.GetByID(r.ctx, id)
+		r.ErrorIs(err, savedsearch.NotFoundError{ID: id})
+	})
+
+	r.Run("return invalid error if id is not uuid", func() {
+		_, err := r.repository.GetByID(r.ctx, "not-uuid")
+		r.ErrorIs(err, savedsearch.InvalidError{ID: "not-uuid"})
+	})
+}
+
+func (r *SavedSearchRepositoryTestSuite) TestDelete() {
+	userID, err := createUser(r.userRepository, "user@gotocompany.com")
+	r.Require().NoError(err)
+	otherUserID, err := createUser(r.userRepository, "other@gotocompany.com")
+	r.Require().NoError(err)
+	id := r.createSavedSearch(userID)
+
+	r.Run("return not found error when deleting saved search of another user", func() {
+		err := r.repository.Delete(r.ctx, otherUserID, id)
+		r.ErrorIs(err, savedsearch.NotFoundError{ID: id})
+	})
+
+	r.Run("delete own saved search", func() {
+		err := r.repository.Delete(r.ctx, userID, id)
+		r.NoError(err)
+
+		_, err = r.repository.GetByID(r.ctx, id)
+		r.ErrorIs(err, savedsearch.NotFoundError{ID: id})
+	})
+}
+
+func (r *SavedSearchRepositoryTestSuite) TestMatches() {
+	userID, err := createUser(r.userRepository, "user@gotocompany.com")
+	r.Require().NoError(err)
+	ast1, err := createAsset(r.assetRepository, userID, "owner@gotocompany.com", "asset-urn-1", "table")
+	r.Require().NoError(err)
+	ast2, err := createAsset(r.assetRepository, userID, "owner@gotocompany.com", "asset-urn-2", "table")
+	r.Require().NoError(err)
+	id := r.createSavedSearch(userID)
+
+	r.Run("initial matches are recorded but not listed", func() {
+		inserted, err := r.repository.InsertMatches(r.ctx, id, []string{ast1.ID, uuid.NewString()}, true)
+		r.NoError(err)
+		r.Equal([]string{ast1.ID}, inserted)
+
+		matches, err := r.repository.GetMatches(r.ctx, id, savedsearch.Filter{})
+		r.NoError(err)
+		r.Empty(matches)
+	})
+
+	r.Run("only new matches are returned and listed", func() {
+		inserted, err := r.repository.InsertMatches(r.ctx, id, []string{ast1.ID, ast2.ID}, false)
+		r.NoError(err)
+		r.Equal([]string{ast2.ID}, inserted)
+
+		matches, err := r.repository.GetMatches(r.ctx, id, savedsearch.Filter{})
+		r.NoError(err)
+		r.Len(matches, 1)
+		r.Equal(ast2.ID, matches[0].Asset.ID)
+		r.Equal("asset-urn-2", matches[0].Asset.URN)
+	})
+
+	r.Run("update last run at", func() {
+		now := time.Now().UTC().Truncate(time.Second)
+		err := r.repository.UpdateLastRunAt(r.ctx, id, now)
+		r.NoError(err)
+
+		ss, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Require().NotNil(ss.LastRunAt)
+		r.True(now.Equal(*ss.LastRunAt))
+	})
+}
+
+func (r *SavedSearchRepositoryTestSuite) TestGetDueIDs() {
+	userID, err := createUser(r.userRepository, "user@gotocompany.com")
+	r.Require().NoError(err)
+	neverRun := r.createSavedSearch(userID)
+	runBefore := r.createSavedSearch(userID)
+	runSince := r.createSavedSearch(userID)
+
+	since := time.Now().UTC().Truncate(time.Hour)
+	r.Require().NoError(r.repository.UpdateLastRunAt(r.ctx, runBefore, since.Add(-time.Minute)))
+	r.Require().NoError(r.repository.UpdateLastRunAt(r.ctx, runSince, since.Add(time.Minute)))
+
+	ids, err := r.repository.GetDueIDs(r.ctx, since)
+	r.NoError(err)
+	r.Equal([]string{neverRun, runBefore}, ids)
+}
+
+func TestSavedSearchRepository(t *testing.T) {
+	suite.Run(t, &SavedSearchRepositoryTestSuite{})
+}
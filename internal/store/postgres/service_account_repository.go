@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/user"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type ServiceAccountModel struct {
+	ID          string         `db:"id"`
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	Email       string         `db:"email"`
+	CreatedBy   sql.NullString `db:"created_by"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+func (m ServiceAccountModel) toServiceAccount() user.ServiceAccount {
+	return user.ServiceAccount{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Email:       m.Email,
+		CreatedBy:   m.CreatedBy.String,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+type APITokenModel struct {
+	ID               string         `db:"id"`
+	ServiceAccountID string         `db:"service_account_id"`
+	Name             string         `db:"name"`
+	Hash             string         `db:"hash"`
+	Scopes           pq.StringArray `db:"scopes"`
+	ExpiresAt        time.Time      `db:"expires_at"`
+	CreatedBy        sql.NullString `db:"created_by"`
+	CreatedAt        time.Time      `db:"created_at"`
+}
+
+func (m APITokenModel) toAPIToken() user.APIToken {
+	scopes := make([]user.TokenScope, 0, len(m.Scopes))
+	for _, s := range m.Scopes {
+		scopes = append(scopes, user.TokenScope(s))
+	}
+	return user.APIToken{
+		ID:               m.ID,
+		ServiceAccountID: m.ServiceAccountID,
+		Name:             m.Name,
+		Hash:             m.Hash,
+		Scopes:           scopes,
+		ExpiresAt:        m.ExpiresAt,
+		CreatedBy:        m.CreatedBy.String,
+		CreatedAt:        m.CreatedAt,
+	}
+}
+
+const selectServiceAccountsQuery = `
+	SELECT
+		sa.user_id AS id, sa.name, sa.description, u.email, sa.created_by, sa.created_at, sa.updated_at
+	FROM
+		service_accounts sa
+	INNER JOIN
+		users u ON u.id = sa.user_id`
+
+// ServiceAccountRepository is a type that manages the service accounts and
+// their api tokens in the primary database
+type ServiceAccountRepository struct {
+	client *Client
+}
+
+// Create insert the user of the service account, reusing the one of a
+// deleted service account of the same name, and the service account
+func (r *ServiceAccountRepository) Create(ctx context.Context, sa *user.ServiceAccount) (string, error) {
+	if sa == nil {
+		return "", errors.New("service account is nil")
+	}
+
+	var id string
+	err := r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		if err := tx.QueryRowxContext(ctx, `
+			INSERT INTO
+			users
+				(email, provider)
+			VALUES
+				($1, $2)
+			ON CONFLICT (email) DO UPDATE SET
+				updated_at = NOW()
+			RETURNING id
+		`, sa.Email, user.ServiceAccountProvider).Scan(&id); err != nil {
+			return fmt.Errorf("failed to create user of service account: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO
+			service_accounts
+				(user_id, name, description, created_by)
+			VALUES
+				($1, $2, $3, $4)
+		`, id, sa.Name, sa.Description, sql.NullString{String: sa.CreatedBy, Valid: sa.CreatedBy != ""}); err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, errDuplicateKey) {
+				return user.ServiceAccountExistsError{Name: sa.Name}
+			}
+			return fmt.Errorf("failed to create service account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// GetByID fetch a service account by its id
+func (r *ServiceAccountRepository) GetByID(ctx context.Context, id string) (user.ServiceAccount, error) {
+	if !isValidUUID(id) {
+		return user.ServiceAccount{}, user.ServiceAccountNotFoundError{ID: id}
+	}
+
+	var m ServiceAccountModel
+	err := r.client.db.GetContext(ctx, &m, selectServiceAccountsQuery+`
+	WHERE
+		sa.user_id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return user.ServiceAccount{}, user.ServiceAccountNotFoundError{ID: id}
+	}
+	if err != nil {
+		return user.ServiceAccount{}, fmt.Errorf("failed fetching service account: %w", err)
+	}
+
+	return m.toServiceAccount(), nil
+}
+
+// GetAll fetch all the service accounts sorted by name
+func (r *ServiceAccountRepository) GetAll(ctx context.Context) ([]user.ServiceAccount, error) {
+	var models []ServiceAccountModel
+	if err := r.client.db.SelectContext(ctx, &models, selectServiceAccountsQuery+`
+	ORDER BY
+		sa.name
+	`); err != nil {
+		return nil, fmt.Errorf("failed fetching service accounts: %w", err)
+	}
+
+	accounts := make([]user.ServiceAccount, 0, len(models))
+	for _, m := range models {
+		accounts = append(accounts, m.toServiceAccount())
+	}
+
+	return accounts, nil
+}
+
+// Delete deletes a service account along with its api tokens
+func (r *ServiceAccountRepository) Delete(ctx context.Context, id string) error {
+	if !isValidUUID(id) {
+		return user.ServiceAccountNotFoundError{ID: id}
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			service_accounts
+		WHERE
+			user_id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete service account: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting service account: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return user.ServiceAccountNotFoundError{ID: id}
+	}
+	return nil
+}
+
+// CreateToken insert a new record in the api_tokens table
+func (r *ServiceAccountRepository) CreateToken(ctx context.Context, t *user.APIToken) (string, error) {
+	if t == nil {
+		return "", errors.New("api token is nil")
+	}
+
+	scopes := make(pq.StringArray, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		scopes = append(scopes, string(s))
+	}
+
+	var id string
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO
+		api_tokens
+			(service_account_id, name, hash, scopes, expires_at, created_by)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, t.ServiceAccountID, t.Name, t.Hash, scopes, t.ExpiresAt.UTC(),
+		sql.NullString{String: t.CreatedBy, Valid: t.CreatedBy != ""}).Scan(&id); err != nil {
+		return "", fmt.Errorf("failed to create api token: %w", checkPostgresError(err))
+	}
+
+	return id, nil
+}
+
+// GetTokens fetch the api tokens of a service account, most recent first
+func (r *ServiceAccountRepository) GetTokens(ctx context.Context, serviceAccountID string) ([]user.APIToken, error) {
+	var models []APITokenModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			id, service_account_id, name, hash, scopes, expires_at, created_by, created_at
+		FROM
+			api_tokens
+		WHERE
+			service_account_id = $1
+		ORDER BY
+			created_at DESC
+	`, serviceAccountID); err != nil {
+		return nil, fmt.Errorf("failed fetching api tokens: %w", err)
+	}
+
+	tokens := make([]user.APIToken, 0, len(models))
+	for _, m := range models {
+		tokens = append(tokens, m.toAPIToken())
+	}
+
+	return tokens, nil
+}
+
+// GetTokenByHash fetch an api token by the hash of its value
+func (r *ServiceAccountRepository) GetTokenByHash(ctx context.Context, hash string) (user.APIToken, error) {
+	var m APITokenModel
+	err := r.client.db.GetContext(ctx, &m, `
+		SELECT
+			id, service_account_id, name, hash, scopes, expires_at, created_by, created_at
+		FROM
+			api_tokens
+		WHERE
+			hash = $1
+	`, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return user.APIToken{}, user.APITokenNotFoundError{}
+	}
+	if err != nil {
+		return user.APIToken{}, fmt.Errorf("failed fetching api token: %w", err)
+	}
+
+	return m.toAPIToken(), nil
+}
+
+// DeleteToken deletes an api token of a service account
+func (r *ServiceAccountRepository) DeleteToken(ctx context.Context, serviceAccountID, id string) error {
+	if !isValidUUID(serviceAccountID) || !isValidUUID(id) {
+		return user.APITokenNotFoundError{ID: id}
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			api_tokens
+		WHERE
+			id = $1 AND service_account_id = $2
+	`, id, serviceAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to delete api token: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting api token: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return user.APITokenNotFoundError{ID: id}
+	}
+	return nil
+}
+
+// NewServiceAccountRepository initializes service account repository
+func NewServiceAccountRepository(c *Client) (*ServiceAccountRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &ServiceAccountRepository{
+		client: c,
+	}, nil
+}
@@ -0,0 +1,146 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceAccountRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.ServiceAccountRepository
+}
+
+func (r *ServiceAccountRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewServiceAccountRepository(r.client)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *ServiceAccountRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *ServiceAccountRepositoryTestSuite) TestCreateAndGet() {
+	sa := &user.ServiceAccount{
+		Name:        "ingestion-bq",
+		Description: "BigQuery ingestion",
+		Email:       "ingestion-bq@" + user.ServiceAccountEmailDomain,
+	}
+
+	r.Run("return service account as created", func() {
+		id, err := r.repository.Create(r.ctx, sa)
+		r.Require().NoError(err)
+
+		actual, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal(id, actual.ID)
+		r.Equal(sa.Name, actual.Name)
+		r.Equal(sa.Description, actual.Description)
+		r.Equal(sa.Email, actual.Email)
+		r.False(actual.CreatedAt.IsZero())
+	})
+
+	r.Run("return exists error if name is taken", func() {
+		_, err := r.repository.Create(r.ctx, sa)
+		r.ErrorIs(err, user.ServiceAccountExistsError{Name: sa.Name})
+	})
+
+	r.Run("return not found error if service account does not exist", func() {
+		_, err := r.repository.GetByID(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e")
+		r.ErrorIs(err, user.ServiceAccountNotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+
+	r.Run("return all service accounts", func() {
+		actual, err := r.repository.GetAll(r.ctx)
+		r.NoError(err)
+		r.Len(actual, 1)
+	})
+}
+
+func (r *ServiceAccountRepositoryTestSuite) TestDeleteKeepsUser() {
+	sa := &user.ServiceAccount{
+		Name:  "ingestion-kafka",
+		Email: "ingestion-kafka@" + user.ServiceAccountEmailDomain,
+	}
+	id, err := r.repository.Create(r.ctx, sa)
+	r.Require().NoError(err)
+
+	r.NoError(r.repository.Delete(r.ctx, id))
+	_, err = r.repository.GetByID(r.ctx, id)
+	r.ErrorIs(err, user.ServiceAccountNotFoundError{ID: id})
+
+	r.Run("recreating service account reuses its user", func() {
+		recreatedID, err := r.repository.Create(r.ctx, sa)
+		r.NoError(err)
+		r.Equal(id, recreatedID)
+	})
+}
+
+func (r *ServiceAccountRepositoryTestSuite) TestTokens() {
+	saID, err := r.repository.Create(r.ctx, &user.ServiceAccount{
+		Name:  "ingestion-pg",
+		Email: "ingestion-pg@" + user.ServiceAccountEmailDomain,
+	})
+	r.Require().NoError(err)
+
+	tkn := &user.APIToken{
+		ServiceAccountID: saID,
+		Name:             "daily",
+		Hash:             "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8",
+		Scopes:           []user.TokenScope{user.TokenScopeRead, user.TokenScopeWrite},
+		ExpiresAt:        time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	id, err := r.repository.CreateToken(r.ctx, tkn)
+	r.Require().NoError(err)
+
+	r.Run("return token by hash", func() {
+		actual, err := r.repository.GetTokenByHash(r.ctx, tkn.Hash)
+		r.NoError(err)
+		r.Equal(id, actual.ID)
+		r.Equal(saID, actual.ServiceAccountID)
+		r.Equal(tkn.Scopes, actual.Scopes)
+		r.True(tkn.ExpiresAt.Equal(actual.ExpiresAt))
+	})
+
+	r.Run("return tokens of service account", func() {
+		actual, err := r.repository.GetTokens(r.ctx, saID)
+		r.NoError(err)
+		r.Len(actual, 1)
+	})
+
+	r.Run("return not found error when revoking token of another service account", func() {
+		err := r.repository.DeleteToken(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e", id)
+		r.ErrorIs(err, user.APITokenNotFoundError{ID: id})
+	})
+
+	r.Run("delete token", func() {
+		r.NoError(r.repository.DeleteToken(r.ctx, saID, id))
+		_, err := r.repository.GetTokenByHash(r.ctx, tkn.Hash)
+		r.ErrorAs(err, new(user.APITokenNotFoundError))
+	})
+}
+
+func TestServiceAccountRepository(t *testing.T) {
+	suite.Run(t, &ServiceAccountRepositoryTestSuite{})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/star"
 	"github.com/goto/compass/core/user"
+	"github.com/lib/pq"
 )
 
 type StarClauses struct {
@@ -113,6 +114,12 @@ func (r *StarRepository) GetAllAssetsByUserID(ctx context.Context, flt star.Filt
 	}
 
 	starClausesValue := r.buildClausesValue(flt)
+	args := []interface{}{userID, starClausesValue.SortKey, starClausesValue.Limit, starClausesValue.Offset}
+	visibilityClause := ""
+	if flt.Viewer.IsRestricted() {
+		visibilityClause = "AND (a.visibility != $5 OR a.visibility_groups && $6)"
+		args = append(args, string(asset.VisibilityRestricted), pq.StringArray(flt.Viewer.Groups))
+	}
 
 	var assetModels []AssetModel
 	if err := r.client.db.SelectContext(ctx, &assetModels, fmt.Sprintf(`
@@ -126,6 +133,8 @@ func (r *StarRepository) GetAllAssetsByUserID(ctx context.Context, flt star.Filt
 			a.data as data,
 			a.labels as labels,
 			a.version as version,
+			a.visibility as visibility,
+			a.visibility_groups as visibility_groups,
 			a.created_at as created_at,
 			a.updated_at as updated_at,
 			u.id as "updated_by.id",
@@ -140,14 +149,14 @@ func (r *StarRepository) GetAllAssetsByUserID(ctx context.Context, flt star.Filt
 		LEFT JOIN
 			users u ON a.updated_by = u.id
 		WHERE
-			s.user_id = $1
+			s.user_id = $1 %s
 		ORDER BY
 			$2 %s
 		LIMIT
 			$3
 		OFFSET
 			$4
-	`, starClausesValue.SortDirectionKey), userID, starClausesValue.SortKey, starClausesValue.Limit, starClausesValue.Offset); err != nil {
+	`, visibilityClause, starClausesValue.SortDirectionKey), args...); err != nil {
 		return nil, fmt.Errorf("failed fetching stars by user: %w", err)
 	}
 
@@ -190,6 +199,8 @@ func (r *StarRepository) GetAssetByUserID(ctx context.Context, userID, assetID s
 			a.data,
 			a.labels,
 			a.version,
+			a.visibility,
+			a.visibility_groups,
 			a.created_at,
 			a.updated_at,
 			u.id as "updated_by.id",
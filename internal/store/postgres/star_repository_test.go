@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/goto/compass/core/asset"
 	"github.com/goto/compass/core/star"
 	"github.com/goto/compass/internal/store/postgres"
 	"github.com/goto/compass/internal/testutils"
@@ -258,6 +259,35 @@ func (r *StarRepositoryTestSuite) TestGetAllAssetsByUserID() {
 		r.Contains(assetIDs, createdAsset3.ID)
 	})
 
+	r.Run("return only the starred assets visible to the viewer", func() {
+		err := testutils.RunMigrationsWithClient(r.T(), r.client)
+		r.NoError(err)
+
+		userID, err := createUser(r.userRepository, "user@gotocompany.com")
+		r.NoError(err)
+
+		public, err := createAsset(r.assetRepository, userID, ownerEmail, "asset-urn-public", "table")
+		r.NoError(err)
+		restricted := getAsset(ownerEmail, "asset-urn-restricted", "table")
+		restricted.UpdatedBy.ID = userID
+		restricted.Visibility = &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}}
+		restricted, _, err = r.assetRepository.Upsert(r.ctx, restricted, false, asset.Config{})
+		r.NoError(err)
+		for _, id := range []string{public.ID, restricted.ID} {
+			_, err := r.repository.Create(r.ctx, userID, id)
+			r.NoError(err)
+		}
+
+		actualAssets, err := r.repository.GetAllAssetsByUserID(r.ctx, star.Filter{Viewer: &asset.Viewer{Groups: []string{"hr"}}}, userID)
+		r.NoError(err)
+		r.Len(actualAssets, 1)
+		r.Equal(public.ID, actualAssets[0].ID)
+
+		actualAssets, err = r.repository.GetAllAssetsByUserID(r.ctx, star.Filter{Viewer: &asset.Viewer{Groups: []string{"finance"}}}, userID)
+		r.NoError(err)
+		r.Len(actualAssets, 2)
+	})
+
 	r.Run("return limited paginated list of starred assets if get by user id success", func() {
 		err := testutils.RunMigrationsWithClient(r.T(), r.client)
 		r.NoError(err)
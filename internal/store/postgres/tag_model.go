@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -9,43 +11,52 @@ import (
 
 // TagModel is a model for tag value in database table
 type TagModel struct {
-	ID        uint                  `db:"id"`
-	Value     string                `db:"value"`
-	AssetID   string                `db:"asset_id"`
-	FieldID   uint                  `db:"field_id"`
-	CreatedAt time.Time             `db:"created_at"`
-	UpdatedAt time.Time             `db:"updated_at"`
-	Field     TagTemplateFieldModel `db:"-"`
+	ID              uint                  `db:"id"`
+	Value           string                `db:"value"`
+	AssetID         string                `db:"asset_id"`
+	ColumnName      string                `db:"column_name"`
+	FieldID         uint                  `db:"field_id"`
+	TemplateVersion uint                  `db:"template_version"`
+	InheritedFrom   *string               `db:"inherited_from"`
+	CreatedAt       time.Time             `db:"created_at"`
+	UpdatedAt       time.Time             `db:"updated_at"`
+	Field           TagTemplateFieldModel `db:"-"`
 }
 
 type TagModels []TagModel
 
-func (ts TagModels) buildMapByTemplateURN() map[string][]TagModel {
-	tagsByTemplateURN := make(map[string][]TagModel)
+// tagKey identifies the tag of a template on an asset or one of its columns
+type tagKey struct {
+	column      string
+	templateURN string
+}
+
+func (ts TagModels) buildMapByKey() map[tagKey][]TagModel {
+	tagsByKey := make(map[tagKey][]TagModel)
 	for _, t := range ts {
-		key := t.Field.TemplateURN
-		if tagsByTemplateURN[key] == nil {
-			tagsByTemplateURN[key] = []TagModel{}
-		}
-		tagsByTemplateURN[key] = append(tagsByTemplateURN[key], t)
+		key := tagKey{column: t.ColumnName, templateURN: t.Field.TemplateURN}
+		tagsByKey[key] = append(tagsByKey[key], t)
 	}
-	return tagsByTemplateURN
+	return tagsByKey
 }
 
 func (ts TagModels) toTags(assetID string, templates TagTemplateModels) []tag.Tag {
 	templateByURN := templates.buildMapByURN()
-	tagsByTemplateURN := ts.buildMapByTemplateURN()
+	tagsByKey := ts.buildMapByKey()
 
 	output := []tag.Tag{}
-	for templateURN, tagModels := range tagsByTemplateURN {
+	for key, tagModels := range tagsByKey {
 		var listOfTagValue []tag.TagValue
-		templateModel := templateByURN[templateURN]
+		templateModel := templateByURN[key.templateURN]
+		templateVersion := templateModel.Version
+		var inheritedFrom string
 		for _, t := range tagModels {
-			var options []string
-			if t.Field.Options != nil {
-				options = strings.Split(*t.Field.Options, ",")
+			templateVersion = min(templateVersion, t.TemplateVersion)
+			if t.InheritedFrom != nil {
+				inheritedFrom = *t.InheritedFrom
 			}
-			parsedValue, _ := tag.ParseTagValue(templateModel.URN, t.FieldID, t.Field.DataType, t.Value, options)
+			field := t.Field.toDomainField()
+			parsedValue, _ := tag.ParseTagValue(templateModel.URN, field, t.Value)
 			listOfTagValue = append(listOfTagValue, tag.TagValue{
 				FieldID:          t.FieldID,
 				FieldValue:       parsedValue,
@@ -53,7 +64,7 @@ func (ts TagModels) toTags(assetID string, templates TagTemplateModels) []tag.Ta
 				FieldDisplayName: t.Field.DisplayName,
 				FieldDescription: t.Field.Description,
 				FieldDataType:    t.Field.DataType,
-				FieldOptions:     options,
+				FieldOptions:     field.Options,
 				FieldRequired:    t.Field.Required,
 				CreatedAt:        t.CreatedAt,
 				UpdatedAt:        t.UpdatedAt,
@@ -61,10 +72,13 @@ func (ts TagModels) toTags(assetID string, templates TagTemplateModels) []tag.Ta
 		}
 		output = append(output, tag.Tag{
 			AssetID:             assetID,
+			Column:              key.column,
 			TemplateURN:         templateModel.URN,
 			TagValues:           listOfTagValue,
 			TemplateDisplayName: templateModel.DisplayName,
 			TemplateDescription: templateModel.Description,
+			TemplateVersion:     templateVersion,
+			InheritedFrom:       inheritedFrom,
 		})
 	}
 	return output
@@ -75,6 +89,8 @@ type TagTemplateModel struct {
 	URN         string                 `db:"urn"`
 	DisplayName string                 `db:"display_name"`
 	Description string                 `db:"description"`
+	Propagation *string                `db:"propagation"`
+	Version     uint                   `db:"version"`
 	CreatedAt   time.Time              `db:"created_at"`
 	UpdatedAt   time.Time              `db:"updated_at"`
 	Fields      TagTemplateFieldModels `db:"-"`
@@ -86,18 +102,41 @@ func (tmp *TagTemplateModel) toTemplate() tag.Template {
 		DisplayName: tmp.DisplayName,
 		Description: tmp.Description,
 		Fields:      tmp.Fields.toDomainFields(),
+		Propagation: tmp.propagationRule(),
+		Version:     tmp.Version,
 		CreatedAt:   tmp.CreatedAt,
 		UpdatedAt:   tmp.UpdatedAt,
 	}
 }
 
+// propagationRule returns the propagation rule stored as JSON, nil if the
+// template has none
+func (tmp *TagTemplateModel) propagationRule() *tag.PropagationRule {
+	if tmp.Propagation == nil {
+		return nil
+	}
+	var rule tag.PropagationRule
+	if err := json.Unmarshal([]byte(*tmp.Propagation), &rule); err != nil {
+		return nil
+	}
+	return &rule
+}
+
 func newTemplateModel(template *tag.Template) *TagTemplateModel {
 	fieldModels := newSliceOfFieldModel(template.Fields)
 
+	var propagation *string
+	if template.Propagation != nil {
+		rule, _ := json.Marshal(template.Propagation)
+		ruleStr := string(rule)
+		propagation = &ruleStr
+	}
+
 	return &TagTemplateModel{
 		URN:         template.URN,
 		DisplayName: template.DisplayName,
 		Description: template.Description,
+		Propagation: propagation,
 		Fields:      fieldModels,
 	}
 }
@@ -121,12 +160,42 @@ type TagTemplateFieldModel struct {
 	DataType    string           `db:"data_type"`
 	Options     *string          `db:"options"`
 	Required    bool             `db:"required"`
+	Multiple    bool             `db:"multiple"`
+	Min         *float64         `db:"min"`
+	Max         *float64         `db:"max"`
+	Pattern     *string          `db:"pattern"`
 	TemplateURN string           `db:"template_urn"`
 	CreatedAt   time.Time        `db:"created_at"`
 	UpdatedAt   time.Time        `db:"updated_at"`
 	Template    TagTemplateModel `db:"-"`
 }
 
+func (f TagTemplateFieldModel) toDomainField() tag.Field {
+	var options []string
+	if f.Options != nil {
+		options = strings.Split(*f.Options, fieldOptionSeparator)
+	}
+	var pattern string
+	if f.Pattern != nil {
+		pattern = *f.Pattern
+	}
+	return tag.Field{
+		ID:          f.ID,
+		URN:         f.URN,
+		DisplayName: f.DisplayName,
+		Description: f.Description,
+		DataType:    f.DataType,
+		Options:     options,
+		Required:    f.Required,
+		Multiple:    f.Multiple,
+		Min:         f.Min,
+		Max:         f.Max,
+		Pattern:     pattern,
+		CreatedAt:   f.CreatedAt,
+		UpdatedAt:   f.UpdatedAt,
+	}
+}
+
 type TagTemplateFieldModels []TagTemplateFieldModel
 
 func (fs *TagTemplateFieldModels) isIDExist(id uint) bool {
@@ -141,21 +210,7 @@ func (fs *TagTemplateFieldModels) isIDExist(id uint) bool {
 func (fs *TagTemplateFieldModels) toDomainFields() []tag.Field {
 	output := make([]tag.Field, len(*fs))
 	for i, field := range *fs {
-		var options []string
-		if field.Options != nil {
-			options = strings.Split(*field.Options, fieldOptionSeparator)
-		}
-		output[i] = tag.Field{
-			ID:          field.ID,
-			URN:         field.URN,
-			DisplayName: field.DisplayName,
-			Description: field.Description,
-			DataType:    field.DataType,
-			Options:     options,
-			Required:    field.Required,
-			CreatedAt:   field.CreatedAt,
-			UpdatedAt:   field.UpdatedAt,
-		}
+		output[i] = field.toDomainField()
 	}
 	return output
 }
@@ -168,6 +223,10 @@ func newSliceOfFieldModel(listOfDomainField []tag.Field) TagTemplateFieldModels
 			joinedOptions := strings.Join(field.Options, fieldOptionSeparator)
 			options = &joinedOptions
 		}
+		var pattern *string
+		if field.Pattern != "" {
+			pattern = &field.Pattern
+		}
 		newFields = append(newFields, TagTemplateFieldModel{
 			ID:          field.ID,
 			URN:         field.URN,
@@ -176,6 +235,10 @@ func newSliceOfFieldModel(listOfDomainField []tag.Field) TagTemplateFieldModels
 			DataType:    field.DataType,
 			Options:     options,
 			Required:    field.Required,
+			Multiple:    field.Multiple,
+			Min:         field.Min,
+			Max:         field.Max,
+			Pattern:     pattern,
 		})
 	}
 	return newFields
@@ -231,21 +294,7 @@ func (tfs TagJoinTemplateFieldModels) toTemplates() (templates []tag.Template) {
 	for _, template := range templatesMap {
 		listOfDomainField := []tag.Field{}
 		for _, field := range template.Fields {
-			var options []string
-			if field.Options != nil {
-				options = strings.Split(*field.Options, ",")
-			}
-			listOfDomainField = append(listOfDomainField, tag.Field{
-				ID:          field.ID,
-				URN:         field.URN,
-				DisplayName: field.DisplayName,
-				Description: field.Description,
-				DataType:    field.DataType,
-				Required:    field.Required,
-				Options:     options,
-				CreatedAt:   field.CreatedAt,
-				UpdatedAt:   field.UpdatedAt,
-			})
+			listOfDomainField = append(listOfDomainField, field.toDomainField())
 		}
 
 		templates = append(templates, tag.Template{
@@ -253,6 +302,8 @@ func (tfs TagJoinTemplateFieldModels) toTemplates() (templates []tag.Template) {
 			DisplayName: template.DisplayName,
 			Description: template.Description,
 			Fields:      listOfDomainField,
+			Propagation: template.propagationRule(),
+			Version:     template.Version,
 			CreatedAt:   template.CreatedAt,
 			UpdatedAt:   template.UpdatedAt,
 		})
@@ -302,3 +353,86 @@ func (ttfs TagJoinTemplateTagFieldModels) toTemplateAndTagModels() (templates Ta
 	}
 	return
 }
+
+// toRawTags builds a tag for each asset and column, in the order of the rows,
+// with the values as they are stored
+func (ttfs TagJoinTemplateTagFieldModels) toRawTags() []tag.Tag {
+	var tags []tag.Tag
+	for _, ttf := range ttfs {
+		if len(tags) == 0 || tags[len(tags)-1].AssetID != ttf.Tag.AssetID || tags[len(tags)-1].Column != ttf.Tag.ColumnName {
+			tags = append(tags, tag.Tag{
+				AssetID:             ttf.Tag.AssetID,
+				Column:              ttf.Tag.ColumnName,
+				TemplateURN:         ttf.Template.URN,
+				TemplateDisplayName: ttf.Template.DisplayName,
+				TemplateDescription: ttf.Template.Description,
+				TemplateVersion:     ttf.Tag.TemplateVersion,
+			})
+		}
+
+		tg := &tags[len(tags)-1]
+		tg.TemplateVersion = min(tg.TemplateVersion, ttf.Tag.TemplateVersion)
+		if ttf.Tag.InheritedFrom != nil {
+			tg.InheritedFrom = *ttf.Tag.InheritedFrom
+		}
+		var options []string
+		if ttf.Field.Options != nil {
+			options = strings.Split(*ttf.Field.Options, fieldOptionSeparator)
+		}
+		tg.TagValues = append(tg.TagValues, tag.TagValue{
+			FieldID:          ttf.Field.ID,
+			FieldValue:       ttf.Tag.Value,
+			FieldURN:         ttf.Field.URN,
+			FieldDisplayName: ttf.Field.DisplayName,
+			FieldDescription: ttf.Field.Description,
+			FieldDataType:    ttf.Field.DataType,
+			FieldOptions:     options,
+			FieldRequired:    ttf.Field.Required,
+			CreatedAt:        ttf.Tag.CreatedAt,
+			UpdatedAt:        ttf.Tag.UpdatedAt,
+		})
+	}
+	return tags
+}
+
+// TagHistoryModel is a change of the tag of a template on an asset or one of
+// its columns, with the stored values by field urn
+type TagHistoryModel struct {
+	ID          uint      `db:"id"`
+	AssetID     string    `db:"asset_id"`
+	ColumnName  string    `db:"column_name"`
+	TemplateURN string    `db:"template_urn"`
+	Action      string    `db:"action"`
+	Actor       *string   `db:"actor"`
+	Before      *JSONMap  `db:"before_values"`
+	After       *JSONMap  `db:"after_values"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (m TagHistoryModel) toHistory() tag.History {
+	var actor string
+	if m.Actor != nil {
+		actor = *m.Actor
+	}
+	return tag.History{
+		AssetID:     m.AssetID,
+		Column:      m.ColumnName,
+		TemplateURN: m.TemplateURN,
+		Action:      tag.HistoryAction(m.Action),
+		Actor:       actor,
+		Before:      historyValues(m.Before),
+		After:       historyValues(m.After),
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func historyValues(m *JSONMap) map[string]string {
+	if m == nil {
+		return nil
+	}
+	values := make(map[string]string, len(*m))
+	for k, v := range *m {
+		values[k] = fmt.Sprint(v)
+	}
+	return values
+}
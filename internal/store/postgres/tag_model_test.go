@@ -16,10 +16,21 @@ func TestTagModel(t *testing.T) {
 	tags := getTagModels()
 
 	t.Run("successfully build map of tags model by template URN", func(t *testing.T) {
-		expectedTagsMap := map[string][]TagModel{
-			"governance_policy": {tags[0], tags[1]},
+		expectedTagsMap := map[tagKey][]TagModel{
+			{templateURN: "governance_policy"}: {tags[0], tags[1]},
 		}
-		tagsMap := tags.buildMapByTemplateURN()
+		tagsMap := tags.buildMapByKey()
+
+		assert.EqualValues(t, expectedTagsMap, tagsMap)
+	})
+	t.Run("successfully build map of tags model by column and template URN", func(t *testing.T) {
+		columnTag := tags[1]
+		columnTag.ColumnName = "user_id"
+		expectedTagsMap := map[tagKey][]TagModel{
+			{templateURN: "governance_policy"}:                    {tags[0]},
+			{column: "user_id", templateURN: "governance_policy"}: {columnTag},
+		}
+		tagsMap := TagModels{tags[0], columnTag}.buildMapByKey()
 
 		assert.EqualValues(t, expectedTagsMap, tagsMap)
 	})
@@ -1,12 +1,16 @@
 package postgres
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -39,6 +43,11 @@ func (r *TagRepository) Create(ctx context.Context, domainTag *tag.Tag) error {
 
 	var insertedModelTags []TagModel
 	if err := r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := readTagValuesWithinTx(ctx, tx, *domainTag)
+		if err != nil {
+			return err
+		}
+
 		timestamp := time.Now().UTC()
 
 		for _, tv := range domainTag.TagValues {
@@ -47,24 +56,28 @@ func (r *TagRepository) Create(ctx context.Context, domainTag *tag.Tag) error {
 				continue
 			}
 			tagToInsert := &TagModel{
-				AssetID:   domainTag.AssetID,
-				FieldID:   tv.FieldID,
-				Value:     fmt.Sprintf("%v", tv.FieldValue),
-				CreatedAt: timestamp,
-				UpdatedAt: timestamp,
+				AssetID:         domainTag.AssetID,
+				ColumnName:      domainTag.Column,
+				FieldID:         tv.FieldID,
+				Value:           tag.FormatTagValue(tv.FieldValue),
+				TemplateVersion: templates[0].Version,
+				InheritedFrom:   inheritedFrom(domainTag),
+				CreatedAt:       timestamp,
+				UpdatedAt:       timestamp,
 			}
 
 			if err := tx.QueryRowxContext(ctx, `
 						INSERT INTO tags
-							(value, asset_id, field_id, created_at, updated_at)
+							(value, asset_id, column_name, field_id, template_version, inherited_from, created_at, updated_at)
 						VALUES
-							($1, $2, $3, $4, $5)
+							($1, $2, $3, $4, $5, $6, $7, $8)
 						RETURNING *`,
-				tagToInsert.Value, tagToInsert.AssetID, tagToInsert.FieldID, tagToInsert.CreatedAt, tagToInsert.UpdatedAt).
+				tagToInsert.Value, tagToInsert.AssetID, tagToInsert.ColumnName, tagToInsert.FieldID, tagToInsert.TemplateVersion, tagToInsert.InheritedFrom, tagToInsert.CreatedAt, tagToInsert.UpdatedAt).
 				StructScan(&insertedTagValue); err != nil {
 				if err := checkPostgresError(err); errors.Is(err, errDuplicateKey) {
 					return tag.DuplicateError{
 						AssetID:     tagToInsert.AssetID,
+						Column:      tagToInsert.ColumnName,
 						TemplateURN: domainTag.TemplateURN,
 					}
 				}
@@ -73,7 +86,7 @@ func (r *TagRepository) Create(ctx context.Context, domainTag *tag.Tag) error {
 
 			insertedModelTags = append(insertedModelTags, insertedTagValue)
 		}
-		return nil
+		return recordTagHistory(ctx, tx, *domainTag, before)
 	}); err != nil {
 		return err
 	}
@@ -90,11 +103,12 @@ func (r *TagRepository) Read(ctx context.Context, filter tag.Tag) ([]tag.Tag, er
 	sqlQuery := `
 		SELECT 
 			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description",
-			t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
-			tg.id as "tags.id", tg.value as "tags.value", tg.asset_id as "tags.asset_id",
-			tg.field_id as "tags.field_id", tg.created_at as "tags.created_at", tg.updated_at as "tags.updated_at",
+			t.version as "tag_templates.version", t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
+			tg.id as "tags.id", tg.value as "tags.value", tg.asset_id as "tags.asset_id", tg.column_name as "tags.column_name", tg.field_id as "tags.field_id",
+			tg.template_version as "tags.template_version", tg.inherited_from as "tags.inherited_from", tg.created_at as "tags.created_at", tg.updated_at as "tags.updated_at",
 			f.id as "tag_template_fields.id", f.urn as "tag_template_fields.urn", f.display_name as "tag_template_fields.display_name", f.description as "tag_template_fields.description",
 			f.data_type as "tag_template_fields.data_type", f.options as "tag_template_fields.options", f.required as "tag_template_fields.required", f.template_urn as "tag_template_fields.template_urn",
+			f.multiple as "tag_template_fields.multiple", f.min as "tag_template_fields.min", f.max as "tag_template_fields.max", f.pattern as "tag_template_fields.pattern",
 			f.created_at as "tag_template_fields.created_at", f.updated_at as "tag_template_fields.updated_at"
 		FROM 
 			tag_templates t
@@ -107,9 +121,9 @@ func (r *TagRepository) Read(ctx context.Context, filter tag.Tag) ([]tag.Tag, er
 	sqlArgs := []interface{}{filter.AssetID}
 
 	if filter.TemplateURN != "" {
-		// filter by asset and template
-		sqlQuery += " AND t.urn = $2"
-		sqlArgs = append(sqlArgs, filter.TemplateURN)
+		// filter by asset, column and template
+		sqlQuery += " AND t.urn = $2 AND tg.column_name = $3"
+		sqlArgs = append(sqlArgs, filter.TemplateURN, filter.Column)
 	}
 
 	var templateTagFields TagJoinTemplateTagFieldModels
@@ -122,6 +136,7 @@ func (r *TagRepository) Read(ctx context.Context, filter tag.Tag) ([]tag.Tag, er
 	if len(templateTagFields) == 0 && filter.TemplateURN != "" {
 		return nil, tag.NotFoundError{
 			AssetID:  filter.AssetID,
+			Column:   filter.Column,
 			Template: filter.TemplateURN,
 		}
 	}
@@ -149,41 +164,62 @@ func (r *TagRepository) Update(ctx context.Context, domainTag *tag.Tag) error {
 
 	var updatedModelTags []TagModel
 	if err := r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := readTagValuesWithinTx(ctx, tx, *domainTag)
+		if err != nil {
+			return err
+		}
+
 		timestamp := time.Now().UTC()
 
 		for _, value := range domainTag.TagValues {
 			if value.FieldValue == nil || value.FieldValue == "" {
 				continue
 			}
-			valueStr := fmt.Sprintf("%v", value.FieldValue)
+			valueStr := tag.FormatTagValue(value.FieldValue)
 			tagModel := &TagModel{
-				Value:     valueStr,
-				AssetID:   domainTag.AssetID,
-				FieldID:   value.FieldID,
-				CreatedAt: timestamp,
-				UpdatedAt: timestamp,
+				Value:           valueStr,
+				AssetID:         domainTag.AssetID,
+				ColumnName:      domainTag.Column,
+				FieldID:         value.FieldID,
+				TemplateVersion: templates[0].Version,
+				InheritedFrom:   inheritedFrom(domainTag),
+				CreatedAt:       timestamp,
+				UpdatedAt:       timestamp,
 			}
 
 			var updatedModelTag TagModel
 			if err := tx.QueryRowxContext(ctx, `
 							INSERT INTO
 							tags 
-								(value, asset_id, field_id, created_at, updated_at)
+								(value, asset_id, column_name, field_id, template_version, inherited_from, created_at, updated_at)
 							VALUES
-								($1, $2, $3, $4, $5)
+								($1, $2, $3, $4, $5, $6, $7, $8)
 							ON CONFLICT 
-								(asset_id, field_id)
+								(asset_id, column_name, field_id)
 							DO UPDATE SET 
-								(value, asset_id, field_id, created_at, updated_at) = 
-								($1, $2, $3, $4, $5) 
+								(value, asset_id, column_name, field_id, template_version, inherited_from, created_at, updated_at) = 
+								($1, $2, $3, $4, $5, $6, $7, $8) 
 							RETURNING *`,
-				tagModel.Value, tagModel.AssetID, tagModel.FieldID, tagModel.CreatedAt, tagModel.UpdatedAt).
+				tagModel.Value, tagModel.AssetID, tagModel.ColumnName, tagModel.FieldID, tagModel.TemplateVersion, tagModel.InheritedFrom, tagModel.CreatedAt, tagModel.UpdatedAt).
 				StructScan(&updatedModelTag); err != nil {
 				return err
 			}
 			updatedModelTags = append(updatedModelTags, updatedModelTag)
 		}
-		return nil
+
+		// the values left as they are share the inheritance of the updated
+		// tag, an inherited tag updated by a user is not inherited anymore
+		if _, err := tx.ExecContext(ctx, `
+							UPDATE
+								tags
+							SET
+								inherited_from = $1
+							WHERE
+								asset_id = $2 AND column_name = $3 AND field_id IN (SELECT id FROM tag_template_fields WHERE template_urn = $4)`,
+			inheritedFrom(domainTag), domainTag.AssetID, domainTag.Column, templates[0].URN); err != nil {
+			return err
+		}
+		return recordTagHistory(ctx, tx, *domainTag, before)
 	}); err != nil {
 		return fmt.Errorf("failed to update a domain tag: %w", err)
 	}
@@ -220,13 +256,18 @@ func (r *TagRepository) Delete(ctx context.Context, domainTag tag.Tag) error {
 	}
 
 	return r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		before, err := readTagValuesWithinTx(ctx, tx, domainTag)
+		if err != nil {
+			return err
+		}
+
 		for _, tagModel := range deletedModelTags {
 			sqlQuery := "DELETE FROM tags WHERE tags.asset_id = $1"
 			sqlArgs := []interface{}{tagModel.AssetID}
 
 			if tagModel.FieldID != 0 {
-				sqlQuery += " AND tags.field_id = $2"
-				sqlArgs = append(sqlArgs, tagModel.FieldID)
+				sqlQuery += " AND tags.field_id = $2 AND tags.column_name = $3"
+				sqlArgs = append(sqlArgs, tagModel.FieldID, domainTag.Column)
 			}
 
 			res, err := tx.ExecContext(ctx, sqlQuery, sqlArgs...)
@@ -239,13 +280,113 @@ func (r *TagRepository) Delete(ctx context.Context, domainTag tag.Tag) error {
 			}
 
 			if rowsAffected == 0 {
-				return tag.NotFoundError{AssetID: tagModel.AssetID, Template: domainTag.TemplateURN}
+				return tag.NotFoundError{AssetID: tagModel.AssetID, Column: domainTag.Column, Template: domainTag.TemplateURN}
 			}
 		}
-		return nil
+		return recordTagHistory(ctx, tx, domainTag, before)
 	})
 }
 
+// ReadByTemplate reads the tags of the template written against an older version
+// of it, the assets and their columns whose tag is current being left out. The
+// values are returned as they are stored, unparsed, as they might not be valid
+// against the current version of the template.
+func (r *TagRepository) ReadByTemplate(ctx context.Context, filter tag.TemplateTagsFilter) ([]tag.Tag, error) {
+	if filter.TemplateURN == "" {
+		return nil, errors.New("template urn should not be empty")
+	}
+
+	var templateTagFields TagJoinTemplateTagFieldModels
+	if err := r.client.db.SelectContext(ctx, &templateTagFields, `
+		WITH outdated_assets AS (
+			SELECT DISTINCT
+				tg.asset_id
+			FROM
+				tags tg
+			JOIN
+				tag_template_fields f ON f.id = tg.field_id
+			WHERE
+				f.template_urn = $1 AND tg.template_version < $2 AND tg.asset_id > $3
+			ORDER BY
+				tg.asset_id
+			LIMIT $4
+		), outdated AS (
+			SELECT DISTINCT
+				tg.asset_id, tg.column_name
+			FROM
+				tags tg
+			JOIN
+				tag_template_fields f ON f.id = tg.field_id
+			JOIN
+				outdated_assets a ON a.asset_id = tg.asset_id
+			WHERE
+				f.template_urn = $1 AND tg.template_version < $2
+		)
+		SELECT
+			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description",
+			t.version as "tag_templates.version", t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
+			tg.id as "tags.id", tg.value as "tags.value", tg.asset_id as "tags.asset_id", tg.column_name as "tags.column_name", tg.field_id as "tags.field_id",
+			tg.template_version as "tags.template_version", tg.inherited_from as "tags.inherited_from", tg.created_at as "tags.created_at", tg.updated_at as "tags.updated_at",
+			f.id as "tag_template_fields.id", f.urn as "tag_template_fields.urn", f.display_name as "tag_template_fields.display_name", f.description as "tag_template_fields.description",
+			f.data_type as "tag_template_fields.data_type", f.options as "tag_template_fields.options", f.required as "tag_template_fields.required", f.template_urn as "tag_template_fields.template_urn",
+			f.multiple as "tag_template_fields.multiple", f.min as "tag_template_fields.min", f.max as "tag_template_fields.max", f.pattern as "tag_template_fields.pattern",
+			f.created_at as "tag_template_fields.created_at", f.updated_at as "tag_template_fields.updated_at"
+		FROM
+			tag_templates t
+		JOIN
+			tag_template_fields f ON f.template_urn = t.urn
+		JOIN
+			tags tg ON f.id = tg.field_id
+		JOIN
+			outdated o ON o.asset_id = tg.asset_id AND o.column_name = tg.column_name
+		WHERE
+			t.urn = $1
+		ORDER BY
+			tg.asset_id, tg.column_name, f.id`,
+		filter.TemplateURN, filter.BelowVersion, filter.AfterAssetID, filter.Size); err != nil {
+		return nil, fmt.Errorf("failed reading outdated tags: %w", err)
+	}
+
+	return templateTagFields.toRawTags(), nil
+}
+
+// ReadHistory reads the changes of the tags of the template on the asset and
+// its columns, the latest first
+func (r *TagRepository) ReadHistory(ctx context.Context, assetID, templateURN string) ([]tag.History, error) {
+	if assetID == "" {
+		return nil, errEmptyAssetID
+	}
+
+	var models []TagHistoryModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			id, asset_id, column_name, template_urn, action, actor, before_values, after_values, created_at
+		FROM
+			tags_history
+		WHERE
+			asset_id = $1 AND template_urn = $2
+		ORDER BY
+			created_at DESC, id DESC`,
+		assetID, templateURN); err != nil {
+		return nil, fmt.Errorf("failed reading tag history: %w", err)
+	}
+
+	history := make([]tag.History, 0, len(models))
+	for _, m := range models {
+		history = append(history, m.toHistory())
+	}
+	return history, nil
+}
+
+// inheritedFrom returns the urn of the asset the tag is inherited from, nil if
+// the tag is not inherited
+func inheritedFrom(domainTag *tag.Tag) *string {
+	if domainTag.InheritedFrom == "" {
+		return nil
+	}
+	return &domainTag.InheritedFrom
+}
+
 func (r *TagRepository) complementTag(domainTag *tag.Tag, template tag.Template, tagModels []TagModel) error {
 	tagByFieldID := make(map[uint]TagModel)
 	for _, t := range tagModels {
@@ -254,7 +395,7 @@ func (r *TagRepository) complementTag(domainTag *tag.Tag, template tag.Template,
 	var listOfTagValue []tag.TagValue
 	for _, field := range template.Fields {
 		t := tagByFieldID[field.ID]
-		parsedValue, _ := tag.ParseTagValue(domainTag.TemplateURN, field.ID, field.DataType, t.Value, field.Options)
+		parsedValue, _ := tag.ParseTagValue(domainTag.TemplateURN, field, t.Value)
 		listOfTagValue = append(listOfTagValue, tag.TagValue{
 			FieldID:          field.ID,
 			FieldValue:       parsedValue,
@@ -271,6 +412,7 @@ func (r *TagRepository) complementTag(domainTag *tag.Tag, template tag.Template,
 	domainTag.TemplateURN = template.URN
 	domainTag.TemplateDescription = template.Description
 	domainTag.TemplateDisplayName = template.DisplayName
+	domainTag.TemplateVersion = template.Version
 	domainTag.TagValues = listOfTagValue
 	return nil
 }
@@ -286,3 +428,100 @@ func NewTagRepository(client *Client) (*TagRepository, error) {
 		client: client,
 	}, nil
 }
+
+// readTagValuesWithinTx reads the stored values of the tags of the asset by
+// column, template and field urn, only of the template on the column of the
+// filter if a template is given
+func readTagValuesWithinTx(ctx context.Context, tx *sqlx.Tx, filter tag.Tag) (map[tagKey]map[string]string, error) {
+	sqlQuery := `
+		SELECT
+			tg.column_name, f.template_urn, f.urn, tg.value
+		FROM
+			tags tg
+		JOIN
+			tag_template_fields f ON f.id = tg.field_id
+		WHERE
+			tg.asset_id = $1`
+	sqlArgs := []interface{}{filter.AssetID}
+	if filter.TemplateURN != "" {
+		sqlQuery += " AND f.template_urn = $2 AND tg.column_name = $3"
+		sqlArgs = append(sqlArgs, filter.TemplateURN, filter.Column)
+	}
+
+	var rows []struct {
+		Column      string `db:"column_name"`
+		TemplateURN string `db:"template_urn"`
+		FieldURN    string `db:"urn"`
+		Value       string `db:"value"`
+	}
+	if err := tx.SelectContext(ctx, &rows, sqlQuery, sqlArgs...); err != nil {
+		return nil, fmt.Errorf("failed reading tag values: %w", err)
+	}
+
+	valuesByKey := make(map[tagKey]map[string]string)
+	for _, row := range rows {
+		key := tagKey{column: row.Column, templateURN: row.TemplateURN}
+		if valuesByKey[key] == nil {
+			valuesByKey[key] = make(map[string]string)
+		}
+		valuesByKey[key][row.FieldURN] = row.Value
+	}
+	return valuesByKey, nil
+}
+
+// recordTagHistory records the changes of the tags of the filter made within
+// the transaction, given their values before it. The user of the context is
+// recorded as the actor.
+func recordTagHistory(ctx context.Context, tx *sqlx.Tx, filter tag.Tag, before map[tagKey]map[string]string) error {
+	after, err := readTagValuesWithinTx(ctx, tx, filter)
+	if err != nil {
+		return err
+	}
+
+	var actor *string
+	if email := user.FromContext(ctx).Email; email != "" {
+		actor = &email
+	}
+
+	changed := maps.Clone(before)
+	maps.Copy(changed, after)
+	keys := slices.SortedFunc(maps.Keys(changed), func(a, b tagKey) int {
+		return cmp.Or(cmp.Compare(a.templateURN, b.templateURN), cmp.Compare(a.column, b.column))
+	})
+
+	timestamp := time.Now().UTC()
+	for _, key := range keys {
+		var action tag.HistoryAction
+		switch b, a := before[key], after[key]; {
+		case b == nil:
+			action = tag.HistoryActionCreate
+		case a == nil:
+			action = tag.HistoryActionDelete
+		case maps.Equal(b, a):
+			continue
+		default:
+			action = tag.HistoryActionUpdate
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO tags_history
+				(asset_id, column_name, template_urn, action, actor, before_values, after_values, created_at)
+			VALUES
+				($1, $2, $3, $4, $5, $6, $7, $8)`,
+			filter.AssetID, key.column, key.templateURN, action, actor, toJSONMap(before[key]), toJSONMap(after[key]), timestamp); err != nil {
+			return fmt.Errorf("failed to record tag history: %w", err)
+		}
+	}
+	return nil
+}
+
+func toJSONMap(values map[string]string) JSONMap {
+	if values == nil {
+		return nil
+	}
+	m := make(JSONMap, len(values))
+	for k, v := range values {
+		m[k] = v
+	}
+	return m
+}
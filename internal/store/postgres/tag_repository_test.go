@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
 	"github.com/goto/compass/internal/store/postgres"
 	"github.com/goto/compass/internal/testutils"
 	"github.com/goto/salt/log"
@@ -252,6 +253,35 @@ func (r *TagRepositoryTestSuite) TestRead() {
 		r.Len(actualTag, expectedLength)
 		r.NoError(actualError)
 	})
+
+	r.Run("should return the tags of the columns of the asset apart from the tag of the asset", func() {
+		err := testutils.RunMigrationsWithClient(r.T(), r.client)
+		r.NoError(err)
+
+		domainTemplate := getTemplate()
+		err = r.templateRepository.Create(r.ctx, domainTemplate)
+		r.Require().NoError(err)
+
+		assetTag := getDomainTag()
+		err = r.repository.Create(r.ctx, &assetTag)
+		r.Require().NoError(err)
+		columnTag := getDomainTag()
+		columnTag.Column = "user_id"
+		err = r.repository.Create(r.ctx, &columnTag)
+		r.Require().NoError(err)
+
+		tags, err := r.repository.Read(r.ctx, tag.Tag{AssetID: domainAssetID})
+		r.NoError(err)
+		r.Len(tags, 2)
+
+		tags, err = r.repository.Read(r.ctx, tag.Tag{AssetID: domainAssetID, Column: "user_id", TemplateURN: "governance_policy"})
+		r.NoError(err)
+		r.Require().Len(tags, 1)
+		r.Equal("user_id", tags[0].Column)
+
+		_, err = r.repository.Read(r.ctx, tag.Tag{AssetID: domainAssetID, Column: "order_id", TemplateURN: "governance_policy"})
+		r.ErrorAs(err, new(tag.NotFoundError))
+	})
 }
 
 func (r *TagRepositoryTestSuite) TestUpdate() {
@@ -325,6 +355,128 @@ func (r *TagRepositoryTestSuite) TestUpdate() {
 	})
 }
 
+func (r *TagRepositoryTestSuite) TestReadByTemplate() {
+	r.Run("should return error if template urn is empty", func() {
+		_, err := r.repository.ReadByTemplate(r.ctx, tag.TemplateTagsFilter{})
+		r.EqualError(err, "template urn should not be empty")
+	})
+
+	r.Run("should return tags written against an older version of the template", func() {
+		err := testutils.RunMigrationsWithClient(r.T(), r.client)
+		r.NoError(err)
+
+		domainTemplate := getTemplate()
+		err = r.templateRepository.Create(r.ctx, domainTemplate)
+		r.Require().NoError(err)
+
+		assetIDs := []string{"asset-a", "asset-b", "asset-c"}
+		for _, assetID := range assetIDs {
+			domainTag := getDomainTag()
+			domainTag.AssetID = assetID
+			err = r.repository.Create(r.ctx, &domainTag)
+			r.Require().NoError(err)
+			r.EqualValues(1, domainTag.TemplateVersion)
+		}
+
+		domainTemplate.Fields[0].Options = []string{"Public", "Internal"}
+		err = r.templateRepository.Update(r.ctx, domainTemplate.URN, domainTemplate)
+		r.Require().NoError(err)
+
+		// rewritten against the current version of the template
+		current := getDomainTag()
+		current.AssetID = "asset-b"
+		current.TagValues[0].FieldValue = "Internal"
+		err = r.repository.Update(r.ctx, &current)
+		r.Require().NoError(err)
+		r.EqualValues(2, current.TemplateVersion)
+
+		// the tag of a column of an outdated asset written against the
+		// current version is not returned with the tag of the asset
+		currentColumn := getDomainTag()
+		currentColumn.AssetID = "asset-a"
+		currentColumn.Column = "email"
+		err = r.repository.Create(r.ctx, &currentColumn)
+		r.Require().NoError(err)
+		r.EqualValues(2, currentColumn.TemplateVersion)
+
+		filter := tag.TemplateTagsFilter{
+			TemplateURN:  domainTemplate.URN,
+			BelowVersion: domainTemplate.Version,
+			Size:         1,
+		}
+		tags, err := r.repository.ReadByTemplate(r.ctx, filter)
+		r.NoError(err)
+		r.Require().Len(tags, 1)
+		r.Equal("asset-a", tags[0].AssetID)
+		r.Empty(tags[0].Column)
+		r.EqualValues(1, tags[0].TemplateVersion)
+		r.Len(tags[0].TagValues, 2)
+		r.Equal("Public", tags[0].TagValues[0].FieldValue)
+
+		filter.AfterAssetID = tags[0].AssetID
+		tags, err = r.repository.ReadByTemplate(r.ctx, filter)
+		r.NoError(err)
+		r.Require().Len(tags, 1)
+		r.Equal("asset-c", tags[0].AssetID)
+
+		filter.AfterAssetID = tags[0].AssetID
+		tags, err = r.repository.ReadByTemplate(r.ctx, filter)
+		r.NoError(err)
+		r.Empty(tags)
+	})
+}
+
+func (r *TagRepositoryTestSuite) TestReadHistory() {
+	r.Run("should return error if asset id is empty", func() {
+		_, err := r.repository.ReadHistory(r.ctx, "", "governance_policy")
+		r.EqualError(err, "asset id should not be empty")
+	})
+
+	r.Run("should record every change of the tag with its actor, the latest first", func() {
+		err := testutils.RunMigrationsWithClient(r.T(), r.client)
+		r.NoError(err)
+
+		domainTemplate := getTemplate()
+		err = r.templateRepository.Create(r.ctx, domainTemplate)
+		r.Require().NoError(err)
+
+		ctx := user.NewContext(r.ctx, user.User{Email: "user@example.com"})
+		domainTag := getDomainTag()
+		err = r.repository.Create(ctx, &domainTag)
+		r.Require().NoError(err)
+
+		updated := getDomainTag()
+		updated.TagValues[0].FieldValue = "Restricted"
+		err = r.repository.Update(ctx, &updated)
+		r.Require().NoError(err)
+
+		// an update without any change is not recorded
+		err = r.repository.Update(ctx, &updated)
+		r.Require().NoError(err)
+
+		err = r.repository.Delete(r.ctx, tag.Tag{AssetID: domainTag.AssetID, TemplateURN: domainTemplate.URN})
+		r.Require().NoError(err)
+
+		history, err := r.repository.ReadHistory(r.ctx, domainTag.AssetID, domainTemplate.URN)
+		r.NoError(err)
+		r.Require().Len(history, 3)
+
+		r.Equal(tag.HistoryActionDelete, history[0].Action)
+		r.Empty(history[0].Actor)
+		r.Equal(map[string]string{"classification": "Restricted", "admin_email": "dexter@gotocompany.com"}, history[0].Before)
+		r.Nil(history[0].After)
+
+		r.Equal(tag.HistoryActionUpdate, history[1].Action)
+		r.Equal("user@example.com", history[1].Actor)
+		r.Equal(map[string]string{"classification": "Public", "admin_email": "dexter@gotocompany.com"}, history[1].Before)
+		r.Equal(map[string]string{"classification": "Restricted", "admin_email": "dexter@gotocompany.com"}, history[1].After)
+
+		r.Equal(tag.HistoryActionCreate, history[2].Action)
+		r.Nil(history[2].Before)
+		r.Equal(map[string]string{"classification": "Public", "admin_email": "dexter@gotocompany.com"}, history[2].After)
+	})
+}
+
 func (r *TagRepositoryTestSuite) TestDelete() {
 	r.Run("should return error if asset id is empty", func() {
 		err := testutils.RunMigrationsWithClient(r.T(), r.client)
@@ -100,7 +100,7 @@ func (r *TagTemplateRepository) ReadAll(ctx context.Context) ([]tag.Template, er
 	return templates, nil
 }
 
-// Update updates template into database
+// Update updates template into database, each update is a new version of the template
 func (r *TagTemplateRepository) Update(ctx context.Context, targetURN string, templateDomain *tag.Template) error {
 	if templateDomain == nil {
 		return errNilTemplate
@@ -176,12 +176,12 @@ func insertTemplateToDBTx(ctx context.Context, tx *sqlx.Tx, templateModel *TagTe
 	if err := tx.QueryRowxContext(ctx, `
 					INSERT INTO 
 					tag_templates 
-						(urn,display_name,description,created_at,updated_at) 
+						(urn,display_name,description,propagation,created_at,updated_at) 
 					VALUES 
-						($1,$2,$3,$4,$5)
+						($1,$2,$3,$4,$5,$6)
 					RETURNING *
 				`,
-		templateModel.URN, templateModel.DisplayName, templateModel.Description, templateModel.CreatedAt, templateModel.UpdatedAt).
+		templateModel.URN, templateModel.DisplayName, templateModel.Description, templateModel.Propagation, templateModel.CreatedAt, templateModel.UpdatedAt).
 		StructScan(&insertedTemplate); err != nil {
 		return fmt.Errorf("failed to insert a template: %w", err)
 	}
@@ -195,12 +195,14 @@ func insertFieldToDBTx(ctx context.Context, tx *sqlx.Tx, field *TagTemplateField
 	if err := tx.QueryRowxContext(ctx, `
 					INSERT INTO 
 					tag_template_fields 
-						(urn, display_name, description, data_type, options, required, template_urn, created_at, updated_at)
+						(urn, display_name, description, data_type, options, required, template_urn, created_at, updated_at,
+						multiple, min, max, pattern)
 					VALUES 
-						($1,$2,$3,$4,$5,$6,$7,$8,$9)
+						($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)
 					RETURNING *
 					`,
-		field.URN, field.DisplayName, field.Description, field.DataType, field.Options, field.Required, field.TemplateURN, field.CreatedAt, field.UpdatedAt).
+		field.URN, field.DisplayName, field.Description, field.DataType, field.Options, field.Required, field.TemplateURN, field.CreatedAt, field.UpdatedAt,
+		field.Multiple, field.Min, field.Max, field.Pattern).
 		StructScan(&insertedField); err != nil {
 		return fmt.Errorf("failed to insert a field: %w", err)
 	}
@@ -213,10 +215,11 @@ func readAllTemplatesFromDB(ctx context.Context, db *sqlx.DB) (TagJoinTemplateFi
 	// return empty with nil error if not found
 	if err := db.Select(&templateFields, `
 		SELECT
-			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description",
-			t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
+			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description", t.propagation as "tag_templates.propagation",
+			t.version as "tag_templates.version", t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
 			f.id as "tag_template_fields.id", f.urn as "tag_template_fields.urn", f.display_name as "tag_template_fields.display_name", f.description as "tag_template_fields.description",
 			f.data_type as "tag_template_fields.data_type", f.options as "tag_template_fields.options", f.required as "tag_template_fields.required", f.template_urn as "tag_template_fields.template_urn",
+			f.multiple as "tag_template_fields.multiple", f.min as "tag_template_fields.min", f.max as "tag_template_fields.max", f.pattern as "tag_template_fields.pattern",
 			f.created_at as "tag_template_fields.created_at", f.updated_at as "tag_template_fields.updated_at"
 		FROM
 			tag_templates t
@@ -235,10 +238,11 @@ func readTemplatesByURNFromDB(ctx context.Context, db *sqlx.DB, templateURN stri
 	// return empty with nil error if not found
 	if err := db.Select(&templateFields, `
 		SELECT
-			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description",
-			t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
+			t.urn as "tag_templates.urn", t.display_name as "tag_templates.display_name", t.description as "tag_templates.description", t.propagation as "tag_templates.propagation",
+			t.version as "tag_templates.version", t.created_at as "tag_templates.created_at", t.updated_at as "tag_templates.updated_at",
 			f.id as "tag_template_fields.id", f.urn as "tag_template_fields.urn", f.display_name as "tag_template_fields.display_name", f.description as "tag_template_fields.description",
 			f.data_type as "tag_template_fields.data_type", f.options as "tag_template_fields.options", f.required as "tag_template_fields.required", f.template_urn as "tag_template_fields.template_urn",
+			f.multiple as "tag_template_fields.multiple", f.min as "tag_template_fields.min", f.max as "tag_template_fields.max", f.pattern as "tag_template_fields.pattern",
 			f.created_at as "tag_template_fields.created_at", f.updated_at as "tag_template_fields.updated_at"
 		FROM
 			tag_templates t
@@ -260,11 +264,11 @@ func updateTemplateToDBTx(ctx context.Context, tx *sqlx.Tx, targetTemplateURN st
 					UPDATE
 						tag_templates 
 					SET
-						urn = $1, display_name = $2, description = $3, updated_at = $4
+						urn = $1, display_name = $2, description = $3, version = version + 1, updated_at = $4, propagation = $6
 					WHERE
 						urn = $5
 					RETURNING *`,
-		templateModel.URN, templateModel.DisplayName, templateModel.Description, templateModel.UpdatedAt, targetTemplateURN).
+		templateModel.URN, templateModel.DisplayName, templateModel.Description, templateModel.UpdatedAt, targetTemplateURN, templateModel.Propagation).
 		StructScan(&updatedTemplate); err != nil {
 		// scan returns sql.ErrNoRows if no rows
 		if errors.Is(err, sql.ErrNoRows) {
@@ -285,12 +289,12 @@ func updateFieldToDBTx(ctx context.Context, tx *sqlx.Tx, field *TagTemplateField
 						tag_template_fields
 					SET
 						urn = $1, display_name = $2, description = $3, data_type = $4, options = $5, 
-						required = $6, template_urn = $7, updated_at = $8
+						required = $6, template_urn = $7, updated_at = $8, multiple = $10, min = $11, max = $12, pattern = $13
 					WHERE
 						id = $9 AND template_urn = $7
 					RETURNING *`,
 		field.URN, field.DisplayName, field.Description, field.DataType, field.Options, field.Required,
-		field.TemplateURN, field.UpdatedAt, field.ID).
+		field.TemplateURN, field.UpdatedAt, field.ID, field.Multiple, field.Min, field.Max, field.Pattern).
 		StructScan(&updatedField); err != nil {
 		return fmt.Errorf("failed updating fields: %w", err)
 	}
@@ -286,6 +286,25 @@ func (r *TagTemplateRepositoryTestSuite) TestUpdate() {
 		r.JSONEq(string(expectedFields), string(actualFields))
 	})
 
+	r.Run("should bump the version of the template on each update", func() {
+		err := testutils.RunMigrationsWithClient(r.T(), r.client)
+		r.NoError(err)
+
+		template := r.getTemplate()
+		err = r.repository.Create(r.ctx, &template)
+		r.Require().NoError(err)
+		r.EqualValues(1, template.Version)
+
+		template.Fields[0].Options = []string{"PIC", "Escalated", "Resolved"}
+		err = r.repository.Update(r.ctx, template.URN, &template)
+		r.Require().NoError(err)
+		r.EqualValues(2, template.Version)
+
+		templates, err := r.repository.Read(r.ctx, template.URN)
+		r.NoError(err)
+		r.EqualValues(2, templates[0].Version)
+	})
+
 	r.Run("should return error if trying to update with conflicting existing template", func() {
 		err := testutils.RunMigrationsWithClient(r.T(), r.client)
 		r.NoError(err)
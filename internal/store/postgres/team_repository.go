@@ -0,0 +1,292 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type TeamModel struct {
+	ID           string         `db:"id"`
+	Name         string         `db:"name"`
+	Description  string         `db:"description"`
+	OnCall       string         `db:"on_call"`
+	SlackChannel string         `db:"slack_channel"`
+	CreatedBy    sql.NullString `db:"created_by"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+func (m TeamModel) toTeam(members []user.User) team.Team {
+	return team.Team{
+		ID:           m.ID,
+		Name:         m.Name,
+		Description:  m.Description,
+		OnCall:       m.OnCall,
+		SlackChannel: m.SlackChannel,
+		Members:      members,
+		CreatedBy:    m.CreatedBy.String,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+type TeamMemberModel struct {
+	TeamID string `db:"team_id"`
+	UserModel
+}
+
+const selectTeamsQuery = `
+	SELECT
+		id, name, description, on_call, slack_channel, created_by, created_at, updated_at
+	FROM
+		teams`
+
+// TeamRepository is a type that manages the teams and their members in the
+// primary database
+type TeamRepository struct {
+	client              *Client
+	defaultUserProvider string
+}
+
+// Create insert a new record in the teams table, the members missing from
+// the users table are inserted too
+func (r *TeamRepository) Create(ctx context.Context, t *team.Team) (string, error) {
+	if t == nil {
+		return "", errors.New("team is nil")
+	}
+
+	var id string
+	err := r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		if err := tx.QueryRowxContext(ctx, `
+			INSERT INTO
+			teams
+				(name, description, on_call, slack_channel, created_by)
+			VALUES
+				($1, $2, $3, $4, $5)
+			RETURNING id
+		`, t.Name, t.Description, t.OnCall, t.SlackChannel,
+			sql.NullString{String: t.CreatedBy, Valid: t.CreatedBy != ""}).Scan(&id); err != nil {
+			err = checkPostgresError(err)
+			if errors.Is(err, errDuplicateKey) {
+				return team.DuplicateError{Name: t.Name}
+			}
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+
+		return r.insertMembers(ctx, tx, id, t.Members)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// GetByID fetch a team by its id, with its members
+func (r *TeamRepository) GetByID(ctx context.Context, id string) (team.Team, error) {
+	if !isValidUUID(id) {
+		return team.Team{}, team.InvalidError{ID: id}
+	}
+
+	var m TeamModel
+	err := r.client.db.GetContext(ctx, &m, selectTeamsQuery+`
+	WHERE
+		id = $1
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return team.Team{}, team.NotFoundError{ID: id}
+	}
+	if err != nil {
+		return team.Team{}, fmt.Errorf("failed fetching team: %w", err)
+	}
+
+	members, err := r.getMembers(ctx, []string{id})
+	if err != nil {
+		return team.Team{}, err
+	}
+
+	return m.toTeam(members[id]), nil
+}
+
+// GetAll fetch the teams, the ones of a member if given in the filter, with
+// their members
+func (r *TeamRepository) GetAll(ctx context.Context, flt team.Filter) ([]team.Team, error) {
+	query, args := selectTeamsQuery, []interface{}{}
+	if flt.MemberID != "" {
+		if !isValidUUID(flt.MemberID) {
+			return []team.Team{}, nil
+		}
+		query += `
+	WHERE
+		id IN (SELECT team_id FROM team_members WHERE user_id = $1)`
+		args = append(args, flt.MemberID)
+	}
+
+	var models []TeamModel
+	if err := r.client.db.SelectContext(ctx, &models, query+`
+	ORDER BY
+		name
+	`, args...); err != nil {
+		return nil, fmt.Errorf("failed fetching teams: %w", err)
+	}
+
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+	members, err := r.getMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make([]team.Team, 0, len(models))
+	for _, m := range models {
+		teams = append(teams, m.toTeam(members[m.ID]))
+	}
+
+	return teams, nil
+}
+
+// Update replaces the details and the members of a team, keeping its name
+func (r *TeamRepository) Update(ctx context.Context, t *team.Team) error {
+	if t == nil {
+		return errors.New("team is nil")
+	}
+	if !isValidUUID(t.ID) {
+		return team.InvalidError{ID: t.ID}
+	}
+
+	return r.client.RunWithinTx(ctx, func(tx *sqlx.Tx) error {
+		res, err := tx.ExecContext(ctx, `
+			UPDATE
+				teams
+			SET
+				description = $2, on_call = $3, slack_channel = $4, updated_at = NOW()
+			WHERE
+				id = $1
+		`, t.ID, t.Description, t.OnCall, t.SlackChannel)
+		if err != nil {
+			return fmt.Errorf("failed to update team: %w", err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get row affected updating team: %w", err)
+		}
+		if rowsAffected == 0 {
+			return team.NotFoundError{ID: t.ID}
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM team_members WHERE team_id = $1`, t.ID); err != nil {
+			return fmt.Errorf("failed to delete members of team: %w", err)
+		}
+
+		return r.insertMembers(ctx, tx, t.ID, t.Members)
+	})
+}
+
+// Delete deletes a team and its memberships, the assets owned by the team
+// keep its name in their owner teams
+func (r *TeamRepository) Delete(ctx context.Context, id string) error {
+	if !isValidUUID(id) {
+		return team.InvalidError{ID: id}
+	}
+
+	res, err := r.client.db.ExecContext(ctx, `
+		DELETE FROM
+			teams
+		WHERE
+			id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get row affected deleting team: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return team.NotFoundError{ID: id}
+	}
+	return nil
+}
+
+func (r *TeamRepository) insertMembers(ctx context.Context, tx *sqlx.Tx, teamID string, members []user.User) error {
+	for _, m := range members {
+		var userID string
+		if err := tx.QueryRowxContext(ctx, `
+			INSERT INTO
+			users
+				(email, provider)
+			VALUES
+				($1, $2)
+			ON CONFLICT (email) DO UPDATE SET
+				email = EXCLUDED.email
+			RETURNING id
+		`, m.Email, r.defaultUserProvider).Scan(&userID); err != nil {
+			return fmt.Errorf("failed to get or create member %q: %w", m.Email, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO
+			team_members
+				(team_id, user_id)
+			VALUES
+				($1, $2)
+			ON CONFLICT DO NOTHING
+		`, teamID, userID); err != nil {
+			return fmt.Errorf("failed to add member %q to team: %w", m.Email, err)
+		}
+	}
+	return nil
+}
+
+func (r *TeamRepository) getMembers(ctx context.Context, teamIDs []string) (map[string][]user.User, error) {
+	members := make(map[string][]user.User, len(teamIDs))
+	if len(teamIDs) == 0 {
+		return members, nil
+	}
+
+	var models []TeamMemberModel
+	if err := r.client.db.SelectContext(ctx, &models, `
+		SELECT
+			tm.team_id, u.id, u.email, u.provider, u.created_at, u.updated_at
+		FROM
+			team_members tm
+		JOIN
+			users u ON u.id = tm.user_id
+		WHERE
+			tm.team_id = ANY($1)
+		ORDER BY
+			u.email
+	`, pq.StringArray(teamIDs)); err != nil {
+		return nil, fmt.Errorf("failed fetching members of teams: %w", err)
+	}
+
+	for _, m := range models {
+		members[m.TeamID] = append(members[m.TeamID], m.UserModel.toUser())
+	}
+	return members, nil
+}
+
+// NewTeamRepository initializes team repository, the members missing from
+// the users table are inserted with the default user provider
+func NewTeamRepository(c *Client, defaultUserProvider string) (*TeamRepository, error) {
+	if c == nil {
+		return nil, errNilPostgresClient
+	}
+	return &TeamRepository{
+		client:              c,
+		defaultUserProvider: defaultUserProvider,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/compass/core/team"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/store/postgres"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type TeamRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *postgres.Client
+	repository *postgres.TeamRepository
+}
+
+func (r *TeamRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := log.NewLogrus()
+	r.client, err = newTestClient(r.T(), logger)
+	if err != nil {
+		r.T().Fatal(err)
+	}
+
+	r.ctx = context.TODO()
+	r.repository, err = postgres.NewTeamRepository(r.client, "shield")
+	if err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *TeamRepositoryTestSuite) SetupTest() {
+	if err := testutils.RunMigrationsWithClient(r.T(), r.client); err != nil {
+		r.T().Fatal(err)
+	}
+}
+
+func (r *TeamRepositoryTestSuite) TestCreateAndGet() {
+	t := &team.Team{
+		Name:         "data-platform",
+		Description:  "owns the warehouse",
+		OnCall:       "oncall@example.com",
+		SlackChannel: "#data-platform",
+		Members:      []user.User{{Email: "john.doe@example.com"}, {Email: "jane.doe@example.com"}},
+	}
+
+	r.Run("return team with members as created", func() {
+		id, err := r.repository.Create(r.ctx, t)
+		r.Require().NoError(err)
+
+		actual, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal(id, actual.ID)
+		r.Equal(t.Name, actual.Name)
+		r.Equal(t.Description, actual.Description)
+		r.Equal(t.OnCall, actual.OnCall)
+		r.Equal(t.SlackChannel, actual.SlackChannel)
+		r.Equal([]string{"jane.doe@example.com", "john.doe@example.com"}, actual.MemberEmails())
+		r.Equal("shield", actual.Members[0].Provider)
+		r.False(actual.CreatedAt.IsZero())
+	})
+
+	r.Run("return duplicate error if name is taken", func() {
+		_, err := r.repository.Create(r.ctx, &team.Team{Name: "data-platform"})
+		r.ErrorIs(err, team.DuplicateError{Name: "data-platform"})
+	})
+
+	r.Run("return invalid error if id is not uuid", func() {
+		_, err := r.repository.GetByID(r.ctx, "invalid")
+		r.ErrorIs(err, team.InvalidError{ID: "invalid"})
+	})
+
+	r.Run("return not found error if team does not exist", func() {
+		_, err := r.repository.GetByID(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e")
+		r.ErrorIs(err, team.NotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+}
+
+func (r *TeamRepositoryTestSuite) TestGetAll() {
+	for _, t := range []team.Team{
+		{Name: "payments", Members: []user.User{{Email: "john.doe@example.com"}}},
+		{Name: "data-platform", Members: []user.User{{Email: "john.doe@example.com"}, {Email: "jane.doe@example.com"}}},
+		{Name: "growth"},
+	} {
+		_, err := r.repository.Create(r.ctx, &t)
+		r.Require().NoError(err)
+	}
+
+	r.Run("return all teams by name", func() {
+		actual, err := r.repository.GetAll(r.ctx, team.Filter{})
+		r.NoError(err)
+		r.Equal([]string{"data-platform", "growth", "payments"}, team.Names(actual))
+		r.Len(actual[0].Members, 2)
+		r.Empty(actual[1].Members)
+	})
+
+	r.Run("return teams of member", func() {
+		all, err := r.repository.GetAll(r.ctx, team.Filter{})
+		r.Require().NoError(err)
+		var johnID string
+		for _, m := range all[0].Members {
+			if m.Email == "john.doe@example.com" {
+				johnID = m.ID
+			}
+		}
+
+		actual, err := r.repository.GetAll(r.ctx, team.Filter{MemberID: johnID})
+		r.NoError(err)
+		r.Equal([]string{"data-platform", "payments"}, team.Names(actual))
+	})
+}
+
+func (r *TeamRepositoryTestSuite) TestUpdate() {
+	id, err := r.repository.Create(r.ctx, &team.Team{
+		Name:    "data-platform",
+		Members: []user.User{{Email: "john.doe@example.com"}},
+	})
+	r.Require().NoError(err)
+
+	r.Run("replace details and members of team", func() {
+		err := r.repository.Update(r.ctx, &team.Team{
+			ID:           id,
+			Name:         "renamed",
+			SlackChannel: "#data",
+			Members:      []user.User{{Email: "jane.doe@example.com"}},
+		})
+		r.Require().NoError(err)
+
+		actual, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal("data-platform", actual.Name)
+		r.Equal("#data", actual.SlackChannel)
+		r.Equal([]string{"jane.doe@example.com"}, actual.MemberEmails())
+	})
+
+	r.Run("return not found error if team does not exist", func() {
+		err := r.repository.Update(r.ctx, &team.Team{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+		r.ErrorIs(err, team.NotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+}
+
+func (r *TeamRepositoryTestSuite) TestDelete() {
+	r.Run("return not found error if team does not exist", func() {
+		err := r.repository.Delete(r.ctx, "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e")
+		r.ErrorIs(err, team.NotFoundError{ID: "f0f8ea7e-0f25-4c2b-a8f3-3f1d1d4b5c6e"})
+	})
+
+	r.Run("delete team", func() {
+		id, err := r.repository.Create(r.ctx, &team.Team{Name: "growth"})
+		r.Require().NoError(err)
+
+		r.NoError(r.repository.Delete(r.ctx, id))
+
+		_, err = r.repository.GetByID(r.ctx, id)
+		r.ErrorIs(err, team.NotFoundError{ID: id})
+	})
+}
+
+func TestTeamRepository(t *testing.T) {
+	suite.Run(t, &TeamRepositoryTestSuite{})
+}
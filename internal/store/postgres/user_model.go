@@ -10,6 +10,9 @@ type UserModel struct {
 	ID        sql.NullString `db:"id"`
 	Email     sql.NullString `db:"email"`
 	Provider  sql.NullString `db:"provider"`
+	Name      sql.NullString `db:"name"`
+	Manager   sql.NullString `db:"manager_email"`
+	Inactive  sql.NullBool   `db:"inactive"`
 	CreatedAt sql.NullTime   `db:"created_at"`
 	UpdatedAt sql.NullTime   `db:"updated_at"`
 }
@@ -19,6 +22,9 @@ func (u *UserModel) toUser() user.User {
 		ID:        u.ID.String,
 		Email:     u.Email.String,
 		Provider:  u.Provider.String,
+		Name:      u.Name.String,
+		Manager:   u.Manager.String,
+		Inactive:  u.Inactive.Bool,
 		CreatedAt: u.CreatedAt.Time,
 		UpdatedAt: u.UpdatedAt.Time,
 	}
@@ -35,6 +41,13 @@ func newUserModel(u *user.User) UserModel {
 	if u.Provider != "" {
 		um.Provider = sql.NullString{String: u.Provider, Valid: true}
 	}
+	if u.Name != "" {
+		um.Name = sql.NullString{String: u.Name, Valid: true}
+	}
+	if u.Manager != "" {
+		um.Manager = sql.NullString{String: u.Manager, Valid: true}
+	}
+	um.Inactive = sql.NullBool{Bool: u.Inactive, Valid: true}
 	um.CreatedAt = sql.NullTime{Time: u.CreatedAt, Valid: true}
 	um.UpdatedAt = sql.NullTime{Time: u.UpdatedAt, Valid: true}
 
@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/goto/compass/core/user"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // UserRepository is a type that manages user operation to the primary database
@@ -123,8 +125,162 @@ func (r *UserRepository) GetOrInsertByEmail(ctx context.Context, ud *user.User)
 	return userID, err
 }
 
+// GetByID retrieves user by given the id
+func (r *UserRepository) GetByID(ctx context.Context, id string) (user.User, error) {
+	if !isValidUUID(id) {
+		return user.User{}, user.NotFoundError{ID: id}
+	}
+
+	u, err := getUserByPredicate(ctx, r.client.db, sq.Eq{"id": id})
+	if errors.Is(err, sql.ErrNoRows) {
+		return user.User{}, user.NotFoundError{ID: id}
+	}
+	if err != nil {
+		return user.User{}, err
+	}
+	return u, nil
+}
+
+// GetAll retrieves the users matching the filter ordered by email, leaving out
+// the service accounts.
+func (r *UserRepository) GetAll(ctx context.Context, flt user.Filter) ([]user.User, error) {
+	builder := r.buildFilterQuery(
+		sq.Select("id", "email", "provider", "name", "manager_email", "inactive", "created_at", "updated_at").From("users"),
+		flt,
+	).OrderBy("email")
+	if flt.Size > 0 {
+		builder = builder.Limit(uint64(flt.Size))
+	}
+	if flt.Offset > 0 {
+		builder = builder.Offset(uint64(flt.Offset))
+	}
+
+	qry, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build get users query: %w", err)
+	}
+
+	var ums UserModels
+	if err := r.client.db.SelectContext(ctx, &ums, qry, args...); err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+	return ums.toUsers(), nil
+}
+
+// GetCount counts the users matching the filter, regardless of its offset and
+// size.
+func (r *UserRepository) GetCount(ctx context.Context, flt user.Filter) (int, error) {
+	qry, args, err := r.buildFilterQuery(sq.Select("count(1)").From("users"), flt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count users query: %w", err)
+	}
+
+	var total int
+	if err := r.client.db.GetContext(ctx, &total, qry, args...); err != nil {
+		return 0, fmt.Errorf("count users: %w", err)
+	}
+	return total, nil
+}
+
+func (*UserRepository) buildFilterQuery(builder sq.SelectBuilder, flt user.Filter) sq.SelectBuilder {
+	builder = builder.Where(sq.Expr("provider IS DISTINCT FROM ?", user.ServiceAccountProvider))
+	if flt.Email != "" {
+		builder = builder.Where(sq.Expr("lower(email) = lower(?)", flt.Email))
+	}
+	if flt.Name != "" {
+		builder = builder.Where(sq.Eq{"name": flt.Name})
+	}
+	return builder
+}
+
+// Update updates the email, name, manager and inactive flag of the user with
+// the id.
+func (r *UserRepository) Update(ctx context.Context, ud *user.User) error {
+	if err := ud.Validate(); err != nil {
+		return err
+	}
+	if !isValidUUID(ud.ID) {
+		return user.NotFoundError{ID: ud.ID}
+	}
+
+	um := newUserModel(ud)
+
+	res, err := r.client.db.ExecContext(ctx, `
+		UPDATE users SET
+			email = $2, name = $3, manager_email = $4, inactive = $5, updated_at = now()
+		WHERE id = $1`,
+		ud.ID, um.Email, um.Name, um.Manager, um.Inactive,
+	)
+	if err != nil {
+		err := checkPostgresError(err)
+		if errors.Is(err, errDuplicateKey) {
+			return user.DuplicateRecordError{Email: ud.Email}
+		}
+		return fmt.Errorf("update user: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update user: %w", err)
+	}
+	if affected == 0 {
+		return user.NotFoundError{ID: ud.ID}
+	}
+	return nil
+}
+
+// Upsert inserts the user or updates the name, manager and inactive flag of
+// the user with the same email, keeping its provider.
+func (r *UserRepository) Upsert(ctx context.Context, ud *user.User) (string, error) {
+	if err := ud.Validate(); err != nil {
+		return "", err
+	}
+
+	um := newUserModel(ud)
+
+	var userID string
+	if err := r.client.db.QueryRowxContext(ctx, `
+		INSERT INTO users (email, provider, name, manager_email, inactive)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (email) DO UPDATE SET
+			name = EXCLUDED.name,
+			manager_email = EXCLUDED.manager_email,
+			inactive = EXCLUDED.inactive,
+			updated_at = now()
+		RETURNING id`,
+		um.Email, um.Provider, um.Name, um.Manager, um.Inactive,
+	).Scan(&userID); err != nil {
+		return "", fmt.Errorf("upsert user: %w", err)
+	}
+	return userID, nil
+}
+
+// DeactivateExcept marks inactive the active users whose email is not given,
+// ignoring the case, and returns them. Service accounts are left out.
+func (r *UserRepository) DeactivateExcept(ctx context.Context, emails []string) ([]user.User, error) {
+	lowered := make(pq.StringArray, 0, len(emails))
+	for _, email := range emails {
+		lowered = append(lowered, strings.ToLower(email))
+	}
+
+	var ums UserModels
+	if err := sqlx.SelectContext(ctx, r.client.db, &ums, `
+		UPDATE users SET inactive = true, updated_at = now()
+		WHERE NOT inactive
+			AND provider IS DISTINCT FROM $2
+			AND NOT (lower(email) = ANY($1))
+		RETURNING id, email, provider, name, manager_email, inactive, created_at, updated_at`,
+		lowered, user.ServiceAccountProvider,
+	); err != nil {
+		return nil, fmt.Errorf("deactivate users: %w", err)
+	}
+	return ums.toUsers(), nil
+}
+
 func getUserByPredicate(ctx context.Context, querier sqlx.QueryerContext, pred sq.Eq) (user.User, error) {
-	qry, args, err := sq.Select("id", "email", "provider", "created_at", "updated_at").
+	qry, args, err := sq.Select("id", "email", "provider", "name", "manager_email", "inactive", "created_at", "updated_at").
 		From("users").
 		Where(pred).
 		PlaceholderFormat(sq.Dollar).
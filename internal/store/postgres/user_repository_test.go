@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/goto/compass/core/user"
 	"github.com/goto/compass/internal/store/postgres"
 	"github.com/goto/compass/internal/testutils"
@@ -225,6 +226,130 @@ func (r *UserRepositoryTestSuite) TestInsertByEmail() {
 	})
 }
 
+func (r *UserRepositoryTestSuite) TestUpsertAndDeactivateExcept() {
+	err := testutils.RunMigrationsWithClient(r.T(), r.client)
+	r.Require().NoError(err)
+
+	r.Run("insert user or update its directory fields", func() {
+		usr := &user.User{Email: "upsert@gotocompany.com", Provider: "shield", Name: "Upsert"}
+		id, err := r.repository.Upsert(r.ctx, usr)
+		r.Require().NoError(err)
+
+		usr.Name = "Upserted"
+		usr.Manager = "manager@gotocompany.com"
+		updatedID, err := r.repository.Upsert(r.ctx, usr)
+		r.Require().NoError(err)
+		r.Equal(id, updatedID)
+
+		got, err := r.repository.GetByEmail(r.ctx, usr.Email)
+		r.NoError(err)
+		r.Equal("shield", got.Provider)
+		r.Equal("Upserted", got.Name)
+		r.Equal("manager@gotocompany.com", got.Manager)
+		r.False(got.Inactive)
+	})
+
+	r.Run("deactivate users not given except service accounts", func() {
+		_, err := r.repository.Upsert(r.ctx, &user.User{Email: "departed@gotocompany.com"})
+		r.Require().NoError(err)
+		_, err = r.repository.Create(r.ctx, &user.User{Email: "bot@serviceaccount.compass", Provider: user.ServiceAccountProvider})
+		r.Require().NoError(err)
+
+		deactivated, err := r.repository.DeactivateExcept(r.ctx, []string{"UPSERT@gotocompany.com"})
+		r.Require().NoError(err)
+		r.Require().Len(deactivated, 1)
+		r.Equal("departed@gotocompany.com", deactivated[0].Email)
+		r.True(deactivated[0].Inactive)
+
+		deactivated, err = r.repository.DeactivateExcept(r.ctx, []string{"upsert@gotocompany.com"})
+		r.NoError(err)
+		r.Empty(deactivated)
+	})
+}
+
+func (r *UserRepositoryTestSuite) TestGetByIDAndUpdate() {
+	err := testutils.RunMigrationsWithClient(r.T(), r.client)
+	r.Require().NoError(err)
+
+	r.Run("return NotFoundError if id is not a uuid or not found", func() {
+		_, err := r.repository.GetByID(r.ctx, "invalid")
+		r.ErrorAs(err, new(user.NotFoundError))
+
+		_, err = r.repository.GetByID(r.ctx, uuid.NewString())
+		r.ErrorAs(err, new(user.NotFoundError))
+	})
+
+	r.Run("update the user fields", func() {
+		id, err := r.repository.Create(r.ctx, &user.User{Email: "update@gotocompany.com", Provider: "shield"})
+		r.Require().NoError(err)
+
+		usr, err := r.repository.GetByID(r.ctx, id)
+		r.Require().NoError(err)
+		usr.Email = "updated@gotocompany.com"
+		usr.Name = "Updated"
+		usr.Manager = "manager@gotocompany.com"
+		usr.Inactive = true
+		r.Require().NoError(r.repository.Update(r.ctx, &usr))
+
+		got, err := r.repository.GetByID(r.ctx, id)
+		r.NoError(err)
+		r.Equal("updated@gotocompany.com", got.Email)
+		r.Equal("Updated", got.Name)
+		r.Equal("manager@gotocompany.com", got.Manager)
+		r.True(got.Inactive)
+	})
+
+	r.Run("return DuplicateRecordError if email is taken", func() {
+		_, err := r.repository.Create(r.ctx, &user.User{Email: "taken@gotocompany.com"})
+		r.Require().NoError(err)
+		id, err := r.repository.Create(r.ctx, &user.User{Email: "taker@gotocompany.com"})
+		r.Require().NoError(err)
+
+		err = r.repository.Update(r.ctx, &user.User{ID: id, Email: "taken@gotocompany.com"})
+		r.ErrorAs(err, new(user.DuplicateRecordError))
+	})
+
+	r.Run("return NotFoundError if user does not exist", func() {
+		err := r.repository.Update(r.ctx, &user.User{ID: uuid.NewString(), Email: "ghost@gotocompany.com"})
+		r.ErrorAs(err, new(user.NotFoundError))
+	})
+}
+
+func (r *UserRepositoryTestSuite) TestGetAllAndGetCount() {
+	err := testutils.RunMigrationsWithClient(r.T(), r.client)
+	r.Require().NoError(err)
+
+	for _, email := range []string{"b@gotocompany.com", "a@gotocompany.com", "c@gotocompany.com"} {
+		_, err := r.repository.Create(r.ctx, &user.User{Email: email, Name: "Name " + email[:1]})
+		r.Require().NoError(err)
+	}
+	_, err = r.repository.Create(r.ctx, &user.User{Email: "bot@serviceaccount.compass", Provider: user.ServiceAccountProvider})
+	r.Require().NoError(err)
+
+	r.Run("return the page of users ordered by email without service accounts", func() {
+		users, err := r.repository.GetAll(r.ctx, user.Filter{Offset: 1, Size: 5})
+		r.NoError(err)
+		r.Require().Len(users, 2)
+		r.Equal("b@gotocompany.com", users[0].Email)
+		r.Equal("c@gotocompany.com", users[1].Email)
+
+		count, err := r.repository.GetCount(r.ctx, user.Filter{})
+		r.NoError(err)
+		r.Equal(3, count)
+	})
+
+	r.Run("filter by email case insensitively and by name", func() {
+		users, err := r.repository.GetAll(r.ctx, user.Filter{Email: "A@GOTOCOMPANY.COM", Size: 5})
+		r.NoError(err)
+		r.Require().Len(users, 1)
+		r.Equal("a@gotocompany.com", users[0].Email)
+
+		count, err := r.repository.GetCount(r.ctx, user.Filter{Name: "Name c"})
+		r.NoError(err)
+		r.Equal(1, count)
+	})
+}
+
 func TestUserRepository(t *testing.T) {
 	suite.Run(t, &UserRepositoryTestSuite{})
 }
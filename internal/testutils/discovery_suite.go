@@ -0,0 +1,446 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/pkg/queryexpr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var discoverySuiteServices atomic.Int64
+
+// DiscoveryRepositoryHarness provides the store specific parts of the
+// behavioral tests of asset.DiscoveryRepository.
+type DiscoveryRepositoryHarness struct {
+	// Repo is the discovery repository under test
+	Repo asset.DiscoveryRepository
+
+	// Refresh makes the writes to the repository visible to searches
+	Refresh func(t *testing.T)
+}
+
+// RunDiscoveryRepositoryTests runs the behavioral tests every implementation
+// of asset.DiscoveryRepository is expected to pass. Every test works on the
+// assets of its own service so the tests do not see each other's assets.
+func RunDiscoveryRepositoryTests(t *testing.T, h DiscoveryRepositoryHarness) {
+	t.Helper()
+
+	ctx := context.Background()
+	repo := h.Repo
+	refresh := func(t *testing.T) {
+		t.Helper()
+		if h.Refresh != nil {
+			h.Refresh(t)
+		}
+	}
+	newService := func() string {
+		return fmt.Sprintf("discovery-suite-%d", discoverySuiteServices.Add(1))
+	}
+	upsert := func(t *testing.T, assets ...asset.Asset) {
+		t.Helper()
+		for _, ast := range assets {
+			require.NoError(t, repo.Upsert(ctx, ast))
+		}
+		refresh(t)
+	}
+	search := func(t *testing.T, cfg asset.SearchConfig) []asset.SearchResult {
+		t.Helper()
+		results, err := repo.Search(ctx, cfg)
+		require.NoError(t, err)
+		return results
+	}
+	resultIDs := func(results []asset.SearchResult) []string {
+		ids := make([]string, len(results))
+		for i, r := range results {
+			ids[i] = r.ID
+		}
+		return ids
+	}
+	newAsset := func(service, id, name string, typ asset.Type) asset.Asset {
+		now := time.Now().UTC()
+		return asset.Asset{
+			ID:          id,
+			URN:         "urn:" + service + ":" + id,
+			Type:        typ,
+			Service:     service,
+			Name:        name,
+			Version:     asset.BaseVersion,
+			UpdatedAt:   now,
+			RefreshedAt: &now,
+		}
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		t.Run("should return error if id is empty", func(t *testing.T) {
+			err := repo.Upsert(ctx, asset.Asset{URN: "urn", Type: asset.Type("table"), Service: newService()})
+			assert.ErrorIs(t, err, asset.ErrEmptyID)
+		})
+
+		t.Run("should return error if type is unknown", func(t *testing.T) {
+			err := repo.Upsert(ctx, asset.Asset{ID: "id", URN: "urn", Type: "unknown-type", Service: newService()})
+			assert.ErrorIs(t, err, asset.ErrUnknownType)
+		})
+
+		t.Run("should overwrite the asset with the same id", func(t *testing.T) {
+			svc := newService()
+			ast := newAsset(svc, svc+"-1", "orders", asset.Type("table"))
+			upsert(t, ast)
+
+			ast.Name = "payments"
+			ast.Description = "all the payments"
+			upsert(t, ast)
+
+			results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+			require.Len(t, results, 1)
+			assert.Equal(t, ast.ID, results[0].ID)
+			assert.Equal(t, ast.URN, results[0].URN)
+			assert.Equal(t, "payments", results[0].Title)
+			assert.Equal(t, "all the payments", results[0].Description)
+			assert.Equal(t, "table", results[0].Type)
+			assert.Equal(t, svc, results[0].Service)
+		})
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		svc := newService()
+		orders := newAsset(svc, svc+"-orders", "orders", asset.Type("table"))
+		orders.Description = "all the orders"
+		orders.Labels = map[string]string{"entity": "gotocompany"}
+		orders.Data = map[string]interface{}{"landscape": "id"}
+		archive := newAsset(svc, svc+"-archive", "orders_archive", asset.Type("table"))
+		archive.Description = "archived orders"
+		archive.Data = map[string]interface{}{"landscape": "sg"}
+		payments := newAsset(svc, svc+"-payments", "payments", asset.Type("topic"))
+		payments.Description = "payment events"
+		payments.Data = map[string]interface{}{"landscape": "id"}
+		deleted := newAsset(svc, svc+"-deleted", "refunds", asset.Type("topic"))
+		deleted.IsDeleted = true
+		upsert(t, orders, archive, payments, deleted)
+
+		inService := func(filters asset.SearchFilter) asset.SearchFilter {
+			if filters == nil {
+				filters = asset.SearchFilter{}
+			}
+			filters["service"] = []string{svc}
+			return filters
+		}
+
+		t.Run("should rank the asset named after the text first", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Text: "orders", Filters: inService(nil)})
+			ids := resultIDs(results)
+			require.Len(t, ids, 2)
+			assert.Equal(t, orders.ID, ids[0])
+			assert.Contains(t, ids, archive.ID)
+			assert.Greater(t, results[0].Score, results[1].Score)
+		})
+
+		t.Run("should filter by type", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Filters: inService(asset.SearchFilter{"type": {"topic"}})})
+			assert.Equal(t, []string{payments.ID}, resultIDs(results))
+		})
+
+		t.Run("should filter by data and labels", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Filters: inService(asset.SearchFilter{"data.landscape": {"id"}})})
+			assert.ElementsMatch(t, []string{orders.ID, payments.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: inService(asset.SearchFilter{"data.landscape": {"id", "sg"}})})
+			assert.ElementsMatch(t, []string{orders.ID, archive.ID, payments.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: inService(asset.SearchFilter{"labels.entity": {"gotocompany"}})})
+			assert.Equal(t, []string{orders.ID}, resultIDs(results))
+		})
+
+		t.Run("should match the queries on fields", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{
+				Filters: inService(nil),
+				Queries: map[string]string{"description": "payment"},
+			})
+			assert.Equal(t, []string{payments.ID}, resultIDs(results))
+		})
+
+		t.Run("should only return deleted assets when filtered by is_deleted", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Filters: inService(nil)})
+			assert.NotContains(t, resultIDs(results), deleted.ID)
+
+			results = search(t, asset.SearchConfig{Filters: inService(asset.SearchFilter{"is_deleted": {"true"}})})
+			require.Len(t, results, 1)
+			assert.Equal(t, deleted.ID, results[0].ID)
+			assert.True(t, results[0].IsDeleted)
+		})
+
+		t.Run("should return error if is_deleted query and filter conflict", func(t *testing.T) {
+			_, err := repo.Search(ctx, asset.SearchConfig{
+				Filters: inService(asset.SearchFilter{"is_deleted": {"true"}}),
+				Queries: map[string]string{"is_deleted": "false"},
+			})
+			assert.Error(t, err)
+		})
+
+		t.Run("should paginate the results", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Filters: inService(nil), MaxResults: 2})
+			assert.Len(t, results, 2)
+
+			results = search(t, asset.SearchConfig{Filters: inService(nil), MaxResults: 2, Offset: 2})
+			assert.Len(t, results, 1)
+		})
+
+		t.Run("should only return the included fields", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{
+				Filters:       inService(asset.SearchFilter{"type": {"topic"}}),
+				IncludeFields: []string{"id", "name"},
+			})
+			require.Len(t, results, 1)
+			assert.Equal(t, payments.ID, results[0].ID)
+			assert.Equal(t, payments.Name, results[0].Title)
+			assert.Empty(t, results[0].Description)
+			assert.Empty(t, results[0].Data)
+		})
+	})
+
+	t.Run("Suggest", func(t *testing.T) {
+		svc := newService()
+		upsert(t,
+			newAsset(svc, svc+"-alpha", "zebracorn-alpha", asset.Type("table")),
+			newAsset(svc, svc+"-beta", "zebracorn-beta", asset.Type("table")),
+		)
+
+		suggestions, err := repo.Suggest(ctx, asset.SearchConfig{Text: "zebracorn-al"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"zebracorn-alpha"}, suggestions)
+	})
+
+	t.Run("GroupAssets", func(t *testing.T) {
+		t.Run("should return error if group by is empty", func(t *testing.T) {
+			_, err := repo.GroupAssets(ctx, asset.GroupConfig{GroupBy: []string{""}})
+			assert.Error(t, err)
+		})
+
+		t.Run("should group the assets by the fields", func(t *testing.T) {
+			svc := newService()
+			upsert(t,
+				newAsset(svc, svc+"-1", "orders", asset.Type("table")),
+				newAsset(svc, svc+"-2", "customers", asset.Type("table")),
+				newAsset(svc, svc+"-3", "payments", asset.Type("topic")),
+			)
+
+			results, err := repo.GroupAssets(ctx, asset.GroupConfig{
+				GroupBy:        []string{"type"},
+				Filters:        asset.SearchFilter{"service": {svc}},
+				IncludedFields: []string{"name"},
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+
+			assert.Equal(t, []asset.GroupField{{Name: "type", Value: "table"}}, results[0].Fields)
+			assert.ElementsMatch(t, []string{"orders", "customers"}, assetNames(results[0].Assets))
+			assert.Equal(t, []asset.GroupField{{Name: "type", Value: "topic"}}, results[1].Fields)
+			assert.Equal(t, []string{"payments"}, assetNames(results[1].Assets))
+		})
+	})
+
+	t.Run("Visibility", func(t *testing.T) {
+		svc := newService()
+		public := newAsset(svc, svc+"-public", "zebrafish-public", asset.Type("table"))
+		restricted := newAsset(svc, svc+"-restricted", "zebrafish-restricted", asset.Type("table"))
+		restricted.Visibility = &asset.Visibility{Kind: asset.VisibilityRestricted, Groups: []string{"finance"}}
+		upsert(t, public, restricted)
+
+		outsider := &asset.Viewer{Groups: []string{"marketing"}}
+		member := &asset.Viewer{Groups: []string{"marketing", "finance"}}
+		filters := asset.SearchFilter{"service": {svc}}
+
+		t.Run("should only search the assets the viewer can see", func(t *testing.T) {
+			results := search(t, asset.SearchConfig{Filters: filters, Viewer: outsider})
+			assert.Equal(t, []string{public.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: filters, Viewer: &asset.Viewer{}})
+			assert.Equal(t, []string{public.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: filters, Viewer: member})
+			assert.ElementsMatch(t, []string{public.ID, restricted.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: filters, Viewer: &asset.Viewer{Unrestricted: true}})
+			assert.ElementsMatch(t, []string{public.ID, restricted.ID}, resultIDs(results))
+
+			results = search(t, asset.SearchConfig{Filters: filters})
+			assert.ElementsMatch(t, []string{public.ID, restricted.ID}, resultIDs(results), "nil viewer sees all the assets")
+		})
+
+		t.Run("should only suggest the assets the viewer can see", func(t *testing.T) {
+			suggestions, err := repo.Suggest(ctx, asset.SearchConfig{Text: "zebrafish-", Viewer: outsider})
+			require.NoError(t, err)
+			assert.Equal(t, []string{public.Name}, suggestions)
+
+			suggestions, err = repo.Suggest(ctx, asset.SearchConfig{Text: "zebrafish-", Viewer: member})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{public.Name, restricted.Name}, suggestions)
+		})
+
+		t.Run("should only group the assets the viewer can see", func(t *testing.T) {
+			results, err := repo.GroupAssets(ctx, asset.GroupConfig{
+				GroupBy:        []string{"type"},
+				Filters:        filters,
+				IncludedFields: []string{"name"},
+				Viewer:         outsider,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, []string{public.Name}, assetNames(results[0].Assets))
+
+			results, err = repo.GroupAssets(ctx, asset.GroupConfig{
+				GroupBy:        []string{"type"},
+				Filters:        filters,
+				IncludedFields: []string{"name"},
+				Viewer:         member,
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.ElementsMatch(t, []string{public.Name, restricted.Name}, assetNames(results[0].Assets))
+		})
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		assert.ErrorIs(t, repo.DeleteByID(ctx, ""), asset.ErrEmptyID)
+
+		svc := newService()
+		ast1, ast2 := newAsset(svc, svc+"-1", "orders", asset.Type("table")), newAsset(svc, svc+"-2", "payments", asset.Type("table"))
+		upsert(t, ast1, ast2)
+
+		require.NoError(t, repo.DeleteByID(ctx, ast1.ID))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{ast2.ID}, resultIDs(results))
+	})
+
+	t.Run("DeleteByURN", func(t *testing.T) {
+		assert.ErrorIs(t, repo.DeleteByURN(ctx, ""), asset.ErrEmptyURN)
+
+		svc := newService()
+		ast1, ast2 := newAsset(svc, svc+"-1", "orders", asset.Type("table")), newAsset(svc, svc+"-2", "payments", asset.Type("table"))
+		upsert(t, ast1, ast2)
+
+		require.NoError(t, repo.DeleteByURN(ctx, ast1.URN))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{ast2.ID}, resultIDs(results))
+	})
+
+	t.Run("SoftDeleteByURN", func(t *testing.T) {
+		assert.ErrorIs(t, repo.SoftDeleteByURN(ctx, asset.SoftDeleteAssetParams{}), asset.ErrEmptyURN)
+
+		svc := newService()
+		ast := newAsset(svc, svc+"-1", "orders", asset.Type("table"))
+		upsert(t, ast)
+
+		now := time.Now().UTC()
+		require.NoError(t, repo.SoftDeleteByURN(ctx, asset.SoftDeleteAssetParams{
+			URN:         ast.URN,
+			UpdatedAt:   now,
+			RefreshedAt: now,
+			NewVersion:  "0.2",
+			UpdatedBy:   "user-id",
+		}))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Empty(t, results)
+
+		results = search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}, "is_deleted": {"true"}}})
+		require.Len(t, results, 1)
+		assert.Equal(t, ast.ID, results[0].ID)
+		assert.True(t, results[0].IsDeleted)
+	})
+
+	t.Run("SoftDeleteAssets", func(t *testing.T) {
+		svc := newService()
+		ast1, ast2 := newAsset(svc, svc+"-1", "orders", asset.Type("table")), newAsset(svc, svc+"-2", "payments", asset.Type("table"))
+		upsert(t, ast1, ast2)
+
+		require.NoError(t, repo.SoftDeleteAssets(ctx, []asset.Asset{ast1}, true))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{ast2.ID}, resultIDs(results))
+
+		results = search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}, "is_deleted": {"true"}}})
+		assert.Equal(t, []string{ast1.ID}, resultIDs(results))
+	})
+
+	t.Run("DeleteByQueryExpr", func(t *testing.T) {
+		err := repo.DeleteByQueryExpr(ctx, asset.DeleteAssetExpr{ExprStr: queryexpr.ESExpr("")})
+		assert.ErrorIs(t, err, asset.ErrEmptyQuery)
+
+		svc := newService()
+		table, topic := newAsset(svc, svc+"-1", "orders", asset.Type("table")), newAsset(svc, svc+"-2", "payments", asset.Type("topic"))
+		upsert(t, table, topic)
+
+		query := "refreshed_at <= '" + time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05Z") +
+			"' && service == '" + svc +
+			"' && type == '" + asset.Type("table").String() + "'"
+		require.NoError(t, repo.DeleteByQueryExpr(ctx, asset.DeleteAssetExpr{ExprStr: queryexpr.ESExpr(query)}))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{topic.ID}, resultIDs(results))
+	})
+
+	t.Run("DeleteByIsDeletedAndServicesAndUpdatedAt", func(t *testing.T) {
+		err := repo.DeleteByIsDeletedAndServicesAndUpdatedAt(ctx, true, nil, time.Now())
+		assert.ErrorIs(t, err, asset.ErrEmptyServices)
+		err = repo.DeleteByIsDeletedAndServicesAndUpdatedAt(ctx, true, []string{"service"}, time.Time{})
+		assert.ErrorIs(t, err, asset.ErrExpiryThresholdTimeIsZero)
+
+		svc := newService()
+		expired := newAsset(svc, svc+"-1", "orders", asset.Type("table"))
+		expired.IsDeleted = true
+		expired.UpdatedAt = time.Now().Add(-48 * time.Hour).UTC()
+		recent := newAsset(svc, svc+"-2", "payments", asset.Type("table"))
+		recent.IsDeleted = true
+		active := newAsset(svc, svc+"-3", "customers", asset.Type("table"))
+		active.UpdatedAt = expired.UpdatedAt
+		upsert(t, expired, recent, active)
+
+		require.NoError(t, repo.DeleteByIsDeletedAndServicesAndUpdatedAt(ctx, true, []string{svc}, time.Now().Add(-24*time.Hour)))
+		refresh(t)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}, "is_deleted": {"true"}}})
+		assert.Equal(t, []string{recent.ID}, resultIDs(results))
+		results = search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{active.ID}, resultIDs(results))
+	})
+
+	t.Run("SyncAssets", func(t *testing.T) {
+		svc := newService()
+		kept, removed := newAsset(svc, svc+"-1", "orders", asset.Type("table")), newAsset(svc, svc+"-2", "payments", asset.Type("table"))
+		upsert(t, kept, removed)
+
+		cleanup, err := repo.SyncAssets(ctx, svc)
+		require.NoError(t, err)
+
+		results := search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.ElementsMatch(t, []string{kept.ID, removed.ID}, resultIDs(results), "assets are searchable during the sync")
+
+		upsert(t, kept)
+		require.NoError(t, cleanup())
+		refresh(t)
+
+		results = search(t, asset.SearchConfig{Filters: asset.SearchFilter{"service": {svc}}})
+		assert.Equal(t, []string{kept.ID}, resultIDs(results))
+	})
+}
+
+func assetNames(assets []asset.Asset) []string {
+	names := make([]string, len(assets))
+	for i, a := range assets {
+		names[i] = a.Name
+	}
+	return names
+}
@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/goto/compass/core/savedsearch"
+)
+
+const (
+	httpTimeout     = 10 * time.Second
+	maxHTTPAttempts = 2
+)
+
+// ErrForbiddenAddress is returned for the webhooks resolving to a loopback,
+// private or link-local address, for the server not to be used to reach the
+// internal network or the metadata of the cloud instance.
+var ErrForbiddenAddress = errors.New("webhook address is not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range, internal too
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// Client implements savedsearch.Notifier by posting the new matches of a
+// saved search to its webhook URL.
+type Client struct {
+	httpClient *http.Client
+	// trustedClient posts to the allowed hosts, wherever they resolve to
+	trustedClient *http.Client
+	allowedHosts  map[string]struct{}
+}
+
+// NewClient returns a client refusing to post to the internal addresses,
+// apart from the allowed hosts.
+func NewClient(allowedHosts ...string) *Client {
+	hosts := make(map[string]struct{}, len(allowedHosts))
+	for _, h := range allowedHosts {
+		hosts[strings.ToLower(h)] = struct{}{}
+	}
+
+	// the address is checked once resolved, for a host not to resolve to an
+	// internal address after being checked. Proxies would be dialed in place
+	// of the host, they are not used.
+	dialer := &net.Dialer{Timeout: httpTimeout, Control: checkAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Client{
+		httpClient:    &http.Client{Timeout: httpTimeout, Transport: transport, CheckRedirect: noRedirect},
+		trustedClient: &http.Client{Timeout: httpTimeout, CheckRedirect: noRedirect},
+		allowedHosts:  hosts,
+	}
+}
+
+func (c *Client) Notify(ctx context.Context, ss savedsearch.SavedSearch, matches []savedsearch.Match) error {
+	payload, err := json.Marshal(newSavedSearchPayload(ss, matches))
+	if err != nil {
+		return fmt.Errorf("encode saved search webhook payload: %w", err)
+	}
+
+	httpClient := c.httpClient
+	if u, err := url.Parse(ss.WebhookURL); err == nil {
+		if _, ok := c.allowedHosts[strings.ToLower(u.Hostname())]; ok {
+			httpClient = c.trustedClient
+		}
+	}
+
+	var lastErr error
+	for attempt := range maxHTTPAttempts {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, ss.WebhookURL, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("create saved search webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := httpClient.Do(req)
+		if errors.Is(err, ErrForbiddenAddress) {
+			return fmt.Errorf("send saved search webhook request: %w", err)
+		}
+		if err != nil {
+			lastErr = fmt.Errorf("attempt %d: send saved search webhook request: %w", attempt+1, err)
+			continue
+		}
+
+		body, _ := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+
+		if res.StatusCode >= 300 {
+			lastErr = fmt.Errorf("attempt %d: saved search webhook response status code %d: %s", attempt+1, res.StatusCode, string(body))
+			continue
+		}
+
+		return nil
+	}
+	return lastErr
+}
+
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isInternal(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+func isInternal(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// noRedirect does not follow the redirects, the webhooks are expected to
+// answer the post themselves.
+func noRedirect(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+type savedSearchPayload struct {
+	SavedSearchID   string         `json:"saved_search_id"`
+	SavedSearchName string         `json:"saved_search_name"`
+	Assets          []assetPayload `json:"assets"`
+}
+
+type assetPayload struct {
+	ID        string    `json:"id"`
+	URN       string    `json:"urn"`
+	Type      string    `json:"type"`
+	Service   string    `json:"service"`
+	Name      string    `json:"name"`
+	MatchedAt time.Time `json:"matched_at"`
+}
+
+func newSavedSearchPayload(ss savedsearch.SavedSearch, matches []savedsearch.Match) savedSearchPayload {
+	assets := make([]assetPayload, 0, len(matches))
+	for _, m := range matches {
+		assets = append(assets, assetPayload{
+			ID:        m.Asset.ID,
+			URN:       m.Asset.URN,
+			Type:      m.Asset.Type.String(),
+			Service:   m.Asset.Service,
+			Name:      m.Asset.Name,
+			MatchedAt: m.MatchedAt,
+		})
+	}
+
+	return savedSearchPayload{
+		SavedSearchID:   ss.ID,
+		SavedSearchName: ss.Name,
+		Assets:          assets,
+	}
+}
@@ -0,0 +1,91 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Notify(t *testing.T) {
+	matches := []savedsearch.Match{
+		{Asset: asset.Asset{ID: "asset-1", URN: "urn-1", Type: asset.Type("table"), Service: "bigquery", Name: "customers"}},
+	}
+
+	t.Run("should post new matches to webhook url", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			var body struct {
+				SavedSearchID string `json:"saved_search_id"`
+				Assets        []struct {
+					URN  string `json:"urn"`
+					Type string `json:"type"`
+				} `json:"assets"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "ss-1", body.SavedSearchID)
+			require.Len(t, body.Assets, 1)
+			assert.Equal(t, "urn-1", body.Assets[0].URN)
+			assert.Equal(t, "table", body.Assets[0].Type)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		err := webhook.NewClient("127.0.0.1").Notify(context.Background(), savedsearch.SavedSearch{ID: "ss-1", WebhookURL: srv.URL}, matches)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should retry on server error", func(t *testing.T) {
+		attempts := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		err := webhook.NewClient("127.0.0.1").Notify(context.Background(), savedsearch.SavedSearch{ID: "ss-1", WebhookURL: srv.URL}, matches)
+		assert.ErrorContains(t, err, "500")
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("should not follow redirects", func(t *testing.T) {
+		srv := httptest.NewServer(http.RedirectHandler("http://169.254.169.254/latest/meta-data", http.StatusFound))
+		defer srv.Close()
+
+		err := webhook.NewClient("127.0.0.1").Notify(context.Background(), savedsearch.SavedSearch{ID: "ss-1", WebhookURL: srv.URL}, matches)
+		assert.ErrorContains(t, err, "302")
+	})
+
+	t.Run("should refuse to post to internal addresses", func(t *testing.T) {
+		called := false
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		for _, webhookURL := range []string{
+			srv.URL,
+			"http://localhost:1/hook",
+			"http://169.254.169.254/latest/meta-data",
+			"http://10.0.0.1/hook",
+			"http://192.168.1.1/hook",
+			"http://[::1]:1/hook",
+			"http://[fe80::1]/hook",
+			"http://0.0.0.0:1/hook",
+		} {
+			err := webhook.NewClient().Notify(context.Background(), savedsearch.SavedSearch{ID: "ss-1", WebhookURL: webhookURL}, matches)
+			assert.ErrorIs(t, err, webhook.ErrForbiddenAddress, webhookURL)
+		}
+		assert.False(t, called)
+	})
+}
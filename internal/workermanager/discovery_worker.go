@@ -69,6 +69,11 @@ func (m *Manager) IndexAsset(ctx context.Context, job worker.JobSpec) error {
 		return fmt.Errorf("index asset: deserialise payload: %w", err)
 	}
 
+	ast, err := withTags(ctx, m.tagReader, ast)
+	if err != nil {
+		return &worker.RetryableError{Cause: fmt.Errorf("index asset: %w", err)}
+	}
+
 	if err := m.discoveryRepo.Upsert(ctx, ast); err != nil {
 		return &worker.RetryableError{
 			Cause: fmt.Errorf("index asset: upsert into discovery repo: %w: urn '%s'", err, ast.URN),
@@ -113,6 +118,10 @@ func (m *Manager) SyncAssets(ctx context.Context, job worker.JobSpec) error {
 		}
 
 		for _, ast := range assets {
+			ast, err := withTags(ctx, m.tagReader, ast)
+			if err != nil {
+				return fmt.Errorf("sync asset: %w", err)
+			}
 			if err := m.discoveryRepo.Upsert(ctx, ast); err != nil {
 				if strings.Contains(err.Error(), "illegal_argument_exception") {
 					m.logger.Error(err.Error())
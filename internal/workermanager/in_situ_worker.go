@@ -2,19 +2,29 @@ package workermanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/tag"
 	"github.com/goto/compass/pkg/queryexpr"
 	"github.com/goto/salt/log"
 )
 
+// errAsyncWorkerOnly is returned for the jobs only the async worker runs.
+var errAsyncWorkerOnly = errors.New("async worker is not enabled")
+
 type InSituWorker struct {
 	discoveryRepo DiscoveryRepository
 	assetRepo     asset.Repository
+	tagReader     TagReader
+	tagMigrator   TagMigrator
+	bulkTagger    BulkTagger
+	tagPropagator TagPropagator
 	mutex         sync.Mutex
 	logger        log.Logger
 }
@@ -23,11 +33,20 @@ func NewInSituWorker(deps Deps) *InSituWorker {
 	return &InSituWorker{
 		discoveryRepo: deps.DiscoveryRepo,
 		assetRepo:     deps.AssetRepo,
+		tagReader:     deps.TagReader,
+		tagMigrator:   deps.TagMigrator,
+		bulkTagger:    deps.BulkTagger,
+		tagPropagator: deps.TagPropagator,
 		logger:        deps.Logger,
 	}
 }
 
 func (m *InSituWorker) EnqueueIndexAssetJob(ctx context.Context, ast asset.Asset) error {
+	ast, err := withTags(ctx, m.tagReader, ast)
+	if err != nil {
+		return fmt.Errorf("index asset: %w", err)
+	}
+
 	if err := m.discoveryRepo.Upsert(ctx, ast); err != nil {
 		return fmt.Errorf("index asset: upsert into discovery repo: %w: urn '%s'", err, ast.URN)
 	}
@@ -102,6 +121,10 @@ func (m *InSituWorker) EnqueueSyncAssetJob(ctx context.Context, service string)
 		}
 
 		for _, ast := range assets {
+			ast, err := withTags(ctx, m.tagReader, ast)
+			if err != nil {
+				return fmt.Errorf("sync asset: %w", err)
+			}
 			if err := m.discoveryRepo.Upsert(ctx, ast); err != nil {
 				if strings.Contains(err.Error(), "illegal_argument_exception") {
 					m.logger.Error(err.Error())
@@ -120,4 +143,80 @@ func (m *InSituWorker) EnqueueSyncAssetJob(ctx context.Context, service string)
 	return cleanupFn()
 }
 
+func (m *InSituWorker) EnqueueReindexAssetJob(ctx context.Context, assetID string) error {
+	ast, err := m.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		if errors.As(err, new(asset.NotFoundError)) {
+			return nil
+		}
+		return fmt.Errorf("reindex asset: get asset: %w: id '%s'", err, assetID)
+	}
+
+	return m.EnqueueIndexAssetJob(ctx, ast)
+}
+
+// EnqueueRunSavedSearchJob fails, saved searches are run periodically by the
+// async worker only.
+func (*InSituWorker) EnqueueRunSavedSearchJob(_ context.Context, id string, _ time.Time) error {
+	return fmt.Errorf("run saved search: %w: id '%s'", errAsyncWorkerOnly, id)
+}
+
+func (m *InSituWorker) EnqueueMigrateTemplateTagsJob(ctx context.Context, migration tag.TemplateMigration) error {
+	report, err := m.tagMigrator.MigrateTemplateTags(ctx, migration)
+	if err != nil {
+		return fmt.Errorf("migrate template tags: %w: template urn '%s'", err, migration.TemplateURN)
+	}
+
+	for _, id := range report.MigratedAssetIDs {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("migrated tag not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	for _, f := range report.Failures {
+		m.logger.Warn("tag not migrated", "template_urn", report.TemplateURN, "asset_id", f.AssetID, "reason", f.Reason)
+	}
+	return nil
+}
+
+func (m *InSituWorker) EnqueueBulkTagAssetsJob(ctx context.Context, req tag.BulkTagRequest) error {
+	report, err := m.bulkTagger.BulkTagAssets(ctx, req)
+	if err != nil {
+		return fmt.Errorf("bulk tag assets: %w: template urn '%s'", err, req.TemplateURN)
+	}
+
+	for _, id := range report.SucceededAssetIDs {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("bulk tagged asset not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+		if m.tagPropagator == nil {
+			continue
+		}
+		if err := m.EnqueuePropagateTagJob(ctx, id, "", report.TemplateURN); err != nil {
+			m.logger.Error("bulk tagged asset tag not propagated", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	for _, f := range report.Failures {
+		m.logger.Warn("asset not bulk tagged", "template_urn", report.TemplateURN, "asset", f.Asset, "reason", f.Reason)
+	}
+	return nil
+}
+
+func (m *InSituWorker) EnqueuePropagateTagJob(ctx context.Context, assetID, column, templateURN string) error {
+	if m.tagPropagator == nil {
+		return nil
+	}
+
+	report, err := m.tagPropagator.PropagateTag(ctx, assetID, column, templateURN)
+	if err != nil {
+		return fmt.Errorf("propagate tag: %w: asset id '%s', template urn '%s'", err, assetID, templateURN)
+	}
+
+	for _, id := range slices.Concat(report.InheritedAssetIDs, report.RemovedAssetIDs) {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("asset of propagated tag not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	return nil
+}
+
 func (*InSituWorker) Close() error { return nil }
@@ -247,3 +247,9 @@ func TestInSituWorker_EnqueueSoftDeleteAssetsJob(t *testing.T) {
 		})
 	}
 }
+
+func TestInSituWorker_EnqueueRunSavedSearchJob(t *testing.T) {
+	wrkr := workermanager.NewInSituWorker(workermanager.Deps{})
+	err := wrkr.EnqueueRunSavedSearchJob(ctx, "some-id", time.Now())
+	assert.ErrorContains(t, err, "run saved search: async worker is not enabled: id 'some-id'")
+}
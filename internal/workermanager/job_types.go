@@ -8,4 +8,11 @@ const (
 	jobDeleteAssetsByServicesAndUpdatedAt = "delete-assets-by-services-and-updated-at"
 	jobSoftDeleteAssets                   = "soft-delete-assets"
 	jobSyncAsset                          = "sync-asset"
+	jobReindexAsset                       = "reindex-asset"
+	jobRunSavedSearch                     = "run-saved-search"
+	jobRunDueSavedSearches                = "run-due-saved-searches"
+	jobMigrateTemplateTags                = "migrate-template-tags"
+	jobBulkTagAssets                      = "bulk-tag-assets"
+	jobPropagateTag                       = "propagate-tag"
+	jobSyncUsers                          = "sync-users"
 )
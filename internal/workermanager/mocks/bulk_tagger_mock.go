@@ -0,0 +1,94 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BulkTagger is an autogenerated mock type for the BulkTagger type
+type BulkTagger struct {
+	mock.Mock
+}
+
+type BulkTagger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BulkTagger) EXPECT() *BulkTagger_Expecter {
+	return &BulkTagger_Expecter{mock: &_m.Mock}
+}
+
+// BulkTagAssets provides a mock function with given fields: ctx, req
+func (_m *BulkTagger) BulkTagAssets(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkTagAssets")
+	}
+
+	var r0 tag.BulkTagReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.BulkTagRequest) tag.BulkTagReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(tag.BulkTagReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.BulkTagRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BulkTagger_BulkTagAssets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkTagAssets'
+type BulkTagger_BulkTagAssets_Call struct {
+	*mock.Call
+}
+
+// BulkTagAssets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req tag.BulkTagRequest
+func (_e *BulkTagger_Expecter) BulkTagAssets(ctx interface{}, req interface{}) *BulkTagger_BulkTagAssets_Call {
+	return &BulkTagger_BulkTagAssets_Call{Call: _e.mock.On("BulkTagAssets", ctx, req)}
+}
+
+func (_c *BulkTagger_BulkTagAssets_Call) Run(run func(ctx context.Context, req tag.BulkTagRequest)) *BulkTagger_BulkTagAssets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.BulkTagRequest))
+	})
+	return _c
+}
+
+func (_c *BulkTagger_BulkTagAssets_Call) Return(_a0 tag.BulkTagReport, _a1 error) *BulkTagger_BulkTagAssets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BulkTagger_BulkTagAssets_Call) RunAndReturn(run func(context.Context, tag.BulkTagRequest) (tag.BulkTagReport, error)) *BulkTagger_BulkTagAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBulkTagger creates a new instance of BulkTagger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBulkTagger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BulkTagger {
+	mock := &BulkTagger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,157 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	savedsearch "github.com/goto/compass/core/savedsearch"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SavedSearchRunner is an autogenerated mock type for the SavedSearchRunner type
+type SavedSearchRunner struct {
+	mock.Mock
+}
+
+type SavedSearchRunner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedSearchRunner) EXPECT() *SavedSearchRunner_Expecter {
+	return &SavedSearchRunner_Expecter{mock: &_m.Mock}
+}
+
+// GetDueIDs provides a mock function with given fields: ctx, since
+func (_m *SavedSearchRunner) GetDueIDs(ctx context.Context, since time.Time) ([]string, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDueIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRunner_GetDueIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDueIDs'
+type SavedSearchRunner_GetDueIDs_Call struct {
+	*mock.Call
+}
+
+// GetDueIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+func (_e *SavedSearchRunner_Expecter) GetDueIDs(ctx interface{}, since interface{}) *SavedSearchRunner_GetDueIDs_Call {
+	return &SavedSearchRunner_GetDueIDs_Call{Call: _e.mock.On("GetDueIDs", ctx, since)}
+}
+
+func (_c *SavedSearchRunner_GetDueIDs_Call) Run(run func(ctx context.Context, since time.Time)) *SavedSearchRunner_GetDueIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRunner_GetDueIDs_Call) Return(_a0 []string, _a1 error) *SavedSearchRunner_GetDueIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRunner_GetDueIDs_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *SavedSearchRunner_GetDueIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx, id
+func (_m *SavedSearchRunner) Run(ctx context.Context, id string) ([]savedsearch.Match, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 []savedsearch.Match
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]savedsearch.Match, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []savedsearch.Match); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]savedsearch.Match)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedSearchRunner_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type SavedSearchRunner_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SavedSearchRunner_Expecter) Run(ctx interface{}, id interface{}) *SavedSearchRunner_Run_Call {
+	return &SavedSearchRunner_Run_Call{Call: _e.mock.On("Run", ctx, id)}
+}
+
+func (_c *SavedSearchRunner_Run_Call) Run(run func(ctx context.Context, id string)) *SavedSearchRunner_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedSearchRunner_Run_Call) Return(_a0 []savedsearch.Match, _a1 error) *SavedSearchRunner_Run_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedSearchRunner_Run_Call) RunAndReturn(run func(context.Context, string) ([]savedsearch.Match, error)) *SavedSearchRunner_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedSearchRunner creates a new instance of SavedSearchRunner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedSearchRunner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedSearchRunner {
+	mock := &SavedSearchRunner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagMigrator is an autogenerated mock type for the TagMigrator type
+type TagMigrator struct {
+	mock.Mock
+}
+
+type TagMigrator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagMigrator) EXPECT() *TagMigrator_Expecter {
+	return &TagMigrator_Expecter{mock: &_m.Mock}
+}
+
+// MigrateTemplateTags provides a mock function with given fields: ctx, migration
+func (_m *TagMigrator) MigrateTemplateTags(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error) {
+	ret := _m.Called(ctx, migration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateTemplateTags")
+	}
+
+	var r0 tag.MigrationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)); ok {
+		return rf(ctx, migration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, tag.TemplateMigration) tag.MigrationReport); ok {
+		r0 = rf(ctx, migration)
+	} else {
+		r0 = ret.Get(0).(tag.MigrationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, tag.TemplateMigration) error); ok {
+		r1 = rf(ctx, migration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagMigrator_MigrateTemplateTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateTemplateTags'
+type TagMigrator_MigrateTemplateTags_Call struct {
+	*mock.Call
+}
+
+// MigrateTemplateTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - migration tag.TemplateMigration
+func (_e *TagMigrator_Expecter) MigrateTemplateTags(ctx interface{}, migration interface{}) *TagMigrator_MigrateTemplateTags_Call {
+	return &TagMigrator_MigrateTemplateTags_Call{Call: _e.mock.On("MigrateTemplateTags", ctx, migration)}
+}
+
+func (_c *TagMigrator_MigrateTemplateTags_Call) Run(run func(ctx context.Context, migration tag.TemplateMigration)) *TagMigrator_MigrateTemplateTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(tag.TemplateMigration))
+	})
+	return _c
+}
+
+func (_c *TagMigrator_MigrateTemplateTags_Call) Return(_a0 tag.MigrationReport, _a1 error) *TagMigrator_MigrateTemplateTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagMigrator_MigrateTemplateTags_Call) RunAndReturn(run func(context.Context, tag.TemplateMigration) (tag.MigrationReport, error)) *TagMigrator_MigrateTemplateTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagMigrator creates a new instance of TagMigrator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagMigrator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagMigrator {
+	mock := &TagMigrator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
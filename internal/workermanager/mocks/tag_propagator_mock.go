@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagPropagator is an autogenerated mock type for the TagPropagator type
+type TagPropagator struct {
+	mock.Mock
+}
+
+type TagPropagator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagPropagator) EXPECT() *TagPropagator_Expecter {
+	return &TagPropagator_Expecter{mock: &_m.Mock}
+}
+
+// PropagateTag provides a mock function with given fields: ctx, assetID, column, templateURN
+func (_m *TagPropagator) PropagateTag(ctx context.Context, assetID string, column string, templateURN string) (tag.PropagationReport, error) {
+	ret := _m.Called(ctx, assetID, column, templateURN)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PropagateTag")
+	}
+
+	var r0 tag.PropagationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (tag.PropagationReport, error)); ok {
+		return rf(ctx, assetID, column, templateURN)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) tag.PropagationReport); ok {
+		r0 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r0 = ret.Get(0).(tag.PropagationReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, assetID, column, templateURN)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagPropagator_PropagateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PropagateTag'
+type TagPropagator_PropagateTag_Call struct {
+	*mock.Call
+}
+
+// PropagateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+//   - column string
+//   - templateURN string
+func (_e *TagPropagator_Expecter) PropagateTag(ctx interface{}, assetID interface{}, column interface{}, templateURN interface{}) *TagPropagator_PropagateTag_Call {
+	return &TagPropagator_PropagateTag_Call{Call: _e.mock.On("PropagateTag", ctx, assetID, column, templateURN)}
+}
+
+func (_c *TagPropagator_PropagateTag_Call) Run(run func(ctx context.Context, assetID string, column string, templateURN string)) *TagPropagator_PropagateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TagPropagator_PropagateTag_Call) Return(_a0 tag.PropagationReport, _a1 error) *TagPropagator_PropagateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagPropagator_PropagateTag_Call) RunAndReturn(run func(context.Context, string, string, string) (tag.PropagationReport, error)) *TagPropagator_PropagateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagPropagator creates a new instance of TagPropagator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagPropagator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagPropagator {
+	mock := &TagPropagator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	tag "github.com/goto/compass/core/tag"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagReader is an autogenerated mock type for the TagReader type
+type TagReader struct {
+	mock.Mock
+}
+
+type TagReader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagReader) EXPECT() *TagReader_Expecter {
+	return &TagReader_Expecter{mock: &_m.Mock}
+}
+
+// GetTagsByAssetID provides a mock function with given fields: ctx, assetID
+func (_m *TagReader) GetTagsByAssetID(ctx context.Context, assetID string) ([]tag.Tag, error) {
+	ret := _m.Called(ctx, assetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsByAssetID")
+	}
+
+	var r0 []tag.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]tag.Tag, error)); ok {
+		return rf(ctx, assetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []tag.Tag); ok {
+		r0 = rf(ctx, assetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tag.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, assetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagReader_GetTagsByAssetID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagsByAssetID'
+type TagReader_GetTagsByAssetID_Call struct {
+	*mock.Call
+}
+
+// GetTagsByAssetID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assetID string
+func (_e *TagReader_Expecter) GetTagsByAssetID(ctx interface{}, assetID interface{}) *TagReader_GetTagsByAssetID_Call {
+	return &TagReader_GetTagsByAssetID_Call{Call: _e.mock.On("GetTagsByAssetID", ctx, assetID)}
+}
+
+func (_c *TagReader_GetTagsByAssetID_Call) Run(run func(ctx context.Context, assetID string)) *TagReader_GetTagsByAssetID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagReader_GetTagsByAssetID_Call) Return(_a0 []tag.Tag, _a1 error) *TagReader_GetTagsByAssetID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagReader_GetTagsByAssetID_Call) RunAndReturn(run func(context.Context, string) ([]tag.Tag, error)) *TagReader_GetTagsByAssetID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagReader creates a new instance of TagReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagReader {
+	mock := &TagReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
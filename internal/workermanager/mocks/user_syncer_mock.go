@@ -0,0 +1,95 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	user "github.com/goto/compass/core/user"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserSyncer is an autogenerated mock type for the UserSyncer type
+type UserSyncer struct {
+	mock.Mock
+}
+
+type UserSyncer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserSyncer) EXPECT() *UserSyncer_Expecter {
+	return &UserSyncer_Expecter{mock: &_m.Mock}
+}
+
+// SyncDirectoryFile provides a mock function with given fields: ctx, path, reassign
+func (_m *UserSyncer) SyncDirectoryFile(ctx context.Context, path string, reassign bool) (user.SyncReport, error) {
+	ret := _m.Called(ctx, path, reassign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncDirectoryFile")
+	}
+
+	var r0 user.SyncReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (user.SyncReport, error)); ok {
+		return rf(ctx, path, reassign)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) user.SyncReport); ok {
+		r0 = rf(ctx, path, reassign)
+	} else {
+		r0 = ret.Get(0).(user.SyncReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, path, reassign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserSyncer_SyncDirectoryFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncDirectoryFile'
+type UserSyncer_SyncDirectoryFile_Call struct {
+	*mock.Call
+}
+
+// SyncDirectoryFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - reassign bool
+func (_e *UserSyncer_Expecter) SyncDirectoryFile(ctx interface{}, path interface{}, reassign interface{}) *UserSyncer_SyncDirectoryFile_Call {
+	return &UserSyncer_SyncDirectoryFile_Call{Call: _e.mock.On("SyncDirectoryFile", ctx, path, reassign)}
+}
+
+func (_c *UserSyncer_SyncDirectoryFile_Call) Run(run func(ctx context.Context, path string, reassign bool)) *UserSyncer_SyncDirectoryFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *UserSyncer_SyncDirectoryFile_Call) Return(_a0 user.SyncReport, _a1 error) *UserSyncer_SyncDirectoryFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserSyncer_SyncDirectoryFile_Call) RunAndReturn(run func(context.Context, string, bool) (user.SyncReport, error)) *UserSyncer_SyncDirectoryFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserSyncer creates a new instance of UserSyncer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserSyncer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserSyncer {
+	mock := &UserSyncer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
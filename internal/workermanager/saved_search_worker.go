@@ -0,0 +1,93 @@
+package workermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=SavedSearchRunner -r --case underscore --with-expecter --structname SavedSearchRunner --filename saved_search_runner_mock.go --output=./mocks
+
+type SavedSearchRunner interface {
+	Run(ctx context.Context, id string) ([]savedsearch.Match, error)
+	GetDueIDs(ctx context.Context, since time.Time) ([]string, error)
+}
+
+func (m *Manager) EnqueueRunSavedSearchJob(ctx context.Context, id string, runAt time.Time) error {
+	err := m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:    jobRunSavedSearch,
+		Payload: ([]byte)(id),
+		RunAt:   runAt,
+		Key:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue run saved search job: %w: id '%s'", err, id)
+	}
+
+	return nil
+}
+
+func (m *Manager) runSavedSearchHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.RunSavedSearch,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.savedSearchTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// RunSavedSearch runs the saved search. A failed run is not retried, the
+// saved search is run again by the next scheduled run of the due ones.
+func (m *Manager) RunSavedSearch(ctx context.Context, job worker.JobSpec) error {
+	id := (string)(job.Payload)
+
+	matches, err := m.savedSearchRunner.Run(ctx, id)
+	if err != nil {
+		if !errors.As(err, new(savedsearch.NotFoundError)) {
+			m.logger.Error("run saved search", "id", id, "err", err)
+		}
+		return nil
+	}
+
+	if len(matches) > 0 {
+		m.logger.Info("saved search has new matches", "id", id, "count", len(matches))
+	}
+	return nil
+}
+
+func (m *Manager) runDueSavedSearchesHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.RunDueSavedSearches,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.savedSearchTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// RunDueSavedSearches enqueues a run of every saved search not run since the
+// job was scheduled. The job is scheduled once per interval across the
+// workers, and so is the run of a saved search.
+func (m *Manager) RunDueSavedSearches(ctx context.Context, job worker.JobSpec) error {
+	ids, err := m.savedSearchRunner.GetDueIDs(ctx, job.RunAt)
+	if err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("get due saved searches: %w", err),
+		}
+	}
+
+	for _, id := range ids {
+		err := m.EnqueueRunSavedSearchJob(ctx, id, job.RunAt)
+		if err != nil && !errors.Is(err, worker.ErrJobExists) {
+			return &worker.RetryableError{Cause: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package workermanager_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/savedsearch"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_EnqueueRunSavedSearchJob(t *testing.T) {
+	runAt := time.Now()
+
+	cases := []struct {
+		name        string
+		enqueueErr  error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			enqueueErr:  errors.New("fail"),
+			expectedErr: "enqueue run saved search job: fail",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			wrkr.EXPECT().
+				Enqueue(ctx, worker.JobSpec{
+					Type:    "run-saved-search",
+					Payload: []byte("some-id"),
+					RunAt:   runAt,
+					Key:     "some-id",
+				}).
+				Return(tc.enqueueErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+			err := mgr.EnqueueRunSavedSearchJob(ctx, "some-id", runAt)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_RunSavedSearch(t *testing.T) {
+	cases := []struct {
+		name    string
+		matches []savedsearch.Match
+		runErr  error
+	}{
+		{name: "Success", matches: []savedsearch.Match{{}}},
+		{
+			name:   "failed run is not retried",
+			runErr: errors.New("fail"),
+		},
+		{
+			name:   "deleted saved search",
+			runErr: savedsearch.NotFoundError{ID: "some-id"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := mocks.NewSavedSearchRunner(t)
+			runner.EXPECT().
+				Run(ctx, "some-id").
+				Return(tc.matches, tc.runErr)
+
+			mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+				Logger:            log.NewNoop(),
+				SavedSearchRunner: runner,
+			})
+			err := mgr.RunSavedSearch(ctx, worker.JobSpec{
+				Type:    "run-saved-search",
+				Payload: []byte("some-id"),
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestManager_RunDueSavedSearches(t *testing.T) {
+	runAt := time.Now().Truncate(time.Hour)
+	runJob := func(id string) worker.JobSpec {
+		return worker.JobSpec{
+			Type:    "run-saved-search",
+			Payload: []byte(id),
+			RunAt:   runAt,
+			Key:     id,
+		}
+	}
+
+	cases := []struct {
+		name        string
+		dueErr      error
+		enqueueErrs map[string]error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "failure to get due saved searches",
+			dueErr:      errors.New("fail"),
+			expectedErr: "get due saved searches: fail",
+		},
+		{
+			name:        "run already enqueued",
+			enqueueErrs: map[string]error{"id-1": worker.ErrJobExists},
+		},
+		{
+			name:        "failure to enqueue run",
+			enqueueErrs: map[string]error{"id-1": errors.New("fail")},
+			expectedErr: "enqueue run saved search job: fail",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := mocks.NewSavedSearchRunner(t)
+			wrkr := mocks.NewWorker(t)
+			if tc.dueErr != nil {
+				runner.EXPECT().
+					GetDueIDs(ctx, runAt).
+					Return(nil, tc.dueErr)
+			} else {
+				runner.EXPECT().
+					GetDueIDs(ctx, runAt).
+					Return([]string{"id-1", "id-2"}, nil)
+				wrkr.EXPECT().
+					Enqueue(ctx, runJob("id-1")).
+					Return(tc.enqueueErrs["id-1"])
+				if tc.expectedErr == "" {
+					wrkr.EXPECT().
+						Enqueue(ctx, runJob("id-2")).
+						Return(nil)
+				}
+			}
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{
+				Logger:            log.NewNoop(),
+				SavedSearchRunner: runner,
+			})
+			err := mgr.RunDueSavedSearches(ctx, worker.JobSpec{
+				Type:  "run-due-saved-searches",
+				RunAt: runAt,
+			})
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package workermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=BulkTagger -r --case underscore --with-expecter --structname BulkTagger --filename bulk_tagger_mock.go --output=./mocks
+
+type BulkTagger interface {
+	BulkTagAssets(ctx context.Context, req tag.BulkTagRequest) (tag.BulkTagReport, error)
+}
+
+func (m *Manager) EnqueueBulkTagAssetsJob(ctx context.Context, req tag.BulkTagRequest) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("enqueue bulk tag assets job: serialize payload: %w", err)
+	}
+
+	err = m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:    jobBulkTagAssets,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue bulk tag assets job: %w: template urn '%s'", err, req.TemplateURN)
+	}
+
+	return nil
+}
+
+func (m *Manager) bulkTagAssetsHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.BulkTagAssets,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.bulkTagTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// BulkTagAssets tags or untags the assets of the request and enqueues their
+// reindex. A retry applies the request again, the assets failing are only
+// reported.
+func (m *Manager) BulkTagAssets(ctx context.Context, job worker.JobSpec) error {
+	var req tag.BulkTagRequest
+	if err := json.Unmarshal(job.Payload, &req); err != nil {
+		return fmt.Errorf("bulk tag assets: deserialise payload: %w", err)
+	}
+
+	if req.Actor != "" {
+		ctx = user.NewContext(ctx, user.User{Email: req.Actor})
+	}
+
+	report, err := m.bulkTagger.BulkTagAssets(ctx, req)
+	if err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("bulk tag assets: %w: template urn '%s'", err, req.TemplateURN),
+		}
+	}
+
+	for _, id := range report.SucceededAssetIDs {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("bulk tagged asset not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+		if m.tagPropagator == nil {
+			continue
+		}
+		if err := m.EnqueuePropagateTagJob(ctx, id, "", report.TemplateURN); err != nil {
+			m.logger.Error("bulk tagged asset tag not propagated", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	for _, f := range report.Failures {
+		m.logger.Warn("asset not bulk tagged", "template_urn", report.TemplateURN, "asset", f.Asset, "reason", f.Reason)
+	}
+	m.logger.Info("assets bulk tagged",
+		"template_urn", report.TemplateURN,
+		"job_id", req.JobID,
+		"remove", req.Remove,
+		"succeeded", len(report.SucceededAssetIDs),
+		"failed", len(report.Failures),
+	)
+	return nil
+}
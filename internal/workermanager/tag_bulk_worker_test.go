@@ -0,0 +1,167 @@
+package workermanager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var sampleBulkTagRequest = tag.BulkTagRequest{
+	TemplateURN: "some-template",
+	QueryExpr:   `service == "bigquery"`,
+	Remove:      true,
+}
+
+const sampleBulkTagPayload = `{"template_urn":"some-template","assets":null,"query_expr":"service == \"bigquery\"","tag_values":null,"remove":true}`
+
+func TestManager_EnqueueBulkTagAssetsJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		enqueueErr  error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			enqueueErr:  errors.New("fail"),
+			expectedErr: "enqueue bulk tag assets job: fail: template urn 'some-template'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			wrkr.EXPECT().
+				Enqueue(ctx, worker.JobSpec{
+					Type:    "bulk-tag-assets",
+					Payload: []byte(sampleBulkTagPayload),
+				}).
+				Return(tc.enqueueErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+			err := mgr.EnqueueBulkTagAssetsJob(ctx, sampleBulkTagRequest)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_BulkTagAssets(t *testing.T) {
+	cases := []struct {
+		name        string
+		bulkTagErr  error
+		expectedErr bool
+	}{
+		{name: "Success"},
+		{
+			name:        "failure is retried",
+			bulkTagErr:  errors.New("fail"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bulkTagger := mocks.NewBulkTagger(t)
+			bulkTagger.EXPECT().
+				BulkTagAssets(ctx, sampleBulkTagRequest).
+				Return(tag.BulkTagReport{
+					TemplateURN:       "some-template",
+					Assets:            2,
+					SucceededAssetIDs: []string{"some-id"},
+					Failures:          []tag.BulkTagFailure{{Asset: "other-id", Reason: "not found"}},
+				}, tc.bulkTagErr)
+
+			wrkr := mocks.NewWorker(t)
+			if tc.bulkTagErr == nil {
+				wrkr.EXPECT().
+					Enqueue(ctx, worker.JobSpec{
+						Type:    "reindex-asset",
+						Payload: []byte("some-id"),
+					}).
+					Return(nil)
+			}
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{
+				Logger:     log.NewNoop(),
+				BulkTagger: bulkTagger,
+			})
+			err := mgr.BulkTagAssets(ctx, worker.JobSpec{
+				Type:    "bulk-tag-assets",
+				Payload: []byte(sampleBulkTagPayload),
+			})
+			if tc.expectedErr {
+				assert.ErrorAs(t, err, new(*worker.RetryableError))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_BulkTagAssetsAsActor(t *testing.T) {
+	req := sampleBulkTagRequest
+	req.Actor = "user@example.com"
+
+	bulkTagger := mocks.NewBulkTagger(t)
+	bulkTagger.EXPECT().
+		BulkTagAssets(mock.MatchedBy(func(ctx context.Context) bool {
+			return user.FromContext(ctx).Email == "user@example.com"
+		}), req).
+		Return(tag.BulkTagReport{}, nil)
+
+	mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+		Logger:     log.NewNoop(),
+		BulkTagger: bulkTagger,
+	})
+	err := mgr.BulkTagAssets(ctx, worker.JobSpec{
+		Type:    "bulk-tag-assets",
+		Payload: testutils.Marshal(t, req),
+	})
+	assert.NoError(t, err)
+}
+
+func TestInSituWorker_EnqueueBulkTagAssetsJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		bulkTagErr  error
+		expectedErr bool
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			bulkTagErr:  errors.New("fail"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bulkTagger := mocks.NewBulkTagger(t)
+			bulkTagger.EXPECT().
+				BulkTagAssets(ctx, sampleBulkTagRequest).
+				Return(tag.BulkTagReport{}, tc.bulkTagErr)
+
+			wrkr := workermanager.NewInSituWorker(workermanager.Deps{
+				Logger:     log.NewNoop(),
+				BulkTagger: bulkTagger,
+			})
+			err := wrkr.EnqueueBulkTagAssetsJob(ctx, sampleBulkTagRequest)
+			if tc.expectedErr {
+				assert.ErrorIs(t, err, tc.bulkTagErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
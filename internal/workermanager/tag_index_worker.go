@@ -0,0 +1,154 @@
+package workermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/goto/compass/core/asset"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=TagReader -r --case underscore --with-expecter --structname TagReader --filename tag_reader_mock.go --output=./mocks
+
+type TagReader interface {
+	GetTagsByAssetID(ctx context.Context, assetID string) ([]tag.Tag, error)
+}
+
+func (m *Manager) EnqueueReindexAssetJob(ctx context.Context, assetID string) error {
+	err := m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:    jobReindexAsset,
+		Payload: ([]byte)(assetID),
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue reindex asset job: %w: id '%s'", err, assetID)
+	}
+
+	return nil
+}
+
+func (m *Manager) reindexAssetHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.ReindexAsset,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.indexTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// ReindexAsset indexes the asset as it is stored, along with its current
+// tags. The job is done if the asset does not exist anymore.
+func (m *Manager) ReindexAsset(ctx context.Context, job worker.JobSpec) error {
+	id := (string)(job.Payload)
+
+	ast, err := m.assetRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.As(err, new(asset.NotFoundError)) {
+			return nil
+		}
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("reindex asset: get asset: %w: id '%s'", err, id),
+		}
+	}
+
+	ast, err = withTags(ctx, m.tagReader, ast)
+	if err != nil {
+		return &worker.RetryableError{Cause: fmt.Errorf("reindex asset: %w", err)}
+	}
+
+	if err := m.discoveryRepo.Upsert(ctx, ast); err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("reindex asset: upsert into discovery repo: %w: urn '%s'", err, ast.URN),
+		}
+	}
+	return nil
+}
+
+// withTags sets the values of the tags of the asset, by template and field
+// urn, for them to be searchable. The values of multi-valued fields are set as
+// a list. Tags of columns are set as the tags of the columns in data.columns,
+// leaving the data of the given asset untouched. The asset is left as is
+// without a reader.
+func withTags(ctx context.Context, r TagReader, ast asset.Asset) (asset.Asset, error) {
+	if r == nil {
+		return ast, nil
+	}
+
+	tags, err := r.GetTagsByAssetID(ctx, ast.ID)
+	if err != nil {
+		return ast, fmt.Errorf("get tags: %w: id '%s'", err, ast.ID)
+	}
+
+	ast.Tags = nil
+	var columnTags map[string]map[string]interface{}
+	for _, t := range tags {
+		values := make(map[string]interface{}, len(t.TagValues))
+		for _, tv := range t.TagValues {
+			switch v := tv.FieldValue.(type) {
+			case nil:
+				continue
+			case []interface{}:
+				elems := make([]string, len(v))
+				for i, elem := range v {
+					elems[i] = tag.FormatTagValue(elem)
+				}
+				values[tv.FieldURN] = elems
+			default:
+				values[tv.FieldURN] = tag.FormatTagValue(v)
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if t.Column != "" {
+			if columnTags == nil {
+				columnTags = make(map[string]map[string]interface{})
+			}
+			if columnTags[t.Column] == nil {
+				columnTags[t.Column] = make(map[string]interface{})
+			}
+			columnTags[t.Column][t.TemplateURN] = values
+			continue
+		}
+		if ast.Tags == nil {
+			ast.Tags = make(map[string]map[string]interface{}, len(tags))
+		}
+		ast.Tags[t.TemplateURN] = values
+	}
+	ast.Data = withColumnTags(ast.Data, columnTags)
+	return ast, nil
+}
+
+// withColumnTags returns a copy of the data with the tags set on the columns
+// in data.columns they belong to. Tags of columns not in the data are dropped.
+func withColumnTags(data map[string]interface{}, columnTags map[string]map[string]interface{}) map[string]interface{} {
+	columns, ok := data["columns"].([]interface{})
+	if !ok || len(columnTags) == 0 {
+		return data
+	}
+
+	tagged := make([]interface{}, len(columns))
+	for i, c := range columns {
+		tagged[i] = c
+		column, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := column["name"].(string)
+		tags, ok := columnTags[name]
+		if !ok {
+			continue
+		}
+		column = maps.Clone(column)
+		column["tags"] = tags
+		tagged[i] = column
+	}
+
+	data = maps.Clone(data)
+	data["columns"] = tagged
+	return data
+}
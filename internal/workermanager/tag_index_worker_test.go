@@ -0,0 +1,257 @@
+package workermanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/asset"
+	assetmocks "github.com/goto/compass/core/asset/mocks"
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/internal/testutils"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleTags = []tag.Tag{
+	{
+		AssetID:     "some-id",
+		TemplateURN: "governance",
+		TagValues: []tag.TagValue{
+			{FieldID: 1, FieldURN: "classification", FieldValue: "confidential"},
+			{FieldID: 2, FieldURN: "retention_days", FieldValue: 30},
+			{FieldID: 3, FieldURN: "owner"},
+			{FieldID: 4, FieldURN: "regions", FieldValue: []interface{}{"id", "sg"}},
+		},
+	},
+}
+
+func TestManager_EnqueueReindexAssetJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		enqueueErr  error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			enqueueErr:  errors.New("fail"),
+			expectedErr: "enqueue reindex asset job: fail: id 'some-id'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			wrkr.EXPECT().
+				Enqueue(ctx, worker.JobSpec{
+					Type:    "reindex-asset",
+					Payload: []byte("some-id"),
+				}).
+				Return(tc.enqueueErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+			err := mgr.EnqueueReindexAssetJob(ctx, "some-id")
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_ReindexAsset(t *testing.T) {
+	sampleAsset := asset.Asset{ID: "some-id", URN: "some-urn", Type: asset.Type("dashboard"), Service: "some-service"}
+	taggedAsset := sampleAsset
+	taggedAsset.Tags = map[string]map[string]interface{}{
+		"governance": {"classification": "confidential", "retention_days": "30", "regions": []string{"id", "sg"}},
+	}
+
+	cases := []struct {
+		name         string
+		getErr       error
+		tagsErr      error
+		discoveryErr error
+		expectedErr  bool
+	}{
+		{name: "Success"},
+		{
+			name:   "deleted asset is skipped",
+			getErr: asset.NotFoundError{AssetID: "some-id"},
+		},
+		{
+			name:        "get failure is retried",
+			getErr:      errors.New("fail"),
+			expectedErr: true,
+		},
+		{
+			name:        "tags failure is retried",
+			tagsErr:     errors.New("fail"),
+			expectedErr: true,
+		},
+		{
+			name:         "discovery failure is retried",
+			discoveryErr: errors.New("fail"),
+			expectedErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assetRepo := assetmocks.NewAssetRepository(t)
+			assetRepo.EXPECT().GetByID(ctx, "some-id").Return(sampleAsset, tc.getErr)
+
+			tagReader := mocks.NewTagReader(t)
+			discoveryRepo := mocks.NewDiscoveryRepository(t)
+			if tc.getErr == nil {
+				tagReader.EXPECT().GetTagsByAssetID(ctx, "some-id").Return(sampleTags, tc.tagsErr)
+				if tc.tagsErr == nil {
+					discoveryRepo.EXPECT().Upsert(ctx, taggedAsset).Return(tc.discoveryErr)
+				}
+			}
+
+			mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+				AssetRepo:     assetRepo,
+				DiscoveryRepo: discoveryRepo,
+				TagReader:     tagReader,
+			})
+			err := mgr.ReindexAsset(ctx, worker.JobSpec{
+				Type:    "reindex-asset",
+				Payload: []byte("some-id"),
+			})
+			if tc.expectedErr {
+				assert.ErrorAs(t, err, new(*worker.RetryableError))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_IndexAssetWithTags(t *testing.T) {
+	sampleAsset := asset.Asset{ID: "some-id", URN: "some-urn", Type: asset.Type("dashboard"), Service: "some-service"}
+	taggedAsset := sampleAsset
+	taggedAsset.Tags = map[string]map[string]interface{}{
+		"governance": {"classification": "confidential", "retention_days": "30", "regions": []string{"id", "sg"}},
+	}
+
+	tagReader := mocks.NewTagReader(t)
+	tagReader.EXPECT().GetTagsByAssetID(ctx, "some-id").Return(sampleTags, nil)
+	discoveryRepo := mocks.NewDiscoveryRepository(t)
+	discoveryRepo.EXPECT().Upsert(ctx, taggedAsset).Return(nil)
+
+	mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+		DiscoveryRepo: discoveryRepo,
+		TagReader:     tagReader,
+	})
+	err := mgr.IndexAsset(ctx, worker.JobSpec{
+		Type:    "index-asset",
+		Payload: testutils.Marshal(t, sampleAsset),
+	})
+	assert.NoError(t, err)
+}
+
+func TestManager_IndexAssetWithColumnTags(t *testing.T) {
+	sampleAsset := asset.Asset{
+		ID: "some-id", URN: "some-urn", Type: asset.Type("table"), Service: "some-service",
+		Data: map[string]interface{}{
+			"columns": []interface{}{
+				map[string]interface{}{"name": "id"},
+				map[string]interface{}{"name": "email"},
+			},
+		},
+	}
+	taggedAsset := sampleAsset
+	taggedAsset.Data = map[string]interface{}{
+		"columns": []interface{}{
+			map[string]interface{}{"name": "id"},
+			map[string]interface{}{
+				"name": "email",
+				"tags": map[string]interface{}{
+					"pii": map[string]interface{}{"category": "contact"},
+				},
+			},
+		},
+	}
+	tags := []tag.Tag{
+		{
+			AssetID:     "some-id",
+			Column:      "email",
+			TemplateURN: "pii",
+			TagValues:   []tag.TagValue{{FieldID: 5, FieldURN: "category", FieldValue: "contact"}},
+		},
+		{
+			AssetID:     "some-id",
+			Column:      "dropped",
+			TemplateURN: "pii",
+			TagValues:   []tag.TagValue{{FieldID: 5, FieldURN: "category", FieldValue: "contact"}},
+		},
+	}
+
+	tagReader := mocks.NewTagReader(t)
+	tagReader.EXPECT().GetTagsByAssetID(ctx, "some-id").Return(tags, nil)
+	discoveryRepo := mocks.NewDiscoveryRepository(t)
+	discoveryRepo.EXPECT().Upsert(ctx, taggedAsset).Return(nil)
+
+	mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+		DiscoveryRepo: discoveryRepo,
+		TagReader:     tagReader,
+	})
+	err := mgr.IndexAsset(ctx, worker.JobSpec{
+		Type:    "index-asset",
+		Payload: testutils.Marshal(t, sampleAsset),
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, sampleAsset.Data["columns"].([]interface{})[1], "tags")
+}
+
+func TestInSituWorker_EnqueueReindexAssetJob(t *testing.T) {
+	sampleAsset := asset.Asset{ID: "some-id", URN: "some-urn", Type: asset.Type("dashboard"), Service: "some-service"}
+	taggedAsset := sampleAsset
+	taggedAsset.Tags = map[string]map[string]interface{}{
+		"governance": {"classification": "confidential", "retention_days": "30", "regions": []string{"id", "sg"}},
+	}
+
+	cases := []struct {
+		name         string
+		getErr       error
+		discoveryErr error
+		expectedErr  bool
+	}{
+		{name: "Success"},
+		{
+			name:   "deleted asset is skipped",
+			getErr: asset.NotFoundError{AssetID: "some-id"},
+		},
+		{
+			name:         "Failure",
+			discoveryErr: errors.New("fail"),
+			expectedErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assetRepo := assetmocks.NewAssetRepository(t)
+			assetRepo.EXPECT().GetByID(ctx, "some-id").Return(sampleAsset, tc.getErr)
+
+			tagReader := mocks.NewTagReader(t)
+			discoveryRepo := mocks.NewDiscoveryRepository(t)
+			if tc.getErr == nil {
+				tagReader.EXPECT().GetTagsByAssetID(ctx, "some-id").Return(sampleTags, nil)
+				discoveryRepo.EXPECT().Upsert(ctx, taggedAsset).Return(tc.discoveryErr)
+			}
+
+			wrkr := workermanager.NewInSituWorker(workermanager.Deps{
+				AssetRepo:     assetRepo,
+				DiscoveryRepo: discoveryRepo,
+				TagReader:     tagReader,
+			})
+			err := wrkr.EnqueueReindexAssetJob(ctx, "some-id")
+			if tc.expectedErr {
+				assert.ErrorIs(t, err, tc.discoveryErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package workermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=TagMigrator -r --case underscore --with-expecter --structname TagMigrator --filename tag_migrator_mock.go --output=./mocks
+
+type TagMigrator interface {
+	MigrateTemplateTags(ctx context.Context, migration tag.TemplateMigration) (tag.MigrationReport, error)
+}
+
+func (m *Manager) EnqueueMigrateTemplateTagsJob(ctx context.Context, migration tag.TemplateMigration) error {
+	payload, err := json.Marshal(migration)
+	if err != nil {
+		return fmt.Errorf("enqueue migrate template tags job: serialize payload: %w", err)
+	}
+
+	err = m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:    jobMigrateTemplateTags,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue migrate template tags job: %w: template urn '%s'", err, migration.TemplateURN)
+	}
+
+	return nil
+}
+
+func (m *Manager) migrateTemplateTagsHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.MigrateTemplateTags,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.tagMigrationTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// MigrateTemplateTags rewrites the outdated tags of the template and enqueues
+// the reindex of the migrated assets. A retry resumes with the tags not
+// migrated yet, the ones failing validation are only reported.
+func (m *Manager) MigrateTemplateTags(ctx context.Context, job worker.JobSpec) error {
+	var migration tag.TemplateMigration
+	if err := json.Unmarshal(job.Payload, &migration); err != nil {
+		return fmt.Errorf("migrate template tags: deserialise payload: %w", err)
+	}
+
+	if migration.Actor != "" {
+		ctx = user.NewContext(ctx, user.User{Email: migration.Actor})
+	}
+
+	report, err := m.tagMigrator.MigrateTemplateTags(ctx, migration)
+	if err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("migrate template tags: %w: template urn '%s'", err, migration.TemplateURN),
+		}
+	}
+
+	for _, id := range report.MigratedAssetIDs {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("migrated tag not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	for _, f := range report.Failures {
+		m.logger.Warn("tag not migrated", "template_urn", report.TemplateURN, "asset_id", f.AssetID, "reason", f.Reason)
+	}
+	m.logger.Info("template tags migrated",
+		"template_urn", report.TemplateURN,
+		"template_version", report.TemplateVersion,
+		"migrated", len(report.MigratedAssetIDs),
+		"failed", len(report.Failures),
+	)
+	return nil
+}
@@ -0,0 +1,130 @@
+package workermanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleMigration = tag.TemplateMigration{
+	TemplateURN: "some-template",
+	Mappings: []tag.FieldValueMapping{
+		{FieldID: 1, Values: map[string]string{"old": "new"}},
+	},
+}
+
+const sampleMigrationPayload = `{"template_urn":"some-template","mappings":[{"field_id":1,"values":{"old":"new"}}]}`
+
+func TestManager_EnqueueMigrateTemplateTagsJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		enqueueErr  error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			enqueueErr:  errors.New("fail"),
+			expectedErr: "enqueue migrate template tags job: fail: template urn 'some-template'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			wrkr.EXPECT().
+				Enqueue(ctx, worker.JobSpec{
+					Type:    "migrate-template-tags",
+					Payload: []byte(sampleMigrationPayload),
+				}).
+				Return(tc.enqueueErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+			err := mgr.EnqueueMigrateTemplateTagsJob(ctx, sampleMigration)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_MigrateTemplateTags(t *testing.T) {
+	cases := []struct {
+		name        string
+		migrateErr  error
+		expectedErr bool
+	}{
+		{name: "Success"},
+		{
+			name:        "failure is retried",
+			migrateErr:  errors.New("fail"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			migrator := mocks.NewTagMigrator(t)
+			migrator.EXPECT().
+				MigrateTemplateTags(ctx, sampleMigration).
+				Return(tag.MigrationReport{
+					TemplateURN: "some-template",
+					Failures:    []tag.MigrationFailure{{AssetID: "some-asset", Reason: "invalid"}},
+				}, tc.migrateErr)
+
+			mgr := workermanager.NewWithWorker(mocks.NewWorker(t), workermanager.Deps{
+				Logger:      log.NewNoop(),
+				TagMigrator: migrator,
+			})
+			err := mgr.MigrateTemplateTags(ctx, worker.JobSpec{
+				Type:    "migrate-template-tags",
+				Payload: []byte(sampleMigrationPayload),
+			})
+			if tc.expectedErr {
+				assert.ErrorAs(t, err, new(*worker.RetryableError))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInSituWorker_EnqueueMigrateTemplateTagsJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		migrateErr  error
+		expectedErr bool
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			migrateErr:  errors.New("fail"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			migrator := mocks.NewTagMigrator(t)
+			migrator.EXPECT().
+				MigrateTemplateTags(ctx, sampleMigration).
+				Return(tag.MigrationReport{}, tc.migrateErr)
+
+			wrkr := workermanager.NewInSituWorker(workermanager.Deps{
+				Logger:      log.NewNoop(),
+				TagMigrator: migrator,
+			})
+			err := wrkr.EnqueueMigrateTemplateTagsJob(ctx, sampleMigration)
+			if tc.expectedErr {
+				assert.ErrorIs(t, err, tc.migrateErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package workermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=TagPropagator -r --case underscore --with-expecter --structname TagPropagator --filename tag_propagator_mock.go --output=./mocks
+
+type TagPropagator interface {
+	PropagateTag(ctx context.Context, assetID, column, templateURN string) (tag.PropagationReport, error)
+}
+
+type propagateTagPayload struct {
+	AssetID     string `json:"asset_id"`
+	Column      string `json:"column,omitempty"`
+	TemplateURN string `json:"template_urn"`
+}
+
+func (m *Manager) EnqueuePropagateTagJob(ctx context.Context, assetID, column, templateURN string) error {
+	payload, err := json.Marshal(propagateTagPayload{AssetID: assetID, Column: column, TemplateURN: templateURN})
+	if err != nil {
+		return fmt.Errorf("enqueue propagate tag job: serialize payload: %w", err)
+	}
+
+	err = m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:    jobPropagateTag,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue propagate tag job: %w: asset id '%s', template urn '%s'", err, assetID, templateURN)
+	}
+
+	return nil
+}
+
+func (m *Manager) propagateTagHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.PropagateTag,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.tagPropagationTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// PropagateTag propagates the tag of the asset, or of its column, to the
+// assets downstream of it and enqueues their reindex. A retry propagates the tag again.
+func (m *Manager) PropagateTag(ctx context.Context, job worker.JobSpec) error {
+	var payload propagateTagPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("propagate tag: deserialise payload: %w", err)
+	}
+
+	report, err := m.tagPropagator.PropagateTag(ctx, payload.AssetID, payload.Column, payload.TemplateURN)
+	if err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("propagate tag: %w: asset id '%s', template urn '%s'", err, payload.AssetID, payload.TemplateURN),
+		}
+	}
+
+	for _, id := range slices.Concat(report.InheritedAssetIDs, report.RemovedAssetIDs) {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("asset of propagated tag not reindexed", "template_urn", report.TemplateURN, "asset_id", id, "err", err)
+		}
+	}
+	m.logger.Info("tag propagated",
+		"template_urn", report.TemplateURN,
+		"source_urn", report.SourceURN,
+		"source_column", report.SourceColumn,
+		"inherited", len(report.InheritedAssetIDs),
+		"removed", len(report.RemovedAssetIDs),
+	)
+	return nil
+}
@@ -0,0 +1,142 @@
+package workermanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/tag"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+const samplePropagateTagPayload = `{"asset_id":"some-id","template_urn":"some-template"}`
+
+func TestManager_EnqueuePropagateTagJob(t *testing.T) {
+	cases := []struct {
+		name        string
+		enqueueErr  error
+		expectedErr string
+	}{
+		{name: "Success"},
+		{
+			name:        "Failure",
+			enqueueErr:  errors.New("fail"),
+			expectedErr: "enqueue propagate tag job: fail: asset id 'some-id', template urn 'some-template'",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			wrkr.EXPECT().
+				Enqueue(ctx, worker.JobSpec{
+					Type:    "propagate-tag",
+					Payload: []byte(samplePropagateTagPayload),
+				}).
+				Return(tc.enqueueErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+			err := mgr.EnqueuePropagateTagJob(ctx, "some-id", "", "some-template")
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_PropagateTag(t *testing.T) {
+	cases := []struct {
+		name         string
+		propagateErr error
+		expectedErr  bool
+	}{
+		{name: "Success"},
+		{
+			name:         "failure is retried",
+			propagateErr: errors.New("fail"),
+			expectedErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			propagator := mocks.NewTagPropagator(t)
+			propagator.EXPECT().
+				PropagateTag(ctx, "some-id", "", "some-template").
+				Return(tag.PropagationReport{
+					TemplateURN:       "some-template",
+					SourceURN:         "some-urn",
+					InheritedAssetIDs: []string{"inheriting-id"},
+					RemovedAssetIDs:   []string{"removed-id"},
+				}, tc.propagateErr)
+
+			wrkr := mocks.NewWorker(t)
+			if tc.propagateErr == nil {
+				for _, id := range []string{"inheriting-id", "removed-id"} {
+					wrkr.EXPECT().
+						Enqueue(ctx, worker.JobSpec{
+							Type:    "reindex-asset",
+							Payload: []byte(id),
+						}).
+						Return(nil)
+				}
+			}
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{
+				Logger:        log.NewNoop(),
+				TagPropagator: propagator,
+			})
+			err := mgr.PropagateTag(ctx, worker.JobSpec{
+				Type:    "propagate-tag",
+				Payload: []byte(samplePropagateTagPayload),
+			})
+			if tc.expectedErr {
+				assert.ErrorAs(t, err, new(*worker.RetryableError))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInSituWorker_EnqueuePropagateTagJob(t *testing.T) {
+	t.Run("does nothing without a propagator", func(t *testing.T) {
+		wrkr := workermanager.NewInSituWorker(workermanager.Deps{Logger: log.NewNoop()})
+		assert.NoError(t, wrkr.EnqueuePropagateTagJob(ctx, "some-id", "", "some-template"))
+	})
+
+	cases := []struct {
+		name         string
+		propagateErr error
+		expectedErr  bool
+	}{
+		{name: "Success"},
+		{
+			name:         "Failure",
+			propagateErr: errors.New("fail"),
+			expectedErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			propagator := mocks.NewTagPropagator(t)
+			propagator.EXPECT().
+				PropagateTag(ctx, "some-id", "", "some-template").
+				Return(tag.PropagationReport{TemplateURN: "some-template"}, tc.propagateErr)
+
+			wrkr := workermanager.NewInSituWorker(workermanager.Deps{
+				Logger:        log.NewNoop(),
+				TagPropagator: propagator,
+			})
+			err := wrkr.EnqueuePropagateTagJob(ctx, "some-id", "", "some-template")
+			if tc.expectedErr {
+				assert.ErrorIs(t, err, tc.propagateErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package workermanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/worker"
+)
+
+//go:generate mockery --name=UserSyncer -r --case underscore --with-expecter --structname UserSyncer --filename user_syncer_mock.go --output=./mocks
+
+type UserSyncer interface {
+	SyncDirectoryFile(ctx context.Context, path string, reassign bool) (user.SyncReport, error)
+}
+
+func (m *Manager) EnqueueSyncUsersJob(ctx context.Context) error {
+	err := m.worker.Enqueue(ctx, worker.JobSpec{
+		Type: jobSyncUsers,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue sync users job: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manager) syncUsersHandler() worker.JobHandler {
+	return worker.JobHandler{
+		Handle: m.SyncUsers,
+		JobOpts: worker.JobOptions{
+			MaxAttempts:     m.maxAttemptsRetry,
+			Timeout:         m.userSyncTimeout,
+			BackoffStrategy: worker.DefaultExponentialBackoff,
+		},
+	}
+}
+
+// SyncUsers syncs the users with the directory file, enqueues the reindex of
+// the assets reassigned and reports the assets left without an active owner.
+func (m *Manager) SyncUsers(ctx context.Context, _ worker.JobSpec) error {
+	report, err := m.userSyncer.SyncDirectoryFile(ctx, m.userSyncFile, m.userSyncReassign)
+	if err != nil {
+		return &worker.RetryableError{
+			Cause: fmt.Errorf("sync users: %w: file '%s'", err, m.userSyncFile),
+		}
+	}
+
+	for _, id := range report.ReassignedAssetIDs {
+		if err := m.EnqueueReindexAssetJob(ctx, id); err != nil {
+			m.logger.Error("reassigned asset not reindexed", "asset_id", id, "err", err)
+		}
+	}
+	for _, u := range report.Deactivated {
+		m.logger.Info("user deactivated", "email", u.Email)
+	}
+	for _, a := range report.Orphaned {
+		m.logger.Warn("asset has no active owner", "urn", a.URN, "suggested_owner", a.SuggestedOwner)
+	}
+	m.logger.Info("users synced",
+		"upserted", report.Upserted,
+		"deactivated", len(report.Deactivated),
+		"orphaned", len(report.Orphaned),
+		"reassigned", len(report.ReassignedAssetIDs),
+	)
+	return nil
+}
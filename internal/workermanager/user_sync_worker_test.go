@@ -0,0 +1,76 @@
+package workermanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/internal/workermanager"
+	"github.com/goto/compass/internal/workermanager/mocks"
+	"github.com/goto/compass/pkg/worker"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager_SyncUsers(t *testing.T) {
+	cfg := workermanager.Config{UserSyncFile: "/exports/users.json", UserSyncReassign: true}
+
+	cases := []struct {
+		name        string
+		report      user.SyncReport
+		syncErr     error
+		reindexed   []string
+		expectedErr bool
+	}{
+		{
+			name: "reassigned assets are reindexed",
+			report: user.SyncReport{
+				Upserted:           2,
+				Deactivated:        []user.User{{Email: "dev@example.com"}},
+				Orphaned:           []user.OrphanedAsset{{ID: "asset-1", URN: "urn:orders"}, {ID: "asset-2", URN: "urn:payments"}},
+				ReassignedAssetIDs: []string{"asset-1"},
+			},
+			reindexed: []string{"asset-1"},
+		},
+		{
+			name:        "failed sync is retried",
+			syncErr:     errors.New("fail"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wrkr := mocks.NewWorker(t)
+			for _, id := range tc.reindexed {
+				wrkr.EXPECT().
+					Enqueue(ctx, worker.JobSpec{Type: "reindex-asset", Payload: []byte(id)}).
+					Return(nil)
+			}
+
+			syncer := mocks.NewUserSyncer(t)
+			syncer.EXPECT().SyncDirectoryFile(ctx, cfg.UserSyncFile, true).Return(tc.report, tc.syncErr)
+
+			mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{
+				Config:     cfg,
+				UserSyncer: syncer,
+				Logger:     log.NewNoop(),
+			})
+			err := mgr.SyncUsers(ctx, worker.JobSpec{Type: "sync-users"})
+			if tc.expectedErr {
+				var retryable *worker.RetryableError
+				assert.ErrorAs(t, err, &retryable)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManager_EnqueueSyncUsersJob(t *testing.T) {
+	wrkr := mocks.NewWorker(t)
+	wrkr.EXPECT().Enqueue(ctx, worker.JobSpec{Type: "sync-users"}).Return(errors.New("fail"))
+
+	mgr := workermanager.NewWithWorker(wrkr, workermanager.Deps{})
+	err := mgr.EnqueueSyncUsersJob(ctx)
+	assert.ErrorContains(t, err, "enqueue sync users job: fail")
+}
@@ -25,11 +25,31 @@ type Manager struct {
 	jobManagerPort   int
 	discoveryRepo    DiscoveryRepository
 	assetRepo        asset.Repository
+	tagReader        TagReader
 	logger           log.Logger
 	syncTimeout      time.Duration
 	indexTimeout     time.Duration
 	deleteTimeout    time.Duration
 	maxAttemptsRetry int
+
+	savedSearchRunner   SavedSearchRunner
+	savedSearchTimeout  time.Duration
+	savedSearchInterval time.Duration
+
+	tagMigrator         TagMigrator
+	tagMigrationTimeout time.Duration
+
+	bulkTagger     BulkTagger
+	bulkTagTimeout time.Duration
+
+	tagPropagator         TagPropagator
+	tagPropagationTimeout time.Duration
+
+	userSyncer       UserSyncer
+	userSyncFile     string
+	userSyncReassign bool
+	userSyncTimeout  time.Duration
+	userSyncInterval time.Duration
 }
 
 //go:generate mockery --name=Worker -r --case underscore --with-expecter --structname Worker --filename worker_mock.go --output=./mocks
@@ -52,13 +72,35 @@ type Config struct {
 	IndexJobTimeout   time.Duration `mapstructure:"index_job_timeout" default:"5s"`
 	DeleteJobTimeout  time.Duration `mapstructure:"delete_job_timeout" default:"5s"`
 	MaxAttemptRetry   int           `mapstructure:"max_attempt_retry" default:"3"`
+
+	SavedSearchJobTimeout  time.Duration `mapstructure:"saved_search_job_timeout" default:"1m"`
+	SavedSearchRunInterval time.Duration `mapstructure:"saved_search_run_interval" default:"1h"`
+
+	TagMigrationJobTimeout   time.Duration `mapstructure:"tag_migration_job_timeout" default:"30m"`
+	BulkTagJobTimeout        time.Duration `mapstructure:"bulk_tag_job_timeout" default:"30m"`
+	TagPropagationJobTimeout time.Duration `mapstructure:"tag_propagation_job_timeout" default:"5m"`
+
+	// UserSyncFile is the directory export, json or csv, the users are
+	// periodically synced from. Users are not synced without it.
+	UserSyncFile        string        `mapstructure:"user_sync_file"`
+	UserSyncReassign    bool          `mapstructure:"user_sync_reassign"`
+	UserSyncJobTimeout  time.Duration `mapstructure:"user_sync_job_timeout" default:"10m"`
+	UserSyncRunInterval time.Duration `mapstructure:"user_sync_run_interval" default:"24h"`
 }
 
 type Deps struct {
 	Config        Config
 	DiscoveryRepo DiscoveryRepository
 	AssetRepo     asset.Repository
+	TagReader     TagReader
 	Logger        log.Logger
+
+	// The following are only needed to process jobs, not to enqueue them.
+	SavedSearchRunner SavedSearchRunner
+	TagMigrator       TagMigrator
+	BulkTagger        BulkTagger
+	TagPropagator     TagPropagator
+	UserSyncer        UserSyncer
 }
 
 func New(ctx context.Context, deps Deps) (*Manager, error) {
@@ -84,11 +126,31 @@ func New(ctx context.Context, deps Deps) (*Manager, error) {
 		jobManagerPort:   cfg.JobManagerPort,
 		discoveryRepo:    deps.DiscoveryRepo,
 		assetRepo:        deps.AssetRepo,
+		tagReader:        deps.TagReader,
 		logger:           deps.Logger,
 		syncTimeout:      cfg.SyncJobTimeout,
 		indexTimeout:     cfg.IndexJobTimeout,
 		deleteTimeout:    cfg.DeleteJobTimeout,
 		maxAttemptsRetry: cfg.MaxAttemptRetry,
+
+		savedSearchRunner:   deps.SavedSearchRunner,
+		savedSearchTimeout:  cfg.SavedSearchJobTimeout,
+		savedSearchInterval: cfg.SavedSearchRunInterval,
+
+		tagMigrator:         deps.TagMigrator,
+		tagMigrationTimeout: cfg.TagMigrationJobTimeout,
+
+		bulkTagger:     deps.BulkTagger,
+		bulkTagTimeout: cfg.BulkTagJobTimeout,
+
+		tagPropagator:         deps.TagPropagator,
+		tagPropagationTimeout: cfg.TagPropagationJobTimeout,
+
+		userSyncer:       deps.UserSyncer,
+		userSyncFile:     cfg.UserSyncFile,
+		userSyncReassign: cfg.UserSyncReassign,
+		userSyncTimeout:  cfg.UserSyncJobTimeout,
+		userSyncInterval: cfg.UserSyncRunInterval,
 	}, nil
 }
 
@@ -96,6 +158,23 @@ func NewWithWorker(w Worker, deps Deps) *Manager {
 	return &Manager{
 		worker:        w,
 		discoveryRepo: deps.DiscoveryRepo,
+		assetRepo:     deps.AssetRepo,
+		tagReader:     deps.TagReader,
+		logger:        deps.Logger,
+
+		savedSearchRunner:   deps.SavedSearchRunner,
+		savedSearchInterval: deps.Config.SavedSearchRunInterval,
+
+		tagMigrator: deps.TagMigrator,
+
+		bulkTagger: deps.BulkTagger,
+
+		tagPropagator: deps.TagPropagator,
+
+		userSyncer:       deps.UserSyncer,
+		userSyncFile:     deps.Config.UserSyncFile,
+		userSyncReassign: deps.Config.UserSyncReassign,
+		userSyncInterval: deps.Config.UserSyncRunInterval,
 	}
 }
 
@@ -117,9 +196,53 @@ func (m *Manager) Run(ctx context.Context) error {
 		}
 	}()
 
+	if m.savedSearchRunner != nil && m.savedSearchInterval > 0 {
+		go m.schedule(ctx, jobRunDueSavedSearches, m.savedSearchInterval)
+	}
+	if m.syncsUsers() && m.userSyncInterval > 0 {
+		go m.schedule(ctx, jobSyncUsers, m.userSyncInterval)
+	}
+
 	return m.worker.Run(ctx)
 }
 
+// schedule enqueues a job of the type at start and at the start of every
+// interval, until the context is done. The job of an interval is keyed by its
+// run time, so the replicas scheduling it enqueue it only once.
+func (m *Manager) schedule(ctx context.Context, jobType string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	runAt := time.Now().Truncate(interval)
+	for {
+		if err := m.enqueueScheduledJob(ctx, jobType, runAt); err != nil {
+			m.logger.Error("schedule job", "type", jobType, "run_at", runAt, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runAt = time.Now().Truncate(interval).Add(interval)
+		}
+	}
+}
+
+// enqueueScheduledJob enqueues the job of the type run at the given time,
+// unless another replica already did.
+func (m *Manager) enqueueScheduledJob(ctx context.Context, jobType string, runAt time.Time) error {
+	err := m.worker.Enqueue(ctx, worker.JobSpec{
+		Type:  jobType,
+		RunAt: runAt,
+		Key:   "scheduled",
+	})
+	if err != nil && !errors.Is(err, worker.ErrJobExists) {
+		return fmt.Errorf("enqueue scheduled %s job: %w", jobType, err)
+	}
+
+	return nil
+}
+
 func (m *Manager) init() error {
 	if m.initDone.Load() {
 		return nil
@@ -134,6 +257,23 @@ func (m *Manager) init() error {
 		jobDeleteAssetsByServicesAndUpdatedAt: m.deleteAssetsByServicesAndUpdatedAtHandler(),
 		jobSoftDeleteAssets:                   m.softDeleteAssetsByQueryHandler(),
 		jobSyncAsset:                          m.syncAssetHandler(),
+		jobReindexAsset:                       m.reindexAssetHandler(),
+	}
+	if m.savedSearchRunner != nil {
+		jobHandlers[jobRunSavedSearch] = m.runSavedSearchHandler()
+		jobHandlers[jobRunDueSavedSearches] = m.runDueSavedSearchesHandler()
+	}
+	if m.tagMigrator != nil {
+		jobHandlers[jobMigrateTemplateTags] = m.migrateTemplateTagsHandler()
+	}
+	if m.bulkTagger != nil {
+		jobHandlers[jobBulkTagAssets] = m.bulkTagAssetsHandler()
+	}
+	if m.tagPropagator != nil {
+		jobHandlers[jobPropagateTag] = m.propagateTagHandler()
+	}
+	if m.syncsUsers() {
+		jobHandlers[jobSyncUsers] = m.syncUsersHandler()
 	}
 	for typ, h := range jobHandlers {
 		if err := m.worker.Register(typ, h); err != nil {
@@ -144,6 +284,10 @@ func (m *Manager) init() error {
 	return m.registerStatsCallback(keys(jobHandlers))
 }
 
+func (m *Manager) syncsUsers() bool {
+	return m.userSyncer != nil && m.userSyncFile != ""
+}
+
 func (m *Manager) Close() error {
 	return m.processor.Close()
 }
@@ -97,6 +97,9 @@ func TestManager_Run(t *testing.T) {
 			wrkr.EXPECT().
 				Register("sync-asset", mock.AnythingOfType("worker.JobHandler")).
 				Return(nil)
+			wrkr.EXPECT().
+				Register("reindex-asset", mock.AnythingOfType("worker.JobHandler")).
+				Return(nil)
 			wrkr.EXPECT().
 				Run(ctx).
 				Return(tc.runErr)
@@ -0,0 +1,65 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readMethodPrefixes start the names of the methods not changing anything
+var readMethodPrefixes = []string{"Get", "List", "Search", "Suggest", "Group"}
+
+// TokenAuthenticator authenticates an API token of a service account for a
+// read or write operation and returns the user of the service account
+type TokenAuthenticator interface {
+	AuthenticateToken(ctx context.Context, token string, write bool) (user.User, error)
+}
+
+// APITokenUserCtx middleware will propagate the user of the service account
+// identified by the API token of the authorization header within request
+// context. Requests without an API token are passed to next, identifying the
+// humans, which may not claim the email of a service account.
+func APITokenUserCtx(authenticator TokenAuthenticator, next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		token := bearerToken(ctx)
+		if !strings.HasPrefix(token, user.APITokenPrefix) {
+			return next(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				if isServiceAccountEmail(user.FromContext(ctx).Email) {
+					return nil, status.Error(codes.Unauthenticated, "service accounts must authenticate with an api token")
+				}
+				return handler(ctx, req)
+			})
+		}
+
+		usr, err := authenticator.AuthenticateToken(ctx, token, isWriteMethod(info.FullMethod))
+		if errors.Is(err, user.ErrAPITokenScope) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid api token: %v", err)
+		}
+
+		newCtx := user.NewContext(ctx, usr)
+		return handler(newCtx, req)
+	}
+}
+
+func isWriteMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func isServiceAccountEmail(email string) bool {
+	return strings.HasSuffix(strings.ToLower(email), "@"+user.ServiceAccountEmailDomain)
+}
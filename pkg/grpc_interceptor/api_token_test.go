@@ -0,0 +1,93 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_testing "github.com/grpc-ecosystem/go-grpc-middleware/testing"
+	pb_testproto "github.com/grpc-ecosystem/go-grpc-middleware/testing/testproto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// staticAuthenticator knows a read-only and a read-write token
+type staticAuthenticator struct{}
+
+func (staticAuthenticator) AuthenticateToken(_ context.Context, token string, write bool) (user.User, error) {
+	switch token {
+	case "compass_read":
+		if write {
+			return user.User{}, user.ErrAPITokenScope
+		}
+	case "compass_write":
+	default:
+		return user.User{}, user.ErrInvalidAPIToken
+	}
+	return user.User{Email: "pipeline@" + user.ServiceAccountEmailDomain, Provider: user.ServiceAccountProvider}, nil
+}
+
+type APITokenTestSuite struct {
+	*grpc_testing.InterceptorTestSuite
+}
+
+func TestAPITokenSuite(t *testing.T) {
+	s := &APITokenTestSuite{
+		InterceptorTestSuite: &grpc_testing.InterceptorTestSuite{
+			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
+			ServerOpts: []grpc.ServerOption{
+				grpc_middleware.WithUnaryServerChain(
+					APITokenUserCtx(staticAuthenticator{}, UserHeaderCtx(IdentityHeaderKeyEmail, IdentityHeaderKeyGroups))),
+			},
+		},
+	}
+	suite.Run(t, s)
+}
+
+func (s *APITokenTestSuite) TestUnary_FallBackWithoutAPIToken() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "user@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.OK, status.Code(err))
+}
+
+func (s *APITokenTestSuite) TestUnary_ServiceAccountEmailWithoutAPIToken() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "pipeline@"+user.ServiceAccountEmailDomain)
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+}
+
+func (s *APITokenTestSuite) TestUnary_TokenInvalid() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "authorization", "Bearer compass_unknown")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	require.EqualError(s.T(), err, "rpc error: code = Unauthenticated desc = invalid api token: "+user.ErrInvalidAPIToken.Error())
+}
+
+func (s *APITokenTestSuite) TestUnary_ReadTokenOnWriteMethod() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "authorization", "Bearer compass_read")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+}
+
+func (s *APITokenTestSuite) TestUnary_WriteToken() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "authorization", "Bearer compass_write")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.OK, status.Code(err))
+}
+
+func TestIsWriteMethod(t *testing.T) {
+	for method, expected := range map[string]bool{
+		"/gotocompany.compass.v1beta1.CompassService/GetAllAssets":    false,
+		"/gotocompany.compass.v1beta1.CompassService/SearchAssets":    false,
+		"/gotocompany.compass.v1beta1.CompassService/ListAuditEvents": false,
+		"/gotocompany.compass.v1beta1.CompassService/UpsertAsset":     true,
+		"/gotocompany.compass.v1beta1.CompassService/CreateComment":   true,
+	} {
+		require.Equal(t, expected, isWriteMethod(method), method)
+	}
+}
@@ -0,0 +1,30 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Auditor records the calls of the write methods, along with their outcome
+type Auditor interface {
+	Audit(ctx context.Context, fullMethod string, req, resp interface{}, err error, latency time.Duration)
+}
+
+// Audit middleware hands the calls of the write methods to the auditor once
+// they are handled. It must be chained after UserHeaderCtx for the user to be
+// in the context, and before Authorization for the denied calls to be
+// audited too.
+func Audit(auditor Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if !isWriteMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err = handler(ctx, req)
+		auditor.Audit(ctx, info.FullMethod, req, resp, err, time.Since(start))
+		return resp, err
+	}
+}
@@ -0,0 +1,87 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/core/user"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_testing "github.com/grpc-ecosystem/go-grpc-middleware/testing"
+	pb_testproto "github.com/grpc-ecosystem/go-grpc-middleware/testing/testproto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type auditCall struct {
+	actor  string
+	method string
+	code   codes.Code
+}
+
+type recordingAuditor struct {
+	mu    sync.Mutex
+	calls []auditCall
+}
+
+func (a *recordingAuditor) Audit(ctx context.Context, fullMethod string, _, _ interface{}, err error, _ time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.calls = append(a.calls, auditCall{
+		actor:  user.FromContext(ctx).Email,
+		method: path.Base(fullMethod),
+		code:   status.Code(err),
+	})
+}
+
+func (a *recordingAuditor) reset() []auditCall {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	calls := a.calls
+	a.calls = nil
+	return calls
+}
+
+type AuditTestSuite struct {
+	*grpc_testing.InterceptorTestSuite
+	auditor *recordingAuditor
+}
+
+func TestAuditSuite(t *testing.T) {
+	auditor := &recordingAuditor{}
+	s := &AuditTestSuite{
+		InterceptorTestSuite: &grpc_testing.InterceptorTestSuite{
+			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
+			ServerOpts: []grpc.ServerOption{
+				grpc_middleware.WithUnaryServerChain(
+					UserHeaderCtx(IdentityHeaderKeyEmail, IdentityHeaderKeyGroups),
+					Audit(auditor),
+					Authorization(pingAuthorizer{})),
+			},
+		},
+		auditor: auditor,
+	}
+	suite.Run(t, s)
+}
+
+func (s *AuditTestSuite) TestUnary_AuditsWriteMethod() {
+	s.auditor.reset()
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "admin@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []auditCall{{actor: "admin@example.com", method: "Ping", code: codes.OK}}, s.auditor.reset())
+}
+
+func (s *AuditTestSuite) TestUnary_AuditsDeniedCall() {
+	s.auditor.reset()
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "user@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	require.Equal(s.T(), []auditCall{{actor: "user@example.com", method: "Ping", code: codes.PermissionDenied}}, s.auditor.reset())
+}
@@ -0,0 +1,25 @@
+package grpc_interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Authorizer decides whether the user in the context is allowed to call the
+// method with the request
+type Authorizer interface {
+	Authorize(ctx context.Context, fullMethod string, req interface{}) error
+}
+
+// Authorization middleware rejects the requests not allowed by the authorizer
+// with the error it returns. It must be chained after UserHeaderCtx for the
+// user to be in the context.
+func Authorization(authorizer Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if err := authorizer.Authorize(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
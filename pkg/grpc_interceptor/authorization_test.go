@@ -0,0 +1,67 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_testing "github.com/grpc-ecosystem/go-grpc-middleware/testing"
+	pb_testproto "github.com/grpc-ecosystem/go-grpc-middleware/testing/testproto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// pingAuthorizer only allows the admin to ping
+type pingAuthorizer struct{}
+
+func (pingAuthorizer) Authorize(ctx context.Context, fullMethod string, _ interface{}) error {
+	if path.Base(fullMethod) != "Ping" {
+		return nil
+	}
+	if user.FromContext(ctx).Email != "admin@example.com" {
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	return nil
+}
+
+type AuthorizationTestSuite struct {
+	*grpc_testing.InterceptorTestSuite
+}
+
+func TestAuthorizationSuite(t *testing.T) {
+	s := &AuthorizationTestSuite{
+		InterceptorTestSuite: &grpc_testing.InterceptorTestSuite{
+			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
+			ServerOpts: []grpc.ServerOption{
+				grpc_middleware.WithUnaryServerChain(
+					UserHeaderCtx(IdentityHeaderKeyEmail, IdentityHeaderKeyGroups),
+					Authorization(pingAuthorizer{})),
+			},
+		},
+	}
+	suite.Run(t, s)
+}
+
+func (s *AuthorizationTestSuite) TestUnary_NotAuthorized() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "user@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(err))
+	require.EqualError(s.T(), err, "rpc error: code = PermissionDenied desc = admin role required")
+}
+
+func (s *AuthorizationTestSuite) TestUnary_Authorized() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "admin@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.OK, status.Code(err))
+}
+
+func (s *AuthorizationTestSuite) TestUnary_MethodNotAuthorized() {
+	_, err := s.Client.PingEmpty(s.SimpleCtx(), &pb_testproto.Empty{})
+	require.Equal(s.T(), codes.OK, status.Code(err))
+}
@@ -0,0 +1,59 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/goto/compass/core/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// TokenVerifier verifies a bearer token and returns the user it identifies
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (user.User, error)
+}
+
+// JWTUserCtx middleware will propagate the user identified by the bearer token
+// of the authorization header within request context, in place of
+// UserHeaderCtx. Requests without a valid token are rejected, except the
+// health checks.
+func JWTUserCtx(verifier TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(ctx, req)
+		}
+
+		token := bearerToken(ctx)
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "bearer token not found")
+		}
+
+		usr, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+		}
+
+		newCtx := user.NewContext(ctx, usr)
+		return handler(newCtx, req)
+	}
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, v := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(v, " ")
+		if ok && strings.EqualFold(scheme, "bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
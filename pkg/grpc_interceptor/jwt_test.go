@@ -0,0 +1,65 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/compass/core/user"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_testing "github.com/grpc-ecosystem/go-grpc-middleware/testing"
+	pb_testproto "github.com/grpc-ecosystem/go-grpc-middleware/testing/testproto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// staticVerifier only knows a single token
+type staticVerifier struct{}
+
+func (staticVerifier) Verify(_ context.Context, token string) (user.User, error) {
+	if token != "valid-token" {
+		return user.User{}, errors.New("token is expired")
+	}
+	return user.User{Email: "user@example.com", Provider: "https://accounts.example.com"}, nil
+}
+
+type JWTTestSuite struct {
+	*grpc_testing.InterceptorTestSuite
+}
+
+func TestJWTSuite(t *testing.T) {
+	s := &JWTTestSuite{
+		InterceptorTestSuite: &grpc_testing.InterceptorTestSuite{
+			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
+			ServerOpts: []grpc.ServerOption{
+				grpc_middleware.WithUnaryServerChain(
+					JWTUserCtx(staticVerifier{})),
+			},
+		},
+	}
+	suite.Run(t, s)
+}
+
+func (s *JWTTestSuite) TestUnary_TokenNotPresent() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "user@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	require.EqualError(s.T(), err, "rpc error: code = Unauthenticated desc = bearer token not found")
+}
+
+func (s *JWTTestSuite) TestUnary_TokenInvalid() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "authorization", "Bearer expired-token")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.Unauthenticated, status.Code(err))
+	require.EqualError(s.T(), err, "rpc error: code = Unauthenticated desc = invalid bearer token: token is expired")
+}
+
+func (s *JWTTestSuite) TestUnary_TokenValid() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "authorization", "bearer valid-token")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.Equal(s.T(), codes.OK, status.Code(err))
+}
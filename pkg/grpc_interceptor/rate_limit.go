@@ -0,0 +1,76 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterKey is the metadata key of the seconds to wait before retrying
+// a call refused by the rate limit
+const RetryAfterKey = "retry-after"
+
+// RateLimiter tells whether the subject may call the method now, and when to
+// retry otherwise
+type RateLimiter interface {
+	Allow(ctx context.Context, subject, fullMethod string) (retryAfter time.Duration, allowed bool)
+}
+
+// RateLimit middleware refuses the calls over the rate limit of their user
+// with ResourceExhausted, along with the retry-after metadata. The anonymous
+// calls are limited by the address of the client, which is also passed to
+// the limiter in the context. It must be chained after UserHeaderCtx for the
+// user to be in the context.
+func RateLimit(limiter RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		host := peerHost(ctx)
+		subject := user.FromContext(ctx).Email
+		if subject == "" {
+			subject = host
+		}
+
+		retryAfter, allowed := limiter.Allow(ratelimit.WithPeer(ctx, host), subject, info.FullMethod)
+		if allowed {
+			return handler(ctx, req)
+		}
+
+		seconds := strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterKey, seconds))
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", seconds)
+	}
+}
+
+// peerHost returns the address of the client. The calls of the HTTP gateway
+// come from loopback, the address of their client being the last one the
+// gateway appended to x-forwarded-for.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if fwd := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(fwd) > 0 {
+			hops := strings.Split(fwd[len(fwd)-1], ",")
+			if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+				return last
+			}
+		}
+	}
+	return host
+}
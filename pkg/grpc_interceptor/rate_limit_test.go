@@ -0,0 +1,88 @@
+package grpc_interceptor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_testing "github.com/grpc-ecosystem/go-grpc-middleware/testing"
+	pb_testproto "github.com/grpc-ecosystem/go-grpc-middleware/testing/testproto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// onceLimiter allows a single call per subject
+type onceLimiter struct {
+	mu   sync.Mutex
+	seen map[string]bool
+}
+
+func (l *onceLimiter) Allow(_ context.Context, subject, _ string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.seen[subject] {
+		return 1500 * time.Millisecond, false
+	}
+	l.seen[subject] = true
+	return 0, true
+}
+
+type RateLimitTestSuite struct {
+	*grpc_testing.InterceptorTestSuite
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	s := &RateLimitTestSuite{
+		InterceptorTestSuite: &grpc_testing.InterceptorTestSuite{
+			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
+			ServerOpts: []grpc.ServerOption{
+				grpc_middleware.WithUnaryServerChain(
+					UserHeaderCtx(IdentityHeaderKeyEmail, IdentityHeaderKeyGroups),
+					RateLimit(&onceLimiter{seen: map[string]bool{}})),
+			},
+		},
+	}
+	suite.Run(t, s)
+}
+
+func (s *RateLimitTestSuite) TestUnary_LimitsPerUser() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "user@example.com")
+	_, err := s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.NoError(s.T(), err)
+
+	var header metadata.MD
+	_, err = s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999}, grpc.Header(&header))
+	require.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+	require.Equal(s.T(), []string{"2"}, header.Get(RetryAfterKey))
+
+	ctx = metadata.AppendToOutgoingContext(s.SimpleCtx(), IdentityHeaderKeyEmail, "other@example.com")
+	_, err = s.Client.Ping(ctx, &pb_testproto.PingRequest{Value: "testuser", SleepTimeMs: 9999})
+	require.NoError(s.T(), err)
+}
+
+func (s *RateLimitTestSuite) TestUnary_LimitsAnonymousByAddress() {
+	_, err := s.Client.PingEmpty(s.SimpleCtx(), &pb_testproto.Empty{})
+	require.NoError(s.T(), err)
+
+	_, err = s.Client.PingEmpty(s.SimpleCtx(), &pb_testproto.Empty{})
+	require.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+}
+
+func (s *RateLimitTestSuite) TestUnary_LimitsForwardedAnonymousByForwardedAddress() {
+	ctx := metadata.AppendToOutgoingContext(s.SimpleCtx(), "x-forwarded-for", "203.0.113.7")
+	_, err := s.Client.PingEmpty(ctx, &pb_testproto.Empty{})
+	require.NoError(s.T(), err)
+
+	_, err = s.Client.PingEmpty(ctx, &pb_testproto.Empty{})
+	require.Equal(s.T(), codes.ResourceExhausted, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(s.SimpleCtx(), "x-forwarded-for", "203.0.113.7, 203.0.113.8")
+	_, err = s.Client.PingEmpty(ctx, &pb_testproto.Empty{})
+	require.NoError(s.T(), err)
+}
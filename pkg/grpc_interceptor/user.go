@@ -3,6 +3,7 @@ package grpc_interceptor
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/goto/compass/core/user"
 	"google.golang.org/grpc"
@@ -10,8 +11,9 @@ import (
 )
 
 // UserHeaderCtx middleware will propagate a valid user ID as string within request context
-// use `user.FromContext` function to get the user ID string
-func UserHeaderCtx(identityHeaderKeyEmail string) grpc.UnaryServerInterceptor {
+// use `user.FromContext` function to get the user ID string. The groups of
+// the user are read from the comma separated values of the groups header.
+func UserHeaderCtx(identityHeaderKeyEmail, identityHeaderKeyGroups string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		userEmail := ""
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -24,7 +26,24 @@ func UserHeaderCtx(identityHeaderKeyEmail string) grpc.UnaryServerInterceptor {
 			userEmail = metadataValues[0]
 		}
 
-		newCtx := user.NewContext(ctx, user.User{Email: userEmail})
+		var groups []string
+		if identityHeaderKeyGroups != "" {
+			for _, v := range md.Get(identityHeaderKeyGroups) {
+				groups = append(groups, splitGroups(v)...)
+			}
+		}
+
+		newCtx := user.NewContext(ctx, user.User{Email: userEmail, Groups: groups})
 		return handler(newCtx, req)
 	}
 }
+
+func splitGroups(s string) []string {
+	var groups []string
+	for _, g := range strings.Split(s, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
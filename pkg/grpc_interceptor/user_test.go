@@ -16,8 +16,9 @@ import (
 )
 
 const (
-	IdentityHeaderKeyUUID  = "Compass-User-ID"
-	IdentityHeaderKeyEmail = "Compass-User-Email"
+	IdentityHeaderKeyUUID   = "Compass-User-ID"
+	IdentityHeaderKeyEmail  = "Compass-User-Email"
+	IdentityHeaderKeyGroups = "Compass-User-Groups"
 )
 
 type UserTestSuite struct {
@@ -30,7 +31,7 @@ func TestUserSuite(t *testing.T) {
 			TestService: &dummyService{TestServiceServer: &grpc_testing.TestPingService{T: t}},
 			ServerOpts: []grpc.ServerOption{
 				grpc_middleware.WithUnaryServerChain(
-					UserHeaderCtx(IdentityHeaderKeyEmail)),
+					UserHeaderCtx(IdentityHeaderKeyEmail, IdentityHeaderKeyGroups)),
 			},
 		},
 	}
@@ -60,3 +61,8 @@ func (s *UserTestSuite) TestUnary_HeaderPresentAndPassed() {
 	code := status.Code(err)
 	require.Equal(s.T(), codes.OK, code)
 }
+
+func TestSplitGroups(t *testing.T) {
+	require.Equal(t, []string{"finance", "hr"}, splitGroups(" finance, ,hr "))
+	require.Nil(t, splitGroups(""))
+}
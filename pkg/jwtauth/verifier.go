@@ -0,0 +1,199 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/goto/compass/core/user"
+	"golang.org/x/sync/singleflight"
+)
+
+// minRefetchInterval limits the fetches of the key set caused by tokens
+// signed with unknown keys.
+const minRefetchInterval = time.Minute
+
+var (
+	ErrMissingEmail     = errors.New("token has no email claim")
+	ErrEmailNotVerified = errors.New("email of token is not verified")
+)
+
+type Config struct {
+	// Enabled makes the users identified by the bearer token of the requests,
+	// in place of the email header.
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	// JWKSURL is the http(s) url or the path of the local file of the key set
+	// the tokens are signed with.
+	JWKSURL string `yaml:"jwks_url" mapstructure:"jwks_url"`
+	// Issuer and Audience are required, the tokens issued by the provider
+	// for other clients being rejected.
+	Issuer   string `yaml:"issuer" mapstructure:"issuer"`
+	Audience string `yaml:"audience" mapstructure:"audience"`
+	// EmailClaim and ProviderClaim are the claims the email and the provider
+	// of the user are read from.
+	EmailClaim    string `yaml:"email_claim" mapstructure:"email_claim" default:"email"`
+	ProviderClaim string `yaml:"provider_claim" mapstructure:"provider_claim" default:"iss"`
+	// GroupsClaim is the claim the groups of the user are read from, either
+	// a list or a comma separated string.
+	GroupsClaim string `yaml:"groups_claim" mapstructure:"groups_claim" default:"groups"`
+	// JWKSRefreshInterval is the age after which the key set is fetched again.
+	JWKSRefreshInterval time.Duration `yaml:"jwks_refresh_interval" mapstructure:"jwks_refresh_interval" default:"1h"`
+	// Leeway is the clock skew tolerated validating the times of the tokens.
+	Leeway time.Duration `yaml:"leeway" mapstructure:"leeway" default:"1m"`
+}
+
+// Verifier validates the signature and the claims of JSON Web Tokens with
+// the keys of a key set, cached until refreshed.
+type Verifier struct {
+	config Config
+	client *http.Client
+	parser *jwt.Parser
+	// fetches shares a fetch of the key set among the concurrent callers
+	fetches singleflight.Group
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewVerifier fetches the key set, failing if it can not be read.
+func NewVerifier(ctx context.Context, cfg Config) (*Verifier, error) {
+	if cfg.JWKSURL == "" {
+		return nil, errors.New("jwks url is empty")
+	}
+	if cfg.Issuer == "" {
+		return nil, errors.New("issuer is empty")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("audience is empty")
+	}
+	if cfg.EmailClaim == "" {
+		cfg.EmailClaim = "email"
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+		jwt.WithIssuer(cfg.Issuer),
+		jwt.WithAudience(cfg.Audience),
+	}
+
+	v := &Verifier{
+		config: cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+		parser: jwt.NewParser(opts...),
+	}
+	if err := v.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Verify returns the user identified by the token, with the email and the
+// provider read from its claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (user.User, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	}); err != nil {
+		return user.User{}, err
+	}
+
+	email, _ := claims[v.config.EmailClaim].(string)
+	if email == "" {
+		return user.User{}, ErrMissingEmail
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return user.User{}, ErrEmailNotVerified
+	}
+
+	usr := user.User{Email: email}
+	if v.config.ProviderClaim != "" {
+		usr.Provider, _ = claims[v.config.ProviderClaim].(string)
+	}
+	if v.config.GroupsClaim != "" {
+		usr.Groups = groupsFromClaim(claims[v.config.GroupsClaim])
+	}
+	return usr, nil
+}
+
+func groupsFromClaim(claim interface{}) []string {
+	var groups []string
+	switch c := claim.(type) {
+	case []interface{}:
+		for _, g := range c {
+			if s, ok := g.(string); ok && s != "" {
+				groups = append(groups, s)
+			}
+		}
+	case string:
+		for _, g := range strings.Split(c, ",") {
+			if g = strings.TrimSpace(g); g != "" {
+				groups = append(groups, g)
+			}
+		}
+	}
+	return groups
+}
+
+// key returns the key with the id, fetching the key set again when it is
+// stale or does not have the key. The cached keys are used when the key set
+// can not be fetched.
+func (v *Verifier) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	age := time.Since(v.fetchedAt)
+	key, ok := v.lookup(kid)
+	v.mu.Unlock()
+
+	if (ok && age > v.config.JWKSRefreshInterval && v.config.JWKSRefreshInterval > 0) ||
+		(!ok && age > minRefetchInterval) {
+		if err := v.refreshKeys(ctx); err == nil {
+			v.mu.Lock()
+			key, ok = v.lookup(kid)
+			v.mu.Unlock()
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// lookup finds the key by its id, a token without id can only be signed with
+// the single key of the set. It must be called with the mutex held.
+func (v *Verifier) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+// refreshKeys fetches the key set without holding the mutex, for the
+// requests to keep verifying with the cached keys meanwhile, and swaps it
+// in once fetched.
+func (v *Verifier) refreshKeys(ctx context.Context) error {
+	_, err, _ := v.fetches.Do("jwks", func() (interface{}, error) {
+		keys, err := fetchKeys(ctx, v.client, v.config.JWKSURL)
+
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		v.fetchedAt = time.Now()
+		if err != nil {
+			return nil, err
+		}
+		v.keys = keys
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,367 @@
+package jwtauth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/goto/compass/core/user"
+	"github.com/goto/compass/pkg/jwtauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer   = "https://accounts.example.com"
+	audience = "compass"
+)
+
+type signingKey struct {
+	kid string
+	key crypto.Signer
+}
+
+func newRSAKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return signingKey{kid: kid, key: key}
+}
+
+func newECKey(t *testing.T, kid string) signingKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return signingKey{kid: kid, key: key}
+}
+
+func (k signingKey) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	method := jwt.SigningMethod(jwt.SigningMethodRS256)
+	if _, ok := k.key.(*ecdsa.PrivateKey); ok {
+		method = jwt.SigningMethodES256
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = k.kid
+
+	signed, err := token.SignedString(k.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (k signingKey) jwk() map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := k.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA", "kid": k.kid, "use": "sig",
+			"n": enc(pub.N.Bytes()), "e": enc(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC", "kid": k.kid, "crv": "P-256",
+			"x": enc(pub.X.FillBytes(make([]byte, 32))), "y": enc(pub.Y.FillBytes(make([]byte, 32))),
+		}
+	}
+	return nil
+}
+
+func jwksOf(t *testing.T, keys ...signingKey) []byte {
+	t.Helper()
+
+	set := map[string][]map[string]string{"keys": {{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"}}}
+	for _, k := range keys {
+		set["keys"] = append(set["keys"], k.jwk())
+	}
+	b, err := json.Marshal(set)
+	require.NoError(t, err)
+	return b
+}
+
+func writeJWKS(t *testing.T, keys ...signingKey) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksOf(t, keys...), 0o600))
+	return path
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   issuer,
+		"aud":   audience,
+		"sub":   "1234",
+		"email": "user@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+	unknownKey := newRSAKey(t, "rsa-1")
+
+	v, err := jwtauth.NewVerifier(ctx, jwtauth.Config{
+		JWKSURL:       writeJWKS(t, rsaKey, ecKey),
+		Issuer:        issuer,
+		Audience:      audience,
+		EmailClaim:    "email",
+		ProviderClaim: "iss",
+		GroupsClaim:   "groups",
+	})
+	require.NoError(t, err)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	with := func(key string, value interface{}) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	cases := []struct {
+		Description string
+		Token       string
+		Expected    user.User
+		ExpectedErr string
+	}{
+		{
+			Description: "should return user of token signed with rsa key",
+			Token:       rsaKey.sign(t, validClaims()),
+			Expected:    user.User{Email: "user@example.com", Provider: issuer},
+		},
+		{
+			Description: "should return user of token signed with ec key",
+			Token:       ecKey.sign(t, validClaims()),
+			Expected:    user.User{Email: "user@example.com", Provider: issuer},
+		},
+		{
+			Description: "should return groups of user from list claim",
+			Token:       rsaKey.sign(t, with("groups", []string{"finance", "hr"})),
+			Expected:    user.User{Email: "user@example.com", Provider: issuer, Groups: []string{"finance", "hr"}},
+		},
+		{
+			Description: "should return groups of user from comma separated claim",
+			Token:       rsaKey.sign(t, with("groups", "finance, hr")),
+			Expected:    user.User{Email: "user@example.com", Provider: issuer, Groups: []string{"finance", "hr"}},
+		},
+		{
+			Description: "should return error if signature does not match key",
+			Token:       unknownKey.sign(t, validClaims()),
+			ExpectedErr: "token signature is invalid",
+		},
+		{
+			Description: "should return error if key is unknown",
+			Token:       signingKey{kid: "rsa-2", key: unknownKey.key}.sign(t, validClaims()),
+			ExpectedErr: `unknown signing key "rsa-2"`,
+		},
+		{
+			Description: "should return error if issuer does not match",
+			Token:       rsaKey.sign(t, with("iss", "https://evil.example.com")),
+			ExpectedErr: "token has invalid issuer",
+		},
+		{
+			Description: "should return error if audience does not match",
+			Token:       rsaKey.sign(t, with("aud", "other")),
+			ExpectedErr: "token has invalid audience",
+		},
+		{
+			Description: "should return error if token is expired",
+			Token:       rsaKey.sign(t, with("exp", time.Now().Add(-time.Hour).Unix())),
+			ExpectedErr: "token is expired",
+		},
+		{
+			Description: "should return error if token does not expire",
+			Token:       rsaKey.sign(t, with("exp", nil)),
+			ExpectedErr: "exp claim is required",
+		},
+		{
+			Description: "should return error if email is missing",
+			Token:       rsaKey.sign(t, with("email", nil)),
+			ExpectedErr: jwtauth.ErrMissingEmail.Error(),
+		},
+		{
+			Description: "should return error if email is not verified",
+			Token:       rsaKey.sign(t, with("email_verified", false)),
+			ExpectedErr: jwtauth.ErrEmailNotVerified.Error(),
+		},
+		{
+			Description: "should return error if token is not signed",
+			Token:       unsigned,
+			ExpectedErr: "signing method none is invalid",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			actual, err := v.Verify(ctx, tc.Token)
+			if tc.ExpectedErr != "" {
+				assert.ErrorContains(t, err, tc.ExpectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	t.Run("should return error if jwks can not be read", func(t *testing.T) {
+		_, err := jwtauth.NewVerifier(context.Background(), jwtauth.Config{
+			JWKSURL:  filepath.Join(t.TempDir(), "missing.json"),
+			Issuer:   issuer,
+			Audience: audience,
+		})
+		assert.ErrorContains(t, err, "read jwks")
+	})
+
+	t.Run("should return error if jwks has no signing key", func(t *testing.T) {
+		_, err := jwtauth.NewVerifier(context.Background(), jwtauth.Config{
+			JWKSURL:  writeJWKS(t),
+			Issuer:   issuer,
+			Audience: audience,
+		})
+		assert.EqualError(t, err, "jwks has no signing key")
+	})
+
+	t.Run("should return error if issuer is empty", func(t *testing.T) {
+		_, err := jwtauth.NewVerifier(context.Background(), jwtauth.Config{
+			JWKSURL:  writeJWKS(t, newRSAKey(t, "rsa")),
+			Audience: audience,
+		})
+		assert.EqualError(t, err, "issuer is empty")
+	})
+
+	t.Run("should return error if audience is empty", func(t *testing.T) {
+		_, err := jwtauth.NewVerifier(context.Background(), jwtauth.Config{
+			JWKSURL: writeJWKS(t, newRSAKey(t, "rsa")),
+			Issuer:  issuer,
+		})
+		assert.EqualError(t, err, "audience is empty")
+	})
+}
+
+func TestVerifier_RefreshKeys(t *testing.T) {
+	ctx := context.Background()
+	oldKey := newRSAKey(t, "old")
+	newKey := newRSAKey(t, "new")
+
+	var (
+		jwks    atomic.Value
+		fetches atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(jwks.Load().([]byte))
+	}))
+	defer srv.Close()
+
+	t.Run("should not fetch keys again right away for unknown key", func(t *testing.T) {
+		jwks.Store(jwksOf(t, oldKey))
+		fetches.Store(0)
+
+		v, err := jwtauth.NewVerifier(ctx, jwtauth.Config{
+			JWKSURL:             srv.URL,
+			Issuer:              issuer,
+			Audience:            audience,
+			JWKSRefreshInterval: time.Hour,
+		})
+		require.NoError(t, err)
+
+		_, err = v.Verify(ctx, oldKey.sign(t, validClaims()))
+		assert.NoError(t, err)
+
+		jwks.Store(jwksOf(t, newKey))
+		_, err = v.Verify(ctx, newKey.sign(t, validClaims()))
+		assert.ErrorContains(t, err, `unknown signing key "new"`)
+		assert.EqualValues(t, 1, fetches.Load())
+	})
+
+	t.Run("should pick up rotated keys once stale", func(t *testing.T) {
+		jwks.Store(jwksOf(t, oldKey))
+		fetches.Store(0)
+
+		v, err := jwtauth.NewVerifier(ctx, jwtauth.Config{
+			JWKSURL:             srv.URL,
+			Issuer:              issuer,
+			Audience:            audience,
+			JWKSRefreshInterval: time.Nanosecond,
+		})
+		require.NoError(t, err)
+
+		jwks.Store(jwksOf(t, newKey))
+		_, err = v.Verify(ctx, oldKey.sign(t, validClaims()))
+		assert.ErrorContains(t, err, `unknown signing key "old"`)
+
+		_, err = v.Verify(ctx, newKey.sign(t, validClaims()))
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, fetches.Load(), int32(2))
+	})
+	t.Run("should not block verifications on a fetch of the key set", func(t *testing.T) {
+		body := jwksOf(t, oldKey)
+		var gated atomic.Bool
+		fetching := make(chan struct{})
+		release := make(chan struct{})
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if gated.CompareAndSwap(true, false) {
+				close(fetching)
+				<-release
+			}
+			_, _ = w.Write(body)
+		}))
+		defer slow.Close()
+
+		v, err := jwtauth.NewVerifier(ctx, jwtauth.Config{
+			JWKSURL:             slow.URL,
+			Issuer:              issuer,
+			Audience:            audience,
+			JWKSRefreshInterval: 50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+
+		oldToken, newToken := oldKey.sign(t, validClaims()), newKey.sign(t, validClaims())
+		gated.Store(true)
+		stale := make(chan error, 1)
+		go func() {
+			_, err := v.Verify(ctx, oldToken)
+			stale <- err
+		}()
+		<-fetching
+
+		unknown := make(chan error, 1)
+		go func() {
+			_, err := v.Verify(ctx, newToken)
+			unknown <- err
+		}()
+		select {
+		case err := <-unknown:
+			assert.ErrorContains(t, err, `unknown signing key "new"`)
+		case <-time.After(time.Second):
+			t.Error("verification waited for the fetch of the key set")
+		}
+
+		close(release)
+		assert.NoError(t, <-stale)
+	})
+}
@@ -0,0 +1,159 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// maxBuckets bounds the buckets kept in memory, the least recently taken
+	// from being dropped first once it is reached.
+	maxBuckets = 10000
+	// maxPeerBuckets bounds the buckets created by the calls of a single
+	// client address, its own least recently taken from being dropped first,
+	// for a client cycling made up subjects not to push out the buckets of
+	// the others.
+	maxPeerBuckets = 1000
+	// pruneInterval is how often the idle buckets are dropped.
+	pruneInterval = time.Minute
+)
+
+type bucket struct {
+	key string
+	// peer is the address of the client the bucket was created for, and
+	// peerEl its element in the buckets of the peer
+	peer      string
+	peerEl    *list.Element
+	tokens    float64
+	limit     Limit
+	updatedAt time.Time
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed > 0 {
+		b.tokens = min(b.tokens+elapsed*b.limit.Rate, float64(b.limit.Burst))
+		b.updatedAt = now
+	}
+}
+
+// idle tells whether the bucket was not taken from for as long as it takes
+// to refill, a full bucket being the same as a new one.
+func (b *bucket) idle(now time.Time) bool {
+	return now.Sub(b.updatedAt).Seconds()*b.limit.Rate >= float64(b.limit.Burst)
+}
+
+type peerContextKey struct{}
+
+// WithPeer returns the context of a call made from the address of a client,
+// the memory store capping the buckets created for each address. The calls
+// without address are not capped.
+func WithPeer(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, peerContextKey{}, addr)
+}
+
+func peerFromContext(ctx context.Context) string {
+	addr, _ := ctx.Value(peerContextKey{}).(string)
+	return addr
+}
+
+// MemoryStore keeps the token buckets in memory, each instance of the server
+// limiting the calls it serves on its own.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*list.Element
+	lru     *list.List
+	// peers holds the elements of lru of the buckets created for each client
+	// address, least recently taken from last
+	peers    map[string]*list.List
+	prunedAt time.Time
+	now      func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*list.Element),
+		lru:     list.New(),
+		peers:   make(map[string]*list.List),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit) (time.Duration, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.prunedAt) >= pruneInterval {
+		s.prune(now)
+	}
+
+	el, ok := s.buckets[key]
+	if ok {
+		s.lru.MoveToFront(el)
+		if b := el.Value.(*bucket); b.peerEl != nil {
+			s.peers[b.peer].MoveToFront(b.peerEl)
+		}
+	} else {
+		el = s.add(peerFromContext(ctx), &bucket{key: key, tokens: float64(limit.Burst), updatedAt: now})
+	}
+	b := el.Value.(*bucket)
+	b.limit = limit
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true, nil
+	}
+	return limit.RetryAfter(b.tokens), false, nil
+}
+
+// add adds the bucket created for the peer, dropping the least recently
+// taken from bucket of the peer, or else of all, when over the caps.
+func (s *MemoryStore) add(peer string, b *bucket) *list.Element {
+	peerBuckets := s.peers[peer]
+	switch {
+	case peer != "" && peerBuckets != nil && peerBuckets.Len() >= maxPeerBuckets:
+		s.drop(peerBuckets.Back().Value.(*list.Element))
+	case s.lru.Len() >= maxBuckets:
+		s.drop(s.lru.Back())
+	}
+
+	el := s.lru.PushFront(b)
+	s.buckets[b.key] = el
+	if peer != "" {
+		if peerBuckets = s.peers[peer]; peerBuckets == nil {
+			peerBuckets = list.New()
+			s.peers[peer] = peerBuckets
+		}
+		b.peer = peer
+		b.peerEl = peerBuckets.PushFront(el)
+	}
+	return el
+}
+
+func (s *MemoryStore) prune(now time.Time) {
+	for el := s.lru.Back(); el != nil; {
+		prev := el.Prev()
+		if el.Value.(*bucket).idle(now) {
+			s.drop(el)
+		}
+		el = prev
+	}
+	s.prunedAt = now
+}
+
+func (s *MemoryStore) drop(el *list.Element) {
+	b := el.Value.(*bucket)
+	delete(s.buckets, b.key)
+	s.lru.Remove(el)
+	if b.peerEl != nil {
+		peerBuckets := s.peers[b.peer]
+		peerBuckets.Remove(b.peerEl)
+		if peerBuckets.Len() == 0 {
+			delete(s.peers, b.peer)
+		}
+	}
+}
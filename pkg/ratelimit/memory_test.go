@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	limit := Limit{Rate: 2, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		_, allowed, err := s.Take(ctx, "key", limit)
+		assert.NoError(t, err)
+		assert.True(t, allowed, "call %d of the burst", i)
+	}
+
+	retryAfter, allowed, err := s.Take(ctx, "key", limit)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	_, allowed, _ = s.Take(ctx, "other", limit)
+	assert.True(t, allowed, "buckets are per key")
+
+	now = now.Add(250 * time.Millisecond)
+	retryAfter, allowed, _ = s.Take(ctx, "key", limit)
+	assert.False(t, allowed)
+	assert.Equal(t, 250*time.Millisecond, retryAfter)
+
+	now = now.Add(250 * time.Millisecond)
+	_, allowed, _ = s.Take(ctx, "key", limit)
+	assert.True(t, allowed, "bucket refilled at the rate")
+
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		_, allowed, _ = s.Take(ctx, "key", limit)
+		assert.True(t, allowed, "bucket refilled up to the burst")
+	}
+	_, allowed, _ = s.Take(ctx, "key", limit)
+	assert.False(t, allowed)
+}
+
+func TestMemoryStore_PrunesIdleBuckets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+
+	_, _, _ = s.Take(ctx, "slow", Limit{Rate: 0.001, Burst: 1})
+	_, _, _ = s.Take(ctx, "fast", Limit{Rate: 1, Burst: 1})
+	assert.Len(t, s.buckets, 2)
+
+	now = now.Add(pruneInterval)
+	_, _, _ = s.Take(ctx, "new", Limit{Rate: 1, Burst: 1})
+	assert.Contains(t, s.buckets, "slow", "bucket still refilling")
+	assert.NotContains(t, s.buckets, "fast", "bucket refilled")
+	assert.Len(t, s.buckets, 2)
+}
+
+func TestMemoryStore_DropsLeastRecentlyUsedBuckets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	limit := Limit{Rate: 0.001, Burst: 1}
+
+	for i := 0; i < maxBuckets; i++ {
+		_, _, _ = s.Take(ctx, strconv.Itoa(i), limit)
+	}
+	_, allowed, _ := s.Take(ctx, "0", limit)
+	assert.False(t, allowed)
+
+	_, allowed, _ = s.Take(ctx, "new", limit)
+	assert.True(t, allowed)
+	assert.Len(t, s.buckets, maxBuckets)
+	assert.Contains(t, s.buckets, "0", "recently used bucket kept")
+	assert.NotContains(t, s.buckets, "1")
+}
+
+func TestMemoryStore_CapsBucketsPerPeer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	limit := Limit{Rate: 0.001, Burst: 1}
+
+	userCtx := WithPeer(ctx, "10.0.0.1")
+	_, allowed, _ := s.Take(userCtx, "user@example.com", limit)
+	assert.True(t, allowed)
+
+	callerCtx := WithPeer(ctx, "10.0.0.2")
+	for i := 0; i < maxBuckets; i++ {
+		_, _, _ = s.Take(callerCtx, "made-up-"+strconv.Itoa(i)+"@example.com", limit)
+	}
+	assert.Len(t, s.buckets, maxPeerBuckets+1)
+	assert.NotContains(t, s.buckets, "made-up-0@example.com", "own bucket dropped")
+
+	_, allowed, _ = s.Take(userCtx, "user@example.com", limit)
+	assert.False(t, allowed, "bucket of the other peer kept")
+
+	now = now.Add(time.Hour)
+	_, _, _ = s.Take(ctx, "new", limit)
+	assert.Empty(t, s.peers, "buckets of the peers pruned")
+}
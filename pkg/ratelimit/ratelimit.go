@@ -0,0 +1,157 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+)
+
+var ErrInvalidRate = errors.New("rate limit rule rate must be positive")
+
+type Config struct {
+	// Enabled limits the rate of the calls matching the rules.
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	// Shared keeps the token buckets in postgres, for the limits to hold
+	// across the instances of the server, rather than in memory.
+	Shared bool `yaml:"shared" mapstructure:"shared" default:"false"`
+	// Rules are matched in order, the first one matching the user and the
+	// method of a call limiting it. The calls matching no rule are not
+	// limited.
+	Rules []Rule `yaml:"rules" mapstructure:"rules"`
+}
+
+// Rule limits the calls of each user to each method, with a token bucket of
+// its own for every user and method.
+type Rule struct {
+	// Users are the emails the rule applies to, service accounts included,
+	// all the users and anonymous clients if empty.
+	Users []string `yaml:"users" mapstructure:"users"`
+	// Methods are the names of the methods the rule applies to, like
+	// UpsertPatchAsset, all the methods if empty.
+	Methods []string `yaml:"methods" mapstructure:"methods"`
+	// Rate is the number of calls allowed per second in the long run.
+	Rate float64 `yaml:"rate" mapstructure:"rate"`
+	// Burst is the number of calls allowed at once, the rate rounded up if
+	// not set.
+	Burst int `yaml:"burst" mapstructure:"burst"`
+}
+
+func (r Rule) matches(subject, method string) bool {
+	return matchesAny(r.Users, subject) && matchesAny(r.Methods, method)
+}
+
+func (r Rule) limit() Limit {
+	burst := r.Burst
+	if burst < 1 {
+		burst = max(int(math.Ceil(r.Rate)), 1)
+	}
+	return Limit{Rate: r.Rate, Burst: burst}
+}
+
+func matchesAny(values []string, s string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Limit is the refill rate, per second, and the size of a token bucket.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// RetryAfter returns the time for a bucket holding the tokens to hold a whole
+// token again.
+func (l Limit) RetryAfter(tokens float64) time.Duration {
+	return time.Duration(math.Ceil((1 - tokens) / l.Rate * float64(time.Second)))
+}
+
+// Store keeps the token buckets.
+type Store interface {
+	// Take takes a token from the bucket of the key, full when first taken
+	// from. It returns the time to wait for a token when the bucket is empty.
+	Take(ctx context.Context, key string, limit Limit) (retryAfter time.Duration, allowed bool, err error)
+}
+
+// Pruner is a store dropping the buckets not taken from for as long as they
+// take to refill, which are the same as new ones.
+type Pruner interface {
+	Prune(ctx context.Context) (pruned int64, err error)
+}
+
+// Prune prunes the buckets of the store every interval, until the context is
+// done.
+func Prune(ctx context.Context, p Pruner, interval time.Duration, logger log.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pruned, err := p.Prune(ctx)
+		if err != nil {
+			logger.Warn("failed to prune rate limit buckets", "err", err)
+			continue
+		}
+		logger.Debug("rate limit buckets pruned", "count", pruned)
+	}
+}
+
+// Limiter limits the rate of the calls of the users with the rules of the
+// config.
+type Limiter struct {
+	rules  []Rule
+	store  Store
+	logger log.Logger
+}
+
+// New creates the limiter, failing on a rule without a positive rate.
+func New(cfg Config, store Store, logger log.Logger) (*Limiter, error) {
+	for i, r := range cfg.Rules {
+		if r.Rate <= 0 || math.IsInf(r.Rate, 0) || math.IsNaN(r.Rate) {
+			return nil, fmt.Errorf("rule %d: %w", i, ErrInvalidRate)
+		}
+	}
+	return &Limiter{
+		rules:  cfg.Rules,
+		store:  store,
+		logger: logger,
+	}, nil
+}
+
+// Allow tells whether the subject, the email of the user or the address of
+// an anonymous client, may call the method now, and when to retry otherwise.
+// The calls are let through when the store fails.
+func (l *Limiter) Allow(ctx context.Context, subject, fullMethod string) (time.Duration, bool) {
+	method := path.Base(fullMethod)
+	for i, r := range l.rules {
+		if !r.matches(subject, method) {
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s/%s", i, strings.ToLower(subject), method)
+		retryAfter, allowed, err := l.store.Take(ctx, key, r.limit())
+		if err != nil {
+			l.logger.Warn("failed to take rate limit token, call let through", "key", key, "err", err)
+			return 0, true
+		}
+		return retryAfter, allowed
+	}
+	return 0, true
+}
@@ -0,0 +1,114 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/compass/pkg/ratelimit"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type take struct {
+	key   string
+	limit ratelimit.Limit
+}
+
+// recordingStore records the takes and denies them all
+type recordingStore struct {
+	takes []take
+	err   error
+}
+
+func (s *recordingStore) Take(_ context.Context, key string, limit ratelimit.Limit) (time.Duration, bool, error) {
+	s.takes = append(s.takes, take{key: key, limit: limit})
+	return time.Second, false, s.err
+}
+
+func TestNew(t *testing.T) {
+	_, err := ratelimit.New(ratelimit.Config{Rules: []ratelimit.Rule{{Rate: 10}, {Rate: 0}}}, ratelimit.NewMemoryStore(), log.NewNoop())
+	assert.ErrorIs(t, err, ratelimit.ErrInvalidRate)
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	const method = "/gotocompany.compass.v1beta1.CompassService/UpsertPatchAsset"
+	cfg := ratelimit.Config{
+		Rules: []ratelimit.Rule{
+			{Users: []string{"Ingestion@serviceaccount.compass"}, Methods: []string{"UpsertPatchAsset"}, Rate: 50, Burst: 100},
+			{Methods: []string{"UpsertPatchAsset", "UpsertAsset"}, Rate: 2.5},
+		},
+	}
+
+	cases := []struct {
+		Description string
+		Subject     string
+		Method      string
+		StoreErr    error
+		Expected    []take
+		Allowed     bool
+	}{
+		{
+			Description: "should take from the bucket of the first matching rule",
+			Subject:     "ingestion@serviceaccount.compass",
+			Method:      method,
+			Expected:    []take{{key: "0/ingestion@serviceaccount.compass/UpsertPatchAsset", limit: ratelimit.Limit{Rate: 50, Burst: 100}}},
+		},
+		{
+			Description: "should default the burst to the rate rounded up",
+			Subject:     "john.doe@example.com",
+			Method:      method,
+			Expected:    []take{{key: "1/john.doe@example.com/UpsertPatchAsset", limit: ratelimit.Limit{Rate: 2.5, Burst: 3}}},
+		},
+		{
+			Description: "should allow the calls matching no rule",
+			Subject:     "john.doe@example.com",
+			Method:      "/gotocompany.compass.v1beta1.CompassService/GetAllAssets",
+			Allowed:     true,
+		},
+		{
+			Description: "should allow the call if the store fails",
+			Subject:     "john.doe@example.com",
+			Method:      method,
+			StoreErr:    errors.New("db down"),
+			Expected:    []take{{key: "1/john.doe@example.com/UpsertPatchAsset", limit: ratelimit.Limit{Rate: 2.5, Burst: 3}}},
+			Allowed:     true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			store := &recordingStore{err: tc.StoreErr}
+			limiter, err := ratelimit.New(cfg, store, log.NewNoop())
+			require.NoError(t, err)
+
+			_, allowed := limiter.Allow(context.Background(), tc.Subject, tc.Method)
+			assert.Equal(t, tc.Allowed, allowed)
+			assert.Equal(t, tc.Expected, store.takes)
+		})
+	}
+}
+
+// countingPruner counts the prunes and cancels the context on the last one
+type countingPruner struct {
+	prunes int
+	last   int
+	cancel context.CancelFunc
+}
+
+func (p *countingPruner) Prune(context.Context) (int64, error) {
+	p.prunes++
+	if p.prunes == p.last {
+		p.cancel()
+	}
+	return 1, errors.New("fail")
+}
+
+func TestPrune(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &countingPruner{last: 3, cancel: cancel}
+
+	ratelimit.Prune(ctx, p, time.Millisecond, log.NewNoop())
+	assert.Equal(t, 3, p.prunes, "pruned every interval despite the failures")
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"strings"
@@ -27,6 +28,11 @@ type JobSpec struct {
 	Type    string    `json:"type"`
 	Payload []byte    `json:"args"`
 	RunAt   time.Time `json:"run_at"`
+
+	// Key, when set, makes the job unique among the jobs of its type run at
+	// the same time: enqueueing it again while it is queued fails with
+	// ErrJobExists.
+	Key string `json:"-"`
 }
 
 // Job represents the specification for async processing and also
@@ -61,14 +67,29 @@ func NewJob(j JobSpec) (Job, error) {
 	if j.RunAt.IsZero() {
 		j.RunAt = now
 	}
+	id := ulid.Make()
+	if j.Key != "" {
+		id = keyedJobID(j)
+	}
 	return Job{
-		ID:        ulid.Make(),
+		ID:        id,
 		JobSpec:   j,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}, nil
 }
 
+// keyedJobID derives the ID of the job from its type, key and run time, for
+// the same keyed job to always have the same ID.
+func keyedJobID(j JobSpec) ulid.ULID {
+	sum := sha256.Sum256([]byte(j.Type + "/" + j.Key))
+
+	var id ulid.ULID
+	_ = id.SetTime(ulid.Timestamp(j.RunAt))
+	_ = id.SetEntropy(sum[:10])
+	return id
+}
+
 // Attempt attempts to safely invoke the handler for this job. Handles success,
 // failure and panic scenarios and updates the job with result in-place.
 func (j *Job) Attempt(baseCtx context.Context, now time.Time, h JobHandler) {
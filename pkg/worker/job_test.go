@@ -206,3 +206,31 @@ func TestJob_Attempt(t *testing.T) {
 		})
 	}
 }
+
+func TestNewJob(t *testing.T) {
+	runAt := time.Unix(1654081526, 0)
+
+	t.Run("UniqueIDs", func(t *testing.T) {
+		j1, err := worker.NewJob(worker.JobSpec{Type: "test", RunAt: runAt})
+		assert.NoError(t, err)
+		j2, err := worker.NewJob(worker.JobSpec{Type: "test", RunAt: runAt})
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, j1.ID, j2.ID)
+	})
+
+	t.Run("KeyedIDs", func(t *testing.T) {
+		j1, err := worker.NewJob(worker.JobSpec{Type: "test", RunAt: runAt, Key: "scheduled"})
+		assert.NoError(t, err)
+		j2, err := worker.NewJob(worker.JobSpec{Type: " Test ", RunAt: runAt, Key: "scheduled"})
+		assert.NoError(t, err)
+		j3, err := worker.NewJob(worker.JobSpec{Type: "test", RunAt: runAt.Add(time.Hour), Key: "scheduled"})
+		assert.NoError(t, err)
+		j4, err := worker.NewJob(worker.JobSpec{Type: "other", RunAt: runAt, Key: "scheduled"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, j1.ID, j2.ID)
+		assert.NotEqual(t, j1.ID, j3.ID)
+		assert.NotEqual(t, j1.ID, j4.ID)
+	})
+}
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _service_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on GetAllDiscussionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3134,6 +3137,10 @@ func (m *SearchFlags) validate(all bool) error {
 
 	// no validation rules for EnableHighlight
 
+	// no validation rules for EnableSemantic
+
+	// no validation rules for EnableExplain
+
 	if len(errors) > 0 {
 		return SearchFlagsMultiError(errors)
 	}
@@ -3267,6 +3274,40 @@ func (m *SearchAssetsResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchAssetsResponseValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchAssetsResponseValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchAssetsResponseValidationError{
+					field:  fmt.Sprintf("Details[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SearchAssetsResponseMultiError(errors)
 	}
@@ -4456,60 +4497,63 @@ var _ interface {
 	ErrorName() string
 } = GetAllTypesResponseValidationError{}
 
-// Validate checks the field values on GetAllAssetsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllAssetsRequest) Validate() error {
+// Validate checks the field values on CreateTypeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllAssetsRequest with the rules
+// ValidateAll checks the field values on CreateTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllAssetsRequestMultiError, or nil if none found.
-func (m *GetAllAssetsRequest) ValidateAll() error {
+// CreateTypeRequestMultiError, or nil if none found.
+func (m *CreateTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllAssetsRequest) validate(all bool) error {
+func (m *CreateTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetQ() != "" {
-
-	}
-
-	if m.GetQFields() != "" {
-
-	}
-
-	if m.GetTypes() != "" {
-
-	}
-
-	if m.GetServices() != "" {
-
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 16 {
+		err := CreateTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 16 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetSort() != "" {
-
+	if !_CreateTypeRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateTypeRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetDirection() != "" {
+	// no validation rules for DisplayName
 
-	}
+	// no validation rules for Icon
 
-	// no validation rules for Data
+	// no validation rules for Description
 
-	if m.GetSize() != 0 {
+	for idx, item := range m.GetRequiredFields() {
+		_, _ = idx, item
 
-		if m.GetSize() < 0 {
-			err := GetAllAssetsRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreateTypeRequestValidationError{
+				field:  fmt.Sprintf("RequiredFields[%v]", idx),
+				reason: "value length must be at least 1 runes",
 			}
 			if !all {
 				return err
@@ -4519,39 +4563,31 @@ func (m *GetAllAssetsRequest) validate(all bool) error {
 
 	}
 
-	if m.GetOffset() != 0 {
-
-		if m.GetOffset() < 0 {
-			err := GetAllAssetsRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if m.GetSearchBoost() < 0 {
+		err := CreateTypeRequestValidationError{
+			field:  "SearchBoost",
+			reason: "value must be greater than or equal to 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for WithTotal
-
-	// no validation rules for IsDeleted
-
 	if len(errors) > 0 {
-		return GetAllAssetsRequestMultiError(errors)
+		return CreateTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllAssetsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAllAssetsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAllAssetsRequestMultiError []error
+// CreateTypeRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTypeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllAssetsRequestMultiError) Error() string {
+func (m CreateTypeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4560,11 +4596,11 @@ func (m GetAllAssetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllAssetsRequestMultiError) AllErrors() []error { return m }
+func (m CreateTypeRequestMultiError) AllErrors() []error { return m }
 
-// GetAllAssetsRequestValidationError is the validation error returned by
-// GetAllAssetsRequest.Validate if the designated constraints aren't met.
-type GetAllAssetsRequestValidationError struct {
+// CreateTypeRequestValidationError is the validation error returned by
+// CreateTypeRequest.Validate if the designated constraints aren't met.
+type CreateTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4572,24 +4608,24 @@ type GetAllAssetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllAssetsRequestValidationError) Field() string { return e.field }
+func (e CreateTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllAssetsRequestValidationError) Reason() string { return e.reason }
+func (e CreateTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllAssetsRequestValidationError) Cause() error { return e.cause }
+func (e CreateTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllAssetsRequestValidationError) Key() bool { return e.key }
+func (e CreateTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllAssetsRequestValidationError) ErrorName() string {
-	return "GetAllAssetsRequestValidationError"
+func (e CreateTypeRequestValidationError) ErrorName() string {
+	return "CreateTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllAssetsRequestValidationError) Error() string {
+func (e CreateTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4601,14 +4637,14 @@ func (e GetAllAssetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllAssetsRequest.%s: %s%s",
+		"invalid %sCreateTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllAssetsRequestValidationError{}
+var _ error = CreateTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4616,80 +4652,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllAssetsRequestValidationError{}
+} = CreateTypeRequestValidationError{}
 
-// Validate checks the field values on GetAllAssetsResponse with the rules
+var _CreateTypeRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on CreateTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllAssetsResponse) Validate() error {
+func (m *CreateTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllAssetsResponse with the rules
+// ValidateAll checks the field values on CreateTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllAssetsResponseMultiError, or nil if none found.
-func (m *GetAllAssetsResponse) ValidateAll() error {
+// CreateTypeResponseMultiError, or nil if none found.
+func (m *CreateTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllAssetsResponse) validate(all bool) error {
+func (m *CreateTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTypeResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetAllAssetsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, CreateTypeResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTypeResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return GetAllAssetsResponseMultiError(errors)
+		return CreateTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllAssetsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAllAssetsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAllAssetsResponseMultiError []error
+// CreateTypeResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTypeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllAssetsResponseMultiError) Error() string {
+func (m CreateTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4698,11 +4729,11 @@ func (m GetAllAssetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllAssetsResponseMultiError) AllErrors() []error { return m }
+func (m CreateTypeResponseMultiError) AllErrors() []error { return m }
 
-// GetAllAssetsResponseValidationError is the validation error returned by
-// GetAllAssetsResponse.Validate if the designated constraints aren't met.
-type GetAllAssetsResponseValidationError struct {
+// CreateTypeResponseValidationError is the validation error returned by
+// CreateTypeResponse.Validate if the designated constraints aren't met.
+type CreateTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4710,24 +4741,24 @@ type GetAllAssetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllAssetsResponseValidationError) Field() string { return e.field }
+func (e CreateTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllAssetsResponseValidationError) Reason() string { return e.reason }
+func (e CreateTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllAssetsResponseValidationError) Cause() error { return e.cause }
+func (e CreateTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllAssetsResponseValidationError) Key() bool { return e.key }
+func (e CreateTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllAssetsResponseValidationError) ErrorName() string {
-	return "GetAllAssetsResponseValidationError"
+func (e CreateTypeResponseValidationError) ErrorName() string {
+	return "CreateTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllAssetsResponseValidationError) Error() string {
+func (e CreateTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4739,14 +4770,14 @@ func (e GetAllAssetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllAssetsResponse.%s: %s%s",
+		"invalid %sCreateTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllAssetsResponseValidationError{}
+var _ error = CreateTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4754,46 +4785,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllAssetsResponseValidationError{}
+} = CreateTypeResponseValidationError{}
 
-// Validate checks the field values on GetAssetByIDRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetByIDRequest) Validate() error {
+// Validate checks the field values on GetTypeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetByIDRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAssetByIDRequestMultiError, or nil if none found.
-func (m *GetAssetByIDRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetTypeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTypeRequestMultiError,
+// or nil if none found.
+func (m *GetTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetByIDRequest) validate(all bool) error {
+func (m *GetTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GetTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetAssetByIDRequestMultiError(errors)
+		return GetTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetByIDRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAssetByIDRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAssetByIDRequestMultiError []error
+// GetTypeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTypeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetByIDRequestMultiError) Error() string {
+func (m GetTypeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4802,11 +4842,11 @@ func (m GetAssetByIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetByIDRequestMultiError) AllErrors() []error { return m }
+func (m GetTypeRequestMultiError) AllErrors() []error { return m }
 
-// GetAssetByIDRequestValidationError is the validation error returned by
-// GetAssetByIDRequest.Validate if the designated constraints aren't met.
-type GetAssetByIDRequestValidationError struct {
+// GetTypeRequestValidationError is the validation error returned by
+// GetTypeRequest.Validate if the designated constraints aren't met.
+type GetTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4814,24 +4854,22 @@ type GetAssetByIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetByIDRequestValidationError) Field() string { return e.field }
+func (e GetTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetByIDRequestValidationError) Reason() string { return e.reason }
+func (e GetTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetByIDRequestValidationError) Cause() error { return e.cause }
+func (e GetTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetByIDRequestValidationError) Key() bool { return e.key }
+func (e GetTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetByIDRequestValidationError) ErrorName() string {
-	return "GetAssetByIDRequestValidationError"
-}
+func (e GetTypeRequestValidationError) ErrorName() string { return "GetTypeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAssetByIDRequestValidationError) Error() string {
+func (e GetTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4843,14 +4881,14 @@ func (e GetAssetByIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetByIDRequest.%s: %s%s",
+		"invalid %sGetTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetByIDRequestValidationError{}
+var _ error = GetTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4858,24 +4896,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetByIDRequestValidationError{}
+} = GetTypeRequestValidationError{}
 
-// Validate checks the field values on GetAssetByIDResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetByIDResponse) Validate() error {
+// Validate checks the field values on GetTypeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetByIDResponse with the rules
+// ValidateAll checks the field values on GetTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAssetByIDResponseMultiError, or nil if none found.
-func (m *GetAssetByIDResponse) ValidateAll() error {
+// GetTypeResponseMultiError, or nil if none found.
+func (m *GetTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetByIDResponse) validate(all bool) error {
+func (m *GetTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4886,7 +4924,7 @@ func (m *GetAssetByIDResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAssetByIDResponseValidationError{
+				errors = append(errors, GetTypeResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4894,7 +4932,7 @@ func (m *GetAssetByIDResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAssetByIDResponseValidationError{
+				errors = append(errors, GetTypeResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4903,7 +4941,7 @@ func (m *GetAssetByIDResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetAssetByIDResponseValidationError{
+			return GetTypeResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4912,19 +4950,19 @@ func (m *GetAssetByIDResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetAssetByIDResponseMultiError(errors)
+		return GetTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetByIDResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAssetByIDResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAssetByIDResponseMultiError []error
+// GetTypeResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTypeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetByIDResponseMultiError) Error() string {
+func (m GetTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4933,11 +4971,11 @@ func (m GetAssetByIDResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetByIDResponseMultiError) AllErrors() []error { return m }
+func (m GetTypeResponseMultiError) AllErrors() []error { return m }
 
-// GetAssetByIDResponseValidationError is the validation error returned by
-// GetAssetByIDResponse.Validate if the designated constraints aren't met.
-type GetAssetByIDResponseValidationError struct {
+// GetTypeResponseValidationError is the validation error returned by
+// GetTypeResponse.Validate if the designated constraints aren't met.
+type GetTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4945,24 +4983,22 @@ type GetAssetByIDResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetByIDResponseValidationError) Field() string { return e.field }
+func (e GetTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetByIDResponseValidationError) Reason() string { return e.reason }
+func (e GetTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetByIDResponseValidationError) Cause() error { return e.cause }
+func (e GetTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetByIDResponseValidationError) Key() bool { return e.key }
+func (e GetTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetByIDResponseValidationError) ErrorName() string {
-	return "GetAssetByIDResponseValidationError"
-}
+func (e GetTypeResponseValidationError) ErrorName() string { return "GetTypeResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetAssetByIDResponseValidationError) Error() string {
+func (e GetTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4974,14 +5010,14 @@ func (e GetAssetByIDResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetByIDResponse.%s: %s%s",
+		"invalid %sGetTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetByIDResponseValidationError{}
+var _ error = GetTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4989,143 +5025,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetByIDResponseValidationError{}
+} = GetTypeResponseValidationError{}
 
-// Validate checks the field values on UpsertAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertAssetRequest) Validate() error {
+// Validate checks the field values on UpdateTypeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertAssetRequest with the rules
+// ValidateAll checks the field values on UpdateTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertAssetRequestMultiError, or nil if none found.
-func (m *UpsertAssetRequest) ValidateAll() error {
+// UpdateTypeRequestMultiError, or nil if none found.
+func (m *UpdateTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertAssetRequest) validate(all bool) error {
+func (m *UpdateTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAsset()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertAssetRequestValidationError{
-					field:  "Asset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertAssetRequestValidationError{
-					field:  "Asset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetAsset()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertAssetRequestValidationError{
-				field:  "Asset",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetUpstreams() {
-		_, _ = idx, item
+	// no validation rules for DisplayName
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpsertAssetRequestValidationError{
-						field:  fmt.Sprintf("Upstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpsertAssetRequestValidationError{
-						field:  fmt.Sprintf("Upstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpsertAssetRequestValidationError{
-					field:  fmt.Sprintf("Upstreams[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Icon
 
-	}
+	// no validation rules for Description
 
-	for idx, item := range m.GetDownstreams() {
+	for idx, item := range m.GetRequiredFields() {
 		_, _ = idx, item
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpsertAssetRequestValidationError{
-						field:  fmt.Sprintf("Downstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpsertAssetRequestValidationError{
-						field:  fmt.Sprintf("Downstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+		if utf8.RuneCountInString(item) < 1 {
+			err := UpdateTypeRequestValidationError{
+				field:  fmt.Sprintf("RequiredFields[%v]", idx),
+				reason: "value length must be at least 1 runes",
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpsertAssetRequestValidationError{
-					field:  fmt.Sprintf("Downstreams[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
 	}
 
-	// no validation rules for UpdateOnly
+	if m.GetSearchBoost() < 0 {
+		err := UpdateTypeRequestValidationError{
+			field:  "SearchBoost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpsertAssetRequestMultiError(errors)
+		return UpdateTypeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertAssetRequestMultiError is an error wrapping multiple validation errors
-// returned by UpsertAssetRequest.ValidateAll() if the designated constraints
+// UpdateTypeRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTypeRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpsertAssetRequestMultiError []error
+type UpdateTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertAssetRequestMultiError) Error() string {
+func (m UpdateTypeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5134,11 +5115,11 @@ func (m UpsertAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertAssetRequestMultiError) AllErrors() []error { return m }
+func (m UpdateTypeRequestMultiError) AllErrors() []error { return m }
 
-// UpsertAssetRequestValidationError is the validation error returned by
-// UpsertAssetRequest.Validate if the designated constraints aren't met.
-type UpsertAssetRequestValidationError struct {
+// UpdateTypeRequestValidationError is the validation error returned by
+// UpdateTypeRequest.Validate if the designated constraints aren't met.
+type UpdateTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5146,24 +5127,24 @@ type UpsertAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertAssetRequestValidationError) Field() string { return e.field }
+func (e UpdateTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertAssetRequestValidationError) Reason() string { return e.reason }
+func (e UpdateTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertAssetRequestValidationError) Cause() error { return e.cause }
+func (e UpdateTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertAssetRequestValidationError) Key() bool { return e.key }
+func (e UpdateTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertAssetRequestValidationError) ErrorName() string {
-	return "UpsertAssetRequestValidationError"
+func (e UpdateTypeRequestValidationError) ErrorName() string {
+	return "UpdateTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertAssetRequestValidationError) Error() string {
+func (e UpdateTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5175,14 +5156,14 @@ func (e UpsertAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertAssetRequest.%s: %s%s",
+		"invalid %sUpdateTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertAssetRequestValidationError{}
+var _ error = UpdateTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5190,46 +5171,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertAssetRequestValidationError{}
+} = UpdateTypeRequestValidationError{}
 
-// Validate checks the field values on UpsertAssetResponse with the rules
+// Validate checks the field values on UpdateTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertAssetResponse) Validate() error {
+func (m *UpdateTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertAssetResponse with the rules
+// ValidateAll checks the field values on UpdateTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertAssetResponseMultiError, or nil if none found.
-func (m *UpsertAssetResponse) ValidateAll() error {
+// UpdateTypeResponseMultiError, or nil if none found.
+func (m *UpdateTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertAssetResponse) validate(all bool) error {
+func (m *UpdateTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTypeResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTypeResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTypeResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return UpsertAssetResponseMultiError(errors)
+		return UpdateTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by UpsertAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpsertAssetResponseMultiError []error
+// UpdateTypeResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateTypeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertAssetResponseMultiError) Error() string {
+func (m UpdateTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5238,11 +5246,11 @@ func (m UpsertAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertAssetResponseMultiError) AllErrors() []error { return m }
+func (m UpdateTypeResponseMultiError) AllErrors() []error { return m }
 
-// UpsertAssetResponseValidationError is the validation error returned by
-// UpsertAssetResponse.Validate if the designated constraints aren't met.
-type UpsertAssetResponseValidationError struct {
+// UpdateTypeResponseValidationError is the validation error returned by
+// UpdateTypeResponse.Validate if the designated constraints aren't met.
+type UpdateTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5250,24 +5258,24 @@ type UpsertAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertAssetResponseValidationError) Field() string { return e.field }
+func (e UpdateTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertAssetResponseValidationError) Reason() string { return e.reason }
+func (e UpdateTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertAssetResponseValidationError) Cause() error { return e.cause }
+func (e UpdateTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertAssetResponseValidationError) Key() bool { return e.key }
+func (e UpdateTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertAssetResponseValidationError) ErrorName() string {
-	return "UpsertAssetResponseValidationError"
+func (e UpdateTypeResponseValidationError) ErrorName() string {
+	return "UpdateTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertAssetResponseValidationError) Error() string {
+func (e UpdateTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5279,14 +5287,14 @@ func (e UpsertAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertAssetResponse.%s: %s%s",
+		"invalid %sUpdateTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertAssetResponseValidationError{}
+var _ error = UpdateTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5294,145 +5302,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertAssetResponseValidationError{}
+} = UpdateTypeResponseValidationError{}
 
-// Validate checks the field values on UpsertPatchAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertPatchAssetRequest) Validate() error {
+// Validate checks the field values on DeleteTypeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertPatchAssetRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertPatchAssetRequestMultiError, or nil if none found.
-func (m *UpsertPatchAssetRequest) ValidateAll() error {
+// DeleteTypeRequestMultiError, or nil if none found.
+func (m *DeleteTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertPatchAssetRequest) validate(all bool) error {
+func (m *DeleteTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAsset()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpsertPatchAssetRequestValidationError{
-					field:  "Asset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpsertPatchAssetRequestValidationError{
-					field:  "Asset",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetAsset()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpsertPatchAssetRequestValidationError{
-				field:  "Asset",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetUpstreams() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpsertPatchAssetRequestValidationError{
-						field:  fmt.Sprintf("Upstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpsertPatchAssetRequestValidationError{
-						field:  fmt.Sprintf("Upstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpsertPatchAssetRequestValidationError{
-					field:  fmt.Sprintf("Upstreams[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	for idx, item := range m.GetDownstreams() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpsertPatchAssetRequestValidationError{
-						field:  fmt.Sprintf("Downstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpsertPatchAssetRequestValidationError{
-						field:  fmt.Sprintf("Downstreams[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpsertPatchAssetRequestValidationError{
-					field:  fmt.Sprintf("Downstreams[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for OverwriteLineage
-
-	// no validation rules for UpdateOnly
-
-	if len(errors) > 0 {
-		return UpsertPatchAssetRequestMultiError(errors)
-	}
+	if len(errors) > 0 {
+		return DeleteTypeRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// UpsertPatchAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by UpsertPatchAssetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpsertPatchAssetRequestMultiError []error
+// DeleteTypeRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTypeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTypeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertPatchAssetRequestMultiError) Error() string {
+func (m DeleteTypeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5441,11 +5359,11 @@ func (m UpsertPatchAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertPatchAssetRequestMultiError) AllErrors() []error { return m }
+func (m DeleteTypeRequestMultiError) AllErrors() []error { return m }
 
-// UpsertPatchAssetRequestValidationError is the validation error returned by
-// UpsertPatchAssetRequest.Validate if the designated constraints aren't met.
-type UpsertPatchAssetRequestValidationError struct {
+// DeleteTypeRequestValidationError is the validation error returned by
+// DeleteTypeRequest.Validate if the designated constraints aren't met.
+type DeleteTypeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5453,24 +5371,24 @@ type UpsertPatchAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertPatchAssetRequestValidationError) Field() string { return e.field }
+func (e DeleteTypeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertPatchAssetRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTypeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertPatchAssetRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTypeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertPatchAssetRequestValidationError) Key() bool { return e.key }
+func (e DeleteTypeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertPatchAssetRequestValidationError) ErrorName() string {
-	return "UpsertPatchAssetRequestValidationError"
+func (e DeleteTypeRequestValidationError) ErrorName() string {
+	return "DeleteTypeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertPatchAssetRequestValidationError) Error() string {
+func (e DeleteTypeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5482,14 +5400,14 @@ func (e UpsertPatchAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertPatchAssetRequest.%s: %s%s",
+		"invalid %sDeleteTypeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertPatchAssetRequestValidationError{}
+var _ error = DeleteTypeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5497,46 +5415,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertPatchAssetRequestValidationError{}
+} = DeleteTypeRequestValidationError{}
 
-// Validate checks the field values on UpsertPatchAssetResponse with the rules
+// Validate checks the field values on DeleteTypeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpsertPatchAssetResponse) Validate() error {
+func (m *DeleteTypeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpsertPatchAssetResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpsertPatchAssetResponseMultiError, or nil if none found.
-func (m *UpsertPatchAssetResponse) ValidateAll() error {
+// DeleteTypeResponseMultiError, or nil if none found.
+func (m *DeleteTypeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpsertPatchAssetResponse) validate(all bool) error {
+func (m *DeleteTypeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return UpsertPatchAssetResponseMultiError(errors)
+		return DeleteTypeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpsertPatchAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by UpsertPatchAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpsertPatchAssetResponseMultiError []error
+// DeleteTypeResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteTypeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTypeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpsertPatchAssetResponseMultiError) Error() string {
+func (m DeleteTypeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5545,11 +5461,11 @@ func (m UpsertPatchAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpsertPatchAssetResponseMultiError) AllErrors() []error { return m }
+func (m DeleteTypeResponseMultiError) AllErrors() []error { return m }
 
-// UpsertPatchAssetResponseValidationError is the validation error returned by
-// UpsertPatchAssetResponse.Validate if the designated constraints aren't met.
-type UpsertPatchAssetResponseValidationError struct {
+// DeleteTypeResponseValidationError is the validation error returned by
+// DeleteTypeResponse.Validate if the designated constraints aren't met.
+type DeleteTypeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5557,24 +5473,24 @@ type UpsertPatchAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertPatchAssetResponseValidationError) Field() string { return e.field }
+func (e DeleteTypeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertPatchAssetResponseValidationError) Reason() string { return e.reason }
+func (e DeleteTypeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertPatchAssetResponseValidationError) Cause() error { return e.cause }
+func (e DeleteTypeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertPatchAssetResponseValidationError) Key() bool { return e.key }
+func (e DeleteTypeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertPatchAssetResponseValidationError) ErrorName() string {
-	return "UpsertPatchAssetResponseValidationError"
+func (e DeleteTypeResponseValidationError) ErrorName() string {
+	return "DeleteTypeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpsertPatchAssetResponseValidationError) Error() string {
+func (e DeleteTypeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5586,14 +5502,14 @@ func (e UpsertPatchAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertPatchAssetResponse.%s: %s%s",
+		"invalid %sDeleteTypeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertPatchAssetResponseValidationError{}
+var _ error = DeleteTypeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5601,46 +5517,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertPatchAssetResponseValidationError{}
+} = DeleteTypeResponseValidationError{}
 
-// Validate checks the field values on DeleteAssetRequest with the rules
+// Validate checks the field values on UpsertTypeSchemaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAssetRequest) Validate() error {
+func (m *UpsertTypeSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertTypeSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAssetRequestMultiError, or nil if none found.
-func (m *DeleteAssetRequest) ValidateAll() error {
+// UpsertTypeSchemaRequestMultiError, or nil if none found.
+func (m *UpsertTypeSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAssetRequest) validate(all bool) error {
+func (m *UpsertTypeSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := UpsertTypeSchemaRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSchema() == nil {
+		err := UpsertTypeSchemaRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertTypeSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertTypeSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertTypeSchemaRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _UpsertTypeSchemaRequest_Mode_InLookup[m.GetMode()]; !ok {
+		err := UpsertTypeSchemaRequestValidationError{
+			field:  "Mode",
+			reason: "value must be in list [ enforce warn]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteAssetRequestMultiError(errors)
+		return UpsertTypeSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAssetRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteAssetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteAssetRequestMultiError []error
+// UpsertTypeSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertTypeSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertTypeSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAssetRequestMultiError) Error() string {
+func (m UpsertTypeSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5649,11 +5625,11 @@ func (m DeleteAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAssetRequestMultiError) AllErrors() []error { return m }
+func (m UpsertTypeSchemaRequestMultiError) AllErrors() []error { return m }
 
-// DeleteAssetRequestValidationError is the validation error returned by
-// DeleteAssetRequest.Validate if the designated constraints aren't met.
-type DeleteAssetRequestValidationError struct {
+// UpsertTypeSchemaRequestValidationError is the validation error returned by
+// UpsertTypeSchemaRequest.Validate if the designated constraints aren't met.
+type UpsertTypeSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5661,24 +5637,24 @@ type DeleteAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAssetRequestValidationError) Field() string { return e.field }
+func (e UpsertTypeSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAssetRequestValidationError) Reason() string { return e.reason }
+func (e UpsertTypeSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAssetRequestValidationError) Cause() error { return e.cause }
+func (e UpsertTypeSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAssetRequestValidationError) Key() bool { return e.key }
+func (e UpsertTypeSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAssetRequestValidationError) ErrorName() string {
-	return "DeleteAssetRequestValidationError"
+func (e UpsertTypeSchemaRequestValidationError) ErrorName() string {
+	return "UpsertTypeSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAssetRequestValidationError) Error() string {
+func (e UpsertTypeSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5690,14 +5666,14 @@ func (e DeleteAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAssetRequest.%s: %s%s",
+		"invalid %sUpsertTypeSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAssetRequestValidationError{}
+var _ error = UpsertTypeSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5705,44 +5681,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAssetRequestValidationError{}
+} = UpsertTypeSchemaRequestValidationError{}
 
-// Validate checks the field values on DeleteAssetResponse with the rules
+var _UpsertTypeSchemaRequest_Mode_InLookup = map[string]struct{}{
+	"":        {},
+	"enforce": {},
+	"warn":    {},
+}
+
+// Validate checks the field values on UpsertTypeSchemaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAssetResponse) Validate() error {
+func (m *UpsertTypeSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpsertTypeSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAssetResponseMultiError, or nil if none found.
-func (m *DeleteAssetResponse) ValidateAll() error {
+// UpsertTypeSchemaResponseMultiError, or nil if none found.
+func (m *UpsertTypeSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAssetResponse) validate(all bool) error {
+func (m *UpsertTypeSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertTypeSchemaResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertTypeSchemaResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertTypeSchemaResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteAssetResponseMultiError(errors)
+		return UpsertTypeSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteAssetResponse.ValidateAll() if the designated
+// UpsertTypeSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertTypeSchemaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteAssetResponseMultiError []error
+type UpsertTypeSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAssetResponseMultiError) Error() string {
+func (m UpsertTypeSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5751,11 +5762,11 @@ func (m DeleteAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAssetResponseMultiError) AllErrors() []error { return m }
+func (m UpsertTypeSchemaResponseMultiError) AllErrors() []error { return m }
 
-// DeleteAssetResponseValidationError is the validation error returned by
-// DeleteAssetResponse.Validate if the designated constraints aren't met.
-type DeleteAssetResponseValidationError struct {
+// UpsertTypeSchemaResponseValidationError is the validation error returned by
+// UpsertTypeSchemaResponse.Validate if the designated constraints aren't met.
+type UpsertTypeSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5763,24 +5774,24 @@ type DeleteAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAssetResponseValidationError) Field() string { return e.field }
+func (e UpsertTypeSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAssetResponseValidationError) Reason() string { return e.reason }
+func (e UpsertTypeSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAssetResponseValidationError) Cause() error { return e.cause }
+func (e UpsertTypeSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAssetResponseValidationError) Key() bool { return e.key }
+func (e UpsertTypeSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAssetResponseValidationError) ErrorName() string {
-	return "DeleteAssetResponseValidationError"
+func (e UpsertTypeSchemaResponseValidationError) ErrorName() string {
+	return "UpsertTypeSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAssetResponseValidationError) Error() string {
+func (e UpsertTypeSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5792,14 +5803,14 @@ func (e DeleteAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAssetResponse.%s: %s%s",
+		"invalid %sUpsertTypeSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAssetResponseValidationError{}
+var _ error = UpsertTypeSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5807,34 +5818,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAssetResponseValidationError{}
+} = UpsertTypeSchemaResponseValidationError{}
 
-// Validate checks the field values on DeleteAssetsRequest with the rules
+// Validate checks the field values on GetTypeSchemaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAssetsRequest) Validate() error {
+func (m *GetTypeSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAssetsRequest with the rules
+// ValidateAll checks the field values on GetTypeSchemaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAssetsRequestMultiError, or nil if none found.
-func (m *DeleteAssetsRequest) ValidateAll() error {
+// GetTypeSchemaRequestMultiError, or nil if none found.
+func (m *GetTypeSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAssetsRequest) validate(all bool) error {
+func (m *GetTypeSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetQueryExpr()) < 3 {
-		err := DeleteAssetsRequestValidationError{
-			field:  "QueryExpr",
-			reason: "value length must be at least 3 runes",
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := GetTypeSchemaRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -5842,22 +5853,20 @@ func (m *DeleteAssetsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for DryRun
-
 	if len(errors) > 0 {
-		return DeleteAssetsRequestMultiError(errors)
+		return GetTypeSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAssetsRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteAssetsRequest.ValidateAll() if the designated
+// GetTypeSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTypeSchemaRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteAssetsRequestMultiError []error
+type GetTypeSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAssetsRequestMultiError) Error() string {
+func (m GetTypeSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5866,11 +5875,11 @@ func (m DeleteAssetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAssetsRequestMultiError) AllErrors() []error { return m }
+func (m GetTypeSchemaRequestMultiError) AllErrors() []error { return m }
 
-// DeleteAssetsRequestValidationError is the validation error returned by
-// DeleteAssetsRequest.Validate if the designated constraints aren't met.
-type DeleteAssetsRequestValidationError struct {
+// GetTypeSchemaRequestValidationError is the validation error returned by
+// GetTypeSchemaRequest.Validate if the designated constraints aren't met.
+type GetTypeSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5878,24 +5887,24 @@ type DeleteAssetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAssetsRequestValidationError) Field() string { return e.field }
+func (e GetTypeSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAssetsRequestValidationError) Reason() string { return e.reason }
+func (e GetTypeSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAssetsRequestValidationError) Cause() error { return e.cause }
+func (e GetTypeSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAssetsRequestValidationError) Key() bool { return e.key }
+func (e GetTypeSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAssetsRequestValidationError) ErrorName() string {
-	return "DeleteAssetsRequestValidationError"
+func (e GetTypeSchemaRequestValidationError) ErrorName() string {
+	return "GetTypeSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAssetsRequestValidationError) Error() string {
+func (e GetTypeSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5907,14 +5916,14 @@ func (e DeleteAssetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAssetsRequest.%s: %s%s",
+		"invalid %sGetTypeSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAssetsRequestValidationError{}
+var _ error = GetTypeSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5922,46 +5931,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAssetsRequestValidationError{}
+} = GetTypeSchemaRequestValidationError{}
 
-// Validate checks the field values on DeleteAssetsResponse with the rules
+// Validate checks the field values on GetTypeSchemaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAssetsResponse) Validate() error {
+func (m *GetTypeSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAssetsResponse with the rules
+// ValidateAll checks the field values on GetTypeSchemaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteAssetsResponseMultiError, or nil if none found.
-func (m *DeleteAssetsResponse) ValidateAll() error {
+// GetTypeSchemaResponseMultiError, or nil if none found.
+func (m *GetTypeSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAssetsResponse) validate(all bool) error {
+func (m *GetTypeSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AffectedRows
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTypeSchemaResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTypeSchemaResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTypeSchemaResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return DeleteAssetsResponseMultiError(errors)
+		return GetTypeSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAssetsResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteAssetsResponse.ValidateAll() if the designated
+// GetTypeSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTypeSchemaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteAssetsResponseMultiError []error
+type GetTypeSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAssetsResponseMultiError) Error() string {
+func (m GetTypeSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5970,11 +6006,11 @@ func (m DeleteAssetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAssetsResponseMultiError) AllErrors() []error { return m }
+func (m GetTypeSchemaResponseMultiError) AllErrors() []error { return m }
 
-// DeleteAssetsResponseValidationError is the validation error returned by
-// DeleteAssetsResponse.Validate if the designated constraints aren't met.
-type DeleteAssetsResponseValidationError struct {
+// GetTypeSchemaResponseValidationError is the validation error returned by
+// GetTypeSchemaResponse.Validate if the designated constraints aren't met.
+type GetTypeSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5982,24 +6018,24 @@ type DeleteAssetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAssetsResponseValidationError) Field() string { return e.field }
+func (e GetTypeSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAssetsResponseValidationError) Reason() string { return e.reason }
+func (e GetTypeSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAssetsResponseValidationError) Cause() error { return e.cause }
+func (e GetTypeSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAssetsResponseValidationError) Key() bool { return e.key }
+func (e GetTypeSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAssetsResponseValidationError) ErrorName() string {
-	return "DeleteAssetsResponseValidationError"
+func (e GetTypeSchemaResponseValidationError) ErrorName() string {
+	return "GetTypeSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAssetsResponseValidationError) Error() string {
+func (e GetTypeSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6011,14 +6047,14 @@ func (e DeleteAssetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAssetsResponse.%s: %s%s",
+		"invalid %sGetTypeSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAssetsResponseValidationError{}
+var _ error = GetTypeSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6026,76 +6062,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAssetsResponseValidationError{}
+} = GetTypeSchemaResponseValidationError{}
 
-// Validate checks the field values on GetAssetStargazersRequest with the rules
+// Validate checks the field values on DeleteTypeSchemaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetStargazersRequest) Validate() error {
+func (m *DeleteTypeSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetStargazersRequest with the
+// ValidateAll checks the field values on DeleteTypeSchemaRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAssetStargazersRequestMultiError, or nil if none found.
-func (m *GetAssetStargazersRequest) ValidateAll() error {
+// DeleteTypeSchemaRequestMultiError, or nil if none found.
+func (m *DeleteTypeSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetStargazersRequest) validate(all bool) error {
+func (m *DeleteTypeSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.GetSize() != 0 {
-
-		if m.GetSize() < 0 {
-			err := GetAssetStargazersRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := DeleteTypeSchemaRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
 		}
-
-	}
-
-	if m.GetOffset() != 0 {
-
-		if m.GetOffset() < 0 {
-			err := GetAssetStargazersRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+		if !all {
+			return err
 		}
-
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetAssetStargazersRequestMultiError(errors)
+		return DeleteTypeSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetStargazersRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAssetStargazersRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetAssetStargazersRequestMultiError []error
+// DeleteTypeSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTypeSchemaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTypeSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetStargazersRequestMultiError) Error() string {
+func (m DeleteTypeSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6104,11 +6119,11 @@ func (m GetAssetStargazersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetStargazersRequestMultiError) AllErrors() []error { return m }
+func (m DeleteTypeSchemaRequestMultiError) AllErrors() []error { return m }
 
-// GetAssetStargazersRequestValidationError is the validation error returned by
-// GetAssetStargazersRequest.Validate if the designated constraints aren't met.
-type GetAssetStargazersRequestValidationError struct {
+// DeleteTypeSchemaRequestValidationError is the validation error returned by
+// DeleteTypeSchemaRequest.Validate if the designated constraints aren't met.
+type DeleteTypeSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6116,24 +6131,24 @@ type GetAssetStargazersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetStargazersRequestValidationError) Field() string { return e.field }
+func (e DeleteTypeSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetStargazersRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTypeSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetStargazersRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTypeSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetStargazersRequestValidationError) Key() bool { return e.key }
+func (e DeleteTypeSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetStargazersRequestValidationError) ErrorName() string {
-	return "GetAssetStargazersRequestValidationError"
+func (e DeleteTypeSchemaRequestValidationError) ErrorName() string {
+	return "DeleteTypeSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetStargazersRequestValidationError) Error() string {
+func (e DeleteTypeSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6145,14 +6160,14 @@ func (e GetAssetStargazersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetStargazersRequest.%s: %s%s",
+		"invalid %sDeleteTypeSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetStargazersRequestValidationError{}
+var _ error = DeleteTypeSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6160,78 +6175,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetStargazersRequestValidationError{}
+} = DeleteTypeSchemaRequestValidationError{}
 
-// Validate checks the field values on GetAssetStargazersResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteTypeSchemaResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetStargazersResponse) Validate() error {
+func (m *DeleteTypeSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetStargazersResponse with the
+// ValidateAll checks the field values on DeleteTypeSchemaResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAssetStargazersResponseMultiError, or nil if none found.
-func (m *GetAssetStargazersResponse) ValidateAll() error {
+// DeleteTypeSchemaResponseMultiError, or nil if none found.
+func (m *DeleteTypeSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetStargazersResponse) validate(all bool) error {
+func (m *DeleteTypeSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAssetStargazersResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAssetStargazersResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAssetStargazersResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetAssetStargazersResponseMultiError(errors)
+		return DeleteTypeSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetStargazersResponseMultiError is an error wrapping multiple
-// validation errors returned by GetAssetStargazersResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetAssetStargazersResponseMultiError []error
+// DeleteTypeSchemaResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTypeSchemaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTypeSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetStargazersResponseMultiError) Error() string {
+func (m DeleteTypeSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6240,11 +6221,11 @@ func (m GetAssetStargazersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetStargazersResponseMultiError) AllErrors() []error { return m }
+func (m DeleteTypeSchemaResponseMultiError) AllErrors() []error { return m }
 
-// GetAssetStargazersResponseValidationError is the validation error returned
-// by GetAssetStargazersResponse.Validate if the designated constraints aren't met.
-type GetAssetStargazersResponseValidationError struct {
+// DeleteTypeSchemaResponseValidationError is the validation error returned by
+// DeleteTypeSchemaResponse.Validate if the designated constraints aren't met.
+type DeleteTypeSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6252,24 +6233,24 @@ type GetAssetStargazersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetStargazersResponseValidationError) Field() string { return e.field }
+func (e DeleteTypeSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetStargazersResponseValidationError) Reason() string { return e.reason }
+func (e DeleteTypeSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetStargazersResponseValidationError) Cause() error { return e.cause }
+func (e DeleteTypeSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetStargazersResponseValidationError) Key() bool { return e.key }
+func (e DeleteTypeSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetStargazersResponseValidationError) ErrorName() string {
-	return "GetAssetStargazersResponseValidationError"
+func (e DeleteTypeSchemaResponseValidationError) ErrorName() string {
+	return "DeleteTypeSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetStargazersResponseValidationError) Error() string {
+func (e DeleteTypeSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6281,14 +6262,14 @@ func (e GetAssetStargazersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetStargazersResponse.%s: %s%s",
+		"invalid %sDeleteTypeSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetStargazersResponseValidationError{}
+var _ error = DeleteTypeSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6296,76 +6277,95 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetStargazersResponseValidationError{}
+} = DeleteTypeSchemaResponseValidationError{}
 
-// Validate checks the field values on GetAssetVersionHistoryRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ValidateTypeSchemaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetVersionHistoryRequest) Validate() error {
+func (m *ValidateTypeSchemaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetVersionHistoryRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetAssetVersionHistoryRequestMultiError, or nil if none found.
-func (m *GetAssetVersionHistoryRequest) ValidateAll() error {
+// ValidateAll checks the field values on ValidateTypeSchemaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateTypeSchemaRequestMultiError, or nil if none found.
+func (m *ValidateTypeSchemaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetVersionHistoryRequest) validate(all bool) error {
+func (m *ValidateTypeSchemaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if m.GetSize() != 0 {
-
-		if m.GetSize() < 0 {
-			err := GetAssetVersionHistoryRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetType()) < 1 {
+		err := ValidateTypeSchemaRequestValidationError{
+			field:  "Type",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if m.GetOffset() != 0 {
+	if m.GetSchema() == nil {
+		err := ValidateTypeSchemaRequestValidationError{
+			field:  "Schema",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if m.GetOffset() < 0 {
-			err := GetAssetVersionHistoryRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
+	if all {
+		switch v := interface{}(m.GetSchema()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValidateTypeSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValidateTypeSchemaRequestValidationError{
+					field:  "Schema",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValidateTypeSchemaRequestValidationError{
+				field:  "Schema",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetAssetVersionHistoryRequestMultiError(errors)
+		return ValidateTypeSchemaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetVersionHistoryRequestMultiError is an error wrapping multiple
-// validation errors returned by GetAssetVersionHistoryRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetAssetVersionHistoryRequestMultiError []error
+// ValidateTypeSchemaRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateTypeSchemaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ValidateTypeSchemaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetVersionHistoryRequestMultiError) Error() string {
+func (m ValidateTypeSchemaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6374,12 +6374,11 @@ func (m GetAssetVersionHistoryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetVersionHistoryRequestMultiError) AllErrors() []error { return m }
+func (m ValidateTypeSchemaRequestMultiError) AllErrors() []error { return m }
 
-// GetAssetVersionHistoryRequestValidationError is the validation error
-// returned by GetAssetVersionHistoryRequest.Validate if the designated
-// constraints aren't met.
-type GetAssetVersionHistoryRequestValidationError struct {
+// ValidateTypeSchemaRequestValidationError is the validation error returned by
+// ValidateTypeSchemaRequest.Validate if the designated constraints aren't met.
+type ValidateTypeSchemaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6387,24 +6386,24 @@ type GetAssetVersionHistoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetVersionHistoryRequestValidationError) Field() string { return e.field }
+func (e ValidateTypeSchemaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetVersionHistoryRequestValidationError) Reason() string { return e.reason }
+func (e ValidateTypeSchemaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetVersionHistoryRequestValidationError) Cause() error { return e.cause }
+func (e ValidateTypeSchemaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetVersionHistoryRequestValidationError) Key() bool { return e.key }
+func (e ValidateTypeSchemaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetVersionHistoryRequestValidationError) ErrorName() string {
-	return "GetAssetVersionHistoryRequestValidationError"
+func (e ValidateTypeSchemaRequestValidationError) ErrorName() string {
+	return "ValidateTypeSchemaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetVersionHistoryRequestValidationError) Error() string {
+func (e ValidateTypeSchemaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6416,14 +6415,14 @@ func (e GetAssetVersionHistoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetVersionHistoryRequest.%s: %s%s",
+		"invalid %sValidateTypeSchemaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetVersionHistoryRequestValidationError{}
+var _ error = ValidateTypeSchemaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6431,47 +6430,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetVersionHistoryRequestValidationError{}
+} = ValidateTypeSchemaRequestValidationError{}
 
-// Validate checks the field values on GetAssetVersionHistoryResponse with the
+// Validate checks the field values on ValidateTypeSchemaResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetVersionHistoryResponse) Validate() error {
+func (m *ValidateTypeSchemaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetVersionHistoryResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetAssetVersionHistoryResponseMultiError, or nil if none found.
-func (m *GetAssetVersionHistoryResponse) ValidateAll() error {
+// ValidateAll checks the field values on ValidateTypeSchemaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateTypeSchemaResponseMultiError, or nil if none found.
+func (m *ValidateTypeSchemaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetVersionHistoryResponse) validate(all bool) error {
+func (m *ValidateTypeSchemaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
+	// no validation rules for Checked
+
+	// no validation rules for Invalid
+
+	for idx, item := range m.GetViolations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAssetVersionHistoryResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
+					errors = append(errors, ValidateTypeSchemaResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAssetVersionHistoryResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
+					errors = append(errors, ValidateTypeSchemaResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -6479,8 +6482,8 @@ func (m *GetAssetVersionHistoryResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAssetVersionHistoryResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				return ValidateTypeSchemaResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6490,19 +6493,19 @@ func (m *GetAssetVersionHistoryResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetAssetVersionHistoryResponseMultiError(errors)
+		return ValidateTypeSchemaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetVersionHistoryResponseMultiError is an error wrapping multiple
-// validation errors returned by GetAssetVersionHistoryResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetAssetVersionHistoryResponseMultiError []error
+// ValidateTypeSchemaResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateTypeSchemaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ValidateTypeSchemaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetVersionHistoryResponseMultiError) Error() string {
+func (m ValidateTypeSchemaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6511,12 +6514,11 @@ func (m GetAssetVersionHistoryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetVersionHistoryResponseMultiError) AllErrors() []error { return m }
+func (m ValidateTypeSchemaResponseMultiError) AllErrors() []error { return m }
 
-// GetAssetVersionHistoryResponseValidationError is the validation error
-// returned by GetAssetVersionHistoryResponse.Validate if the designated
-// constraints aren't met.
-type GetAssetVersionHistoryResponseValidationError struct {
+// ValidateTypeSchemaResponseValidationError is the validation error returned
+// by ValidateTypeSchemaResponse.Validate if the designated constraints aren't met.
+type ValidateTypeSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6524,24 +6526,24 @@ type GetAssetVersionHistoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetVersionHistoryResponseValidationError) Field() string { return e.field }
+func (e ValidateTypeSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetVersionHistoryResponseValidationError) Reason() string { return e.reason }
+func (e ValidateTypeSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetVersionHistoryResponseValidationError) Cause() error { return e.cause }
+func (e ValidateTypeSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetVersionHistoryResponseValidationError) Key() bool { return e.key }
+func (e ValidateTypeSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetVersionHistoryResponseValidationError) ErrorName() string {
-	return "GetAssetVersionHistoryResponseValidationError"
+func (e ValidateTypeSchemaResponseValidationError) ErrorName() string {
+	return "ValidateTypeSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetVersionHistoryResponseValidationError) Error() string {
+func (e ValidateTypeSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6553,14 +6555,14 @@ func (e GetAssetVersionHistoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetVersionHistoryResponse.%s: %s%s",
+		"invalid %sValidateTypeSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetVersionHistoryResponseValidationError{}
+var _ error = ValidateTypeSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6568,48 +6570,104 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetVersionHistoryResponseValidationError{}
+} = ValidateTypeSchemaResponseValidationError{}
 
-// Validate checks the field values on GetAssetByVersionRequest with the rules
+// Validate checks the field values on GetAllAssetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetByVersionRequest) Validate() error {
+func (m *GetAllAssetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetByVersionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAllAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAssetByVersionRequestMultiError, or nil if none found.
-func (m *GetAssetByVersionRequest) ValidateAll() error {
+// GetAllAssetsRequestMultiError, or nil if none found.
+func (m *GetAllAssetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetByVersionRequest) validate(all bool) error {
+func (m *GetAllAssetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if m.GetQ() != "" {
 
-	// no validation rules for Version
+	}
+
+	if m.GetQFields() != "" {
+
+	}
+
+	if m.GetTypes() != "" {
+
+	}
+
+	if m.GetServices() != "" {
+
+	}
+
+	if m.GetSort() != "" {
+
+	}
+
+	if m.GetDirection() != "" {
+
+	}
+
+	// no validation rules for Data
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetAllAssetsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetAllAssetsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for WithTotal
+
+	// no validation rules for IsDeleted
 
 	if len(errors) > 0 {
-		return GetAssetByVersionRequestMultiError(errors)
+		return GetAllAssetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetByVersionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAssetByVersionRequest.ValidateAll() if the designated
+// GetAllAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllAssetsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAssetByVersionRequestMultiError []error
+type GetAllAssetsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetByVersionRequestMultiError) Error() string {
+func (m GetAllAssetsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6618,11 +6676,11 @@ func (m GetAssetByVersionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetByVersionRequestMultiError) AllErrors() []error { return m }
+func (m GetAllAssetsRequestMultiError) AllErrors() []error { return m }
 
-// GetAssetByVersionRequestValidationError is the validation error returned by
-// GetAssetByVersionRequest.Validate if the designated constraints aren't met.
-type GetAssetByVersionRequestValidationError struct {
+// GetAllAssetsRequestValidationError is the validation error returned by
+// GetAllAssetsRequest.Validate if the designated constraints aren't met.
+type GetAllAssetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6630,24 +6688,24 @@ type GetAssetByVersionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetByVersionRequestValidationError) Field() string { return e.field }
+func (e GetAllAssetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetByVersionRequestValidationError) Reason() string { return e.reason }
+func (e GetAllAssetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetByVersionRequestValidationError) Cause() error { return e.cause }
+func (e GetAllAssetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetByVersionRequestValidationError) Key() bool { return e.key }
+func (e GetAllAssetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetByVersionRequestValidationError) ErrorName() string {
-	return "GetAssetByVersionRequestValidationError"
+func (e GetAllAssetsRequestValidationError) ErrorName() string {
+	return "GetAllAssetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetByVersionRequestValidationError) Error() string {
+func (e GetAllAssetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6659,14 +6717,14 @@ func (e GetAssetByVersionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetByVersionRequest.%s: %s%s",
+		"invalid %sGetAllAssetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetByVersionRequestValidationError{}
+var _ error = GetAllAssetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6674,73 +6732,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetByVersionRequestValidationError{}
+} = GetAllAssetsRequestValidationError{}
 
-// Validate checks the field values on GetAssetByVersionResponse with the rules
+// Validate checks the field values on GetAllAssetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAssetByVersionResponse) Validate() error {
+func (m *GetAllAssetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAssetByVersionResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAllAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAssetByVersionResponseMultiError, or nil if none found.
-func (m *GetAssetByVersionResponse) ValidateAll() error {
+// GetAllAssetsResponseMultiError, or nil if none found.
+func (m *GetAllAssetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAssetByVersionResponse) validate(all bool) error {
+func (m *GetAllAssetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAssetByVersionResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAssetByVersionResponseValidationError{
-					field:  "Data",
+				return GetAllAssetsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAssetByVersionResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return GetAssetByVersionResponseMultiError(errors)
+		return GetAllAssetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAssetByVersionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAssetByVersionResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetAssetByVersionResponseMultiError []error
+// GetAllAssetsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllAssetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllAssetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAssetByVersionResponseMultiError) Error() string {
+func (m GetAllAssetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6749,11 +6814,11 @@ func (m GetAssetByVersionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAssetByVersionResponseMultiError) AllErrors() []error { return m }
+func (m GetAllAssetsResponseMultiError) AllErrors() []error { return m }
 
-// GetAssetByVersionResponseValidationError is the validation error returned by
-// GetAssetByVersionResponse.Validate if the designated constraints aren't met.
-type GetAssetByVersionResponseValidationError struct {
+// GetAllAssetsResponseValidationError is the validation error returned by
+// GetAllAssetsResponse.Validate if the designated constraints aren't met.
+type GetAllAssetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6761,24 +6826,24 @@ type GetAssetByVersionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAssetByVersionResponseValidationError) Field() string { return e.field }
+func (e GetAllAssetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAssetByVersionResponseValidationError) Reason() string { return e.reason }
+func (e GetAllAssetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAssetByVersionResponseValidationError) Cause() error { return e.cause }
+func (e GetAllAssetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAssetByVersionResponseValidationError) Key() bool { return e.key }
+func (e GetAllAssetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAssetByVersionResponseValidationError) ErrorName() string {
-	return "GetAssetByVersionResponseValidationError"
+func (e GetAllAssetsResponseValidationError) ErrorName() string {
+	return "GetAllAssetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAssetByVersionResponseValidationError) Error() string {
+func (e GetAllAssetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6790,14 +6855,14 @@ func (e GetAssetByVersionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAssetByVersionResponse.%s: %s%s",
+		"invalid %sGetAllAssetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAssetByVersionResponseValidationError{}
+var _ error = GetAllAssetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6805,84 +6870,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAssetByVersionResponseValidationError{}
+} = GetAllAssetsResponseValidationError{}
 
-// Validate checks the field values on CreateAssetProbeRequest with the rules
+// Validate checks the field values on GetAssetByIDRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAssetProbeRequest) Validate() error {
+func (m *GetAssetByIDRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAssetProbeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetByIDRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAssetProbeRequestMultiError, or nil if none found.
-func (m *CreateAssetProbeRequest) ValidateAll() error {
+// GetAssetByIDRequestMultiError, or nil if none found.
+func (m *GetAssetByIDRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAssetProbeRequest) validate(all bool) error {
+func (m *GetAssetByIDRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetAssetUrn()) < 1 {
-		err := CreateAssetProbeRequestValidationError{
-			field:  "AssetUrn",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetProbe()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateAssetProbeRequestValidationError{
-					field:  "Probe",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateAssetProbeRequestValidationError{
-					field:  "Probe",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetProbe()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateAssetProbeRequestValidationError{
-				field:  "Probe",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CreateAssetProbeRequestMultiError(errors)
+		return GetAssetByIDRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAssetProbeRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateAssetProbeRequest.ValidateAll() if the designated
+// GetAssetByIDRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssetByIDRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAssetProbeRequestMultiError []error
+type GetAssetByIDRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAssetProbeRequestMultiError) Error() string {
+func (m GetAssetByIDRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6891,11 +6918,11 @@ func (m CreateAssetProbeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAssetProbeRequestMultiError) AllErrors() []error { return m }
+func (m GetAssetByIDRequestMultiError) AllErrors() []error { return m }
 
-// CreateAssetProbeRequestValidationError is the validation error returned by
-// CreateAssetProbeRequest.Validate if the designated constraints aren't met.
-type CreateAssetProbeRequestValidationError struct {
+// GetAssetByIDRequestValidationError is the validation error returned by
+// GetAssetByIDRequest.Validate if the designated constraints aren't met.
+type GetAssetByIDRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6903,24 +6930,24 @@ type CreateAssetProbeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAssetProbeRequestValidationError) Field() string { return e.field }
+func (e GetAssetByIDRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAssetProbeRequestValidationError) Reason() string { return e.reason }
+func (e GetAssetByIDRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAssetProbeRequestValidationError) Cause() error { return e.cause }
+func (e GetAssetByIDRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAssetProbeRequestValidationError) Key() bool { return e.key }
+func (e GetAssetByIDRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAssetProbeRequestValidationError) ErrorName() string {
-	return "CreateAssetProbeRequestValidationError"
+func (e GetAssetByIDRequestValidationError) ErrorName() string {
+	return "GetAssetByIDRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAssetProbeRequestValidationError) Error() string {
+func (e GetAssetByIDRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6932,14 +6959,14 @@ func (e CreateAssetProbeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAssetProbeRequest.%s: %s%s",
+		"invalid %sGetAssetByIDRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAssetProbeRequestValidationError{}
+var _ error = GetAssetByIDRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6947,46 +6974,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAssetProbeRequestValidationError{}
+} = GetAssetByIDRequestValidationError{}
 
-// Validate checks the field values on CreateAssetProbeResponse with the rules
+// Validate checks the field values on GetAssetByIDResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAssetProbeResponse) Validate() error {
+func (m *GetAssetByIDResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAssetProbeResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetByIDResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAssetProbeResponseMultiError, or nil if none found.
-func (m *CreateAssetProbeResponse) ValidateAll() error {
+// GetAssetByIDResponseMultiError, or nil if none found.
+func (m *GetAssetByIDResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAssetProbeResponse) validate(all bool) error {
+func (m *GetAssetByIDResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAssetByIDResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAssetByIDResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAssetByIDResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateAssetProbeResponseMultiError(errors)
+		return GetAssetByIDResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAssetProbeResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAssetProbeResponse.ValidateAll() if the designated
+// GetAssetByIDResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAssetByIDResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAssetProbeResponseMultiError []error
+type GetAssetByIDResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAssetProbeResponseMultiError) Error() string {
+func (m GetAssetByIDResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6995,11 +7049,11 @@ func (m CreateAssetProbeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAssetProbeResponseMultiError) AllErrors() []error { return m }
+func (m GetAssetByIDResponseMultiError) AllErrors() []error { return m }
 
-// CreateAssetProbeResponseValidationError is the validation error returned by
-// CreateAssetProbeResponse.Validate if the designated constraints aren't met.
-type CreateAssetProbeResponseValidationError struct {
+// GetAssetByIDResponseValidationError is the validation error returned by
+// GetAssetByIDResponse.Validate if the designated constraints aren't met.
+type GetAssetByIDResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7007,24 +7061,24 @@ type CreateAssetProbeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAssetProbeResponseValidationError) Field() string { return e.field }
+func (e GetAssetByIDResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAssetProbeResponseValidationError) Reason() string { return e.reason }
+func (e GetAssetByIDResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAssetProbeResponseValidationError) Cause() error { return e.cause }
+func (e GetAssetByIDResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAssetProbeResponseValidationError) Key() bool { return e.key }
+func (e GetAssetByIDResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAssetProbeResponseValidationError) ErrorName() string {
-	return "CreateAssetProbeResponseValidationError"
+func (e GetAssetByIDResponseValidationError) ErrorName() string {
+	return "GetAssetByIDResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAssetProbeResponseValidationError) Error() string {
+func (e GetAssetByIDResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7036,14 +7090,14 @@ func (e CreateAssetProbeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAssetProbeResponse.%s: %s%s",
+		"invalid %sGetAssetByIDResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAssetProbeResponseValidationError{}
+var _ error = GetAssetByIDResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7051,57 +7105,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAssetProbeResponseValidationError{}
+} = GetAssetByIDResponseValidationError{}
 
-// Validate checks the field values on SyncAssetsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SyncAssetsRequest) Validate() error {
+// Validate checks the field values on UpsertAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpsertAssetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SyncAssetsRequest with the rules
+// ValidateAll checks the field values on UpsertAssetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SyncAssetsRequestMultiError, or nil if none found.
-func (m *SyncAssetsRequest) ValidateAll() error {
+// UpsertAssetRequestMultiError, or nil if none found.
+func (m *UpsertAssetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SyncAssetsRequest) validate(all bool) error {
+func (m *UpsertAssetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return SyncAssetsRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// SyncAssetsRequestMultiError is an error wrapping multiple validation errors
-// returned by SyncAssetsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SyncAssetsRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m SyncAssetsRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetAsset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertAssetRequestValidationError{
+					field:  "Asset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertAssetRequestValidationError{
+					field:  "Asset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertAssetRequestValidationError{
+				field:  "Asset",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m SyncAssetsRequestMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetUpstreams() {
+		_, _ = idx, item
 
-// SyncAssetsRequestValidationError is the validation error returned by
-// SyncAssetsRequest.Validate if the designated constraints aren't met.
-type SyncAssetsRequestValidationError struct {
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertAssetRequestValidationError{
+						field:  fmt.Sprintf("Upstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertAssetRequestValidationError{
+						field:  fmt.Sprintf("Upstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertAssetRequestValidationError{
+					field:  fmt.Sprintf("Upstreams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDownstreams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertAssetRequestValidationError{
+						field:  fmt.Sprintf("Downstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertAssetRequestValidationError{
+						field:  fmt.Sprintf("Downstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertAssetRequestValidationError{
+					field:  fmt.Sprintf("Downstreams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UpdateOnly
+
+	if len(errors) > 0 {
+		return UpsertAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpsertAssetRequestMultiError is an error wrapping multiple validation errors
+// returned by UpsertAssetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpsertAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpsertAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpsertAssetRequestMultiError) AllErrors() []error { return m }
+
+// UpsertAssetRequestValidationError is the validation error returned by
+// UpsertAssetRequest.Validate if the designated constraints aren't met.
+type UpsertAssetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7109,24 +7262,24 @@ type SyncAssetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SyncAssetsRequestValidationError) Field() string { return e.field }
+func (e UpsertAssetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SyncAssetsRequestValidationError) Reason() string { return e.reason }
+func (e UpsertAssetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SyncAssetsRequestValidationError) Cause() error { return e.cause }
+func (e UpsertAssetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SyncAssetsRequestValidationError) Key() bool { return e.key }
+func (e UpsertAssetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SyncAssetsRequestValidationError) ErrorName() string {
-	return "SyncAssetsRequestValidationError"
+func (e UpsertAssetRequestValidationError) ErrorName() string {
+	return "UpsertAssetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SyncAssetsRequestValidationError) Error() string {
+func (e UpsertAssetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7138,14 +7291,14 @@ func (e SyncAssetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSyncAssetsRequest.%s: %s%s",
+		"invalid %sUpsertAssetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SyncAssetsRequestValidationError{}
+var _ error = UpsertAssetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7153,44 +7306,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SyncAssetsRequestValidationError{}
+} = UpsertAssetRequestValidationError{}
 
-// Validate checks the field values on SyncAssetsResponse with the rules
+// Validate checks the field values on UpsertAssetResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SyncAssetsResponse) Validate() error {
+func (m *UpsertAssetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SyncAssetsResponse with the rules
+// ValidateAll checks the field values on UpsertAssetResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SyncAssetsResponseMultiError, or nil if none found.
-func (m *SyncAssetsResponse) ValidateAll() error {
+// UpsertAssetResponseMultiError, or nil if none found.
+func (m *UpsertAssetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SyncAssetsResponse) validate(all bool) error {
+func (m *UpsertAssetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	if len(errors) > 0 {
-		return SyncAssetsResponseMultiError(errors)
+		return UpsertAssetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SyncAssetsResponseMultiError is an error wrapping multiple validation errors
-// returned by SyncAssetsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type SyncAssetsResponseMultiError []error
+// UpsertAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertAssetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SyncAssetsResponseMultiError) Error() string {
+func (m UpsertAssetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7199,11 +7354,11 @@ func (m SyncAssetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SyncAssetsResponseMultiError) AllErrors() []error { return m }
+func (m UpsertAssetResponseMultiError) AllErrors() []error { return m }
 
-// SyncAssetsResponseValidationError is the validation error returned by
-// SyncAssetsResponse.Validate if the designated constraints aren't met.
-type SyncAssetsResponseValidationError struct {
+// UpsertAssetResponseValidationError is the validation error returned by
+// UpsertAssetResponse.Validate if the designated constraints aren't met.
+type UpsertAssetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7211,24 +7366,24 @@ type SyncAssetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SyncAssetsResponseValidationError) Field() string { return e.field }
+func (e UpsertAssetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SyncAssetsResponseValidationError) Reason() string { return e.reason }
+func (e UpsertAssetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SyncAssetsResponseValidationError) Cause() error { return e.cause }
+func (e UpsertAssetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SyncAssetsResponseValidationError) Key() bool { return e.key }
+func (e UpsertAssetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SyncAssetsResponseValidationError) ErrorName() string {
-	return "SyncAssetsResponseValidationError"
+func (e UpsertAssetResponseValidationError) ErrorName() string {
+	return "UpsertAssetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SyncAssetsResponseValidationError) Error() string {
+func (e UpsertAssetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7240,14 +7395,14 @@ func (e SyncAssetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSyncAssetsResponse.%s: %s%s",
+		"invalid %sUpsertAssetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SyncAssetsResponseValidationError{}
+var _ error = UpsertAssetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7255,76 +7410,145 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SyncAssetsResponseValidationError{}
+} = UpsertAssetResponseValidationError{}
 
-// Validate checks the field values on GetUserStarredAssetsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpsertPatchAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserStarredAssetsRequest) Validate() error {
+func (m *UpsertPatchAssetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserStarredAssetsRequest with the
+// ValidateAll checks the field values on UpsertPatchAssetRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserStarredAssetsRequestMultiError, or nil if none found.
-func (m *GetUserStarredAssetsRequest) ValidateAll() error {
+// UpsertPatchAssetRequestMultiError, or nil if none found.
+func (m *UpsertPatchAssetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserStarredAssetsRequest) validate(all bool) error {
+func (m *UpsertPatchAssetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	if m.GetSize() != 0 {
-
-		if m.GetSize() < 0 {
-			err := GetUserStarredAssetsRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
+	if all {
+		switch v := interface{}(m.GetAsset()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertPatchAssetRequestValidationError{
+					field:  "Asset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertPatchAssetRequestValidationError{
+					field:  "Asset",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsset()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertPatchAssetRequestValidationError{
+				field:  "Asset",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	if m.GetOffset() != 0 {
+	for idx, item := range m.GetUpstreams() {
+		_, _ = idx, item
 
-		if m.GetOffset() < 0 {
-			err := GetUserStarredAssetsRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertPatchAssetRequestValidationError{
+						field:  fmt.Sprintf("Upstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertPatchAssetRequestValidationError{
+						field:  fmt.Sprintf("Upstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertPatchAssetRequestValidationError{
+					field:  fmt.Sprintf("Upstreams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
-	if len(errors) > 0 {
-		return GetUserStarredAssetsRequestMultiError(errors)
-	}
+	for idx, item := range m.GetDownstreams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpsertPatchAssetRequestValidationError{
+						field:  fmt.Sprintf("Downstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpsertPatchAssetRequestValidationError{
+						field:  fmt.Sprintf("Downstreams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertPatchAssetRequestValidationError{
+					field:  fmt.Sprintf("Downstreams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OverwriteLineage
+
+	// no validation rules for UpdateOnly
+
+	if len(errors) > 0 {
+		return UpsertPatchAssetRequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// GetUserStarredAssetsRequestMultiError is an error wrapping multiple
-// validation errors returned by GetUserStarredAssetsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetUserStarredAssetsRequestMultiError []error
+// UpsertPatchAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by UpsertPatchAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertPatchAssetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserStarredAssetsRequestMultiError) Error() string {
+func (m UpsertPatchAssetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7333,12 +7557,11 @@ func (m GetUserStarredAssetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserStarredAssetsRequestMultiError) AllErrors() []error { return m }
+func (m UpsertPatchAssetRequestMultiError) AllErrors() []error { return m }
 
-// GetUserStarredAssetsRequestValidationError is the validation error returned
-// by GetUserStarredAssetsRequest.Validate if the designated constraints
-// aren't met.
-type GetUserStarredAssetsRequestValidationError struct {
+// UpsertPatchAssetRequestValidationError is the validation error returned by
+// UpsertPatchAssetRequest.Validate if the designated constraints aren't met.
+type UpsertPatchAssetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7346,24 +7569,24 @@ type GetUserStarredAssetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserStarredAssetsRequestValidationError) Field() string { return e.field }
+func (e UpsertPatchAssetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserStarredAssetsRequestValidationError) Reason() string { return e.reason }
+func (e UpsertPatchAssetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserStarredAssetsRequestValidationError) Cause() error { return e.cause }
+func (e UpsertPatchAssetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserStarredAssetsRequestValidationError) Key() bool { return e.key }
+func (e UpsertPatchAssetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserStarredAssetsRequestValidationError) ErrorName() string {
-	return "GetUserStarredAssetsRequestValidationError"
+func (e UpsertPatchAssetRequestValidationError) ErrorName() string {
+	return "UpsertPatchAssetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserStarredAssetsRequestValidationError) Error() string {
+func (e UpsertPatchAssetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7375,14 +7598,14 @@ func (e GetUserStarredAssetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserStarredAssetsRequest.%s: %s%s",
+		"invalid %sUpsertPatchAssetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserStarredAssetsRequestValidationError{}
+var _ error = UpsertPatchAssetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7390,78 +7613,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserStarredAssetsRequestValidationError{}
+} = UpsertPatchAssetRequestValidationError{}
 
-// Validate checks the field values on GetUserStarredAssetsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpsertPatchAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserStarredAssetsResponse) Validate() error {
+func (m *UpsertPatchAssetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserStarredAssetsResponse with the
+// ValidateAll checks the field values on UpsertPatchAssetResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserStarredAssetsResponseMultiError, or nil if none found.
-func (m *GetUserStarredAssetsResponse) ValidateAll() error {
+// UpsertPatchAssetResponseMultiError, or nil if none found.
+func (m *UpsertPatchAssetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserStarredAssetsResponse) validate(all bool) error {
+func (m *UpsertPatchAssetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUserStarredAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUserStarredAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetUserStarredAssetsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetUserStarredAssetsResponseMultiError(errors)
+		return UpsertPatchAssetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserStarredAssetsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetUserStarredAssetsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetUserStarredAssetsResponseMultiError []error
+// UpsertPatchAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by UpsertPatchAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpsertPatchAssetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserStarredAssetsResponseMultiError) Error() string {
+func (m UpsertPatchAssetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7470,12 +7661,11 @@ func (m GetUserStarredAssetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserStarredAssetsResponseMultiError) AllErrors() []error { return m }
+func (m UpsertPatchAssetResponseMultiError) AllErrors() []error { return m }
 
-// GetUserStarredAssetsResponseValidationError is the validation error returned
-// by GetUserStarredAssetsResponse.Validate if the designated constraints
-// aren't met.
-type GetUserStarredAssetsResponseValidationError struct {
+// UpsertPatchAssetResponseValidationError is the validation error returned by
+// UpsertPatchAssetResponse.Validate if the designated constraints aren't met.
+type UpsertPatchAssetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7483,24 +7673,24 @@ type GetUserStarredAssetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserStarredAssetsResponseValidationError) Field() string { return e.field }
+func (e UpsertPatchAssetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserStarredAssetsResponseValidationError) Reason() string { return e.reason }
+func (e UpsertPatchAssetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserStarredAssetsResponseValidationError) Cause() error { return e.cause }
+func (e UpsertPatchAssetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserStarredAssetsResponseValidationError) Key() bool { return e.key }
+func (e UpsertPatchAssetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserStarredAssetsResponseValidationError) ErrorName() string {
-	return "GetUserStarredAssetsResponseValidationError"
+func (e UpsertPatchAssetResponseValidationError) ErrorName() string {
+	return "UpsertPatchAssetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserStarredAssetsResponseValidationError) Error() string {
+func (e UpsertPatchAssetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7512,14 +7702,14 @@ func (e GetUserStarredAssetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserStarredAssetsResponse.%s: %s%s",
+		"invalid %sUpsertPatchAssetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserStarredAssetsResponseValidationError{}
+var _ error = UpsertPatchAssetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7527,74 +7717,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserStarredAssetsResponseValidationError{}
+} = UpsertPatchAssetResponseValidationError{}
 
-// Validate checks the field values on GetMyStarredAssetsRequest with the rules
+// Validate checks the field values on DeleteAssetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyStarredAssetsRequest) Validate() error {
+func (m *DeleteAssetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyStarredAssetsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyStarredAssetsRequestMultiError, or nil if none found.
-func (m *GetMyStarredAssetsRequest) ValidateAll() error {
+// DeleteAssetRequestMultiError, or nil if none found.
+func (m *DeleteAssetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyStarredAssetsRequest) validate(all bool) error {
+func (m *DeleteAssetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSize() != 0 {
-
-		if m.GetSize() < 0 {
-			err := GetMyStarredAssetsRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetOffset() != 0 {
-
-		if m.GetOffset() < 0 {
-			err := GetMyStarredAssetsRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetMyStarredAssetsRequestMultiError(errors)
+		return DeleteAssetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMyStarredAssetsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetMyStarredAssetsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetMyStarredAssetsRequestMultiError []error
+// DeleteAssetRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAssetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAssetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyStarredAssetsRequestMultiError) Error() string {
+func (m DeleteAssetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7603,11 +7765,11 @@ func (m GetMyStarredAssetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyStarredAssetsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteAssetRequestMultiError) AllErrors() []error { return m }
 
-// GetMyStarredAssetsRequestValidationError is the validation error returned by
-// GetMyStarredAssetsRequest.Validate if the designated constraints aren't met.
-type GetMyStarredAssetsRequestValidationError struct {
+// DeleteAssetRequestValidationError is the validation error returned by
+// DeleteAssetRequest.Validate if the designated constraints aren't met.
+type DeleteAssetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7615,24 +7777,24 @@ type GetMyStarredAssetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyStarredAssetsRequestValidationError) Field() string { return e.field }
+func (e DeleteAssetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyStarredAssetsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteAssetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyStarredAssetsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteAssetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyStarredAssetsRequestValidationError) Key() bool { return e.key }
+func (e DeleteAssetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyStarredAssetsRequestValidationError) ErrorName() string {
-	return "GetMyStarredAssetsRequestValidationError"
+func (e DeleteAssetRequestValidationError) ErrorName() string {
+	return "DeleteAssetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyStarredAssetsRequestValidationError) Error() string {
+func (e DeleteAssetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7644,14 +7806,14 @@ func (e GetMyStarredAssetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyStarredAssetsRequest.%s: %s%s",
+		"invalid %sDeleteAssetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyStarredAssetsRequestValidationError{}
+var _ error = DeleteAssetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7659,78 +7821,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyStarredAssetsRequestValidationError{}
+} = DeleteAssetRequestValidationError{}
 
-// Validate checks the field values on GetMyStarredAssetsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyStarredAssetsResponse) Validate() error {
+// Validate checks the field values on DeleteAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAssetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyStarredAssetsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyStarredAssetsResponseMultiError, or nil if none found.
-func (m *GetMyStarredAssetsResponse) ValidateAll() error {
+// DeleteAssetResponseMultiError, or nil if none found.
+func (m *DeleteAssetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyStarredAssetsResponse) validate(all bool) error {
+func (m *DeleteAssetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetMyStarredAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetMyStarredAssetsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetMyStarredAssetsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return GetMyStarredAssetsResponseMultiError(errors)
+		return DeleteAssetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMyStarredAssetsResponseMultiError is an error wrapping multiple
-// validation errors returned by GetMyStarredAssetsResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetMyStarredAssetsResponseMultiError []error
+// DeleteAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAssetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyStarredAssetsResponseMultiError) Error() string {
+func (m DeleteAssetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7739,11 +7867,11 @@ func (m GetMyStarredAssetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyStarredAssetsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteAssetResponseMultiError) AllErrors() []error { return m }
 
-// GetMyStarredAssetsResponseValidationError is the validation error returned
-// by GetMyStarredAssetsResponse.Validate if the designated constraints aren't met.
-type GetMyStarredAssetsResponseValidationError struct {
+// DeleteAssetResponseValidationError is the validation error returned by
+// DeleteAssetResponse.Validate if the designated constraints aren't met.
+type DeleteAssetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7751,24 +7879,24 @@ type GetMyStarredAssetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyStarredAssetsResponseValidationError) Field() string { return e.field }
+func (e DeleteAssetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyStarredAssetsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteAssetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyStarredAssetsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteAssetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyStarredAssetsResponseValidationError) Key() bool { return e.key }
+func (e DeleteAssetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyStarredAssetsResponseValidationError) ErrorName() string {
-	return "GetMyStarredAssetsResponseValidationError"
+func (e DeleteAssetResponseValidationError) ErrorName() string {
+	return "DeleteAssetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyStarredAssetsResponseValidationError) Error() string {
+func (e DeleteAssetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7780,14 +7908,14 @@ func (e GetMyStarredAssetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyStarredAssetsResponse.%s: %s%s",
+		"invalid %sDeleteAssetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyStarredAssetsResponseValidationError{}
+var _ error = DeleteAssetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7795,46 +7923,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyStarredAssetsResponseValidationError{}
+} = DeleteAssetResponseValidationError{}
 
-// Validate checks the field values on GetMyStarredAssetRequest with the rules
+// Validate checks the field values on DeleteAssetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyStarredAssetRequest) Validate() error {
+func (m *DeleteAssetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyStarredAssetRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyStarredAssetRequestMultiError, or nil if none found.
-func (m *GetMyStarredAssetRequest) ValidateAll() error {
+// DeleteAssetsRequestMultiError, or nil if none found.
+func (m *DeleteAssetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyStarredAssetRequest) validate(all bool) error {
+func (m *DeleteAssetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	if utf8.RuneCountInString(m.GetQueryExpr()) < 3 {
+		err := DeleteAssetsRequestValidationError{
+			field:  "QueryExpr",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return GetMyStarredAssetRequestMultiError(errors)
+		return DeleteAssetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMyStarredAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by GetMyStarredAssetRequest.ValidateAll() if the designated
+// DeleteAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAssetsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetMyStarredAssetRequestMultiError []error
+type DeleteAssetsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyStarredAssetRequestMultiError) Error() string {
+func (m DeleteAssetsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7843,11 +7982,11 @@ func (m GetMyStarredAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyStarredAssetRequestMultiError) AllErrors() []error { return m }
+func (m DeleteAssetsRequestMultiError) AllErrors() []error { return m }
 
-// GetMyStarredAssetRequestValidationError is the validation error returned by
-// GetMyStarredAssetRequest.Validate if the designated constraints aren't met.
-type GetMyStarredAssetRequestValidationError struct {
+// DeleteAssetsRequestValidationError is the validation error returned by
+// DeleteAssetsRequest.Validate if the designated constraints aren't met.
+type DeleteAssetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7855,24 +7994,24 @@ type GetMyStarredAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyStarredAssetRequestValidationError) Field() string { return e.field }
+func (e DeleteAssetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyStarredAssetRequestValidationError) Reason() string { return e.reason }
+func (e DeleteAssetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyStarredAssetRequestValidationError) Cause() error { return e.cause }
+func (e DeleteAssetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyStarredAssetRequestValidationError) Key() bool { return e.key }
+func (e DeleteAssetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyStarredAssetRequestValidationError) ErrorName() string {
-	return "GetMyStarredAssetRequestValidationError"
+func (e DeleteAssetsRequestValidationError) ErrorName() string {
+	return "DeleteAssetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyStarredAssetRequestValidationError) Error() string {
+func (e DeleteAssetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7884,14 +8023,14 @@ func (e GetMyStarredAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyStarredAssetRequest.%s: %s%s",
+		"invalid %sDeleteAssetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyStarredAssetRequestValidationError{}
+var _ error = DeleteAssetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7899,73 +8038,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyStarredAssetRequestValidationError{}
+} = DeleteAssetsRequestValidationError{}
 
-// Validate checks the field values on GetMyStarredAssetResponse with the rules
+// Validate checks the field values on DeleteAssetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyStarredAssetResponse) Validate() error {
+func (m *DeleteAssetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyStarredAssetResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyStarredAssetResponseMultiError, or nil if none found.
-func (m *GetMyStarredAssetResponse) ValidateAll() error {
+// DeleteAssetsResponseMultiError, or nil if none found.
+func (m *DeleteAssetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyStarredAssetResponse) validate(all bool) error {
+func (m *DeleteAssetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetMyStarredAssetResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetMyStarredAssetResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetMyStarredAssetResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AffectedRows
 
 	if len(errors) > 0 {
-		return GetMyStarredAssetResponseMultiError(errors)
+		return DeleteAssetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMyStarredAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by GetMyStarredAssetResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetMyStarredAssetResponseMultiError []error
+// DeleteAssetsResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAssetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAssetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyStarredAssetResponseMultiError) Error() string {
+func (m DeleteAssetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7974,11 +8086,11 @@ func (m GetMyStarredAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyStarredAssetResponseMultiError) AllErrors() []error { return m }
+func (m DeleteAssetsResponseMultiError) AllErrors() []error { return m }
 
-// GetMyStarredAssetResponseValidationError is the validation error returned by
-// GetMyStarredAssetResponse.Validate if the designated constraints aren't met.
-type GetMyStarredAssetResponseValidationError struct {
+// DeleteAssetsResponseValidationError is the validation error returned by
+// DeleteAssetsResponse.Validate if the designated constraints aren't met.
+type DeleteAssetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7986,24 +8098,24 @@ type GetMyStarredAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyStarredAssetResponseValidationError) Field() string { return e.field }
+func (e DeleteAssetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyStarredAssetResponseValidationError) Reason() string { return e.reason }
+func (e DeleteAssetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyStarredAssetResponseValidationError) Cause() error { return e.cause }
+func (e DeleteAssetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyStarredAssetResponseValidationError) Key() bool { return e.key }
+func (e DeleteAssetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyStarredAssetResponseValidationError) ErrorName() string {
-	return "GetMyStarredAssetResponseValidationError"
+func (e DeleteAssetsResponseValidationError) ErrorName() string {
+	return "DeleteAssetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyStarredAssetResponseValidationError) Error() string {
+func (e DeleteAssetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8015,14 +8127,14 @@ func (e GetMyStarredAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyStarredAssetResponse.%s: %s%s",
+		"invalid %sDeleteAssetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyStarredAssetResponseValidationError{}
+var _ error = DeleteAssetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8030,46 +8142,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyStarredAssetResponseValidationError{}
+} = DeleteAssetsResponseValidationError{}
 
-// Validate checks the field values on StarAssetRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StarAssetRequest) Validate() error {
+// Validate checks the field values on GetAssetStargazersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAssetStargazersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StarAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetStargazersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StarAssetRequestMultiError, or nil if none found.
-func (m *StarAssetRequest) ValidateAll() error {
+// GetAssetStargazersRequestMultiError, or nil if none found.
+func (m *GetAssetStargazersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StarAssetRequest) validate(all bool) error {
+func (m *GetAssetStargazersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	// no validation rules for Id
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetAssetStargazersRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetAssetStargazersRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return StarAssetRequestMultiError(errors)
+		return GetAssetStargazersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StarAssetRequestMultiError is an error wrapping multiple validation errors
-// returned by StarAssetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StarAssetRequestMultiError []error
+// GetAssetStargazersRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssetStargazersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAssetStargazersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StarAssetRequestMultiError) Error() string {
+func (m GetAssetStargazersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8078,11 +8220,11 @@ func (m StarAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StarAssetRequestMultiError) AllErrors() []error { return m }
+func (m GetAssetStargazersRequestMultiError) AllErrors() []error { return m }
 
-// StarAssetRequestValidationError is the validation error returned by
-// StarAssetRequest.Validate if the designated constraints aren't met.
-type StarAssetRequestValidationError struct {
+// GetAssetStargazersRequestValidationError is the validation error returned by
+// GetAssetStargazersRequest.Validate if the designated constraints aren't met.
+type GetAssetStargazersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8090,22 +8232,24 @@ type StarAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StarAssetRequestValidationError) Field() string { return e.field }
+func (e GetAssetStargazersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StarAssetRequestValidationError) Reason() string { return e.reason }
+func (e GetAssetStargazersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StarAssetRequestValidationError) Cause() error { return e.cause }
+func (e GetAssetStargazersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StarAssetRequestValidationError) Key() bool { return e.key }
+func (e GetAssetStargazersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StarAssetRequestValidationError) ErrorName() string { return "StarAssetRequestValidationError" }
+func (e GetAssetStargazersRequestValidationError) ErrorName() string {
+	return "GetAssetStargazersRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StarAssetRequestValidationError) Error() string {
+func (e GetAssetStargazersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8117,14 +8261,14 @@ func (e StarAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStarAssetRequest.%s: %s%s",
+		"invalid %sGetAssetStargazersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StarAssetRequestValidationError{}
+var _ error = GetAssetStargazersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8132,46 +8276,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StarAssetRequestValidationError{}
+} = GetAssetStargazersRequestValidationError{}
 
-// Validate checks the field values on StarAssetResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *StarAssetResponse) Validate() error {
+// Validate checks the field values on GetAssetStargazersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAssetStargazersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StarAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetStargazersResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StarAssetResponseMultiError, or nil if none found.
-func (m *StarAssetResponse) ValidateAll() error {
+// GetAssetStargazersResponseMultiError, or nil if none found.
+func (m *GetAssetStargazersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StarAssetResponse) validate(all bool) error {
+func (m *GetAssetStargazersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAssetStargazersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAssetStargazersResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAssetStargazersResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return StarAssetResponseMultiError(errors)
+		return GetAssetStargazersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StarAssetResponseMultiError is an error wrapping multiple validation errors
-// returned by StarAssetResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StarAssetResponseMultiError []error
+// GetAssetStargazersResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAssetStargazersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAssetStargazersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StarAssetResponseMultiError) Error() string {
+func (m GetAssetStargazersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8180,11 +8356,11 @@ func (m StarAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StarAssetResponseMultiError) AllErrors() []error { return m }
+func (m GetAssetStargazersResponseMultiError) AllErrors() []error { return m }
 
-// StarAssetResponseValidationError is the validation error returned by
-// StarAssetResponse.Validate if the designated constraints aren't met.
-type StarAssetResponseValidationError struct {
+// GetAssetStargazersResponseValidationError is the validation error returned
+// by GetAssetStargazersResponse.Validate if the designated constraints aren't met.
+type GetAssetStargazersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8192,24 +8368,24 @@ type StarAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StarAssetResponseValidationError) Field() string { return e.field }
+func (e GetAssetStargazersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StarAssetResponseValidationError) Reason() string { return e.reason }
+func (e GetAssetStargazersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StarAssetResponseValidationError) Cause() error { return e.cause }
+func (e GetAssetStargazersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StarAssetResponseValidationError) Key() bool { return e.key }
+func (e GetAssetStargazersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StarAssetResponseValidationError) ErrorName() string {
-	return "StarAssetResponseValidationError"
+func (e GetAssetStargazersResponseValidationError) ErrorName() string {
+	return "GetAssetStargazersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StarAssetResponseValidationError) Error() string {
+func (e GetAssetStargazersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8221,14 +8397,14 @@ func (e StarAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStarAssetResponse.%s: %s%s",
+		"invalid %sGetAssetStargazersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StarAssetResponseValidationError{}
+var _ error = GetAssetStargazersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8236,46 +8412,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StarAssetResponseValidationError{}
+} = GetAssetStargazersResponseValidationError{}
 
-// Validate checks the field values on UnstarAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAssetVersionHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnstarAssetRequest) Validate() error {
+func (m *GetAssetVersionHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnstarAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnstarAssetRequestMultiError, or nil if none found.
-func (m *UnstarAssetRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetAssetVersionHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAssetVersionHistoryRequestMultiError, or nil if none found.
+func (m *GetAssetVersionHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnstarAssetRequest) validate(all bool) error {
+func (m *GetAssetVersionHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	// no validation rules for Id
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetAssetVersionHistoryRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetAssetVersionHistoryRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return UnstarAssetRequestMultiError(errors)
+		return GetAssetVersionHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnstarAssetRequestMultiError is an error wrapping multiple validation errors
-// returned by UnstarAssetRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UnstarAssetRequestMultiError []error
+// GetAssetVersionHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAssetVersionHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetAssetVersionHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnstarAssetRequestMultiError) Error() string {
+func (m GetAssetVersionHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8284,11 +8490,12 @@ func (m UnstarAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnstarAssetRequestMultiError) AllErrors() []error { return m }
+func (m GetAssetVersionHistoryRequestMultiError) AllErrors() []error { return m }
 
-// UnstarAssetRequestValidationError is the validation error returned by
-// UnstarAssetRequest.Validate if the designated constraints aren't met.
-type UnstarAssetRequestValidationError struct {
+// GetAssetVersionHistoryRequestValidationError is the validation error
+// returned by GetAssetVersionHistoryRequest.Validate if the designated
+// constraints aren't met.
+type GetAssetVersionHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8296,24 +8503,24 @@ type UnstarAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnstarAssetRequestValidationError) Field() string { return e.field }
+func (e GetAssetVersionHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnstarAssetRequestValidationError) Reason() string { return e.reason }
+func (e GetAssetVersionHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnstarAssetRequestValidationError) Cause() error { return e.cause }
+func (e GetAssetVersionHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnstarAssetRequestValidationError) Key() bool { return e.key }
+func (e GetAssetVersionHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnstarAssetRequestValidationError) ErrorName() string {
-	return "UnstarAssetRequestValidationError"
+func (e GetAssetVersionHistoryRequestValidationError) ErrorName() string {
+	return "GetAssetVersionHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnstarAssetRequestValidationError) Error() string {
+func (e GetAssetVersionHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8325,14 +8532,14 @@ func (e UnstarAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnstarAssetRequest.%s: %s%s",
+		"invalid %sGetAssetVersionHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnstarAssetRequestValidationError{}
+var _ error = GetAssetVersionHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8340,44 +8547,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnstarAssetRequestValidationError{}
+} = GetAssetVersionHistoryRequestValidationError{}
 
-// Validate checks the field values on UnstarAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAssetVersionHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnstarAssetResponse) Validate() error {
+func (m *GetAssetVersionHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnstarAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnstarAssetResponseMultiError, or nil if none found.
-func (m *UnstarAssetResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetAssetVersionHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAssetVersionHistoryResponseMultiError, or nil if none found.
+func (m *GetAssetVersionHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnstarAssetResponse) validate(all bool) error {
+func (m *GetAssetVersionHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAssetVersionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAssetVersionHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAssetVersionHistoryResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return UnstarAssetResponseMultiError(errors)
+		return GetAssetVersionHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnstarAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by UnstarAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UnstarAssetResponseMultiError []error
+// GetAssetVersionHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAssetVersionHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetAssetVersionHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnstarAssetResponseMultiError) Error() string {
+func (m GetAssetVersionHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8386,11 +8627,12 @@ func (m UnstarAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnstarAssetResponseMultiError) AllErrors() []error { return m }
+func (m GetAssetVersionHistoryResponseMultiError) AllErrors() []error { return m }
 
-// UnstarAssetResponseValidationError is the validation error returned by
-// UnstarAssetResponse.Validate if the designated constraints aren't met.
-type UnstarAssetResponseValidationError struct {
+// GetAssetVersionHistoryResponseValidationError is the validation error
+// returned by GetAssetVersionHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetAssetVersionHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8398,24 +8640,24 @@ type UnstarAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnstarAssetResponseValidationError) Field() string { return e.field }
+func (e GetAssetVersionHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnstarAssetResponseValidationError) Reason() string { return e.reason }
+func (e GetAssetVersionHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnstarAssetResponseValidationError) Cause() error { return e.cause }
+func (e GetAssetVersionHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnstarAssetResponseValidationError) Key() bool { return e.key }
+func (e GetAssetVersionHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnstarAssetResponseValidationError) ErrorName() string {
-	return "UnstarAssetResponseValidationError"
+func (e GetAssetVersionHistoryResponseValidationError) ErrorName() string {
+	return "GetAssetVersionHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnstarAssetResponseValidationError) Error() string {
+func (e GetAssetVersionHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8427,14 +8669,14 @@ func (e UnstarAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnstarAssetResponse.%s: %s%s",
+		"invalid %sGetAssetVersionHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnstarAssetResponseValidationError{}
+var _ error = GetAssetVersionHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8442,102 +8684,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnstarAssetResponseValidationError{}
+} = GetAssetVersionHistoryResponseValidationError{}
 
-// Validate checks the field values on GetMyDiscussionsRequest with the rules
+// Validate checks the field values on GetAssetByVersionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyDiscussionsRequest) Validate() error {
+func (m *GetAssetByVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyDiscussionsRequest with the
+// ValidateAll checks the field values on GetAssetByVersionRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyDiscussionsRequestMultiError, or nil if none found.
-func (m *GetMyDiscussionsRequest) ValidateAll() error {
+// GetAssetByVersionRequestMultiError, or nil if none found.
+func (m *GetAssetByVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyDiscussionsRequest) validate(all bool) error {
+func (m *GetAssetByVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetFilter() != "" {
-
-	}
-
-	if m.GetType() != "" {
-
-	}
-
-	if m.GetState() != "" {
-
-	}
-
-	if m.GetAsset() != "" {
-
-	}
-
-	if m.GetLabels() != "" {
-
-	}
-
-	if m.GetSort() != "" {
-
-	}
-
-	if m.GetDirection() != "" {
-
-	}
-
-	if m.GetSize() != 0 {
-
-		if m.GetSize() < 0 {
-			err := GetMyDiscussionsRequestValidationError{
-				field:  "Size",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.GetOffset() != 0 {
-
-		if m.GetOffset() < 0 {
-			err := GetMyDiscussionsRequestValidationError{
-				field:  "Offset",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Id
 
-	}
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return GetMyDiscussionsRequestMultiError(errors)
+		return GetAssetByVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMyDiscussionsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetMyDiscussionsRequest.ValidateAll() if the designated
+// GetAssetByVersionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssetByVersionRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetMyDiscussionsRequestMultiError []error
+type GetAssetByVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyDiscussionsRequestMultiError) Error() string {
+func (m GetAssetByVersionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8546,11 +8734,11 @@ func (m GetMyDiscussionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyDiscussionsRequestMultiError) AllErrors() []error { return m }
+func (m GetAssetByVersionRequestMultiError) AllErrors() []error { return m }
 
-// GetMyDiscussionsRequestValidationError is the validation error returned by
-// GetMyDiscussionsRequest.Validate if the designated constraints aren't met.
-type GetMyDiscussionsRequestValidationError struct {
+// GetAssetByVersionRequestValidationError is the validation error returned by
+// GetAssetByVersionRequest.Validate if the designated constraints aren't met.
+type GetAssetByVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8558,24 +8746,24 @@ type GetMyDiscussionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyDiscussionsRequestValidationError) Field() string { return e.field }
+func (e GetAssetByVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyDiscussionsRequestValidationError) Reason() string { return e.reason }
+func (e GetAssetByVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyDiscussionsRequestValidationError) Cause() error { return e.cause }
+func (e GetAssetByVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyDiscussionsRequestValidationError) Key() bool { return e.key }
+func (e GetAssetByVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyDiscussionsRequestValidationError) ErrorName() string {
-	return "GetMyDiscussionsRequestValidationError"
+func (e GetAssetByVersionRequestValidationError) ErrorName() string {
+	return "GetAssetByVersionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyDiscussionsRequestValidationError) Error() string {
+func (e GetAssetByVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8587,14 +8775,14 @@ func (e GetMyDiscussionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyDiscussionsRequest.%s: %s%s",
+		"invalid %sGetAssetByVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyDiscussionsRequestValidationError{}
+var _ error = GetAssetByVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8602,78 +8790,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyDiscussionsRequestValidationError{}
+} = GetAssetByVersionRequestValidationError{}
 
-// Validate checks the field values on GetMyDiscussionsResponse with the rules
+// Validate checks the field values on GetAssetByVersionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMyDiscussionsResponse) Validate() error {
+func (m *GetAssetByVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMyDiscussionsResponse with the
+// ValidateAll checks the field values on GetAssetByVersionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMyDiscussionsResponseMultiError, or nil if none found.
-func (m *GetMyDiscussionsResponse) ValidateAll() error {
+// GetAssetByVersionResponseMultiError, or nil if none found.
+func (m *GetAssetByVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMyDiscussionsResponse) validate(all bool) error {
+func (m *GetAssetByVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetMyDiscussionsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetMyDiscussionsResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAssetByVersionResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetMyDiscussionsResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, GetAssetByVersionResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	}
-
-	if len(errors) > 0 {
-		return GetMyDiscussionsResponseMultiError(errors)
-	}
-
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAssetByVersionResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAssetByVersionResponseMultiError(errors)
+	}
+
 	return nil
 }
 
-// GetMyDiscussionsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetMyDiscussionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetMyDiscussionsResponseMultiError []error
+// GetAssetByVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAssetByVersionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetAssetByVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMyDiscussionsResponseMultiError) Error() string {
+func (m GetAssetByVersionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8682,11 +8865,11 @@ func (m GetMyDiscussionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMyDiscussionsResponseMultiError) AllErrors() []error { return m }
+func (m GetAssetByVersionResponseMultiError) AllErrors() []error { return m }
 
-// GetMyDiscussionsResponseValidationError is the validation error returned by
-// GetMyDiscussionsResponse.Validate if the designated constraints aren't met.
-type GetMyDiscussionsResponseValidationError struct {
+// GetAssetByVersionResponseValidationError is the validation error returned by
+// GetAssetByVersionResponse.Validate if the designated constraints aren't met.
+type GetAssetByVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8694,24 +8877,24 @@ type GetMyDiscussionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMyDiscussionsResponseValidationError) Field() string { return e.field }
+func (e GetAssetByVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMyDiscussionsResponseValidationError) Reason() string { return e.reason }
+func (e GetAssetByVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMyDiscussionsResponseValidationError) Cause() error { return e.cause }
+func (e GetAssetByVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMyDiscussionsResponseValidationError) Key() bool { return e.key }
+func (e GetAssetByVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMyDiscussionsResponseValidationError) ErrorName() string {
-	return "GetMyDiscussionsResponseValidationError"
+func (e GetAssetByVersionResponseValidationError) ErrorName() string {
+	return "GetAssetByVersionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMyDiscussionsResponseValidationError) Error() string {
+func (e GetAssetByVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8723,14 +8906,14 @@ func (e GetMyDiscussionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMyDiscussionsResponse.%s: %s%s",
+		"invalid %sGetAssetByVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMyDiscussionsResponseValidationError{}
+var _ error = GetAssetByVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8738,86 +8921,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMyDiscussionsResponseValidationError{}
+} = GetAssetByVersionResponseValidationError{}
 
-// Validate checks the field values on CreateTagAssetRequest with the rules
+// Validate checks the field values on GetAssetSchemaDiffRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTagAssetRequest) Validate() error {
+func (m *GetAssetSchemaDiffRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTagAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetSchemaDiffRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTagAssetRequestMultiError, or nil if none found.
-func (m *CreateTagAssetRequest) ValidateAll() error {
+// GetAssetSchemaDiffRequestMultiError, or nil if none found.
+func (m *GetAssetSchemaDiffRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTagAssetRequest) validate(all bool) error {
+func (m *GetAssetSchemaDiffRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
-
-	// no validation rules for TemplateUrn
-
-	for idx, item := range m.GetTagValues() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTagAssetRequestValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTagAssetRequestValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTagAssetRequestValidationError{
-					field:  fmt.Sprintf("TagValues[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetUrn()) < 1 {
+		err := GetAssetSchemaDiffRequestValidationError{
+			field:  "Urn",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for TemplateDisplayName
+	if utf8.RuneCountInString(m.GetFromVersion()) < 1 {
+		err := GetAssetSchemaDiffRequestValidationError{
+			field:  "FromVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for TemplateDescription
+	if utf8.RuneCountInString(m.GetToVersion()) < 1 {
+		err := GetAssetSchemaDiffRequestValidationError{
+			field:  "ToVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateTagAssetRequestMultiError(errors)
+		return GetAssetSchemaDiffRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTagAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTagAssetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTagAssetRequestMultiError []error
+// GetAssetSchemaDiffRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssetSchemaDiffRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAssetSchemaDiffRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTagAssetRequestMultiError) Error() string {
+func (m GetAssetSchemaDiffRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8826,11 +9000,11 @@ func (m CreateTagAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTagAssetRequestMultiError) AllErrors() []error { return m }
+func (m GetAssetSchemaDiffRequestMultiError) AllErrors() []error { return m }
 
-// CreateTagAssetRequestValidationError is the validation error returned by
-// CreateTagAssetRequest.Validate if the designated constraints aren't met.
-type CreateTagAssetRequestValidationError struct {
+// GetAssetSchemaDiffRequestValidationError is the validation error returned by
+// GetAssetSchemaDiffRequest.Validate if the designated constraints aren't met.
+type GetAssetSchemaDiffRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8838,24 +9012,24 @@ type CreateTagAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTagAssetRequestValidationError) Field() string { return e.field }
+func (e GetAssetSchemaDiffRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTagAssetRequestValidationError) Reason() string { return e.reason }
+func (e GetAssetSchemaDiffRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTagAssetRequestValidationError) Cause() error { return e.cause }
+func (e GetAssetSchemaDiffRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTagAssetRequestValidationError) Key() bool { return e.key }
+func (e GetAssetSchemaDiffRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTagAssetRequestValidationError) ErrorName() string {
-	return "CreateTagAssetRequestValidationError"
+func (e GetAssetSchemaDiffRequestValidationError) ErrorName() string {
+	return "GetAssetSchemaDiffRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTagAssetRequestValidationError) Error() string {
+func (e GetAssetSchemaDiffRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8867,14 +9041,14 @@ func (e CreateTagAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTagAssetRequest.%s: %s%s",
+		"invalid %sGetAssetSchemaDiffRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTagAssetRequestValidationError{}
+var _ error = GetAssetSchemaDiffRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8882,24 +9056,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTagAssetRequestValidationError{}
+} = GetAssetSchemaDiffRequestValidationError{}
 
-// Validate checks the field values on CreateTagAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetAssetSchemaDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTagAssetResponse) Validate() error {
+func (m *GetAssetSchemaDiffResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTagAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetAssetSchemaDiffResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTagAssetResponseMultiError, or nil if none found.
-func (m *CreateTagAssetResponse) ValidateAll() error {
+// GetAssetSchemaDiffResponseMultiError, or nil if none found.
+func (m *GetAssetSchemaDiffResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTagAssetResponse) validate(all bool) error {
+func (m *GetAssetSchemaDiffResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8910,7 +9084,7 @@ func (m *CreateTagAssetResponse) validate(all bool) error {
 		switch v := interface{}(m.GetData()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTagAssetResponseValidationError{
+				errors = append(errors, GetAssetSchemaDiffResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8918,7 +9092,7 @@ func (m *CreateTagAssetResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTagAssetResponseValidationError{
+				errors = append(errors, GetAssetSchemaDiffResponseValidationError{
 					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8927,7 +9101,7 @@ func (m *CreateTagAssetResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTagAssetResponseValidationError{
+			return GetAssetSchemaDiffResponseValidationError{
 				field:  "Data",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8936,19 +9110,19 @@ func (m *CreateTagAssetResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateTagAssetResponseMultiError(errors)
+		return GetAssetSchemaDiffResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTagAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTagAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTagAssetResponseMultiError []error
+// GetAssetSchemaDiffResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAssetSchemaDiffResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAssetSchemaDiffResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTagAssetResponseMultiError) Error() string {
+func (m GetAssetSchemaDiffResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8957,11 +9131,11 @@ func (m CreateTagAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTagAssetResponseMultiError) AllErrors() []error { return m }
+func (m GetAssetSchemaDiffResponseMultiError) AllErrors() []error { return m }
 
-// CreateTagAssetResponseValidationError is the validation error returned by
-// CreateTagAssetResponse.Validate if the designated constraints aren't met.
-type CreateTagAssetResponseValidationError struct {
+// GetAssetSchemaDiffResponseValidationError is the validation error returned
+// by GetAssetSchemaDiffResponse.Validate if the designated constraints aren't met.
+type GetAssetSchemaDiffResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8969,24 +9143,24 @@ type CreateTagAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTagAssetResponseValidationError) Field() string { return e.field }
+func (e GetAssetSchemaDiffResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTagAssetResponseValidationError) Reason() string { return e.reason }
+func (e GetAssetSchemaDiffResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTagAssetResponseValidationError) Cause() error { return e.cause }
+func (e GetAssetSchemaDiffResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTagAssetResponseValidationError) Key() bool { return e.key }
+func (e GetAssetSchemaDiffResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTagAssetResponseValidationError) ErrorName() string {
-	return "CreateTagAssetResponseValidationError"
+func (e GetAssetSchemaDiffResponseValidationError) ErrorName() string {
+	return "GetAssetSchemaDiffResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTagAssetResponseValidationError) Error() string {
+func (e GetAssetSchemaDiffResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8998,14 +9172,14 @@ func (e CreateTagAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTagAssetResponse.%s: %s%s",
+		"invalid %sGetAssetSchemaDiffResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTagAssetResponseValidationError{}
+var _ error = GetAssetSchemaDiffResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9013,48 +9187,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTagAssetResponseValidationError{}
+} = GetAssetSchemaDiffResponseValidationError{}
 
-// Validate checks the field values on GetTagByAssetAndTemplateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateAssetProbeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTagByAssetAndTemplateRequest) Validate() error {
+func (m *CreateAssetProbeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTagByAssetAndTemplateRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetTagByAssetAndTemplateRequestMultiError, or nil if none found.
-func (m *GetTagByAssetAndTemplateRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateAssetProbeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAssetProbeRequestMultiError, or nil if none found.
+func (m *CreateAssetProbeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTagByAssetAndTemplateRequest) validate(all bool) error {
+func (m *CreateAssetProbeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	if utf8.RuneCountInString(m.GetAssetUrn()) < 1 {
+		err := CreateAssetProbeRequestValidationError{
+			field:  "AssetUrn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for TemplateUrn
+	if all {
+		switch v := interface{}(m.GetProbe()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAssetProbeRequestValidationError{
+					field:  "Probe",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAssetProbeRequestValidationError{
+					field:  "Probe",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProbe()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAssetProbeRequestValidationError{
+				field:  "Probe",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetTagByAssetAndTemplateRequestMultiError(errors)
+		return CreateAssetProbeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTagByAssetAndTemplateRequestMultiError is an error wrapping multiple
-// validation errors returned by GetTagByAssetAndTemplateRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GetTagByAssetAndTemplateRequestMultiError []error
+// CreateAssetProbeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAssetProbeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAssetProbeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTagByAssetAndTemplateRequestMultiError) Error() string {
+func (m CreateAssetProbeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9063,12 +9273,11 @@ func (m GetTagByAssetAndTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTagByAssetAndTemplateRequestMultiError) AllErrors() []error { return m }
+func (m CreateAssetProbeRequestMultiError) AllErrors() []error { return m }
 
-// GetTagByAssetAndTemplateRequestValidationError is the validation error
-// returned by GetTagByAssetAndTemplateRequest.Validate if the designated
-// constraints aren't met.
-type GetTagByAssetAndTemplateRequestValidationError struct {
+// CreateAssetProbeRequestValidationError is the validation error returned by
+// CreateAssetProbeRequest.Validate if the designated constraints aren't met.
+type CreateAssetProbeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9076,24 +9285,24 @@ type GetTagByAssetAndTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTagByAssetAndTemplateRequestValidationError) Field() string { return e.field }
+func (e CreateAssetProbeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTagByAssetAndTemplateRequestValidationError) Reason() string { return e.reason }
+func (e CreateAssetProbeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTagByAssetAndTemplateRequestValidationError) Cause() error { return e.cause }
+func (e CreateAssetProbeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTagByAssetAndTemplateRequestValidationError) Key() bool { return e.key }
+func (e CreateAssetProbeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTagByAssetAndTemplateRequestValidationError) ErrorName() string {
-	return "GetTagByAssetAndTemplateRequestValidationError"
+func (e CreateAssetProbeRequestValidationError) ErrorName() string {
+	return "CreateAssetProbeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTagByAssetAndTemplateRequestValidationError) Error() string {
+func (e CreateAssetProbeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9105,14 +9314,14 @@ func (e GetTagByAssetAndTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTagByAssetAndTemplateRequest.%s: %s%s",
+		"invalid %sCreateAssetProbeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTagByAssetAndTemplateRequestValidationError{}
+var _ error = CreateAssetProbeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9120,75 +9329,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTagByAssetAndTemplateRequestValidationError{}
+} = CreateAssetProbeRequestValidationError{}
 
-// Validate checks the field values on GetTagByAssetAndTemplateResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GetTagByAssetAndTemplateResponse) Validate() error {
+// Validate checks the field values on CreateAssetProbeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAssetProbeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTagByAssetAndTemplateResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetTagByAssetAndTemplateResponseMultiError, or nil if none found.
-func (m *GetTagByAssetAndTemplateResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateAssetProbeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAssetProbeResponseMultiError, or nil if none found.
+func (m *CreateAssetProbeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTagByAssetAndTemplateResponse) validate(all bool) error {
+func (m *CreateAssetProbeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetTagByAssetAndTemplateResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetTagByAssetAndTemplateResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetTagByAssetAndTemplateResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetTagByAssetAndTemplateResponseMultiError(errors)
+		return CreateAssetProbeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTagByAssetAndTemplateResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GetTagByAssetAndTemplateResponse.ValidateAll() if the designated
+// CreateAssetProbeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAssetProbeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetTagByAssetAndTemplateResponseMultiError []error
+type CreateAssetProbeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTagByAssetAndTemplateResponseMultiError) Error() string {
+func (m CreateAssetProbeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9197,12 +9377,11 @@ func (m GetTagByAssetAndTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTagByAssetAndTemplateResponseMultiError) AllErrors() []error { return m }
+func (m CreateAssetProbeResponseMultiError) AllErrors() []error { return m }
 
-// GetTagByAssetAndTemplateResponseValidationError is the validation error
-// returned by GetTagByAssetAndTemplateResponse.Validate if the designated
-// constraints aren't met.
-type GetTagByAssetAndTemplateResponseValidationError struct {
+// CreateAssetProbeResponseValidationError is the validation error returned by
+// CreateAssetProbeResponse.Validate if the designated constraints aren't met.
+type CreateAssetProbeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9210,24 +9389,24 @@ type GetTagByAssetAndTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTagByAssetAndTemplateResponseValidationError) Field() string { return e.field }
+func (e CreateAssetProbeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTagByAssetAndTemplateResponseValidationError) Reason() string { return e.reason }
+func (e CreateAssetProbeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTagByAssetAndTemplateResponseValidationError) Cause() error { return e.cause }
+func (e CreateAssetProbeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTagByAssetAndTemplateResponseValidationError) Key() bool { return e.key }
+func (e CreateAssetProbeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTagByAssetAndTemplateResponseValidationError) ErrorName() string {
-	return "GetTagByAssetAndTemplateResponseValidationError"
+func (e CreateAssetProbeResponseValidationError) ErrorName() string {
+	return "CreateAssetProbeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTagByAssetAndTemplateResponseValidationError) Error() string {
+func (e CreateAssetProbeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9239,14 +9418,14 @@ func (e GetTagByAssetAndTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTagByAssetAndTemplateResponse.%s: %s%s",
+		"invalid %sCreateAssetProbeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTagByAssetAndTemplateResponseValidationError{}
+var _ error = CreateAssetProbeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9254,86 +9433,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTagByAssetAndTemplateResponseValidationError{}
+} = CreateAssetProbeResponseValidationError{}
 
-// Validate checks the field values on UpdateTagAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTagAssetRequest) Validate() error {
+// Validate checks the field values on SyncAssetsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SyncAssetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTagAssetRequest with the rules
+// ValidateAll checks the field values on SyncAssetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTagAssetRequestMultiError, or nil if none found.
-func (m *UpdateTagAssetRequest) ValidateAll() error {
+// SyncAssetsRequestMultiError, or nil if none found.
+func (m *SyncAssetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTagAssetRequest) validate(all bool) error {
+func (m *SyncAssetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
-
-	// no validation rules for TemplateUrn
-
-	for idx, item := range m.GetTagValues() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateTagAssetRequestValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateTagAssetRequestValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateTagAssetRequestValidationError{
-					field:  fmt.Sprintf("TagValues[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for TemplateDisplayName
-
-	// no validation rules for TemplateDescription
-
 	if len(errors) > 0 {
-		return UpdateTagAssetRequestMultiError(errors)
+		return SyncAssetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTagAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateTagAssetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTagAssetRequestMultiError []error
+// SyncAssetsRequestMultiError is an error wrapping multiple validation errors
+// returned by SyncAssetsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SyncAssetsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTagAssetRequestMultiError) Error() string {
+func (m SyncAssetsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9342,11 +9479,11 @@ func (m UpdateTagAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTagAssetRequestMultiError) AllErrors() []error { return m }
+func (m SyncAssetsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateTagAssetRequestValidationError is the validation error returned by
-// UpdateTagAssetRequest.Validate if the designated constraints aren't met.
-type UpdateTagAssetRequestValidationError struct {
+// SyncAssetsRequestValidationError is the validation error returned by
+// SyncAssetsRequest.Validate if the designated constraints aren't met.
+type SyncAssetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9354,24 +9491,24 @@ type UpdateTagAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTagAssetRequestValidationError) Field() string { return e.field }
+func (e SyncAssetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTagAssetRequestValidationError) Reason() string { return e.reason }
+func (e SyncAssetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTagAssetRequestValidationError) Cause() error { return e.cause }
+func (e SyncAssetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTagAssetRequestValidationError) Key() bool { return e.key }
+func (e SyncAssetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTagAssetRequestValidationError) ErrorName() string {
-	return "UpdateTagAssetRequestValidationError"
+func (e SyncAssetsRequestValidationError) ErrorName() string {
+	return "SyncAssetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTagAssetRequestValidationError) Error() string {
+func (e SyncAssetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9383,14 +9520,14 @@ func (e UpdateTagAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTagAssetRequest.%s: %s%s",
+		"invalid %sSyncAssetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTagAssetRequestValidationError{}
+var _ error = SyncAssetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9398,73 +9535,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTagAssetRequestValidationError{}
+} = SyncAssetsRequestValidationError{}
 
-// Validate checks the field values on UpdateTagAssetResponse with the rules
+// Validate checks the field values on SyncAssetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTagAssetResponse) Validate() error {
+func (m *SyncAssetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTagAssetResponse with the rules
+// ValidateAll checks the field values on SyncAssetsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTagAssetResponseMultiError, or nil if none found.
-func (m *UpdateTagAssetResponse) ValidateAll() error {
+// SyncAssetsResponseMultiError, or nil if none found.
+func (m *SyncAssetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTagAssetResponse) validate(all bool) error {
+func (m *SyncAssetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateTagAssetResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateTagAssetResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateTagAssetResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return UpdateTagAssetResponseMultiError(errors)
+		return SyncAssetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTagAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateTagAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTagAssetResponseMultiError []error
+// SyncAssetsResponseMultiError is an error wrapping multiple validation errors
+// returned by SyncAssetsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SyncAssetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTagAssetResponseMultiError) Error() string {
+func (m SyncAssetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9473,11 +9581,11 @@ func (m UpdateTagAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTagAssetResponseMultiError) AllErrors() []error { return m }
+func (m SyncAssetsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateTagAssetResponseValidationError is the validation error returned by
-// UpdateTagAssetResponse.Validate if the designated constraints aren't met.
-type UpdateTagAssetResponseValidationError struct {
+// SyncAssetsResponseValidationError is the validation error returned by
+// SyncAssetsResponse.Validate if the designated constraints aren't met.
+type SyncAssetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9485,24 +9593,24 @@ type UpdateTagAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTagAssetResponseValidationError) Field() string { return e.field }
+func (e SyncAssetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTagAssetResponseValidationError) Reason() string { return e.reason }
+func (e SyncAssetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTagAssetResponseValidationError) Cause() error { return e.cause }
+func (e SyncAssetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTagAssetResponseValidationError) Key() bool { return e.key }
+func (e SyncAssetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTagAssetResponseValidationError) ErrorName() string {
-	return "UpdateTagAssetResponseValidationError"
+func (e SyncAssetsResponseValidationError) ErrorName() string {
+	return "SyncAssetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTagAssetResponseValidationError) Error() string {
+func (e SyncAssetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9514,14 +9622,14 @@ func (e UpdateTagAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTagAssetResponse.%s: %s%s",
+		"invalid %sSyncAssetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTagAssetResponseValidationError{}
+var _ error = SyncAssetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9529,48 +9637,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTagAssetResponseValidationError{}
+} = SyncAssetsResponseValidationError{}
 
-// Validate checks the field values on DeleteTagAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUserStarredAssetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTagAssetRequest) Validate() error {
+func (m *GetUserStarredAssetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTagAssetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserStarredAssetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTagAssetRequestMultiError, or nil if none found.
-func (m *DeleteTagAssetRequest) ValidateAll() error {
+// GetUserStarredAssetsRequestMultiError, or nil if none found.
+func (m *GetUserStarredAssetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTagAssetRequest) validate(all bool) error {
+func (m *GetUserStarredAssetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	// no validation rules for UserId
 
-	// no validation rules for TemplateUrn
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetUserStarredAssetsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetUserStarredAssetsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DeleteTagAssetRequestMultiError(errors)
+		return GetUserStarredAssetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTagAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteTagAssetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTagAssetRequestMultiError []error
+// GetUserStarredAssetsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserStarredAssetsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserStarredAssetsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTagAssetRequestMultiError) Error() string {
+func (m GetUserStarredAssetsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9579,11 +9715,12 @@ func (m DeleteTagAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTagAssetRequestMultiError) AllErrors() []error { return m }
+func (m GetUserStarredAssetsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteTagAssetRequestValidationError is the validation error returned by
-// DeleteTagAssetRequest.Validate if the designated constraints aren't met.
-type DeleteTagAssetRequestValidationError struct {
+// GetUserStarredAssetsRequestValidationError is the validation error returned
+// by GetUserStarredAssetsRequest.Validate if the designated constraints
+// aren't met.
+type GetUserStarredAssetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9591,24 +9728,24 @@ type DeleteTagAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTagAssetRequestValidationError) Field() string { return e.field }
+func (e GetUserStarredAssetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTagAssetRequestValidationError) Reason() string { return e.reason }
+func (e GetUserStarredAssetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTagAssetRequestValidationError) Cause() error { return e.cause }
+func (e GetUserStarredAssetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTagAssetRequestValidationError) Key() bool { return e.key }
+func (e GetUserStarredAssetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTagAssetRequestValidationError) ErrorName() string {
-	return "DeleteTagAssetRequestValidationError"
+func (e GetUserStarredAssetsRequestValidationError) ErrorName() string {
+	return "GetUserStarredAssetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTagAssetRequestValidationError) Error() string {
+func (e GetUserStarredAssetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9620,14 +9757,14 @@ func (e DeleteTagAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTagAssetRequest.%s: %s%s",
+		"invalid %sGetUserStarredAssetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTagAssetRequestValidationError{}
+var _ error = GetUserStarredAssetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9635,44 +9772,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTagAssetRequestValidationError{}
+} = GetUserStarredAssetsRequestValidationError{}
 
-// Validate checks the field values on DeleteTagAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUserStarredAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTagAssetResponse) Validate() error {
+func (m *GetUserStarredAssetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTagAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserStarredAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTagAssetResponseMultiError, or nil if none found.
-func (m *DeleteTagAssetResponse) ValidateAll() error {
+// GetUserStarredAssetsResponseMultiError, or nil if none found.
+func (m *GetUserStarredAssetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTagAssetResponse) validate(all bool) error {
+func (m *GetUserStarredAssetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUserStarredAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUserStarredAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUserStarredAssetsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteTagAssetResponseMultiError(errors)
+		return GetUserStarredAssetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTagAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTagAssetResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteTagAssetResponseMultiError []error
+// GetUserStarredAssetsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUserStarredAssetsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserStarredAssetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTagAssetResponseMultiError) Error() string {
+func (m GetUserStarredAssetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9681,11 +9852,12 @@ func (m DeleteTagAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTagAssetResponseMultiError) AllErrors() []error { return m }
+func (m GetUserStarredAssetsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteTagAssetResponseValidationError is the validation error returned by
-// DeleteTagAssetResponse.Validate if the designated constraints aren't met.
-type DeleteTagAssetResponseValidationError struct {
+// GetUserStarredAssetsResponseValidationError is the validation error returned
+// by GetUserStarredAssetsResponse.Validate if the designated constraints
+// aren't met.
+type GetUserStarredAssetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9693,24 +9865,24 @@ type DeleteTagAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTagAssetResponseValidationError) Field() string { return e.field }
+func (e GetUserStarredAssetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTagAssetResponseValidationError) Reason() string { return e.reason }
+func (e GetUserStarredAssetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTagAssetResponseValidationError) Cause() error { return e.cause }
+func (e GetUserStarredAssetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTagAssetResponseValidationError) Key() bool { return e.key }
+func (e GetUserStarredAssetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTagAssetResponseValidationError) ErrorName() string {
-	return "DeleteTagAssetResponseValidationError"
+func (e GetUserStarredAssetsResponseValidationError) ErrorName() string {
+	return "GetUserStarredAssetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTagAssetResponseValidationError) Error() string {
+func (e GetUserStarredAssetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9722,14 +9894,14 @@ func (e DeleteTagAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTagAssetResponse.%s: %s%s",
+		"invalid %sGetUserStarredAssetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTagAssetResponseValidationError{}
+var _ error = GetUserStarredAssetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9737,46 +9909,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTagAssetResponseValidationError{}
+} = GetUserStarredAssetsResponseValidationError{}
 
-// Validate checks the field values on GetAllTagsByAssetRequest with the rules
+// Validate checks the field values on GetMyStarredAssetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllTagsByAssetRequest) Validate() error {
+func (m *GetMyStarredAssetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllTagsByAssetRequest with the
+// ValidateAll checks the field values on GetMyStarredAssetsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllTagsByAssetRequestMultiError, or nil if none found.
-func (m *GetAllTagsByAssetRequest) ValidateAll() error {
+// GetMyStarredAssetsRequestMultiError, or nil if none found.
+func (m *GetMyStarredAssetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllTagsByAssetRequest) validate(all bool) error {
+func (m *GetMyStarredAssetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetMyStarredAssetsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetMyStarredAssetsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return GetAllTagsByAssetRequestMultiError(errors)
+		return GetMyStarredAssetsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllTagsByAssetRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAllTagsByAssetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAllTagsByAssetRequestMultiError []error
-
+// GetMyStarredAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyStarredAssetsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetMyStarredAssetsRequestMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllTagsByAssetRequestMultiError) Error() string {
+func (m GetMyStarredAssetsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9785,11 +9985,11 @@ func (m GetAllTagsByAssetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllTagsByAssetRequestMultiError) AllErrors() []error { return m }
+func (m GetMyStarredAssetsRequestMultiError) AllErrors() []error { return m }
 
-// GetAllTagsByAssetRequestValidationError is the validation error returned by
-// GetAllTagsByAssetRequest.Validate if the designated constraints aren't met.
-type GetAllTagsByAssetRequestValidationError struct {
+// GetMyStarredAssetsRequestValidationError is the validation error returned by
+// GetMyStarredAssetsRequest.Validate if the designated constraints aren't met.
+type GetMyStarredAssetsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9797,24 +9997,24 @@ type GetAllTagsByAssetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllTagsByAssetRequestValidationError) Field() string { return e.field }
+func (e GetMyStarredAssetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllTagsByAssetRequestValidationError) Reason() string { return e.reason }
+func (e GetMyStarredAssetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllTagsByAssetRequestValidationError) Cause() error { return e.cause }
+func (e GetMyStarredAssetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllTagsByAssetRequestValidationError) Key() bool { return e.key }
+func (e GetMyStarredAssetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllTagsByAssetRequestValidationError) ErrorName() string {
-	return "GetAllTagsByAssetRequestValidationError"
+func (e GetMyStarredAssetsRequestValidationError) ErrorName() string {
+	return "GetMyStarredAssetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllTagsByAssetRequestValidationError) Error() string {
+func (e GetMyStarredAssetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9826,14 +10026,14 @@ func (e GetAllTagsByAssetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllTagsByAssetRequest.%s: %s%s",
+		"invalid %sGetMyStarredAssetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllTagsByAssetRequestValidationError{}
+var _ error = GetMyStarredAssetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9841,24 +10041,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllTagsByAssetRequestValidationError{}
+} = GetMyStarredAssetsRequestValidationError{}
 
-// Validate checks the field values on GetAllTagsByAssetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetMyStarredAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllTagsByAssetResponse) Validate() error {
+func (m *GetMyStarredAssetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllTagsByAssetResponse with the
+// ValidateAll checks the field values on GetMyStarredAssetsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllTagsByAssetResponseMultiError, or nil if none found.
-func (m *GetAllTagsByAssetResponse) ValidateAll() error {
+// GetMyStarredAssetsResponseMultiError, or nil if none found.
+func (m *GetMyStarredAssetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllTagsByAssetResponse) validate(all bool) error {
+func (m *GetMyStarredAssetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9872,7 +10072,7 @@ func (m *GetAllTagsByAssetResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllTagsByAssetResponseValidationError{
+					errors = append(errors, GetMyStarredAssetsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9880,7 +10080,7 @@ func (m *GetAllTagsByAssetResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllTagsByAssetResponseValidationError{
+					errors = append(errors, GetMyStarredAssetsResponseValidationError{
 						field:  fmt.Sprintf("Data[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9889,7 +10089,7 @@ func (m *GetAllTagsByAssetResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAllTagsByAssetResponseValidationError{
+				return GetMyStarredAssetsResponseValidationError{
 					field:  fmt.Sprintf("Data[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9900,19 +10100,19 @@ func (m *GetAllTagsByAssetResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetAllTagsByAssetResponseMultiError(errors)
+		return GetMyStarredAssetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllTagsByAssetResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAllTagsByAssetResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetAllTagsByAssetResponseMultiError []error
+// GetMyStarredAssetsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMyStarredAssetsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMyStarredAssetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllTagsByAssetResponseMultiError) Error() string {
+func (m GetMyStarredAssetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9921,11 +10121,11 @@ func (m GetAllTagsByAssetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllTagsByAssetResponseMultiError) AllErrors() []error { return m }
+func (m GetMyStarredAssetsResponseMultiError) AllErrors() []error { return m }
 
-// GetAllTagsByAssetResponseValidationError is the validation error returned by
-// GetAllTagsByAssetResponse.Validate if the designated constraints aren't met.
-type GetAllTagsByAssetResponseValidationError struct {
+// GetMyStarredAssetsResponseValidationError is the validation error returned
+// by GetMyStarredAssetsResponse.Validate if the designated constraints aren't met.
+type GetMyStarredAssetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9933,24 +10133,24 @@ type GetAllTagsByAssetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllTagsByAssetResponseValidationError) Field() string { return e.field }
+func (e GetMyStarredAssetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllTagsByAssetResponseValidationError) Reason() string { return e.reason }
+func (e GetMyStarredAssetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllTagsByAssetResponseValidationError) Cause() error { return e.cause }
+func (e GetMyStarredAssetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllTagsByAssetResponseValidationError) Key() bool { return e.key }
+func (e GetMyStarredAssetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllTagsByAssetResponseValidationError) ErrorName() string {
-	return "GetAllTagsByAssetResponseValidationError"
+func (e GetMyStarredAssetsResponseValidationError) ErrorName() string {
+	return "GetMyStarredAssetsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllTagsByAssetResponseValidationError) Error() string {
+func (e GetMyStarredAssetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9962,14 +10162,14 @@ func (e GetAllTagsByAssetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllTagsByAssetResponse.%s: %s%s",
+		"invalid %sGetMyStarredAssetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllTagsByAssetResponseValidationError{}
+var _ error = GetMyStarredAssetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9977,46 +10177,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllTagsByAssetResponseValidationError{}
+} = GetMyStarredAssetsResponseValidationError{}
 
-// Validate checks the field values on GetAllTagTemplatesRequest with the rules
+// Validate checks the field values on GetMyStarredAssetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllTagTemplatesRequest) Validate() error {
+func (m *GetMyStarredAssetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllTagTemplatesRequest with the
+// ValidateAll checks the field values on GetMyStarredAssetRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllTagTemplatesRequestMultiError, or nil if none found.
-func (m *GetAllTagTemplatesRequest) ValidateAll() error {
+// GetMyStarredAssetRequestMultiError, or nil if none found.
+func (m *GetMyStarredAssetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllTagTemplatesRequest) validate(all bool) error {
+func (m *GetMyStarredAssetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Urn
+	// no validation rules for AssetId
 
 	if len(errors) > 0 {
-		return GetAllTagTemplatesRequestMultiError(errors)
+		return GetMyStarredAssetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllTagTemplatesRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAllTagTemplatesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetAllTagTemplatesRequestMultiError []error
+// GetMyStarredAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyStarredAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyStarredAssetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllTagTemplatesRequestMultiError) Error() string {
+func (m GetMyStarredAssetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10025,11 +10225,11 @@ func (m GetAllTagTemplatesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllTagTemplatesRequestMultiError) AllErrors() []error { return m }
+func (m GetMyStarredAssetRequestMultiError) AllErrors() []error { return m }
 
-// GetAllTagTemplatesRequestValidationError is the validation error returned by
-// GetAllTagTemplatesRequest.Validate if the designated constraints aren't met.
-type GetAllTagTemplatesRequestValidationError struct {
+// GetMyStarredAssetRequestValidationError is the validation error returned by
+// GetMyStarredAssetRequest.Validate if the designated constraints aren't met.
+type GetMyStarredAssetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10037,24 +10237,24 @@ type GetAllTagTemplatesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllTagTemplatesRequestValidationError) Field() string { return e.field }
+func (e GetMyStarredAssetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllTagTemplatesRequestValidationError) Reason() string { return e.reason }
+func (e GetMyStarredAssetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllTagTemplatesRequestValidationError) Cause() error { return e.cause }
+func (e GetMyStarredAssetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllTagTemplatesRequestValidationError) Key() bool { return e.key }
+func (e GetMyStarredAssetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllTagTemplatesRequestValidationError) ErrorName() string {
-	return "GetAllTagTemplatesRequestValidationError"
+func (e GetMyStarredAssetRequestValidationError) ErrorName() string {
+	return "GetMyStarredAssetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllTagTemplatesRequestValidationError) Error() string {
+func (e GetMyStarredAssetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10066,14 +10266,14 @@ func (e GetAllTagTemplatesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllTagTemplatesRequest.%s: %s%s",
+		"invalid %sGetMyStarredAssetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllTagTemplatesRequestValidationError{}
+var _ error = GetMyStarredAssetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10081,78 +10281,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllTagTemplatesRequestValidationError{}
+} = GetMyStarredAssetRequestValidationError{}
 
-// Validate checks the field values on GetAllTagTemplatesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetMyStarredAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAllTagTemplatesResponse) Validate() error {
+func (m *GetMyStarredAssetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAllTagTemplatesResponse with the
+// ValidateAll checks the field values on GetMyStarredAssetResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAllTagTemplatesResponseMultiError, or nil if none found.
-func (m *GetAllTagTemplatesResponse) ValidateAll() error {
+// GetMyStarredAssetResponseMultiError, or nil if none found.
+func (m *GetMyStarredAssetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAllTagTemplatesResponse) validate(all bool) error {
+func (m *GetMyStarredAssetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetData() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAllTagTemplatesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAllTagTemplatesResponseValidationError{
-						field:  fmt.Sprintf("Data[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMyStarredAssetResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetAllTagTemplatesResponseValidationError{
-					field:  fmt.Sprintf("Data[%v]", idx),
+				errors = append(errors, GetMyStarredAssetResponseValidationError{
+					field:  "Data",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMyStarredAssetResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return GetAllTagTemplatesResponseMultiError(errors)
+		return GetMyStarredAssetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAllTagTemplatesResponseMultiError is an error wrapping multiple
-// validation errors returned by GetAllTagTemplatesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetAllTagTemplatesResponseMultiError []error
+// GetMyStarredAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMyStarredAssetResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetMyStarredAssetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAllTagTemplatesResponseMultiError) Error() string {
+func (m GetMyStarredAssetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10161,11 +10356,11 @@ func (m GetAllTagTemplatesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAllTagTemplatesResponseMultiError) AllErrors() []error { return m }
+func (m GetMyStarredAssetResponseMultiError) AllErrors() []error { return m }
 
-// GetAllTagTemplatesResponseValidationError is the validation error returned
-// by GetAllTagTemplatesResponse.Validate if the designated constraints aren't met.
-type GetAllTagTemplatesResponseValidationError struct {
+// GetMyStarredAssetResponseValidationError is the validation error returned by
+// GetMyStarredAssetResponse.Validate if the designated constraints aren't met.
+type GetMyStarredAssetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10173,24 +10368,24 @@ type GetAllTagTemplatesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAllTagTemplatesResponseValidationError) Field() string { return e.field }
+func (e GetMyStarredAssetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAllTagTemplatesResponseValidationError) Reason() string { return e.reason }
+func (e GetMyStarredAssetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAllTagTemplatesResponseValidationError) Cause() error { return e.cause }
+func (e GetMyStarredAssetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAllTagTemplatesResponseValidationError) Key() bool { return e.key }
+func (e GetMyStarredAssetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAllTagTemplatesResponseValidationError) ErrorName() string {
-	return "GetAllTagTemplatesResponseValidationError"
+func (e GetMyStarredAssetResponseValidationError) ErrorName() string {
+	return "GetMyStarredAssetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAllTagTemplatesResponseValidationError) Error() string {
+func (e GetMyStarredAssetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10202,14 +10397,14 @@ func (e GetAllTagTemplatesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAllTagTemplatesResponse.%s: %s%s",
+		"invalid %sGetMyStarredAssetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAllTagTemplatesResponseValidationError{}
+var _ error = GetMyStarredAssetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10217,84 +10412,13291 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAllTagTemplatesResponseValidationError{}
+} = GetMyStarredAssetResponseValidationError{}
 
-// Validate checks the field values on CreateTagTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTagTemplateRequest) Validate() error {
-	return m.validate(false)
-}
+// Validate checks the field values on StarAssetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StarAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarAssetRequestMultiError, or nil if none found.
+func (m *StarAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	if len(errors) > 0 {
+		return StarAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarAssetRequestMultiError is an error wrapping multiple validation errors
+// returned by StarAssetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StarAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarAssetRequestMultiError) AllErrors() []error { return m }
+
+// StarAssetRequestValidationError is the validation error returned by
+// StarAssetRequest.Validate if the designated constraints aren't met.
+type StarAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarAssetRequestValidationError) ErrorName() string { return "StarAssetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StarAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarAssetRequestValidationError{}
+
+// Validate checks the field values on StarAssetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StarAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StarAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StarAssetResponseMultiError, or nil if none found.
+func (m *StarAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StarAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return StarAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StarAssetResponseMultiError is an error wrapping multiple validation errors
+// returned by StarAssetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StarAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StarAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StarAssetResponseMultiError) AllErrors() []error { return m }
+
+// StarAssetResponseValidationError is the validation error returned by
+// StarAssetResponse.Validate if the designated constraints aren't met.
+type StarAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StarAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StarAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StarAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StarAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StarAssetResponseValidationError) ErrorName() string {
+	return "StarAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StarAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStarAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StarAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StarAssetResponseValidationError{}
+
+// Validate checks the field values on UnstarAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarAssetRequestMultiError, or nil if none found.
+func (m *UnstarAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	if len(errors) > 0 {
+		return UnstarAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarAssetRequestMultiError is an error wrapping multiple validation errors
+// returned by UnstarAssetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnstarAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarAssetRequestMultiError) AllErrors() []error { return m }
+
+// UnstarAssetRequestValidationError is the validation error returned by
+// UnstarAssetRequest.Validate if the designated constraints aren't met.
+type UnstarAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarAssetRequestValidationError) ErrorName() string {
+	return "UnstarAssetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarAssetRequestValidationError{}
+
+// Validate checks the field values on UnstarAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnstarAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnstarAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnstarAssetResponseMultiError, or nil if none found.
+func (m *UnstarAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnstarAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnstarAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnstarAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by UnstarAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnstarAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnstarAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnstarAssetResponseMultiError) AllErrors() []error { return m }
+
+// UnstarAssetResponseValidationError is the validation error returned by
+// UnstarAssetResponse.Validate if the designated constraints aren't met.
+type UnstarAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnstarAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnstarAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnstarAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnstarAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnstarAssetResponseValidationError) ErrorName() string {
+	return "UnstarAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnstarAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnstarAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnstarAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnstarAssetResponseValidationError{}
+
+// Validate checks the field values on GetMyDiscussionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyDiscussionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyDiscussionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyDiscussionsRequestMultiError, or nil if none found.
+func (m *GetMyDiscussionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyDiscussionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFilter() != "" {
+
+	}
+
+	if m.GetType() != "" {
+
+	}
+
+	if m.GetState() != "" {
+
+	}
+
+	if m.GetAsset() != "" {
+
+	}
+
+	if m.GetLabels() != "" {
+
+	}
+
+	if m.GetSort() != "" {
+
+	}
+
+	if m.GetDirection() != "" {
+
+	}
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetMyDiscussionsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetMyDiscussionsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyDiscussionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyDiscussionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyDiscussionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyDiscussionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyDiscussionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyDiscussionsRequestMultiError) AllErrors() []error { return m }
+
+// GetMyDiscussionsRequestValidationError is the validation error returned by
+// GetMyDiscussionsRequest.Validate if the designated constraints aren't met.
+type GetMyDiscussionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyDiscussionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyDiscussionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyDiscussionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyDiscussionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyDiscussionsRequestValidationError) ErrorName() string {
+	return "GetMyDiscussionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyDiscussionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyDiscussionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyDiscussionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyDiscussionsRequestValidationError{}
+
+// Validate checks the field values on GetMyDiscussionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyDiscussionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyDiscussionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyDiscussionsResponseMultiError, or nil if none found.
+func (m *GetMyDiscussionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyDiscussionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMyDiscussionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMyDiscussionsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMyDiscussionsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyDiscussionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyDiscussionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMyDiscussionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyDiscussionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyDiscussionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyDiscussionsResponseMultiError) AllErrors() []error { return m }
+
+// GetMyDiscussionsResponseValidationError is the validation error returned by
+// GetMyDiscussionsResponse.Validate if the designated constraints aren't met.
+type GetMyDiscussionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyDiscussionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyDiscussionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyDiscussionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyDiscussionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyDiscussionsResponseValidationError) ErrorName() string {
+	return "GetMyDiscussionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyDiscussionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyDiscussionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyDiscussionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyDiscussionsResponseValidationError{}
+
+// Validate checks the field values on CreateTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagAssetRequestMultiError, or nil if none found.
+func (m *CreateTagAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTagAssetRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTagAssetRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTagAssetRequestValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TemplateDisplayName
+
+	// no validation rules for TemplateDescription
+
+	if len(errors) > 0 {
+		return CreateTagAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTagAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTagAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagAssetRequestMultiError) AllErrors() []error { return m }
+
+// CreateTagAssetRequestValidationError is the validation error returned by
+// CreateTagAssetRequest.Validate if the designated constraints aren't met.
+type CreateTagAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagAssetRequestValidationError) ErrorName() string {
+	return "CreateTagAssetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTagAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagAssetRequestValidationError{}
+
+// Validate checks the field values on CreateTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagAssetResponseMultiError, or nil if none found.
+func (m *CreateTagAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagAssetResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagAssetResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagAssetResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTagAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTagAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTagAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagAssetResponseMultiError) AllErrors() []error { return m }
+
+// CreateTagAssetResponseValidationError is the validation error returned by
+// CreateTagAssetResponse.Validate if the designated constraints aren't met.
+type CreateTagAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagAssetResponseValidationError) ErrorName() string {
+	return "CreateTagAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTagAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagAssetResponseValidationError{}
+
+// Validate checks the field values on GetTagByAssetAndTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagByAssetAndTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagByAssetAndTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetTagByAssetAndTemplateRequestMultiError, or nil if none found.
+func (m *GetTagByAssetAndTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagByAssetAndTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return GetTagByAssetAndTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagByAssetAndTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTagByAssetAndTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetTagByAssetAndTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagByAssetAndTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagByAssetAndTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetTagByAssetAndTemplateRequestValidationError is the validation error
+// returned by GetTagByAssetAndTemplateRequest.Validate if the designated
+// constraints aren't met.
+type GetTagByAssetAndTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagByAssetAndTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagByAssetAndTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagByAssetAndTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagByAssetAndTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagByAssetAndTemplateRequestValidationError) ErrorName() string {
+	return "GetTagByAssetAndTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagByAssetAndTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagByAssetAndTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagByAssetAndTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagByAssetAndTemplateRequestValidationError{}
+
+// Validate checks the field values on GetTagByAssetAndTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetTagByAssetAndTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagByAssetAndTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetTagByAssetAndTemplateResponseMultiError, or nil if none found.
+func (m *GetTagByAssetAndTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagByAssetAndTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTagByAssetAndTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTagByAssetAndTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTagByAssetAndTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTagByAssetAndTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagByAssetAndTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetTagByAssetAndTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagByAssetAndTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagByAssetAndTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagByAssetAndTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetTagByAssetAndTemplateResponseValidationError is the validation error
+// returned by GetTagByAssetAndTemplateResponse.Validate if the designated
+// constraints aren't met.
+type GetTagByAssetAndTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagByAssetAndTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagByAssetAndTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagByAssetAndTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagByAssetAndTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagByAssetAndTemplateResponseValidationError) ErrorName() string {
+	return "GetTagByAssetAndTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagByAssetAndTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagByAssetAndTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagByAssetAndTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagByAssetAndTemplateResponseValidationError{}
+
+// Validate checks the field values on UpdateTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagAssetRequestMultiError, or nil if none found.
+func (m *UpdateTagAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateTagAssetRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateTagAssetRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateTagAssetRequestValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TemplateDisplayName
+
+	// no validation rules for TemplateDescription
+
+	if len(errors) > 0 {
+		return UpdateTagAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTagAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTagAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagAssetRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagAssetRequestValidationError is the validation error returned by
+// UpdateTagAssetRequest.Validate if the designated constraints aren't met.
+type UpdateTagAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagAssetRequestValidationError) ErrorName() string {
+	return "UpdateTagAssetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagAssetRequestValidationError{}
+
+// Validate checks the field values on UpdateTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagAssetResponseMultiError, or nil if none found.
+func (m *UpdateTagAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTagAssetResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTagAssetResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTagAssetResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateTagAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTagAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagAssetResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTagAssetResponseValidationError is the validation error returned by
+// UpdateTagAssetResponse.Validate if the designated constraints aren't met.
+type UpdateTagAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagAssetResponseValidationError) ErrorName() string {
+	return "UpdateTagAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagAssetResponseValidationError{}
+
+// Validate checks the field values on DeleteTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagAssetRequestMultiError, or nil if none found.
+func (m *DeleteTagAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return DeleteTagAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTagAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTagAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagAssetRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTagAssetRequestValidationError is the validation error returned by
+// DeleteTagAssetRequest.Validate if the designated constraints aren't met.
+type DeleteTagAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagAssetRequestValidationError) ErrorName() string {
+	return "DeleteTagAssetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTagAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagAssetRequestValidationError{}
+
+// Validate checks the field values on DeleteTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagAssetResponseMultiError, or nil if none found.
+func (m *DeleteTagAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTagAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTagAssetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTagAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagAssetResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTagAssetResponseValidationError is the validation error returned by
+// DeleteTagAssetResponse.Validate if the designated constraints aren't met.
+type DeleteTagAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagAssetResponseValidationError) ErrorName() string {
+	return "DeleteTagAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTagAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagAssetResponseValidationError{}
+
+// Validate checks the field values on CreateColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateColumnTagRequestMultiError, or nil if none found.
+func (m *CreateColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for Column
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateColumnTagRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateColumnTagRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateColumnTagRequestValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// CreateColumnTagRequestValidationError is the validation error returned by
+// CreateColumnTagRequest.Validate if the designated constraints aren't met.
+type CreateColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateColumnTagRequestValidationError) ErrorName() string {
+	return "CreateColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateColumnTagRequestValidationError{}
+
+// Validate checks the field values on CreateColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateColumnTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateColumnTagResponseMultiError, or nil if none found.
+func (m *CreateColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateColumnTagResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// CreateColumnTagResponseValidationError is the validation error returned by
+// CreateColumnTagResponse.Validate if the designated constraints aren't met.
+type CreateColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateColumnTagResponseValidationError) ErrorName() string {
+	return "CreateColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateColumnTagResponseValidationError{}
+
+// Validate checks the field values on GetColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagRequestMultiError, or nil if none found.
+func (m *GetColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for Column
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return GetColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// GetColumnTagRequestValidationError is the validation error returned by
+// GetColumnTagRequest.Validate if the designated constraints aren't met.
+type GetColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagRequestValidationError) ErrorName() string {
+	return "GetColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagRequestValidationError{}
+
+// Validate checks the field values on GetColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetColumnTagResponseMultiError, or nil if none found.
+func (m *GetColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetColumnTagResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by GetColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// GetColumnTagResponseValidationError is the validation error returned by
+// GetColumnTagResponse.Validate if the designated constraints aren't met.
+type GetColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetColumnTagResponseValidationError) ErrorName() string {
+	return "GetColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetColumnTagResponseValidationError{}
+
+// Validate checks the field values on UpdateColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateColumnTagRequestMultiError, or nil if none found.
+func (m *UpdateColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for Column
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateColumnTagRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateColumnTagRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateColumnTagRequestValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateColumnTagRequestValidationError is the validation error returned by
+// UpdateColumnTagRequest.Validate if the designated constraints aren't met.
+type UpdateColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateColumnTagRequestValidationError) ErrorName() string {
+	return "UpdateColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateColumnTagRequestValidationError{}
+
+// Validate checks the field values on UpdateColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateColumnTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateColumnTagResponseMultiError, or nil if none found.
+func (m *UpdateColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateColumnTagResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateColumnTagResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// UpdateColumnTagResponseValidationError is the validation error returned by
+// UpdateColumnTagResponse.Validate if the designated constraints aren't met.
+type UpdateColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateColumnTagResponseValidationError) ErrorName() string {
+	return "UpdateColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateColumnTagResponseValidationError{}
+
+// Validate checks the field values on DeleteColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteColumnTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteColumnTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteColumnTagRequestMultiError, or nil if none found.
+func (m *DeleteColumnTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteColumnTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for Column
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return DeleteColumnTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteColumnTagRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteColumnTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteColumnTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteColumnTagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteColumnTagRequestMultiError) AllErrors() []error { return m }
+
+// DeleteColumnTagRequestValidationError is the validation error returned by
+// DeleteColumnTagRequest.Validate if the designated constraints aren't met.
+type DeleteColumnTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteColumnTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteColumnTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteColumnTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteColumnTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteColumnTagRequestValidationError) ErrorName() string {
+	return "DeleteColumnTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteColumnTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteColumnTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteColumnTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteColumnTagRequestValidationError{}
+
+// Validate checks the field values on DeleteColumnTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteColumnTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteColumnTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteColumnTagResponseMultiError, or nil if none found.
+func (m *DeleteColumnTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteColumnTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteColumnTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteColumnTagResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteColumnTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteColumnTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteColumnTagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteColumnTagResponseMultiError) AllErrors() []error { return m }
+
+// DeleteColumnTagResponseValidationError is the validation error returned by
+// DeleteColumnTagResponse.Validate if the designated constraints aren't met.
+type DeleteColumnTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteColumnTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteColumnTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteColumnTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteColumnTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteColumnTagResponseValidationError) ErrorName() string {
+	return "DeleteColumnTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteColumnTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteColumnTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteColumnTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteColumnTagResponseValidationError{}
+
+// Validate checks the field values on GetAllTagsByAssetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTagsByAssetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTagsByAssetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTagsByAssetRequestMultiError, or nil if none found.
+func (m *GetAllTagsByAssetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTagsByAssetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	if len(errors) > 0 {
+		return GetAllTagsByAssetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTagsByAssetRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllTagsByAssetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllTagsByAssetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTagsByAssetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTagsByAssetRequestMultiError) AllErrors() []error { return m }
+
+// GetAllTagsByAssetRequestValidationError is the validation error returned by
+// GetAllTagsByAssetRequest.Validate if the designated constraints aren't met.
+type GetAllTagsByAssetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTagsByAssetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTagsByAssetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTagsByAssetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTagsByAssetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTagsByAssetRequestValidationError) ErrorName() string {
+	return "GetAllTagsByAssetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTagsByAssetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTagsByAssetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTagsByAssetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTagsByAssetRequestValidationError{}
+
+// Validate checks the field values on GetAllTagsByAssetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTagsByAssetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTagsByAssetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTagsByAssetResponseMultiError, or nil if none found.
+func (m *GetAllTagsByAssetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTagsByAssetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllTagsByAssetResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllTagsByAssetResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllTagsByAssetResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllTagsByAssetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTagsByAssetResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllTagsByAssetResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetAllTagsByAssetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTagsByAssetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTagsByAssetResponseMultiError) AllErrors() []error { return m }
+
+// GetAllTagsByAssetResponseValidationError is the validation error returned by
+// GetAllTagsByAssetResponse.Validate if the designated constraints aren't met.
+type GetAllTagsByAssetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTagsByAssetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTagsByAssetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTagsByAssetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTagsByAssetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTagsByAssetResponseValidationError) ErrorName() string {
+	return "GetAllTagsByAssetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTagsByAssetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTagsByAssetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTagsByAssetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTagsByAssetResponseValidationError{}
+
+// Validate checks the field values on GetTagHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagHistoryRequestMultiError, or nil if none found.
+func (m *GetTagHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return GetTagHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTagHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetTagHistoryRequestValidationError is the validation error returned by
+// GetTagHistoryRequest.Validate if the designated constraints aren't met.
+type GetTagHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagHistoryRequestValidationError) ErrorName() string {
+	return "GetTagHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagHistoryRequestValidationError{}
+
+// Validate checks the field values on GetTagHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagHistoryResponseMultiError, or nil if none found.
+func (m *GetTagHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTagHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTagHistoryResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTagHistoryResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTagHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTagHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetTagHistoryResponseValidationError is the validation error returned by
+// GetTagHistoryResponse.Validate if the designated constraints aren't met.
+type GetTagHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagHistoryResponseValidationError) ErrorName() string {
+	return "GetTagHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagHistoryResponseValidationError{}
+
+// Validate checks the field values on GetAllTagTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTagTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTagTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTagTemplatesRequestMultiError, or nil if none found.
+func (m *GetAllTagTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTagTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	if len(errors) > 0 {
+		return GetAllTagTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTagTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllTagTemplatesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAllTagTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTagTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTagTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// GetAllTagTemplatesRequestValidationError is the validation error returned by
+// GetAllTagTemplatesRequest.Validate if the designated constraints aren't met.
+type GetAllTagTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTagTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTagTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTagTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTagTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTagTemplatesRequestValidationError) ErrorName() string {
+	return "GetAllTagTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTagTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTagTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTagTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTagTemplatesRequestValidationError{}
+
+// Validate checks the field values on GetAllTagTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTagTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTagTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTagTemplatesResponseMultiError, or nil if none found.
+func (m *GetAllTagTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTagTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllTagTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllTagTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllTagTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllTagTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTagTemplatesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAllTagTemplatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAllTagTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTagTemplatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTagTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// GetAllTagTemplatesResponseValidationError is the validation error returned
+// by GetAllTagTemplatesResponse.Validate if the designated constraints aren't met.
+type GetAllTagTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTagTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTagTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTagTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTagTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTagTemplatesResponseValidationError) ErrorName() string {
+	return "GetAllTagTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTagTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTagTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTagTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTagTemplatesResponseValidationError{}
+
+// Validate checks the field values on CreateTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagTemplateRequestMultiError, or nil if none found.
+func (m *CreateTagTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTagTemplateRequestValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPropagation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagTemplateRequestValidationError{
+					field:  "Propagation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagTemplateRequestValidationError{
+					field:  "Propagation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPropagation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagTemplateRequestValidationError{
+				field:  "Propagation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTagTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTagTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTagTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateTagTemplateRequestValidationError is the validation error returned by
+// CreateTagTemplateRequest.Validate if the designated constraints aren't met.
+type CreateTagTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagTemplateRequestValidationError) ErrorName() string {
+	return "CreateTagTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTagTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagTemplateRequestValidationError{}
+
+// Validate checks the field values on CreateTagTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTagTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTagTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTagTemplateResponseMultiError, or nil if none found.
+func (m *CreateTagTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTagTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTagTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTagTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTagTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateTagTemplateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateTagTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTagTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTagTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateTagTemplateResponseValidationError is the validation error returned by
+// CreateTagTemplateResponse.Validate if the designated constraints aren't met.
+type CreateTagTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTagTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTagTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTagTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTagTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTagTemplateResponseValidationError) ErrorName() string {
+	return "CreateTagTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTagTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTagTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTagTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTagTemplateResponseValidationError{}
+
+// Validate checks the field values on GetTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagTemplateRequestMultiError, or nil if none found.
+func (m *GetTagTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return GetTagTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTagTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetTagTemplateRequestValidationError is the validation error returned by
+// GetTagTemplateRequest.Validate if the designated constraints aren't met.
+type GetTagTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagTemplateRequestValidationError) ErrorName() string {
+	return "GetTagTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagTemplateRequestValidationError{}
+
+// Validate checks the field values on GetTagTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTagTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTagTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTagTemplateResponseMultiError, or nil if none found.
+func (m *GetTagTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTagTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTagTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTagTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTagTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTagTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTagTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTagTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTagTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetTagTemplateResponseValidationError is the validation error returned by
+// GetTagTemplateResponse.Validate if the designated constraints aren't met.
+type GetTagTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTagTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTagTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTagTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTagTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTagTemplateResponseValidationError) ErrorName() string {
+	return "GetTagTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTagTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTagTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTagTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTagTemplateResponseValidationError{}
+
+// Validate checks the field values on UpdateTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagTemplateRequestMultiError, or nil if none found.
+func (m *UpdateTagTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateTagTemplateRequestValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPropagation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTagTemplateRequestValidationError{
+					field:  "Propagation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTagTemplateRequestValidationError{
+					field:  "Propagation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPropagation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTagTemplateRequestValidationError{
+				field:  "Propagation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTagTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTagTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTagTemplateRequestValidationError is the validation error returned by
+// UpdateTagTemplateRequest.Validate if the designated constraints aren't met.
+type UpdateTagTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagTemplateRequestValidationError) ErrorName() string {
+	return "UpdateTagTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateTagTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTagTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTagTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTagTemplateResponseMultiError, or nil if none found.
+func (m *UpdateTagTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTagTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTagTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTagTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTagTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateTagTemplateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateTagTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTagTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTagTemplateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTagTemplateResponseValidationError is the validation error returned by
+// UpdateTagTemplateResponse.Validate if the designated constraints aren't met.
+type UpdateTagTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTagTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTagTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTagTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTagTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTagTemplateResponseValidationError) ErrorName() string {
+	return "UpdateTagTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTagTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTagTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTagTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTagTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagTemplateRequestMultiError, or nil if none found.
+func (m *DeleteTagTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	if len(errors) > 0 {
+		return DeleteTagTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTagTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTagTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTagTemplateRequestValidationError is the validation error returned by
+// DeleteTagTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTagTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTagTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTagTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteTagTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTagTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTagTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTagTemplateResponseMultiError, or nil if none found.
+func (m *DeleteTagTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTagTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTagTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTagTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteTagTemplateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteTagTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTagTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTagTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTagTemplateResponseValidationError is the validation error returned by
+// DeleteTagTemplateResponse.Validate if the designated constraints aren't met.
+type DeleteTagTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTagTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTagTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTagTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTagTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTagTemplateResponseValidationError) ErrorName() string {
+	return "DeleteTagTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTagTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTagTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTagTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTagTemplateResponseValidationError{}
+
+// Validate checks the field values on MigrateTagTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateTagTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateTagTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateTagTemplateRequestMultiError, or nil if none found.
+func (m *MigrateTagTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateTagTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetMappings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MigrateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MigrateTagTemplateRequestValidationError{
+						field:  fmt.Sprintf("Mappings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MigrateTagTemplateRequestValidationError{
+					field:  fmt.Sprintf("Mappings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return MigrateTagTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateTagTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by MigrateTagTemplateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type MigrateTagTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateTagTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateTagTemplateRequestMultiError) AllErrors() []error { return m }
+
+// MigrateTagTemplateRequestValidationError is the validation error returned by
+// MigrateTagTemplateRequest.Validate if the designated constraints aren't met.
+type MigrateTagTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateTagTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateTagTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateTagTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateTagTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateTagTemplateRequestValidationError) ErrorName() string {
+	return "MigrateTagTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateTagTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateTagTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateTagTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateTagTemplateRequestValidationError{}
+
+// Validate checks the field values on MigrateTagTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MigrateTagTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrateTagTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MigrateTagTemplateResponseMultiError, or nil if none found.
+func (m *MigrateTagTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrateTagTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrateTagTemplateResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrateTagTemplateResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MigrateTagTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrateTagTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by MigrateTagTemplateResponse.ValidateAll() if
+// the designated constraints aren't met.
+type MigrateTagTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrateTagTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrateTagTemplateResponseMultiError) AllErrors() []error { return m }
+
+// MigrateTagTemplateResponseValidationError is the validation error returned
+// by MigrateTagTemplateResponse.Validate if the designated constraints aren't met.
+type MigrateTagTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrateTagTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrateTagTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrateTagTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrateTagTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrateTagTemplateResponseValidationError) ErrorName() string {
+	return "MigrateTagTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MigrateTagTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrateTagTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrateTagTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrateTagTemplateResponseValidationError{}
+
+// Validate checks the field values on BulkTagAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkTagAssetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTagAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTagAssetsRequestMultiError, or nil if none found.
+func (m *BulkTagAssetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTagAssetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	// no validation rules for QueryExpr
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkTagAssetsRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkTagAssetsRequestValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkTagAssetsRequestValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return BulkTagAssetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTagAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkTagAssetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulkTagAssetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTagAssetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTagAssetsRequestMultiError) AllErrors() []error { return m }
+
+// BulkTagAssetsRequestValidationError is the validation error returned by
+// BulkTagAssetsRequest.Validate if the designated constraints aren't met.
+type BulkTagAssetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTagAssetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTagAssetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTagAssetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTagAssetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTagAssetsRequestValidationError) ErrorName() string {
+	return "BulkTagAssetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkTagAssetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTagAssetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTagAssetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTagAssetsRequestValidationError{}
+
+// Validate checks the field values on BulkTagAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkTagAssetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTagAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTagAssetsResponseMultiError, or nil if none found.
+func (m *BulkTagAssetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTagAssetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkTagAssetsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkTagAssetsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkTagAssetsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkTagAssetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTagAssetsResponseMultiError is an error wrapping multiple validation
+// errors returned by BulkTagAssetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BulkTagAssetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTagAssetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTagAssetsResponseMultiError) AllErrors() []error { return m }
+
+// BulkTagAssetsResponseValidationError is the validation error returned by
+// BulkTagAssetsResponse.Validate if the designated constraints aren't met.
+type BulkTagAssetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTagAssetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTagAssetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTagAssetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTagAssetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTagAssetsResponseValidationError) ErrorName() string {
+	return "BulkTagAssetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkTagAssetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTagAssetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTagAssetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTagAssetsResponseValidationError{}
+
+// Validate checks the field values on BulkUntagAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUntagAssetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUntagAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUntagAssetsRequestMultiError, or nil if none found.
+func (m *BulkUntagAssetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUntagAssetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TemplateUrn
+
+	// no validation rules for QueryExpr
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return BulkUntagAssetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUntagAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkUntagAssetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulkUntagAssetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUntagAssetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUntagAssetsRequestMultiError) AllErrors() []error { return m }
+
+// BulkUntagAssetsRequestValidationError is the validation error returned by
+// BulkUntagAssetsRequest.Validate if the designated constraints aren't met.
+type BulkUntagAssetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUntagAssetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUntagAssetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUntagAssetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUntagAssetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUntagAssetsRequestValidationError) ErrorName() string {
+	return "BulkUntagAssetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUntagAssetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUntagAssetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUntagAssetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUntagAssetsRequestValidationError{}
+
+// Validate checks the field values on BulkUntagAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkUntagAssetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkUntagAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkUntagAssetsResponseMultiError, or nil if none found.
+func (m *BulkUntagAssetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkUntagAssetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkUntagAssetsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkUntagAssetsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkUntagAssetsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkUntagAssetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkUntagAssetsResponseMultiError is an error wrapping multiple validation
+// errors returned by BulkUntagAssetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BulkUntagAssetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkUntagAssetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkUntagAssetsResponseMultiError) AllErrors() []error { return m }
+
+// BulkUntagAssetsResponseValidationError is the validation error returned by
+// BulkUntagAssetsResponse.Validate if the designated constraints aren't met.
+type BulkUntagAssetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkUntagAssetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkUntagAssetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkUntagAssetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkUntagAssetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkUntagAssetsResponseValidationError) ErrorName() string {
+	return "BulkUntagAssetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkUntagAssetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkUntagAssetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkUntagAssetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkUntagAssetsResponseValidationError{}
+
+// Validate checks the field values on GetBulkTagJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBulkTagJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBulkTagJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBulkTagJobRequestMultiError, or nil if none found.
+func (m *GetBulkTagJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBulkTagJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBulkTagJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBulkTagJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBulkTagJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBulkTagJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBulkTagJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBulkTagJobRequestMultiError) AllErrors() []error { return m }
+
+// GetBulkTagJobRequestValidationError is the validation error returned by
+// GetBulkTagJobRequest.Validate if the designated constraints aren't met.
+type GetBulkTagJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBulkTagJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBulkTagJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBulkTagJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBulkTagJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBulkTagJobRequestValidationError) ErrorName() string {
+	return "GetBulkTagJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBulkTagJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBulkTagJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBulkTagJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBulkTagJobRequestValidationError{}
+
+// Validate checks the field values on GetBulkTagJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBulkTagJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBulkTagJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBulkTagJobResponseMultiError, or nil if none found.
+func (m *GetBulkTagJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBulkTagJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBulkTagJobResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBulkTagJobResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBulkTagJobResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBulkTagJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBulkTagJobResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBulkTagJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBulkTagJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBulkTagJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBulkTagJobResponseMultiError) AllErrors() []error { return m }
+
+// GetBulkTagJobResponseValidationError is the validation error returned by
+// GetBulkTagJobResponse.Validate if the designated constraints aren't met.
+type GetBulkTagJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBulkTagJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBulkTagJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBulkTagJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBulkTagJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBulkTagJobResponseValidationError) ErrorName() string {
+	return "GetBulkTagJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBulkTagJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBulkTagJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBulkTagJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBulkTagJobResponseValidationError{}
+
+// Validate checks the field values on CreateSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSavedSearchRequestMultiError, or nil if none found.
+func (m *CreateSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateSavedSearchRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetText() != "" {
+
+	}
+
+	// no validation rules for Filter
+
+	// no validation rules for Query
+
+	if all {
+		switch v := interface{}(m.GetFlags()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Flags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchRequestValidationError{
+					field:  "Flags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFlags()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchRequestValidationError{
+				field:  "Flags",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetWebhookUrl() != "" {
+
+		if uri, err := url.Parse(m.GetWebhookUrl()); err != nil {
+			err = CreateSavedSearchRequestValidationError{
+				field:  "WebhookUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := CreateSavedSearchRequestValidationError{
+				field:  "WebhookUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSavedSearchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// CreateSavedSearchRequestValidationError is the validation error returned by
+// CreateSavedSearchRequest.Validate if the designated constraints aren't met.
+type CreateSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSavedSearchRequestValidationError) ErrorName() string {
+	return "CreateSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSavedSearchRequestValidationError{}
+
+// Validate checks the field values on CreateSavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSavedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSavedSearchResponseMultiError, or nil if none found.
+func (m *CreateSavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSavedSearchResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSavedSearchResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSavedSearchResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSavedSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateSavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSavedSearchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// CreateSavedSearchResponseValidationError is the validation error returned by
+// CreateSavedSearchResponse.Validate if the designated constraints aren't met.
+type CreateSavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSavedSearchResponseValidationError) ErrorName() string {
+	return "CreateSavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSavedSearchResponseValidationError{}
+
+// Validate checks the field values on GetMySavedSearchesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMySavedSearchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMySavedSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMySavedSearchesRequestMultiError, or nil if none found.
+func (m *GetMySavedSearchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMySavedSearchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMySavedSearchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMySavedSearchesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMySavedSearchesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetMySavedSearchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMySavedSearchesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMySavedSearchesRequestMultiError) AllErrors() []error { return m }
+
+// GetMySavedSearchesRequestValidationError is the validation error returned by
+// GetMySavedSearchesRequest.Validate if the designated constraints aren't met.
+type GetMySavedSearchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMySavedSearchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMySavedSearchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMySavedSearchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMySavedSearchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMySavedSearchesRequestValidationError) ErrorName() string {
+	return "GetMySavedSearchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMySavedSearchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMySavedSearchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMySavedSearchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMySavedSearchesRequestValidationError{}
+
+// Validate checks the field values on GetMySavedSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMySavedSearchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMySavedSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMySavedSearchesResponseMultiError, or nil if none found.
+func (m *GetMySavedSearchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMySavedSearchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMySavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMySavedSearchesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMySavedSearchesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMySavedSearchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMySavedSearchesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMySavedSearchesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMySavedSearchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMySavedSearchesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMySavedSearchesResponseMultiError) AllErrors() []error { return m }
+
+// GetMySavedSearchesResponseValidationError is the validation error returned
+// by GetMySavedSearchesResponse.Validate if the designated constraints aren't met.
+type GetMySavedSearchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMySavedSearchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMySavedSearchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMySavedSearchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMySavedSearchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMySavedSearchesResponseValidationError) ErrorName() string {
+	return "GetMySavedSearchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMySavedSearchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMySavedSearchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMySavedSearchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMySavedSearchesResponseValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchRequestMultiError, or nil if none found.
+func (m *DeleteSavedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSavedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchRequestValidationError is the validation error returned by
+// DeleteSavedSearchRequest.Validate if the designated constraints aren't met.
+type DeleteSavedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchRequestValidationError) ErrorName() string {
+	return "DeleteSavedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchRequestValidationError{}
+
+// Validate checks the field values on DeleteSavedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSavedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSavedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSavedSearchResponseMultiError, or nil if none found.
+func (m *DeleteSavedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSavedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSavedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSavedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSavedSearchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSavedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSavedSearchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSavedSearchResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSavedSearchResponseValidationError is the validation error returned by
+// DeleteSavedSearchResponse.Validate if the designated constraints aren't met.
+type DeleteSavedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSavedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSavedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSavedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSavedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSavedSearchResponseValidationError) ErrorName() string {
+	return "DeleteSavedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSavedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSavedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSavedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSavedSearchResponseValidationError{}
+
+// Validate checks the field values on GetSavedSearchMatchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedSearchMatchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedSearchMatchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSavedSearchMatchesRequestMultiError, or nil if none found.
+func (m *GetSavedSearchMatchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedSearchMatchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetSavedSearchMatchesRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetSavedSearchMatchesRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSavedSearchMatchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedSearchMatchesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSavedSearchMatchesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSavedSearchMatchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedSearchMatchesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedSearchMatchesRequestMultiError) AllErrors() []error { return m }
+
+// GetSavedSearchMatchesRequestValidationError is the validation error returned
+// by GetSavedSearchMatchesRequest.Validate if the designated constraints
+// aren't met.
+type GetSavedSearchMatchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedSearchMatchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedSearchMatchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedSearchMatchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedSearchMatchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedSearchMatchesRequestValidationError) ErrorName() string {
+	return "GetSavedSearchMatchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedSearchMatchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedSearchMatchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedSearchMatchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedSearchMatchesRequestValidationError{}
+
+// Validate checks the field values on GetSavedSearchMatchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSavedSearchMatchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSavedSearchMatchesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSavedSearchMatchesResponseMultiError, or nil if none found.
+func (m *GetSavedSearchMatchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSavedSearchMatchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSavedSearchMatchesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSavedSearchMatchesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSavedSearchMatchesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSavedSearchMatchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSavedSearchMatchesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSavedSearchMatchesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetSavedSearchMatchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSavedSearchMatchesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSavedSearchMatchesResponseMultiError) AllErrors() []error { return m }
+
+// GetSavedSearchMatchesResponseValidationError is the validation error
+// returned by GetSavedSearchMatchesResponse.Validate if the designated
+// constraints aren't met.
+type GetSavedSearchMatchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSavedSearchMatchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSavedSearchMatchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSavedSearchMatchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSavedSearchMatchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSavedSearchMatchesResponseValidationError) ErrorName() string {
+	return "GetSavedSearchMatchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSavedSearchMatchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSavedSearchMatchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSavedSearchMatchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSavedSearchMatchesResponseValidationError{}
+
+// Validate checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePolicyRequestMultiError, or nil if none found.
+func (m *CreatePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSubject()) < 1 {
+		err := CreatePolicyRequestValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePolicyRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := CreatePolicyRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [viewer editor owner admin]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetScope() == nil {
+		err := CreatePolicyRequestValidationError{
+			field:  "Scope",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetScope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePolicyRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePolicyRequestValidationError{
+					field:  "Scope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePolicyRequestValidationError{
+				field:  "Scope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePolicyRequestMultiError) AllErrors() []error { return m }
+
+// CreatePolicyRequestValidationError is the validation error returned by
+// CreatePolicyRequest.Validate if the designated constraints aren't met.
+type CreatePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePolicyRequestValidationError) ErrorName() string {
+	return "CreatePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePolicyRequestValidationError{}
+
+var _CreatePolicyRequest_Role_InLookup = map[string]struct{}{
+	"viewer": {},
+	"editor": {},
+	"owner":  {},
+	"admin":  {},
+}
+
+// Validate checks the field values on CreatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePolicyResponseMultiError, or nil if none found.
+func (m *CreatePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePolicyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePolicyResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePolicyResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePolicyResponseMultiError) AllErrors() []error { return m }
+
+// CreatePolicyResponseValidationError is the validation error returned by
+// CreatePolicyResponse.Validate if the designated constraints aren't met.
+type CreatePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePolicyResponseValidationError) ErrorName() string {
+	return "CreatePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePolicyResponseValidationError{}
+
+// Validate checks the field values on GetAllPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllPoliciesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllPoliciesRequestMultiError, or nil if none found.
+func (m *GetAllPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Subject
+
+	if len(errors) > 0 {
+		return GetAllPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllPoliciesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllPoliciesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// GetAllPoliciesRequestValidationError is the validation error returned by
+// GetAllPoliciesRequest.Validate if the designated constraints aren't met.
+type GetAllPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllPoliciesRequestValidationError) ErrorName() string {
+	return "GetAllPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllPoliciesRequestValidationError{}
+
+// Validate checks the field values on GetAllPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllPoliciesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllPoliciesResponseMultiError, or nil if none found.
+func (m *GetAllPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllPoliciesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllPoliciesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// GetAllPoliciesResponseValidationError is the validation error returned by
+// GetAllPoliciesResponse.Validate if the designated constraints aren't met.
+type GetAllPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllPoliciesResponseValidationError) ErrorName() string {
+	return "GetAllPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllPoliciesResponseValidationError{}
+
+// Validate checks the field values on DeletePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePolicyRequestMultiError, or nil if none found.
+func (m *DeletePolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeletePolicyRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeletePolicyRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeletePolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePolicyRequestMultiError) AllErrors() []error { return m }
+
+// DeletePolicyRequestValidationError is the validation error returned by
+// DeletePolicyRequest.Validate if the designated constraints aren't met.
+type DeletePolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePolicyRequestValidationError) ErrorName() string {
+	return "DeletePolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePolicyRequestValidationError{}
+
+// Validate checks the field values on DeletePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePolicyResponseMultiError, or nil if none found.
+func (m *DeletePolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePolicyResponseMultiError) AllErrors() []error { return m }
+
+// DeletePolicyResponseValidationError is the validation error returned by
+// DeletePolicyResponse.Validate if the designated constraints aren't met.
+type DeletePolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePolicyResponseValidationError) ErrorName() string {
+	return "DeletePolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePolicyResponseValidationError{}
+
+// Validate checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CreateServiceAccountRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateServiceAccountRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9_-]{2,49}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return CreateServiceAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountRequestValidationError is the validation error returned
+// by CreateServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountRequestValidationError{}
+
+var _CreateServiceAccountRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9_-]{2,49}$")
+
+// Validate checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountResponseMultiError, or nil if none found.
+func (m *CreateServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateServiceAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountResponseValidationError is the validation error returned
+// by CreateServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountResponseValidationError) ErrorName() string {
+	return "CreateServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountResponseValidationError{}
+
+// Validate checks the field values on GetAllServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllServiceAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllServiceAccountsRequestMultiError, or nil if none found.
+func (m *GetAllServiceAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllServiceAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAllServiceAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllServiceAccountsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAllServiceAccountsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetAllServiceAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllServiceAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllServiceAccountsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllServiceAccountsRequestValidationError is the validation error returned
+// by GetAllServiceAccountsRequest.Validate if the designated constraints
+// aren't met.
+type GetAllServiceAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllServiceAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllServiceAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllServiceAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllServiceAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllServiceAccountsRequestValidationError) ErrorName() string {
+	return "GetAllServiceAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllServiceAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllServiceAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllServiceAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllServiceAccountsRequestValidationError{}
+
+// Validate checks the field values on GetAllServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllServiceAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllServiceAccountsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetAllServiceAccountsResponseMultiError, or nil if none found.
+func (m *GetAllServiceAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllServiceAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllServiceAccountsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllServiceAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllServiceAccountsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAllServiceAccountsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetAllServiceAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllServiceAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllServiceAccountsResponseMultiError) AllErrors() []error { return m }
+
+// GetAllServiceAccountsResponseValidationError is the validation error
+// returned by GetAllServiceAccountsResponse.Validate if the designated
+// constraints aren't met.
+type GetAllServiceAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllServiceAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllServiceAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllServiceAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllServiceAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllServiceAccountsResponseValidationError) ErrorName() string {
+	return "GetAllServiceAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllServiceAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllServiceAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllServiceAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllServiceAccountsResponseValidationError{}
+
+// Validate checks the field values on DeleteServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteServiceAccountRequestMultiError, or nil if none found.
+func (m *DeleteServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteServiceAccountRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteServiceAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteServiceAccountRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// DeleteServiceAccountRequestValidationError is the validation error returned
+// by DeleteServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type DeleteServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteServiceAccountRequestValidationError) ErrorName() string {
+	return "DeleteServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteServiceAccountRequestValidationError{}
+
+// Validate checks the field values on DeleteServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteServiceAccountResponseMultiError, or nil if none found.
+func (m *DeleteServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteServiceAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// DeleteServiceAccountResponseValidationError is the validation error returned
+// by DeleteServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type DeleteServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteServiceAccountResponseValidationError) ErrorName() string {
+	return "DeleteServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteServiceAccountResponseValidationError{}
+
+// Validate checks the field values on CreateAPITokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPITokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPITokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPITokenRequestMultiError, or nil if none found.
+func (m *CreateAPITokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPITokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetServiceAccountId()); err != nil {
+		err = CreateAPITokenRequestValidationError{
+			field:  "ServiceAccountId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(m.GetScopes()) < 1 {
+		err := CreateAPITokenRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if _, ok := _CreateAPITokenRequest_Scopes_InLookup[item]; !ok {
+			err := CreateAPITokenRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value must be in list [read write]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPITokenRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPITokenRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPITokenRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPITokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateAPITokenRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateAPITokenRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPITokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPITokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPITokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPITokenRequestMultiError) AllErrors() []error { return m }
+
+// CreateAPITokenRequestValidationError is the validation error returned by
+// CreateAPITokenRequest.Validate if the designated constraints aren't met.
+type CreateAPITokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPITokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPITokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPITokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPITokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPITokenRequestValidationError) ErrorName() string {
+	return "CreateAPITokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPITokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPITokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPITokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPITokenRequestValidationError{}
+
+var _CreateAPITokenRequest_Scopes_InLookup = map[string]struct{}{
+	"read":  {},
+	"write": {},
+}
+
+// Validate checks the field values on CreateAPITokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPITokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPITokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPITokenResponseMultiError, or nil if none found.
+func (m *CreateAPITokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPITokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPITokenResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPITokenResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPITokenResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return CreateAPITokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPITokenResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPITokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPITokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPITokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPITokenResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPITokenResponseValidationError is the validation error returned by
+// CreateAPITokenResponse.Validate if the designated constraints aren't met.
+type CreateAPITokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPITokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPITokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPITokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPITokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPITokenResponseValidationError) ErrorName() string {
+	return "CreateAPITokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPITokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPITokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPITokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPITokenResponseValidationError{}
+
+// Validate checks the field values on GetAllAPITokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllAPITokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllAPITokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllAPITokensRequestMultiError, or nil if none found.
+func (m *GetAllAPITokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllAPITokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetServiceAccountId()); err != nil {
+		err = GetAllAPITokensRequestValidationError{
+			field:  "ServiceAccountId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAllAPITokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetAllAPITokensRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetAllAPITokensRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAllAPITokensRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllAPITokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllAPITokensRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllAPITokensRequestMultiError) AllErrors() []error { return m }
+
+// GetAllAPITokensRequestValidationError is the validation error returned by
+// GetAllAPITokensRequest.Validate if the designated constraints aren't met.
+type GetAllAPITokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllAPITokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllAPITokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllAPITokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllAPITokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllAPITokensRequestValidationError) ErrorName() string {
+	return "GetAllAPITokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllAPITokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllAPITokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllAPITokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllAPITokensRequestValidationError{}
+
+// Validate checks the field values on GetAllAPITokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllAPITokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllAPITokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllAPITokensResponseMultiError, or nil if none found.
+func (m *GetAllAPITokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllAPITokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllAPITokensResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllAPITokensResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllAPITokensResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllAPITokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllAPITokensResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllAPITokensResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllAPITokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllAPITokensResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllAPITokensResponseMultiError) AllErrors() []error { return m }
+
+// GetAllAPITokensResponseValidationError is the validation error returned by
+// GetAllAPITokensResponse.Validate if the designated constraints aren't met.
+type GetAllAPITokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllAPITokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllAPITokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllAPITokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllAPITokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllAPITokensResponseValidationError) ErrorName() string {
+	return "GetAllAPITokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllAPITokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllAPITokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllAPITokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllAPITokensResponseValidationError{}
+
+// Validate checks the field values on RevokeAPITokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPITokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPITokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPITokenRequestMultiError, or nil if none found.
+func (m *RevokeAPITokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPITokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetServiceAccountId()); err != nil {
+		err = RevokeAPITokenRequestValidationError{
+			field:  "ServiceAccountId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = RevokeAPITokenRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeAPITokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RevokeAPITokenRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevokeAPITokenRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPITokenRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPITokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPITokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPITokenRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAPITokenRequestValidationError is the validation error returned by
+// RevokeAPITokenRequest.Validate if the designated constraints aren't met.
+type RevokeAPITokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPITokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPITokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPITokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPITokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPITokenRequestValidationError) ErrorName() string {
+	return "RevokeAPITokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPITokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPITokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPITokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPITokenRequestValidationError{}
+
+// Validate checks the field values on RevokeAPITokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPITokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPITokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPITokenResponseMultiError, or nil if none found.
+func (m *RevokeAPITokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPITokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPITokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPITokenResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPITokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPITokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPITokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPITokenResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAPITokenResponseValidationError is the validation error returned by
+// RevokeAPITokenResponse.Validate if the designated constraints aren't met.
+type RevokeAPITokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPITokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPITokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPITokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPITokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPITokenResponseValidationError) ErrorName() string {
+	return "RevokeAPITokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPITokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPITokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPITokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPITokenResponseValidationError{}
+
+// Validate checks the field values on CreateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamRequestMultiError, or nil if none found.
+func (m *CreateTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := CreateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateTeamRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9._-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for OnCall
+
+	// no validation rules for SlackChannel
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreateTeamRequestValidationError{
+				field:  fmt.Sprintf("Members[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamRequestMultiError) AllErrors() []error { return m }
+
+// CreateTeamRequestValidationError is the validation error returned by
+// CreateTeamRequest.Validate if the designated constraints aren't met.
+type CreateTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamRequestValidationError) ErrorName() string {
+	return "CreateTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamRequestValidationError{}
+
+var _CreateTeamRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9._-]*$")
+
+// Validate checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamResponseMultiError, or nil if none found.
+func (m *CreateTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTeamResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamResponseMultiError) AllErrors() []error { return m }
+
+// CreateTeamResponseValidationError is the validation error returned by
+// CreateTeamResponse.Validate if the designated constraints aren't met.
+type CreateTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamResponseValidationError) ErrorName() string {
+	return "CreateTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamResponseValidationError{}
+
+// Validate checks the field values on GetAllTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTeamsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTeamsRequestMultiError, or nil if none found.
+func (m *GetAllTeamsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTeamsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAllTeamsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTeamsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAllTeamsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAllTeamsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTeamsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTeamsRequestMultiError) AllErrors() []error { return m }
+
+// GetAllTeamsRequestValidationError is the validation error returned by
+// GetAllTeamsRequest.Validate if the designated constraints aren't met.
+type GetAllTeamsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTeamsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTeamsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTeamsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTeamsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTeamsRequestValidationError) ErrorName() string {
+	return "GetAllTeamsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTeamsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTeamsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTeamsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTeamsRequestValidationError{}
+
+// Validate checks the field values on GetAllTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAllTeamsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAllTeamsResponseMultiError, or nil if none found.
+func (m *GetAllTeamsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllTeamsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllTeamsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllTeamsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllTeamsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAllTeamsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAllTeamsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAllTeamsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAllTeamsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllTeamsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllTeamsResponseMultiError) AllErrors() []error { return m }
+
+// GetAllTeamsResponseValidationError is the validation error returned by
+// GetAllTeamsResponse.Validate if the designated constraints aren't met.
+type GetAllTeamsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllTeamsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllTeamsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllTeamsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllTeamsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllTeamsResponseValidationError) ErrorName() string {
+	return "GetAllTeamsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAllTeamsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllTeamsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllTeamsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllTeamsResponseValidationError{}
+
+// Validate checks the field values on GetTeamRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetTeamRequestMultiError,
+// or nil if none found.
+func (m *GetTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetTeamRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetTeamRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamRequestMultiError) AllErrors() []error { return m }
+
+// GetTeamRequestValidationError is the validation error returned by
+// GetTeamRequest.Validate if the designated constraints aren't met.
+type GetTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamRequestValidationError) ErrorName() string { return "GetTeamRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamRequestValidationError{}
+
+// Validate checks the field values on GetTeamResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTeamResponseMultiError, or nil if none found.
+func (m *GetTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTeamResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by GetTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTeamResponseMultiError) AllErrors() []error { return m }
+
+// GetTeamResponseValidationError is the validation error returned by
+// GetTeamResponse.Validate if the designated constraints aren't met.
+type GetTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTeamResponseValidationError) ErrorName() string { return "GetTeamResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTeamResponseValidationError{}
+
+// Validate checks the field values on UpdateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamRequestMultiError, or nil if none found.
+func (m *UpdateTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateTeamRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for OnCall
+
+	// no validation rules for SlackChannel
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := UpdateTeamRequestValidationError{
+				field:  fmt.Sprintf("Members[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateTeamRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTeamRequestValidationError is the validation error returned by
+// UpdateTeamRequest.Validate if the designated constraints aren't met.
+type UpdateTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamRequestValidationError) ErrorName() string {
+	return "UpdateTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamRequestValidationError{}
+
+// Validate checks the field values on UpdateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamResponseMultiError, or nil if none found.
+func (m *UpdateTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTeamResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTeamResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTeamResponseValidationError is the validation error returned by
+// UpdateTeamResponse.Validate if the designated constraints aren't met.
+type UpdateTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamResponseValidationError) ErrorName() string {
+	return "UpdateTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamResponseValidationError{}
+
+// Validate checks the field values on DeleteTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamRequestMultiError, or nil if none found.
+func (m *DeleteTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteTeamRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTeamRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTeamRequestValidationError is the validation error returned by
+// DeleteTeamRequest.Validate if the designated constraints aren't met.
+type DeleteTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamRequestValidationError) ErrorName() string {
+	return "DeleteTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamRequestValidationError{}
+
+// Validate checks the field values on DeleteTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamResponseMultiError, or nil if none found.
+func (m *DeleteTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTeamResponseValidationError is the validation error returned by
+// DeleteTeamResponse.Validate if the designated constraints aren't met.
+type DeleteTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamResponseValidationError) ErrorName() string {
+	return "DeleteTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamResponseValidationError{}
+
+// Validate checks the field values on GetMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyProfileRequestMultiError, or nil if none found.
+func (m *GetMyProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMyProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyProfileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetMyProfileRequestValidationError is the validation error returned by
+// GetMyProfileRequest.Validate if the designated constraints aren't met.
+type GetMyProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyProfileRequestValidationError) ErrorName() string {
+	return "GetMyProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyProfileRequestValidationError{}
+
+// Validate checks the field values on GetMyProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyProfileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyProfileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyProfileResponseMultiError, or nil if none found.
+func (m *GetMyProfileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyProfileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMyProfileResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMyProfileResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMyProfileResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMyProfileResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMyProfileResponseValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMyProfileResponseValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyProfileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyProfileResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMyProfileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyProfileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyProfileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyProfileResponseMultiError) AllErrors() []error { return m }
+
+// GetMyProfileResponseValidationError is the validation error returned by
+// GetMyProfileResponse.Validate if the designated constraints aren't met.
+type GetMyProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyProfileResponseValidationError) ErrorName() string {
+	return "GetMyProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyProfileResponseValidationError{}
+
+// Validate checks the field values on GetMyTeamsAssetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyTeamsAssetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyTeamsAssetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyTeamsAssetsRequestMultiError, or nil if none found.
+func (m *GetMyTeamsAssetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyTeamsAssetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := GetMyTeamsAssetsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := GetMyTeamsAssetsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyTeamsAssetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyTeamsAssetsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyTeamsAssetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyTeamsAssetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyTeamsAssetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyTeamsAssetsRequestMultiError) AllErrors() []error { return m }
+
+// GetMyTeamsAssetsRequestValidationError is the validation error returned by
+// GetMyTeamsAssetsRequest.Validate if the designated constraints aren't met.
+type GetMyTeamsAssetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyTeamsAssetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyTeamsAssetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyTeamsAssetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyTeamsAssetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyTeamsAssetsRequestValidationError) ErrorName() string {
+	return "GetMyTeamsAssetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyTeamsAssetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyTeamsAssetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyTeamsAssetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyTeamsAssetsRequestValidationError{}
+
+// Validate checks the field values on GetMyTeamsAssetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyTeamsAssetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyTeamsAssetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyTeamsAssetsResponseMultiError, or nil if none found.
+func (m *GetMyTeamsAssetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyTeamsAssetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMyTeamsAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMyTeamsAssetsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMyTeamsAssetsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMyTeamsAssetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyTeamsAssetsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetMyTeamsAssetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyTeamsAssetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyTeamsAssetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyTeamsAssetsResponseMultiError) AllErrors() []error { return m }
+
+// GetMyTeamsAssetsResponseValidationError is the validation error returned by
+// GetMyTeamsAssetsResponse.Validate if the designated constraints aren't met.
+type GetMyTeamsAssetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyTeamsAssetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyTeamsAssetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyTeamsAssetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyTeamsAssetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyTeamsAssetsResponseValidationError) ErrorName() string {
+	return "GetMyTeamsAssetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyTeamsAssetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyTeamsAssetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyTeamsAssetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyTeamsAssetsResponseValidationError{}
+
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actor
+
+	// no validation rules for Method
+
+	// no validation rules for TargetId
+
+	// no validation rules for Result
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSize() != 0 {
+
+		if m.GetSize() < 0 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "Size",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOffset() != 0 {
+
+		if m.GetOffset() < 0 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "Offset",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Uuid
+
+	// no validation rules for Email
+
+	// no validation rules for Provider
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Change) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Change with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ChangeMultiError, or nil if none found.
+func (m *Change) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Change) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeMultiError is an error wrapping multiple validation errors returned by
+// Change.ValidateAll() if the designated constraints aren't met.
+type ChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeMultiError) AllErrors() []error { return m }
+
+// ChangeValidationError is the validation error returned by Change.Validate if
+// the designated constraints aren't met.
+type ChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeValidationError) ErrorName() string { return "ChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeValidationError{}
+
+// Validate checks the field values on ColumnChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ColumnChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ColumnChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ColumnChangeMultiError, or
+// nil if none found.
+func (m *ColumnChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ColumnChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Column
+
+	// no validation rules for OldColumn
+
+	// no validation rules for DataType
+
+	// no validation rules for OldDataType
+
+	// no validation rules for Breaking
+
+	if len(errors) > 0 {
+		return ColumnChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ColumnChangeMultiError is an error wrapping multiple validation errors
+// returned by ColumnChange.ValidateAll() if the designated constraints aren't met.
+type ColumnChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ColumnChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ColumnChangeMultiError) AllErrors() []error { return m }
+
+// ColumnChangeValidationError is the validation error returned by
+// ColumnChange.Validate if the designated constraints aren't met.
+type ColumnChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ColumnChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ColumnChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ColumnChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ColumnChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ColumnChangeValidationError) ErrorName() string { return "ColumnChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ColumnChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sColumnChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ColumnChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ColumnChangeValidationError{}
+
+// Validate checks the field values on SchemaDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SchemaDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchemaDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SchemaDiffMultiError, or
+// nil if none found.
+func (m *SchemaDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchemaDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchemaDiffValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchemaDiffValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchemaDiffValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SchemaDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchemaDiffMultiError is an error wrapping multiple validation errors
+// returned by SchemaDiff.ValidateAll() if the designated constraints aren't met.
+type SchemaDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchemaDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchemaDiffMultiError) AllErrors() []error { return m }
+
+// SchemaDiffValidationError is the validation error returned by
+// SchemaDiff.Validate if the designated constraints aren't met.
+type SchemaDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchemaDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchemaDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchemaDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchemaDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchemaDiffValidationError) ErrorName() string { return "SchemaDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchemaDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchemaDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchemaDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchemaDiffValidationError{}
+
+// Validate checks the field values on Asset with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Asset) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Asset with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AssetMultiError, or nil if none found.
+func (m *Asset) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Asset) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Urn
+
+	// no validation rules for Type
+
+	// no validation rules for Service
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssetValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	for idx, item := range m.GetOwners() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Owners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Owners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssetValidationError{
+					field:  fmt.Sprintf("Owners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssetValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChangelog() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Changelog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Changelog[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssetValidationError{
+					field:  fmt.Sprintf("Changelog[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssetValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssetValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Url
+
+	for idx, item := range m.GetProbes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssetValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssetValidationError{
+					field:  fmt.Sprintf("Probes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsDeleted
+
+	if all {
+		switch v := interface{}(m.GetVisibility()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisibility()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssetValidationError{
+				field:  "Visibility",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssetMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssetMultiError is an error wrapping multiple validation errors returned by
+// Asset.ValidateAll() if the designated constraints aren't met.
+type AssetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssetMultiError) AllErrors() []error { return m }
+
+// AssetValidationError is the validation error returned by Asset.Validate if
+// the designated constraints aren't met.
+type AssetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssetValidationError) ErrorName() string { return "AssetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAsset.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssetValidationError{}
+
+// Validate checks the field values on Probe with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Probe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Probe with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProbeMultiError, or nil if none found.
+func (m *Probe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Probe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AssetUrn
+
+	// no validation rules for Status
+
+	// no validation rules for StatusReason
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProbeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProbeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProbeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProbeMultiError is an error wrapping multiple validation errors returned by
+// Probe.ValidateAll() if the designated constraints aren't met.
+type ProbeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProbeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProbeMultiError) AllErrors() []error { return m }
+
+// ProbeValidationError is the validation error returned by Probe.Validate if
+// the designated constraints aren't met.
+type ProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProbeValidationError) ErrorName() string { return "ProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProbeValidationError{}
+
+// Validate checks the field values on Discussion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Discussion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Discussion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiscussionMultiError, or
+// nil if none found.
+func (m *Discussion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Discussion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for Type
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscussionValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscussionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiscussionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiscussionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DiscussionMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscussionMultiError is an error wrapping multiple validation errors
+// returned by Discussion.ValidateAll() if the designated constraints aren't met.
+type DiscussionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscussionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscussionMultiError) AllErrors() []error { return m }
+
+// DiscussionValidationError is the validation error returned by
+// Discussion.Validate if the designated constraints aren't met.
+type DiscussionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscussionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscussionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscussionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscussionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscussionValidationError) ErrorName() string { return "DiscussionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiscussionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscussion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscussionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscussionValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DiscussionId
+
+	// no validation rules for Body
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on LineageEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageEdgeMultiError, or
+// nil if none found.
+func (m *LineageEdge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageEdge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Target
+
+	if all {
+		switch v := interface{}(m.GetProp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Prop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageEdgeValidationError{
+					field:  "Prop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageEdgeValidationError{
+				field:  "Prop",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LineageEdgeMultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageEdgeMultiError is an error wrapping multiple validation errors
+// returned by LineageEdge.ValidateAll() if the designated constraints aren't met.
+type LineageEdgeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageEdgeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageEdgeMultiError) AllErrors() []error { return m }
+
+// LineageEdgeValidationError is the validation error returned by
+// LineageEdge.Validate if the designated constraints aren't met.
+type LineageEdgeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageEdgeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageEdgeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageEdgeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageEdgeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageEdgeValidationError) ErrorName() string { return "LineageEdgeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageEdgeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageEdge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageEdgeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageEdgeValidationError{}
+
+// Validate checks the field values on LineageEdgeV2 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageEdgeV2) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageEdgeV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageEdgeV2MultiError, or
+// nil if none found.
+func (m *LineageEdgeV2) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageEdgeV2) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceAsset
+
+	// no validation rules for TargetAsset
+
+	if all {
+		switch v := interface{}(m.GetProp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LineageEdgeV2ValidationError{
+					field:  "Prop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LineageEdgeV2ValidationError{
+					field:  "Prop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LineageEdgeV2ValidationError{
+				field:  "Prop",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SourceColumn != nil {
+		// no validation rules for SourceColumn
+	}
+
+	if m.TargetColumn != nil {
+		// no validation rules for TargetColumn
+	}
+
+	if len(errors) > 0 {
+		return LineageEdgeV2MultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageEdgeV2MultiError is an error wrapping multiple validation errors
+// returned by LineageEdgeV2.ValidateAll() if the designated constraints
+// aren't met.
+type LineageEdgeV2MultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageEdgeV2MultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageEdgeV2MultiError) AllErrors() []error { return m }
+
+// LineageEdgeV2ValidationError is the validation error returned by
+// LineageEdgeV2.Validate if the designated constraints aren't met.
+type LineageEdgeV2ValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageEdgeV2ValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageEdgeV2ValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageEdgeV2ValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageEdgeV2ValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageEdgeV2ValidationError) ErrorName() string { return "LineageEdgeV2ValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageEdgeV2ValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageEdgeV2.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageEdgeV2ValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageEdgeV2ValidationError{}
+
+// Validate checks the field values on LineageNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LineageNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LineageNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LineageNodeMultiError, or
+// nil if none found.
+func (m *LineageNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LineageNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	// no validation rules for Type
+
+	// no validation rules for Service
+
+	if len(errors) > 0 {
+		return LineageNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// LineageNodeMultiError is an error wrapping multiple validation errors
+// returned by LineageNode.ValidateAll() if the designated constraints aren't met.
+type LineageNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LineageNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LineageNodeMultiError) AllErrors() []error { return m }
+
+// LineageNodeValidationError is the validation error returned by
+// LineageNode.Validate if the designated constraints aren't met.
+type LineageNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LineageNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LineageNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LineageNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LineageNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LineageNodeValidationError) ErrorName() string { return "LineageNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LineageNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLineageNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LineageNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LineageNodeValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	for idx, item := range m.GetTagValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TagValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TagValidationError{
+						field:  fmt.Sprintf("TagValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TagValidationError{
+					field:  fmt.Sprintf("TagValues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TemplateDisplayName
+
+	// no validation rules for TemplateDescription
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for InheritedFrom
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on TagHistory with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagHistory with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagHistoryMultiError, or
+// nil if none found.
+func (m *TagHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AssetId
+
+	// no validation rules for TemplateUrn
+
+	// no validation rules for Action
+
+	// no validation rules for Actor
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagHistoryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagHistoryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Column
+
+	if len(errors) > 0 {
+		return TagHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagHistoryMultiError is an error wrapping multiple validation errors
+// returned by TagHistory.ValidateAll() if the designated constraints aren't met.
+type TagHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagHistoryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagHistoryMultiError) AllErrors() []error { return m }
+
+// TagHistoryValidationError is the validation error returned by
+// TagHistory.Validate if the designated constraints aren't met.
+type TagHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagHistoryValidationError) ErrorName() string { return "TagHistoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-// ValidateAll checks the field values on CreateTagTemplateRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateTagTemplateRequestMultiError, or nil if none found.
-func (m *CreateTagTemplateRequest) ValidateAll() error {
+var _ error = TagHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagHistoryValidationError{}
+
+// Validate checks the field values on TagValue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagValueMultiError, or nil
+// if none found.
+func (m *TagValue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTagTemplateRequest) validate(all bool) error {
+func (m *TagValue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Urn
+	// no validation rules for FieldId
 
-	// no validation rules for DisplayName
+	if all {
+		switch v := interface{}(m.GetFieldValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValueValidationError{
+					field:  "FieldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagValueValidationError{
+					field:  "FieldValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValueValidationError{
+				field:  "FieldValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Description
+	// no validation rules for FieldUrn
 
-	for idx, item := range m.GetFields() {
-		_, _ = idx, item
+	// no validation rules for FieldDisplayName
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTagTemplateRequestValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTagTemplateRequestValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for FieldDescription
+
+	// no validation rules for FieldDataType
+
+	// no validation rules for FieldRequired
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValueValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CreateTagTemplateRequestValidationError{
-					field:  fmt.Sprintf("Fields[%v]", idx),
+				errors = append(errors, TagValueValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValueValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagValueValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagValueValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagValueValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return CreateTagTemplateRequestMultiError(errors)
+		return TagValueMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTagTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateTagTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateTagTemplateRequestMultiError []error
+// TagValueMultiError is an error wrapping multiple validation errors returned
+// by TagValue.ValidateAll() if the designated constraints aren't met.
+type TagValueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTagTemplateRequestMultiError) Error() string {
+func (m TagValueMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10303,11 +23705,11 @@ func (m CreateTagTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTagTemplateRequestMultiError) AllErrors() []error { return m }
+func (m TagValueMultiError) AllErrors() []error { return m }
 
-// CreateTagTemplateRequestValidationError is the validation error returned by
-// CreateTagTemplateRequest.Validate if the designated constraints aren't met.
-type CreateTagTemplateRequestValidationError struct {
+// TagValueValidationError is the validation error returned by
+// TagValue.Validate if the designated constraints aren't met.
+type TagValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10315,24 +23717,22 @@ type CreateTagTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTagTemplateRequestValidationError) Field() string { return e.field }
+func (e TagValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTagTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TagValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTagTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TagValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTagTemplateRequestValidationError) Key() bool { return e.key }
+func (e TagValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTagTemplateRequestValidationError) ErrorName() string {
-	return "CreateTagTemplateRequestValidationError"
-}
+func (e TagValueValidationError) ErrorName() string { return "TagValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTagTemplateRequestValidationError) Error() string {
+func (e TagValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10344,14 +23744,14 @@ func (e CreateTagTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTagTemplateRequest.%s: %s%s",
+		"invalid %sTagValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTagTemplateRequestValidationError{}
+var _ error = TagValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -10359,53 +23759,153 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTagTemplateRequestValidationError{}
+} = TagValueValidationError{}
 
-// Validate checks the field values on CreateTagTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTagTemplateResponse) Validate() error {
+// Validate checks the field values on TagTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagTemplate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTagTemplateResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateTagTemplateResponseMultiError, or nil if none found.
-func (m *CreateTagTemplateResponse) ValidateAll() error {
+// ValidateAll checks the field values on TagTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagTemplateMultiError, or
+// nil if none found.
+func (m *TagTemplate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTagTemplateResponse) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *TagTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TagTemplateValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TagTemplateValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TagTemplateValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagTemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagTemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagTemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagTemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
+	// no validation rules for Version
 
 	if all {
-		switch v := interface{}(m.GetData()).(type) {
+		switch v := interface{}(m.GetPropagation()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTagTemplateResponseValidationError{
-					field:  "Data",
+				errors = append(errors, TagTemplateValidationError{
+					field:  "Propagation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTagTemplateResponseValidationError{
-					field:  "Data",
+				errors = append(errors, TagTemplateValidationError{
+					field:  "Propagation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPropagation()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTagTemplateResponseValidationError{
-				field:  "Data",
+			return TagTemplateValidationError{
+				field:  "Propagation",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10413,19 +23913,18 @@ func (m *CreateTagTemplateResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateTagTemplateResponseMultiError(errors)
+		return TagTemplateMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTagTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateTagTemplateResponse.ValidateAll() if the
-// designated constraints aren't met.
-type CreateTagTemplateResponseMultiError []error
+// TagTemplateMultiError is an error wrapping multiple validation errors
+// returned by TagTemplate.ValidateAll() if the designated constraints aren't met.
+type TagTemplateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTagTemplateResponseMultiError) Error() string {
+func (m TagTemplateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10434,11 +23933,11 @@ func (m CreateTagTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTagTemplateResponseMultiError) AllErrors() []error { return m }
+func (m TagTemplateMultiError) AllErrors() []error { return m }
 
-// CreateTagTemplateResponseValidationError is the validation error returned by
-// CreateTagTemplateResponse.Validate if the designated constraints aren't met.
-type CreateTagTemplateResponseValidationError struct {
+// TagTemplateValidationError is the validation error returned by
+// TagTemplate.Validate if the designated constraints aren't met.
+type TagTemplateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10446,24 +23945,22 @@ type CreateTagTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTagTemplateResponseValidationError) Field() string { return e.field }
+func (e TagTemplateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTagTemplateResponseValidationError) Reason() string { return e.reason }
+func (e TagTemplateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTagTemplateResponseValidationError) Cause() error { return e.cause }
+func (e TagTemplateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTagTemplateResponseValidationError) Key() bool { return e.key }
+func (e TagTemplateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTagTemplateResponseValidationError) ErrorName() string {
-	return "CreateTagTemplateResponseValidationError"
-}
+func (e TagTemplateValidationError) ErrorName() string { return "TagTemplateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTagTemplateResponseValidationError) Error() string {
+func (e TagTemplateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10475,14 +23972,14 @@ func (e CreateTagTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTagTemplateResponse.%s: %s%s",
+		"invalid %sTagTemplate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTagTemplateResponseValidationError{}
+var _ error = TagTemplateValidationError{}
 
 var _ interface {
 	Field() string
@@ -10490,46 +23987,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTagTemplateResponseValidationError{}
+} = TagTemplateValidationError{}
 
-// Validate checks the field values on GetTagTemplateRequest with the rules
+// Validate checks the field values on TagPropagationRule with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTagTemplateRequest) Validate() error {
+func (m *TagPropagationRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTagTemplateRequest with the rules
+// ValidateAll checks the field values on TagPropagationRule with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTagTemplateRequestMultiError, or nil if none found.
-func (m *GetTagTemplateRequest) ValidateAll() error {
+// TagPropagationRuleMultiError, or nil if none found.
+func (m *TagPropagationRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTagTemplateRequest) validate(all bool) error {
+func (m *TagPropagationRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TemplateUrn
+	// no validation rules for Depth
+
+	// no validation rules for Conditions
 
 	if len(errors) > 0 {
-		return GetTagTemplateRequestMultiError(errors)
+		return TagPropagationRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTagTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by GetTagTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetTagTemplateRequestMultiError []error
+// TagPropagationRuleMultiError is an error wrapping multiple validation errors
+// returned by TagPropagationRule.ValidateAll() if the designated constraints
+// aren't met.
+type TagPropagationRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTagTemplateRequestMultiError) Error() string {
+func (m TagPropagationRuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10538,11 +24037,11 @@ func (m GetTagTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTagTemplateRequestMultiError) AllErrors() []error { return m }
+func (m TagPropagationRuleMultiError) AllErrors() []error { return m }
 
-// GetTagTemplateRequestValidationError is the validation error returned by
-// GetTagTemplateRequest.Validate if the designated constraints aren't met.
-type GetTagTemplateRequestValidationError struct {
+// TagPropagationRuleValidationError is the validation error returned by
+// TagPropagationRule.Validate if the designated constraints aren't met.
+type TagPropagationRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10550,24 +24049,24 @@ type GetTagTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTagTemplateRequestValidationError) Field() string { return e.field }
+func (e TagPropagationRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTagTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TagPropagationRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTagTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TagPropagationRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTagTemplateRequestValidationError) Key() bool { return e.key }
+func (e TagPropagationRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTagTemplateRequestValidationError) ErrorName() string {
-	return "GetTagTemplateRequestValidationError"
+func (e TagPropagationRuleValidationError) ErrorName() string {
+	return "TagPropagationRuleValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTagTemplateRequestValidationError) Error() string {
+func (e TagPropagationRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10579,14 +24078,14 @@ func (e GetTagTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTagTemplateRequest.%s: %s%s",
+		"invalid %sTagPropagationRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTagTemplateRequestValidationError{}
+var _ error = TagPropagationRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -10594,73 +24093,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTagTemplateRequestValidationError{}
+} = TagPropagationRuleValidationError{}
 
-// Validate checks the field values on GetTagTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTagTemplateResponse) Validate() error {
+// Validate checks the field values on TagTemplateField with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TagTemplateField) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTagTemplateResponse with the rules
+// ValidateAll checks the field values on TagTemplateField with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetTagTemplateResponseMultiError, or nil if none found.
-func (m *GetTagTemplateResponse) ValidateAll() error {
+// TagTemplateFieldMultiError, or nil if none found.
+func (m *TagTemplateField) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTagTemplateResponse) validate(all bool) error {
+func (m *TagTemplateField) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Urn
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for DataType
+
+	// no validation rules for Required
+
 	if all {
-		switch v := interface{}(m.GetData()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetTagTemplateResponseValidationError{
-					field:  "Data",
+				errors = append(errors, TagTemplateFieldValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetTagTemplateResponseValidationError{
-					field:  "Data",
+				errors = append(errors, TagTemplateFieldValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetTagTemplateResponseValidationError{
-				field:  "Data",
+			return TagTemplateFieldValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TagTemplateFieldValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TagTemplateFieldValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TagTemplateFieldValidationError{
+				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Multiple
+
+	// no validation rules for Pattern
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
 	if len(errors) > 0 {
-		return GetTagTemplateResponseMultiError(errors)
+		return TagTemplateFieldMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTagTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by GetTagTemplateResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetTagTemplateResponseMultiError []error
+// TagTemplateFieldMultiError is an error wrapping multiple validation errors
+// returned by TagTemplateField.ValidateAll() if the designated constraints
+// aren't met.
+type TagTemplateFieldMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTagTemplateResponseMultiError) Error() string {
+func (m TagTemplateFieldMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10669,11 +24221,11 @@ func (m GetTagTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTagTemplateResponseMultiError) AllErrors() []error { return m }
+func (m TagTemplateFieldMultiError) AllErrors() []error { return m }
 
-// GetTagTemplateResponseValidationError is the validation error returned by
-// GetTagTemplateResponse.Validate if the designated constraints aren't met.
-type GetTagTemplateResponseValidationError struct {
+// TagTemplateFieldValidationError is the validation error returned by
+// TagTemplateField.Validate if the designated constraints aren't met.
+type TagTemplateFieldValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10681,24 +24233,22 @@ type GetTagTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTagTemplateResponseValidationError) Field() string { return e.field }
+func (e TagTemplateFieldValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTagTemplateResponseValidationError) Reason() string { return e.reason }
+func (e TagTemplateFieldValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTagTemplateResponseValidationError) Cause() error { return e.cause }
+func (e TagTemplateFieldValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTagTemplateResponseValidationError) Key() bool { return e.key }
+func (e TagTemplateFieldValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTagTemplateResponseValidationError) ErrorName() string {
-	return "GetTagTemplateResponseValidationError"
-}
+func (e TagTemplateFieldValidationError) ErrorName() string { return "TagTemplateFieldValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetTagTemplateResponseValidationError) Error() string {
+func (e TagTemplateFieldValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10710,14 +24260,14 @@ func (e GetTagTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTagTemplateResponse.%s: %s%s",
+		"invalid %sTagTemplateField.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTagTemplateResponseValidationError{}
+var _ error = TagTemplateFieldValidationError{}
 
 var _ interface {
 	Field() string
@@ -10725,84 +24275,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTagTemplateResponseValidationError{}
+} = TagTemplateFieldValidationError{}
 
-// Validate checks the field values on UpdateTagTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTagTemplateRequest) Validate() error {
+// Validate checks the field values on TagValueMapping with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TagValueMapping) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on UpdateTagTemplateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+
+// ValidateAll checks the field values on TagValueMapping with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTagTemplateRequestMultiError, or nil if none found.
-func (m *UpdateTagTemplateRequest) ValidateAll() error {
+// TagValueMappingMultiError, or nil if none found.
+func (m *TagValueMapping) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTagTemplateRequest) validate(all bool) error {
+func (m *TagValueMapping) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TemplateUrn
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	for idx, item := range m.GetFields() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateTagTemplateRequestValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateTagTemplateRequestValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateTagTemplateRequestValidationError{
-					field:  fmt.Sprintf("Fields[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for FieldId
 
-	}
+	// no validation rules for Values
 
 	if len(errors) > 0 {
-		return UpdateTagTemplateRequestMultiError(errors)
+		return TagValueMappingMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTagTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateTagTemplateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateTagTemplateRequestMultiError []error
+// TagValueMappingMultiError is an error wrapping multiple validation errors
+// returned by TagValueMapping.ValidateAll() if the designated constraints
+// aren't met.
+type TagValueMappingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTagTemplateRequestMultiError) Error() string {
+func (m TagValueMappingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10811,11 +24325,11 @@ func (m UpdateTagTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTagTemplateRequestMultiError) AllErrors() []error { return m }
+func (m TagValueMappingMultiError) AllErrors() []error { return m }
 
-// UpdateTagTemplateRequestValidationError is the validation error returned by
-// UpdateTagTemplateRequest.Validate if the designated constraints aren't met.
-type UpdateTagTemplateRequestValidationError struct {
+// TagValueMappingValidationError is the validation error returned by
+// TagValueMapping.Validate if the designated constraints aren't met.
+type TagValueMappingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10823,24 +24337,22 @@ type UpdateTagTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTagTemplateRequestValidationError) Field() string { return e.field }
+func (e TagValueMappingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTagTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TagValueMappingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTagTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TagValueMappingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTagTemplateRequestValidationError) Key() bool { return e.key }
+func (e TagValueMappingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTagTemplateRequestValidationError) ErrorName() string {
-	return "UpdateTagTemplateRequestValidationError"
-}
+func (e TagValueMappingValidationError) ErrorName() string { return "TagValueMappingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateTagTemplateRequestValidationError) Error() string {
+func (e TagValueMappingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10852,14 +24364,14 @@ func (e UpdateTagTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTagTemplateRequest.%s: %s%s",
+		"invalid %sTagValueMapping.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTagTemplateRequestValidationError{}
+var _ error = TagValueMappingValidationError{}
 
 var _ interface {
 	Field() string
@@ -10867,73 +24379,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTagTemplateRequestValidationError{}
+} = TagValueMappingValidationError{}
 
-// Validate checks the field values on UpdateTagTemplateResponse with the rules
+// Validate checks the field values on TagMigrationReport with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateTagTemplateResponse) Validate() error {
+func (m *TagMigrationReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateTagTemplateResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TagMigrationReport with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateTagTemplateResponseMultiError, or nil if none found.
-func (m *UpdateTagTemplateResponse) ValidateAll() error {
+// TagMigrationReportMultiError, or nil if none found.
+func (m *TagMigrationReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateTagTemplateResponse) validate(all bool) error {
+func (m *TagMigrationReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateTagTemplateResponseValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for TemplateUrn
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for Tags
+
+	// no validation rules for Migrated
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TagMigrationReportValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TagMigrationReportValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateTagTemplateResponseValidationError{
-					field:  "Data",
+				return TagMigrationReportValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateTagTemplateResponseValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateTagTemplateResponseMultiError(errors)
+		return TagMigrationReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateTagTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateTagTemplateResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateTagTemplateResponseMultiError []error
+// TagMigrationReportMultiError is an error wrapping multiple validation errors
+// returned by TagMigrationReport.ValidateAll() if the designated constraints
+// aren't met.
+type TagMigrationReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateTagTemplateResponseMultiError) Error() string {
+func (m TagMigrationReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10942,11 +24467,11 @@ func (m UpdateTagTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateTagTemplateResponseMultiError) AllErrors() []error { return m }
+func (m TagMigrationReportMultiError) AllErrors() []error { return m }
 
-// UpdateTagTemplateResponseValidationError is the validation error returned by
-// UpdateTagTemplateResponse.Validate if the designated constraints aren't met.
-type UpdateTagTemplateResponseValidationError struct {
+// TagMigrationReportValidationError is the validation error returned by
+// TagMigrationReport.Validate if the designated constraints aren't met.
+type TagMigrationReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10954,24 +24479,24 @@ type UpdateTagTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateTagTemplateResponseValidationError) Field() string { return e.field }
+func (e TagMigrationReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateTagTemplateResponseValidationError) Reason() string { return e.reason }
+func (e TagMigrationReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateTagTemplateResponseValidationError) Cause() error { return e.cause }
+func (e TagMigrationReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateTagTemplateResponseValidationError) Key() bool { return e.key }
+func (e TagMigrationReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateTagTemplateResponseValidationError) ErrorName() string {
-	return "UpdateTagTemplateResponseValidationError"
+func (e TagMigrationReportValidationError) ErrorName() string {
+	return "TagMigrationReportValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateTagTemplateResponseValidationError) Error() string {
+func (e TagMigrationReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10983,14 +24508,14 @@ func (e UpdateTagTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateTagTemplateResponse.%s: %s%s",
+		"invalid %sTagMigrationReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateTagTemplateResponseValidationError{}
+var _ error = TagMigrationReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -10998,46 +24523,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateTagTemplateResponseValidationError{}
+} = TagMigrationReportValidationError{}
 
-// Validate checks the field values on DeleteTagTemplateRequest with the rules
+// Validate checks the field values on TagMigrationFailure with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTagTemplateRequest) Validate() error {
+func (m *TagMigrationFailure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTagTemplateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TagMigrationFailure with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteTagTemplateRequestMultiError, or nil if none found.
-func (m *DeleteTagTemplateRequest) ValidateAll() error {
+// TagMigrationFailureMultiError, or nil if none found.
+func (m *TagMigrationFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTagTemplateRequest) validate(all bool) error {
+func (m *TagMigrationFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TemplateUrn
+	// no validation rules for AssetId
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return DeleteTagTemplateRequestMultiError(errors)
+		return TagMigrationFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTagTemplateRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteTagTemplateRequest.ValidateAll() if the designated
+// TagMigrationFailureMultiError is an error wrapping multiple validation
+// errors returned by TagMigrationFailure.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteTagTemplateRequestMultiError []error
+type TagMigrationFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTagTemplateRequestMultiError) Error() string {
+func (m TagMigrationFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11046,11 +24573,11 @@ func (m DeleteTagTemplateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTagTemplateRequestMultiError) AllErrors() []error { return m }
+func (m TagMigrationFailureMultiError) AllErrors() []error { return m }
 
-// DeleteTagTemplateRequestValidationError is the validation error returned by
-// DeleteTagTemplateRequest.Validate if the designated constraints aren't met.
-type DeleteTagTemplateRequestValidationError struct {
+// TagMigrationFailureValidationError is the validation error returned by
+// TagMigrationFailure.Validate if the designated constraints aren't met.
+type TagMigrationFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11058,24 +24585,24 @@ type DeleteTagTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTagTemplateRequestValidationError) Field() string { return e.field }
+func (e TagMigrationFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTagTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TagMigrationFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTagTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TagMigrationFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTagTemplateRequestValidationError) Key() bool { return e.key }
+func (e TagMigrationFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTagTemplateRequestValidationError) ErrorName() string {
-	return "DeleteTagTemplateRequestValidationError"
+func (e TagMigrationFailureValidationError) ErrorName() string {
+	return "TagMigrationFailureValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTagTemplateRequestValidationError) Error() string {
+func (e TagMigrationFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11087,14 +24614,14 @@ func (e DeleteTagTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTagTemplateRequest.%s: %s%s",
+		"invalid %sTagMigrationFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTagTemplateRequestValidationError{}
+var _ error = TagMigrationFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -11102,44 +24629,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTagTemplateRequestValidationError{}
+} = TagMigrationFailureValidationError{}
 
-// Validate checks the field values on DeleteTagTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteTagTemplateResponse) Validate() error {
+// Validate checks the field values on BulkTagReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BulkTagReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteTagTemplateResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteTagTemplateResponseMultiError, or nil if none found.
-func (m *DeleteTagTemplateResponse) ValidateAll() error {
+// ValidateAll checks the field values on BulkTagReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BulkTagReportMultiError, or
+// nil if none found.
+func (m *BulkTagReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteTagTemplateResponse) validate(all bool) error {
+func (m *BulkTagReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for TemplateUrn
+
+	// no validation rules for Assets
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkTagReportValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkTagReportValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkTagReportValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for JobId
+
 	if len(errors) > 0 {
-		return DeleteTagTemplateResponseMultiError(errors)
+		return BulkTagReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteTagTemplateResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteTagTemplateResponse.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteTagTemplateResponseMultiError []error
+// BulkTagReportMultiError is an error wrapping multiple validation errors
+// returned by BulkTagReport.ValidateAll() if the designated constraints
+// aren't met.
+type BulkTagReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteTagTemplateResponseMultiError) Error() string {
+func (m BulkTagReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11148,11 +24715,11 @@ func (m DeleteTagTemplateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteTagTemplateResponseMultiError) AllErrors() []error { return m }
+func (m BulkTagReportMultiError) AllErrors() []error { return m }
 
-// DeleteTagTemplateResponseValidationError is the validation error returned by
-// DeleteTagTemplateResponse.Validate if the designated constraints aren't met.
-type DeleteTagTemplateResponseValidationError struct {
+// BulkTagReportValidationError is the validation error returned by
+// BulkTagReport.Validate if the designated constraints aren't met.
+type BulkTagReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11160,24 +24727,22 @@ type DeleteTagTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTagTemplateResponseValidationError) Field() string { return e.field }
+func (e BulkTagReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTagTemplateResponseValidationError) Reason() string { return e.reason }
+func (e BulkTagReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTagTemplateResponseValidationError) Cause() error { return e.cause }
+func (e BulkTagReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTagTemplateResponseValidationError) Key() bool { return e.key }
+func (e BulkTagReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTagTemplateResponseValidationError) ErrorName() string {
-	return "DeleteTagTemplateResponseValidationError"
-}
+func (e BulkTagReportValidationError) ErrorName() string { return "BulkTagReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTagTemplateResponseValidationError) Error() string {
+func (e BulkTagReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11189,14 +24754,14 @@ func (e DeleteTagTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTagTemplateResponse.%s: %s%s",
+		"invalid %sBulkTagReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTagTemplateResponseValidationError{}
+var _ error = BulkTagReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -11204,42 +24769,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTagTemplateResponseValidationError{}
+} = BulkTagReportValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on BulkTagJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BulkTagJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// ValidateAll checks the field values on BulkTagJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BulkTagJobMultiError, or
+// nil if none found.
+func (m *BulkTagJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *BulkTagJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TemplateUrn
+
+	// no validation rules for Remove
 
-	// no validation rules for Id
+	// no validation rules for Status
 
-	// no validation rules for Uuid
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkTagJobValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkTagJobValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkTagJobValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Email
+	// no validation rules for Error
 
-	// no validation rules for Provider
+	// no validation rules for CreatedBy
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, BulkTagJobValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11247,7 +24846,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, BulkTagJobValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11256,7 +24855,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return BulkTagJobValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11268,7 +24867,7 @@ func (m *User) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, BulkTagJobValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11276,7 +24875,7 @@ func (m *User) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
+				errors = append(errors, BulkTagJobValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11285,7 +24884,7 @@ func (m *User) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserValidationError{
+			return BulkTagJobValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11294,18 +24893,18 @@ func (m *User) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return BulkTagJobMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// BulkTagJobMultiError is an error wrapping multiple validation errors
+// returned by BulkTagJob.ValidateAll() if the designated constraints aren't met.
+type BulkTagJobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m BulkTagJobMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11314,11 +24913,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m BulkTagJobMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// BulkTagJobValidationError is the validation error returned by
+// BulkTagJob.Validate if the designated constraints aren't met.
+type BulkTagJobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11326,22 +24925,22 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e BulkTagJobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e BulkTagJobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e BulkTagJobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e BulkTagJobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e BulkTagJobValidationError) ErrorName() string { return "BulkTagJobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e BulkTagJobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11353,14 +24952,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sBulkTagJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = BulkTagJobValidationError{}
 
 var _ interface {
 	Field() string
@@ -11368,102 +24967,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = BulkTagJobValidationError{}
 
-// Validate checks the field values on Change with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on BulkTagFailure with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Change) Validate() error {
+func (m *BulkTagFailure) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Change with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ChangeMultiError, or nil if none found.
-func (m *Change) ValidateAll() error {
+// ValidateAll checks the field values on BulkTagFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BulkTagFailureMultiError,
+// or nil if none found.
+func (m *BulkTagFailure) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Change) validate(all bool) error {
+func (m *BulkTagFailure) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	if all {
-		switch v := interface{}(m.GetFrom()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeValidationError{
-					field:  "From",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeValidationError{
-					field:  "From",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeValidationError{
-				field:  "From",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Asset
 
-	if all {
-		switch v := interface{}(m.GetTo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeValidationError{
-					field:  "To",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeValidationError{
-					field:  "To",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeValidationError{
-				field:  "To",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return ChangeMultiError(errors)
+		return BulkTagFailureMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeMultiError is an error wrapping multiple validation errors returned by
-// Change.ValidateAll() if the designated constraints aren't met.
-type ChangeMultiError []error
+// BulkTagFailureMultiError is an error wrapping multiple validation errors
+// returned by BulkTagFailure.ValidateAll() if the designated constraints
+// aren't met.
+type BulkTagFailureMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeMultiError) Error() string {
+func (m BulkTagFailureMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11472,11 +25017,11 @@ func (m ChangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeMultiError) AllErrors() []error { return m }
+func (m BulkTagFailureMultiError) AllErrors() []error { return m }
 
-// ChangeValidationError is the validation error returned by Change.Validate if
-// the designated constraints aren't met.
-type ChangeValidationError struct {
+// BulkTagFailureValidationError is the validation error returned by
+// BulkTagFailure.Validate if the designated constraints aren't met.
+type BulkTagFailureValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11484,22 +25029,22 @@ type ChangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeValidationError) Field() string { return e.field }
+func (e BulkTagFailureValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeValidationError) Reason() string { return e.reason }
+func (e BulkTagFailureValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeValidationError) Cause() error { return e.cause }
+func (e BulkTagFailureValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeValidationError) Key() bool { return e.key }
+func (e BulkTagFailureValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeValidationError) ErrorName() string { return "ChangeValidationError" }
+func (e BulkTagFailureValidationError) ErrorName() string { return "BulkTagFailureValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ChangeValidationError) Error() string {
+func (e BulkTagFailureValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11511,14 +25056,14 @@ func (e ChangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChange.%s: %s%s",
+		"invalid %sBulkTagFailure.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChangeValidationError{}
+var _ error = BulkTagFailureValidationError{}
 
 var _ interface {
 	Field() string
@@ -11526,176 +25071,236 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeValidationError{}
+} = BulkTagFailureValidationError{}
 
-// Validate checks the field values on Asset with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Asset) Validate() error {
+// Validate checks the field values on Type with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Type) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Asset with the rules defined in the
+// ValidateAll checks the field values on Type with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AssetMultiError, or nil if none found.
-func (m *Asset) ValidateAll() error {
+// a list of violation errors wrapped in TypeMultiError, or nil if none found.
+func (m *Type) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Asset) validate(all bool) error {
+func (m *Type) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Urn
+	// no validation rules for Name
 
-	// no validation rules for Type
+	// no validation rules for Count
 
-	// no validation rules for Service
+	// no validation rules for DisplayName
 
-	// no validation rules for Name
+	// no validation rules for Icon
 
 	// no validation rules for Description
 
+	// no validation rules for SearchBoost
+
 	if all {
-		switch v := interface{}(m.GetData()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AssetValidationError{
-					field:  "Data",
+				errors = append(errors, TypeValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AssetValidationError{
-					field:  "Data",
+				errors = append(errors, TypeValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AssetValidationError{
-				field:  "Data",
+			return TypeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TypeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TypeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TypeValidationError{
+				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Labels
+	if len(errors) > 0 {
+		return TypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TypeMultiError is an error wrapping multiple validation errors returned by
+// Type.ValidateAll() if the designated constraints aren't met.
+type TypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TypeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TypeMultiError) AllErrors() []error { return m }
+
+// TypeValidationError is the validation error returned by Type.Validate if the
+// designated constraints aren't met.
+type TypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypeValidationError) ErrorName() string { return "TypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	for idx, item := range m.GetOwners() {
-		_, _ = idx, item
+	return fmt.Sprintf(
+		"invalid %sType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Owners[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Owners[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AssetValidationError{
-					field:  fmt.Sprintf("Owners[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ error = TypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypeValidationError{}
+
+// Validate checks the field values on TypeSchema with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TypeSchema) Validate() error {
+	return m.validate(false)
+}
 
+// ValidateAll checks the field values on TypeSchema with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TypeSchemaMultiError, or
+// nil if none found.
+func (m *TypeSchema) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TypeSchema) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for Version
+	var errors []error
+
+	// no validation rules for Type
 
 	if all {
-		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		switch v := interface{}(m.GetSchema()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AssetValidationError{
-					field:  "UpdatedBy",
+				errors = append(errors, TypeSchemaValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AssetValidationError{
-					field:  "UpdatedBy",
+				errors = append(errors, TypeSchemaValidationError{
+					field:  "Schema",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSchema()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AssetValidationError{
-				field:  "UpdatedBy",
+			return TypeSchemaValidationError{
+				field:  "Schema",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetChangelog() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Changelog[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Changelog[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AssetValidationError{
-					field:  fmt.Sprintf("Changelog[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Mode
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AssetValidationError{
+				errors = append(errors, TypeSchemaValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11703,7 +25308,7 @@ func (m *Asset) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AssetValidationError{
+				errors = append(errors, TypeSchemaValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11712,7 +25317,7 @@ func (m *Asset) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AssetValidationError{
+			return TypeSchemaValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11724,7 +25329,7 @@ func (m *Asset) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AssetValidationError{
+				errors = append(errors, TypeSchemaValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11732,7 +25337,7 @@ func (m *Asset) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AssetValidationError{
+				errors = append(errors, TypeSchemaValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11741,7 +25346,7 @@ func (m *Asset) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AssetValidationError{
+			return TypeSchemaValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11749,57 +25354,19 @@ func (m *Asset) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Url
-
-	for idx, item := range m.GetProbes() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Probes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AssetValidationError{
-						field:  fmt.Sprintf("Probes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AssetValidationError{
-					field:  fmt.Sprintf("Probes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for IsDeleted
-
 	if len(errors) > 0 {
-		return AssetMultiError(errors)
+		return TypeSchemaMultiError(errors)
 	}
 
 	return nil
 }
 
-// AssetMultiError is an error wrapping multiple validation errors returned by
-// Asset.ValidateAll() if the designated constraints aren't met.
-type AssetMultiError []error
+// TypeSchemaMultiError is an error wrapping multiple validation errors
+// returned by TypeSchema.ValidateAll() if the designated constraints aren't met.
+type TypeSchemaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AssetMultiError) Error() string {
+func (m TypeSchemaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11808,11 +25375,11 @@ func (m AssetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AssetMultiError) AllErrors() []error { return m }
+func (m TypeSchemaMultiError) AllErrors() []error { return m }
 
-// AssetValidationError is the validation error returned by Asset.Validate if
-// the designated constraints aren't met.
-type AssetValidationError struct {
+// TypeSchemaValidationError is the validation error returned by
+// TypeSchema.Validate if the designated constraints aren't met.
+type TypeSchemaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11820,22 +25387,22 @@ type AssetValidationError struct {
 }
 
 // Field function returns field value.
-func (e AssetValidationError) Field() string { return e.field }
+func (e TypeSchemaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AssetValidationError) Reason() string { return e.reason }
+func (e TypeSchemaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AssetValidationError) Cause() error { return e.cause }
+func (e TypeSchemaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AssetValidationError) Key() bool { return e.key }
+func (e TypeSchemaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AssetValidationError) ErrorName() string { return "AssetValidationError" }
+func (e TypeSchemaValidationError) ErrorName() string { return "TypeSchemaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AssetValidationError) Error() string {
+func (e TypeSchemaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11847,14 +25414,14 @@ func (e AssetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAsset.%s: %s%s",
+		"invalid %sTypeSchema.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AssetValidationError{}
+var _ error = TypeSchemaValidationError{}
 
 var _ interface {
 	Field() string
@@ -11862,23 +25429,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AssetValidationError{}
+} = TypeSchemaValidationError{}
 
-// Validate checks the field values on Probe with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Probe) Validate() error {
+func (m *SavedSearch) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Probe with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProbeMultiError, or nil if none found.
-func (m *Probe) ValidateAll() error {
+// ValidateAll checks the field values on SavedSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SavedSearchMultiError, or
+// nil if none found.
+func (m *SavedSearch) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Probe) validate(all bool) error {
+func (m *SavedSearch) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11887,64 +25455,68 @@ func (m *Probe) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for AssetUrn
+	// no validation rules for Name
 
-	// no validation rules for Status
+	// no validation rules for Text
 
-	// no validation rules for StatusReason
+	// no validation rules for Filter
+
+	// no validation rules for Query
 
 	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
+		switch v := interface{}(m.GetFlags()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProbeValidationError{
-					field:  "Metadata",
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Flags",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProbeValidationError{
-					field:  "Metadata",
+				errors = append(errors, SavedSearchValidationError{
+					field:  "Flags",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFlags()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProbeValidationError{
-				field:  "Metadata",
+			return SavedSearchValidationError{
+				field:  "Flags",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for WebhookUrl
+
 	if all {
-		switch v := interface{}(m.GetTimestamp()).(type) {
+		switch v := interface{}(m.GetLastRunAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProbeValidationError{
-					field:  "Timestamp",
+				errors = append(errors, SavedSearchValidationError{
+					field:  "LastRunAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProbeValidationError{
-					field:  "Timestamp",
+				errors = append(errors, SavedSearchValidationError{
+					field:  "LastRunAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastRunAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProbeValidationError{
-				field:  "Timestamp",
+			return SavedSearchValidationError{
+				field:  "LastRunAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11955,7 +25527,7 @@ func (m *Probe) validate(all bool) error {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProbeValidationError{
+				errors = append(errors, SavedSearchValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11963,7 +25535,7 @@ func (m *Probe) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ProbeValidationError{
+				errors = append(errors, SavedSearchValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11972,7 +25544,7 @@ func (m *Probe) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ProbeValidationError{
+			return SavedSearchValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11980,19 +25552,48 @@ func (m *Probe) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedSearchValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedSearchValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ProbeMultiError(errors)
+		return SavedSearchMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProbeMultiError is an error wrapping multiple validation errors returned by
-// Probe.ValidateAll() if the designated constraints aren't met.
-type ProbeMultiError []error
+// SavedSearchMultiError is an error wrapping multiple validation errors
+// returned by SavedSearch.ValidateAll() if the designated constraints aren't met.
+type SavedSearchMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProbeMultiError) Error() string {
+func (m SavedSearchMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12001,11 +25602,11 @@ func (m ProbeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProbeMultiError) AllErrors() []error { return m }
+func (m SavedSearchMultiError) AllErrors() []error { return m }
 
-// ProbeValidationError is the validation error returned by Probe.Validate if
-// the designated constraints aren't met.
-type ProbeValidationError struct {
+// SavedSearchValidationError is the validation error returned by
+// SavedSearch.Validate if the designated constraints aren't met.
+type SavedSearchValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12013,22 +25614,22 @@ type ProbeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProbeValidationError) Field() string { return e.field }
+func (e SavedSearchValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProbeValidationError) Reason() string { return e.reason }
+func (e SavedSearchValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProbeValidationError) Cause() error { return e.cause }
+func (e SavedSearchValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProbeValidationError) Key() bool { return e.key }
+func (e SavedSearchValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProbeValidationError) ErrorName() string { return "ProbeValidationError" }
+func (e SavedSearchValidationError) ErrorName() string { return "SavedSearchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProbeValidationError) Error() string {
+func (e SavedSearchValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12040,14 +25641,14 @@ func (e ProbeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProbe.%s: %s%s",
+		"invalid %sSavedSearch.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProbeValidationError{}
+var _ error = SavedSearchValidationError{}
 
 var _ interface {
 	Field() string
@@ -12055,92 +25656,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProbeValidationError{}
+} = SavedSearchValidationError{}
 
-// Validate checks the field values on Discussion with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Discussion) Validate() error {
+// Validate checks the field values on SavedSearchMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SavedSearchMatch) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Discussion with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DiscussionMultiError, or
-// nil if none found.
-func (m *Discussion) ValidateAll() error {
+// ValidateAll checks the field values on SavedSearchMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SavedSearchMatchMultiError, or nil if none found.
+func (m *SavedSearchMatch) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Discussion) validate(all bool) error {
+func (m *SavedSearchMatch) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Title
-
-	// no validation rules for Body
-
-	// no validation rules for Type
-
-	// no validation rules for State
-
-	if all {
-		switch v := interface{}(m.GetOwner()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "Owner",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "Owner",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DiscussionValidationError{
-				field:  "Owner",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetAsset()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "Asset",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "Asset",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAsset()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DiscussionValidationError{
-				field:  "CreatedAt",
+			return SavedSearchMatchValidationError{
+				field:  "Asset",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -12148,28 +25710,28 @@ func (m *Discussion) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetMatchedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "MatchedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DiscussionValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, SavedSearchMatchValidationError{
+					field:  "MatchedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMatchedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DiscussionValidationError{
-				field:  "UpdatedAt",
+			return SavedSearchMatchValidationError{
+				field:  "MatchedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -12177,18 +25739,19 @@ func (m *Discussion) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DiscussionMultiError(errors)
+		return SavedSearchMatchMultiError(errors)
 	}
 
 	return nil
 }
 
-// DiscussionMultiError is an error wrapping multiple validation errors
-// returned by Discussion.ValidateAll() if the designated constraints aren't met.
-type DiscussionMultiError []error
+// SavedSearchMatchMultiError is an error wrapping multiple validation errors
+// returned by SavedSearchMatch.ValidateAll() if the designated constraints
+// aren't met.
+type SavedSearchMatchMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DiscussionMultiError) Error() string {
+func (m SavedSearchMatchMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12197,11 +25760,11 @@ func (m DiscussionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DiscussionMultiError) AllErrors() []error { return m }
+func (m SavedSearchMatchMultiError) AllErrors() []error { return m }
 
-// DiscussionValidationError is the validation error returned by
-// Discussion.Validate if the designated constraints aren't met.
-type DiscussionValidationError struct {
+// SavedSearchMatchValidationError is the validation error returned by
+// SavedSearchMatch.Validate if the designated constraints aren't met.
+type SavedSearchMatchValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12209,22 +25772,22 @@ type DiscussionValidationError struct {
 }
 
 // Field function returns field value.
-func (e DiscussionValidationError) Field() string { return e.field }
+func (e SavedSearchMatchValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DiscussionValidationError) Reason() string { return e.reason }
+func (e SavedSearchMatchValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DiscussionValidationError) Cause() error { return e.cause }
+func (e SavedSearchMatchValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DiscussionValidationError) Key() bool { return e.key }
+func (e SavedSearchMatchValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DiscussionValidationError) ErrorName() string { return "DiscussionValidationError" }
+func (e SavedSearchMatchValidationError) ErrorName() string { return "SavedSearchMatchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DiscussionValidationError) Error() string {
+func (e SavedSearchMatchValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12236,14 +25799,14 @@ func (e DiscussionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDiscussion.%s: %s%s",
+		"invalid %sSavedSearchMatch.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DiscussionValidationError{}
+var _ error = SavedSearchMatchValidationError{}
 
 var _ interface {
 	Field() string
@@ -12251,23 +25814,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DiscussionValidationError{}
+} = SavedSearchMatchValidationError{}
 
-// Validate checks the field values on Comment with the rules defined in the
+// Validate checks the field values on Policy with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Comment) Validate() error {
+func (m *Policy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Comment with the rules defined in the
+// ValidateAll checks the field values on Policy with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CommentMultiError, or nil if none found.
-func (m *Comment) ValidateAll() error {
+// a list of violation errors wrapped in PolicyMultiError, or nil if none found.
+func (m *Policy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Comment) validate(all bool) error {
+func (m *Policy) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12276,73 +25839,46 @@ func (m *Comment) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for DiscussionId
+	// no validation rules for Subject
 
-	// no validation rules for Body
+	// no validation rules for Role
 
 	if all {
-		switch v := interface{}(m.GetOwner()).(type) {
+		switch v := interface{}(m.GetScope()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "Owner",
+				errors = append(errors, PolicyValidationError{
+					field:  "Scope",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "Owner",
+				errors = append(errors, PolicyValidationError{
+					field:  "Scope",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetScope()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommentValidationError{
-				field:  "Owner",
+			return PolicyValidationError{
+				field:  "Scope",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedBy()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "UpdatedBy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
-					field:  "UpdatedBy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CommentValidationError{
-				field:  "UpdatedBy",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedBy
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12350,7 +25886,7 @@ func (m *Comment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12359,7 +25895,7 @@ func (m *Comment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommentValidationError{
+			return PolicyValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12371,7 +25907,7 @@ func (m *Comment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CommentValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12379,7 +25915,7 @@ func (m *Comment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CommentValidationError{
+				errors = append(errors, PolicyValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12388,7 +25924,7 @@ func (m *Comment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CommentValidationError{
+			return PolicyValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12397,18 +25933,18 @@ func (m *Comment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CommentMultiError(errors)
+		return PolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentMultiError is an error wrapping multiple validation errors returned
-// by Comment.ValidateAll() if the designated constraints aren't met.
-type CommentMultiError []error
+// PolicyMultiError is an error wrapping multiple validation errors returned by
+// Policy.ValidateAll() if the designated constraints aren't met.
+type PolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentMultiError) Error() string {
+func (m PolicyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12417,11 +25953,11 @@ func (m CommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentMultiError) AllErrors() []error { return m }
+func (m PolicyMultiError) AllErrors() []error { return m }
 
-// CommentValidationError is the validation error returned by Comment.Validate
-// if the designated constraints aren't met.
-type CommentValidationError struct {
+// PolicyValidationError is the validation error returned by Policy.Validate if
+// the designated constraints aren't met.
+type PolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12429,22 +25965,22 @@ type CommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentValidationError) Field() string { return e.field }
+func (e PolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentValidationError) Reason() string { return e.reason }
+func (e PolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentValidationError) Cause() error { return e.cause }
+func (e PolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentValidationError) Key() bool { return e.key }
+func (e PolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+func (e PolicyValidationError) ErrorName() string { return "PolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentValidationError) Error() string {
+func (e PolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12456,14 +25992,14 @@ func (e CommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sComment.%s: %s%s",
+		"invalid %sPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentValidationError{}
+var _ error = PolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -12471,76 +26007,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentValidationError{}
+} = PolicyValidationError{}
 
-// Validate checks the field values on LineageEdge with the rules defined in
+// Validate checks the field values on PolicyScope with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LineageEdge) Validate() error {
+func (m *PolicyScope) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LineageEdge with the rules defined in
+// ValidateAll checks the field values on PolicyScope with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LineageEdgeMultiError, or
+// result is a list of violation errors wrapped in PolicyScopeMultiError, or
 // nil if none found.
-func (m *LineageEdge) ValidateAll() error {
+func (m *PolicyScope) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LineageEdge) validate(all bool) error {
+func (m *PolicyScope) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Source
-
-	// no validation rules for Target
-
-	if all {
-		switch v := interface{}(m.GetProp()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LineageEdgeValidationError{
-					field:  "Prop",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, LineageEdgeValidationError{
-					field:  "Prop",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _PolicyScope_Kind_InLookup[m.GetKind()]; !ok {
+		err := PolicyScopeValidationError{
+			field:  "Kind",
+			reason: "value must be in list [global service type asset]",
 		}
-	} else if v, ok := interface{}(m.GetProp()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LineageEdgeValidationError{
-				field:  "Prop",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Value
+
 	if len(errors) > 0 {
-		return LineageEdgeMultiError(errors)
+		return PolicyScopeMultiError(errors)
 	}
 
 	return nil
 }
 
-// LineageEdgeMultiError is an error wrapping multiple validation errors
-// returned by LineageEdge.ValidateAll() if the designated constraints aren't met.
-type LineageEdgeMultiError []error
+// PolicyScopeMultiError is an error wrapping multiple validation errors
+// returned by PolicyScope.ValidateAll() if the designated constraints aren't met.
+type PolicyScopeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LineageEdgeMultiError) Error() string {
+func (m PolicyScopeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12549,11 +26065,11 @@ func (m LineageEdgeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LineageEdgeMultiError) AllErrors() []error { return m }
+func (m PolicyScopeMultiError) AllErrors() []error { return m }
 
-// LineageEdgeValidationError is the validation error returned by
-// LineageEdge.Validate if the designated constraints aren't met.
-type LineageEdgeValidationError struct {
+// PolicyScopeValidationError is the validation error returned by
+// PolicyScope.Validate if the designated constraints aren't met.
+type PolicyScopeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12561,22 +26077,22 @@ type LineageEdgeValidationError struct {
 }
 
 // Field function returns field value.
-func (e LineageEdgeValidationError) Field() string { return e.field }
+func (e PolicyScopeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LineageEdgeValidationError) Reason() string { return e.reason }
+func (e PolicyScopeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LineageEdgeValidationError) Cause() error { return e.cause }
+func (e PolicyScopeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LineageEdgeValidationError) Key() bool { return e.key }
+func (e PolicyScopeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LineageEdgeValidationError) ErrorName() string { return "LineageEdgeValidationError" }
+func (e PolicyScopeValidationError) ErrorName() string { return "PolicyScopeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LineageEdgeValidationError) Error() string {
+func (e PolicyScopeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12588,14 +26104,14 @@ func (e LineageEdgeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLineageEdge.%s: %s%s",
+		"invalid %sPolicyScope.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LineageEdgeValidationError{}
+var _ error = PolicyScopeValidationError{}
 
 var _ interface {
 	Field() string
@@ -12603,85 +26119,119 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LineageEdgeValidationError{}
+} = PolicyScopeValidationError{}
 
-// Validate checks the field values on LineageEdgeV2 with the rules defined in
+var _PolicyScope_Kind_InLookup = map[string]struct{}{
+	"global":  {},
+	"service": {},
+	"type":    {},
+	"asset":   {},
+}
+
+// Validate checks the field values on ServiceAccount with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LineageEdgeV2) Validate() error {
+func (m *ServiceAccount) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LineageEdgeV2 with the rules defined
+// ValidateAll checks the field values on ServiceAccount with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LineageEdgeV2MultiError, or
-// nil if none found.
-func (m *LineageEdgeV2) ValidateAll() error {
+// result is a list of violation errors wrapped in ServiceAccountMultiError,
+// or nil if none found.
+func (m *ServiceAccount) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LineageEdgeV2) validate(all bool) error {
+func (m *ServiceAccount) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for SourceAsset
-
-	// no validation rules for TargetAsset
-
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Email
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if all {
-		switch v := interface{}(m.GetProp()).(type) {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LineageEdgeV2ValidationError{
-					field:  "Prop",
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LineageEdgeV2ValidationError{
-					field:  "Prop",
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProp()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LineageEdgeV2ValidationError{
-				field:  "Prop",
+			return ServiceAccountValidationError{
+				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.SourceColumn != nil {
-		// no validation rules for SourceColumn
-	}
-
-	if m.TargetColumn != nil {
-		// no validation rules for TargetColumn
-	}
-
 	if len(errors) > 0 {
-		return LineageEdgeV2MultiError(errors)
+		return ServiceAccountMultiError(errors)
 	}
 
 	return nil
 }
 
-// LineageEdgeV2MultiError is an error wrapping multiple validation errors
-// returned by LineageEdgeV2.ValidateAll() if the designated constraints
+// ServiceAccountMultiError is an error wrapping multiple validation errors
+// returned by ServiceAccount.ValidateAll() if the designated constraints
 // aren't met.
-type LineageEdgeV2MultiError []error
+type ServiceAccountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LineageEdgeV2MultiError) Error() string {
+func (m ServiceAccountMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12690,11 +26240,11 @@ func (m LineageEdgeV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LineageEdgeV2MultiError) AllErrors() []error { return m }
+func (m ServiceAccountMultiError) AllErrors() []error { return m }
 
-// LineageEdgeV2ValidationError is the validation error returned by
-// LineageEdgeV2.Validate if the designated constraints aren't met.
-type LineageEdgeV2ValidationError struct {
+// ServiceAccountValidationError is the validation error returned by
+// ServiceAccount.Validate if the designated constraints aren't met.
+type ServiceAccountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12702,22 +26252,22 @@ type LineageEdgeV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e LineageEdgeV2ValidationError) Field() string { return e.field }
+func (e ServiceAccountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LineageEdgeV2ValidationError) Reason() string { return e.reason }
+func (e ServiceAccountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LineageEdgeV2ValidationError) Cause() error { return e.cause }
+func (e ServiceAccountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LineageEdgeV2ValidationError) Key() bool { return e.key }
+func (e ServiceAccountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LineageEdgeV2ValidationError) ErrorName() string { return "LineageEdgeV2ValidationError" }
+func (e ServiceAccountValidationError) ErrorName() string { return "ServiceAccountValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LineageEdgeV2ValidationError) Error() string {
+func (e ServiceAccountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12729,14 +26279,14 @@ func (e LineageEdgeV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLineageEdgeV2.%s: %s%s",
+		"invalid %sServiceAccount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LineageEdgeV2ValidationError{}
+var _ error = ServiceAccountValidationError{}
 
 var _ interface {
 	Field() string
@@ -12744,49 +26294,109 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LineageEdgeV2ValidationError{}
+} = ServiceAccountValidationError{}
 
-// Validate checks the field values on LineageNode with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on APIToken with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LineageNode) Validate() error {
+func (m *APIToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LineageNode with the rules defined in
+// ValidateAll checks the field values on APIToken with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LineageNodeMultiError, or
-// nil if none found.
-func (m *LineageNode) ValidateAll() error {
+// result is a list of violation errors wrapped in APITokenMultiError, or nil
+// if none found.
+func (m *APIToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LineageNode) validate(all bool) error {
+func (m *APIToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Urn
+	// no validation rules for Id
 
-	// no validation rules for Type
+	// no validation rules for ServiceAccountId
 
-	// no validation rules for Service
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APITokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APITokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APITokenValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APITokenValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APITokenValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APITokenValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return LineageNodeMultiError(errors)
+		return APITokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// LineageNodeMultiError is an error wrapping multiple validation errors
-// returned by LineageNode.ValidateAll() if the designated constraints aren't met.
-type LineageNodeMultiError []error
+// APITokenMultiError is an error wrapping multiple validation errors returned
+// by APIToken.ValidateAll() if the designated constraints aren't met.
+type APITokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LineageNodeMultiError) Error() string {
+func (m APITokenMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12795,11 +26405,11 @@ func (m LineageNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LineageNodeMultiError) AllErrors() []error { return m }
+func (m APITokenMultiError) AllErrors() []error { return m }
 
-// LineageNodeValidationError is the validation error returned by
-// LineageNode.Validate if the designated constraints aren't met.
-type LineageNodeValidationError struct {
+// APITokenValidationError is the validation error returned by
+// APIToken.Validate if the designated constraints aren't met.
+type APITokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12807,22 +26417,22 @@ type LineageNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e LineageNodeValidationError) Field() string { return e.field }
+func (e APITokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LineageNodeValidationError) Reason() string { return e.reason }
+func (e APITokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LineageNodeValidationError) Cause() error { return e.cause }
+func (e APITokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LineageNodeValidationError) Key() bool { return e.key }
+func (e APITokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LineageNodeValidationError) ErrorName() string { return "LineageNodeValidationError" }
+func (e APITokenValidationError) ErrorName() string { return "APITokenValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LineageNodeValidationError) Error() string {
+func (e APITokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12834,14 +26444,14 @@ func (e LineageNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLineageNode.%s: %s%s",
+		"invalid %sAPIToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LineageNodeValidationError{}
+var _ error = APITokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -12849,84 +26459,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LineageNodeValidationError{}
+} = APITokenValidationError{}
 
-// Validate checks the field values on Tag with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Tag) Validate() error {
+// Validate checks the field values on AssetVisibility with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssetVisibility) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Tag with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TagMultiError, or nil if none found.
-func (m *Tag) ValidateAll() error {
+// ValidateAll checks the field values on AssetVisibility with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssetVisibilityMultiError, or nil if none found.
+func (m *AssetVisibility) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Tag) validate(all bool) error {
+func (m *AssetVisibility) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AssetId
-
-	// no validation rules for TemplateUrn
-
-	for idx, item := range m.GetTagValues() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TagValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TagValidationError{
-						field:  fmt.Sprintf("TagValues[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TagValidationError{
-					field:  fmt.Sprintf("TagValues[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if _, ok := _AssetVisibility_Kind_InLookup[m.GetKind()]; !ok {
+		err := AssetVisibilityValidationError{
+			field:  "Kind",
+			reason: "value must be in list [public restricted]",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for TemplateDisplayName
-
-	// no validation rules for TemplateDescription
-
 	if len(errors) > 0 {
-		return TagMultiError(errors)
+		return AssetVisibilityMultiError(errors)
 	}
 
 	return nil
 }
 
-// TagMultiError is an error wrapping multiple validation errors returned by
-// Tag.ValidateAll() if the designated constraints aren't met.
-type TagMultiError []error
+// AssetVisibilityMultiError is an error wrapping multiple validation errors
+// returned by AssetVisibility.ValidateAll() if the designated constraints
+// aren't met.
+type AssetVisibilityMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TagMultiError) Error() string {
+func (m AssetVisibilityMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12935,11 +26516,11 @@ func (m TagMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TagMultiError) AllErrors() []error { return m }
+func (m AssetVisibilityMultiError) AllErrors() []error { return m }
 
-// TagValidationError is the validation error returned by Tag.Validate if the
-// designated constraints aren't met.
-type TagValidationError struct {
+// AssetVisibilityValidationError is the validation error returned by
+// AssetVisibility.Validate if the designated constraints aren't met.
+type AssetVisibilityValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12947,22 +26528,22 @@ type TagValidationError struct {
 }
 
 // Field function returns field value.
-func (e TagValidationError) Field() string { return e.field }
+func (e AssetVisibilityValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TagValidationError) Reason() string { return e.reason }
+func (e AssetVisibilityValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TagValidationError) Cause() error { return e.cause }
+func (e AssetVisibilityValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TagValidationError) Key() bool { return e.key }
+func (e AssetVisibilityValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+func (e AssetVisibilityValidationError) ErrorName() string { return "AssetVisibilityValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TagValidationError) Error() string {
+func (e AssetVisibilityValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12974,14 +26555,14 @@ func (e TagValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTag.%s: %s%s",
+		"invalid %sAssetVisibility.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TagValidationError{}
+var _ error = AssetVisibilityValidationError{}
 
 var _ interface {
 	Field() string
@@ -12989,76 +26570,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TagValidationError{}
+} = AssetVisibilityValidationError{}
 
-// Validate checks the field values on TagValue with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TagValue) Validate() error {
+var _AssetVisibility_Kind_InLookup = map[string]struct{}{
+	"public":     {},
+	"restricted": {},
+}
+
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Team) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TagValue with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TagValueMultiError, or nil
-// if none found.
-func (m *TagValue) ValidateAll() error {
+// ValidateAll checks the field values on Team with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TeamMultiError, or nil if none found.
+func (m *Team) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TagValue) validate(all bool) error {
+func (m *Team) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FieldId
+	// no validation rules for Id
 
-	if all {
-		switch v := interface{}(m.GetFieldValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagValueValidationError{
-					field:  "FieldValue",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for OnCall
+
+	// no validation rules for SlackChannel
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeamValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeamValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TagValueValidationError{
-					field:  "FieldValue",
+				return TeamValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TagValueValidationError{
-				field:  "FieldValue",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
-
-	// no validation rules for FieldUrn
-
-	// no validation rules for FieldDisplayName
-
-	// no validation rules for FieldDescription
 
-	// no validation rules for FieldDataType
+	}
 
-	// no validation rules for FieldRequired
+	// no validation rules for CreatedBy
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagValueValidationError{
+				errors = append(errors, TeamValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13066,7 +26656,7 @@ func (m *TagValue) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TagValueValidationError{
+				errors = append(errors, TeamValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13075,7 +26665,7 @@ func (m *TagValue) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TagValueValidationError{
+			return TeamValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13087,7 +26677,7 @@ func (m *TagValue) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagValueValidationError{
+				errors = append(errors, TeamValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13095,7 +26685,7 @@ func (m *TagValue) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TagValueValidationError{
+				errors = append(errors, TeamValidationError{
 					field:  "UpdatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13104,7 +26694,7 @@ func (m *TagValue) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TagValueValidationError{
+			return TeamValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13113,18 +26703,18 @@ func (m *TagValue) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TagValueMultiError(errors)
+		return TeamMultiError(errors)
 	}
 
 	return nil
 }
 
-// TagValueMultiError is an error wrapping multiple validation errors returned
-// by TagValue.ValidateAll() if the designated constraints aren't met.
-type TagValueMultiError []error
+// TeamMultiError is an error wrapping multiple validation errors returned by
+// Team.ValidateAll() if the designated constraints aren't met.
+type TeamMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TagValueMultiError) Error() string {
+func (m TeamMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13133,11 +26723,11 @@ func (m TagValueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TagValueMultiError) AllErrors() []error { return m }
+func (m TeamMultiError) AllErrors() []error { return m }
 
-// TagValueValidationError is the validation error returned by
-// TagValue.Validate if the designated constraints aren't met.
-type TagValueValidationError struct {
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13145,22 +26735,22 @@ type TagValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e TagValueValidationError) Field() string { return e.field }
+func (e TeamValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TagValueValidationError) Reason() string { return e.reason }
+func (e TeamValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TagValueValidationError) Cause() error { return e.cause }
+func (e TeamValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TagValueValidationError) Key() bool { return e.key }
+func (e TeamValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TagValueValidationError) ErrorName() string { return "TagValueValidationError" }
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TagValueValidationError) Error() string {
+func (e TeamValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13172,14 +26762,14 @@ func (e TagValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTagValue.%s: %s%s",
+		"invalid %sTeam.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TagValueValidationError{}
+var _ error = TeamValidationError{}
 
 var _ interface {
 	Field() string
@@ -13187,75 +26777,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TagValueValidationError{}
+} = TeamValidationError{}
 
-// Validate checks the field values on TagTemplate with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TagTemplate) Validate() error {
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TagTemplate with the rules defined in
+// ValidateAll checks the field values on AuditEvent with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TagTemplateMultiError, or
+// result is a list of violation errors wrapped in AuditEventMultiError, or
 // nil if none found.
-func (m *TagTemplate) ValidateAll() error {
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TagTemplate) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Urn
+	// no validation rules for Id
 
-	// no validation rules for DisplayName
+	// no validation rules for Actor
 
-	// no validation rules for Description
+	// no validation rules for Method
 
-	for idx, item := range m.GetFields() {
-		_, _ = idx, item
+	// no validation rules for Request
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TagTemplateValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, TagTemplateValidationError{
-						field:  fmt.Sprintf("Fields[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TagTemplateValidationError{
-					field:  fmt.Sprintf("Fields[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Result
 
-	}
+	// no validation rules for Error
+
+	// no validation rules for LatencyMs
 
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagTemplateValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13263,7 +26827,7 @@ func (m *TagTemplate) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TagTemplateValidationError{
+				errors = append(errors, AuditEventValidationError{
 					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13272,7 +26836,7 @@ func (m *TagTemplate) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TagTemplateValidationError{
+			return AuditEventValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13280,48 +26844,19 @@ func (m *TagTemplate) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagTemplateValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TagTemplateValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TagTemplateValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return TagTemplateMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// TagTemplateMultiError is an error wrapping multiple validation errors
-// returned by TagTemplate.ValidateAll() if the designated constraints aren't met.
-type TagTemplateMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TagTemplateMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13330,11 +26865,11 @@ func (m TagTemplateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TagTemplateMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// TagTemplateValidationError is the validation error returned by
-// TagTemplate.Validate if the designated constraints aren't met.
-type TagTemplateValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13342,22 +26877,22 @@ type TagTemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e TagTemplateValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TagTemplateValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TagTemplateValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TagTemplateValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TagTemplateValidationError) ErrorName() string { return "TagTemplateValidationError" }
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TagTemplateValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13369,14 +26904,14 @@ func (e TagTemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTagTemplate.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TagTemplateValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -13384,24 +26919,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TagTemplateValidationError{}
+} = AuditEventValidationError{}
 
-// Validate checks the field values on TagTemplateField with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TagTemplateField) Validate() error {
+// Validate checks the field values on SearchResultDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchResultDetail) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TagTemplateField with the rules
+// ValidateAll checks the field values on SearchResultDetail with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TagTemplateFieldMultiError, or nil if none found.
-func (m *TagTemplateField) ValidateAll() error {
+// SearchResultDetailMultiError, or nil if none found.
+func (m *SearchResultDetail) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TagTemplateField) validate(all bool) error {
+func (m *SearchResultDetail) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13410,88 +26945,199 @@ func (m *TagTemplateField) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Urn
+	// no validation rules for Score
 
-	// no validation rules for DisplayName
+	{
+		sorted_keys := make([]string, len(m.GetHighlights()))
+		i := 0
+		for key := range m.GetHighlights() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHighlights()[key]
+			_ = val
 
-	// no validation rules for Description
+			// no validation rules for Highlights[key]
 
-	// no validation rules for DataType
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SearchResultDetailValidationError{
+							field:  fmt.Sprintf("Highlights[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SearchResultDetailValidationError{
+							field:  fmt.Sprintf("Highlights[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SearchResultDetailValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
 
-	// no validation rules for Required
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
+		switch v := interface{}(m.GetExplanation()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagTemplateFieldValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, SearchResultDetailValidationError{
+					field:  "Explanation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TagTemplateFieldValidationError{
-					field:  "CreatedAt",
+				errors = append(errors, SearchResultDetailValidationError{
+					field:  "Explanation",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TagTemplateFieldValidationError{
-				field:  "CreatedAt",
+			return SearchResultDetailValidationError{
+				field:  "Explanation",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TagTemplateFieldValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TagTemplateFieldValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TagTemplateFieldValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return SearchResultDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchResultDetailMultiError is an error wrapping multiple validation errors
+// returned by SearchResultDetail.ValidateAll() if the designated constraints
+// aren't met.
+type SearchResultDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchResultDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchResultDetailMultiError) AllErrors() []error { return m }
+
+// SearchResultDetailValidationError is the validation error returned by
+// SearchResultDetail.Validate if the designated constraints aren't met.
+type SearchResultDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchResultDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchResultDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchResultDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchResultDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchResultDetailValidationError) ErrorName() string {
+	return "SearchResultDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchResultDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchResultDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchResultDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchResultDetailValidationError{}
+
+// Validate checks the field values on SearchHighlight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchHighlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHighlight with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchHighlightMultiError, or nil if none found.
+func (m *SearchHighlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHighlight) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return TagTemplateFieldMultiError(errors)
+		return SearchHighlightMultiError(errors)
 	}
 
 	return nil
 }
 
-// TagTemplateFieldMultiError is an error wrapping multiple validation errors
-// returned by TagTemplateField.ValidateAll() if the designated constraints
+// SearchHighlightMultiError is an error wrapping multiple validation errors
+// returned by SearchHighlight.ValidateAll() if the designated constraints
 // aren't met.
-type TagTemplateFieldMultiError []error
+type SearchHighlightMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TagTemplateFieldMultiError) Error() string {
+func (m SearchHighlightMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13500,11 +27146,11 @@ func (m TagTemplateFieldMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TagTemplateFieldMultiError) AllErrors() []error { return m }
+func (m SearchHighlightMultiError) AllErrors() []error { return m }
 
-// TagTemplateFieldValidationError is the validation error returned by
-// TagTemplateField.Validate if the designated constraints aren't met.
-type TagTemplateFieldValidationError struct {
+// SearchHighlightValidationError is the validation error returned by
+// SearchHighlight.Validate if the designated constraints aren't met.
+type SearchHighlightValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13512,22 +27158,22 @@ type TagTemplateFieldValidationError struct {
 }
 
 // Field function returns field value.
-func (e TagTemplateFieldValidationError) Field() string { return e.field }
+func (e SearchHighlightValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TagTemplateFieldValidationError) Reason() string { return e.reason }
+func (e SearchHighlightValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TagTemplateFieldValidationError) Cause() error { return e.cause }
+func (e SearchHighlightValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TagTemplateFieldValidationError) Key() bool { return e.key }
+func (e SearchHighlightValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TagTemplateFieldValidationError) ErrorName() string { return "TagTemplateFieldValidationError" }
+func (e SearchHighlightValidationError) ErrorName() string { return "SearchHighlightValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TagTemplateFieldValidationError) Error() string {
+func (e SearchHighlightValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13539,14 +27185,14 @@ func (e TagTemplateFieldValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTagTemplateField.%s: %s%s",
+		"invalid %sSearchHighlight.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TagTemplateFieldValidationError{}
+var _ error = SearchHighlightValidationError{}
 
 var _ interface {
 	Field() string
@@ -13554,46 +27200,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TagTemplateFieldValidationError{}
+} = SearchHighlightValidationError{}
 
-// Validate checks the field values on Type with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Type) Validate() error {
+// Validate checks the field values on SearchExplanation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchExplanation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Type with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TypeMultiError, or nil if none found.
-func (m *Type) ValidateAll() error {
+// ValidateAll checks the field values on SearchExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchExplanationMultiError, or nil if none found.
+func (m *SearchExplanation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Type) validate(all bool) error {
+func (m *SearchExplanation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Value
 
-	// no validation rules for Count
+	// no validation rules for Description
+
+	for idx, item := range m.GetDetails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchExplanationValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchExplanationValidationError{
+						field:  fmt.Sprintf("Details[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchExplanationValidationError{
+					field:  fmt.Sprintf("Details[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return TypeMultiError(errors)
+		return SearchExplanationMultiError(errors)
 	}
 
 	return nil
 }
 
-// TypeMultiError is an error wrapping multiple validation errors returned by
-// Type.ValidateAll() if the designated constraints aren't met.
-type TypeMultiError []error
+// SearchExplanationMultiError is an error wrapping multiple validation errors
+// returned by SearchExplanation.ValidateAll() if the designated constraints
+// aren't met.
+type SearchExplanationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TypeMultiError) Error() string {
+func (m SearchExplanationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13602,11 +27284,11 @@ func (m TypeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TypeMultiError) AllErrors() []error { return m }
+func (m SearchExplanationMultiError) AllErrors() []error { return m }
 
-// TypeValidationError is the validation error returned by Type.Validate if the
-// designated constraints aren't met.
-type TypeValidationError struct {
+// SearchExplanationValidationError is the validation error returned by
+// SearchExplanation.Validate if the designated constraints aren't met.
+type SearchExplanationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13614,22 +27296,24 @@ type TypeValidationError struct {
 }
 
 // Field function returns field value.
-func (e TypeValidationError) Field() string { return e.field }
+func (e SearchExplanationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TypeValidationError) Reason() string { return e.reason }
+func (e SearchExplanationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TypeValidationError) Cause() error { return e.cause }
+func (e SearchExplanationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TypeValidationError) Key() bool { return e.key }
+func (e SearchExplanationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TypeValidationError) ErrorName() string { return "TypeValidationError" }
+func (e SearchExplanationValidationError) ErrorName() string {
+	return "SearchExplanationValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TypeValidationError) Error() string {
+func (e SearchExplanationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13641,14 +27325,14 @@ func (e TypeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sType.%s: %s%s",
+		"invalid %sSearchExplanation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TypeValidationError{}
+var _ error = SearchExplanationValidationError{}
 
 var _ interface {
 	Field() string
@@ -13656,7 +27340,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TypeValidationError{}
+} = SearchExplanationValidationError{}
 
 // Validate checks the field values on GetGraphResponse_ProbesInfo with the
 // rules defined in the proto definition for this message. If any rules are
@@ -14188,6 +27872,113 @@ var _ interface {
 	ErrorName() string
 } = GetGraphV2Response_NodeAttributesValidationError{}
 
+// Validate checks the field values on ValidateTypeSchemaResponse_Violation
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ValidateTypeSchemaResponse_Violation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateTypeSchemaResponse_Violation
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ValidateTypeSchemaResponse_ViolationMultiError, or nil if none found.
+func (m *ValidateTypeSchemaResponse_Violation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateTypeSchemaResponse_Violation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Urn
+
+	if len(errors) > 0 {
+		return ValidateTypeSchemaResponse_ViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateTypeSchemaResponse_ViolationMultiError is an error wrapping multiple
+// validation errors returned by
+// ValidateTypeSchemaResponse_Violation.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateTypeSchemaResponse_ViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateTypeSchemaResponse_ViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateTypeSchemaResponse_ViolationMultiError) AllErrors() []error { return m }
+
+// ValidateTypeSchemaResponse_ViolationValidationError is the validation error
+// returned by ValidateTypeSchemaResponse_Violation.Validate if the designated
+// constraints aren't met.
+type ValidateTypeSchemaResponse_ViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateTypeSchemaResponse_ViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateTypeSchemaResponse_ViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateTypeSchemaResponse_ViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateTypeSchemaResponse_ViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateTypeSchemaResponse_ViolationValidationError) ErrorName() string {
+	return "ValidateTypeSchemaResponse_ViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateTypeSchemaResponse_ViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateTypeSchemaResponse_Violation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateTypeSchemaResponse_ViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateTypeSchemaResponse_ViolationValidationError{}
+
 // Validate checks the field values on UpsertAssetRequest_Asset with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -14287,6 +28078,35 @@ func (m *UpsertAssetRequest_Asset) validate(all bool) error {
 
 	// no validation rules for Url
 
+	if all {
+		switch v := interface{}(m.GetVisibility()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertAssetRequest_AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertAssetRequest_AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisibility()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertAssetRequest_AssetValidationError{
+				field:  "Visibility",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpsertAssetRequest_AssetMultiError(errors)
 	}
@@ -14478,6 +28298,35 @@ func (m *UpsertPatchAssetRequest_Asset) validate(all bool) error {
 
 	// no validation rules for Url
 
+	if all {
+		switch v := interface{}(m.GetVisibility()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpsertPatchAssetRequest_AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpsertPatchAssetRequest_AssetValidationError{
+					field:  "Visibility",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVisibility()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpsertPatchAssetRequest_AssetValidationError{
+				field:  "Visibility",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UpsertPatchAssetRequest_AssetMultiError(errors)
 	}
@@ -34,6 +34,14 @@ const (
 	CompassService_GetGraph_FullMethodName                 = "/gotocompany.compass.v1beta1.CompassService/GetGraph"
 	CompassService_GetGraphV2_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/GetGraphV2"
 	CompassService_GetAllTypes_FullMethodName              = "/gotocompany.compass.v1beta1.CompassService/GetAllTypes"
+	CompassService_CreateType_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/CreateType"
+	CompassService_GetType_FullMethodName                  = "/gotocompany.compass.v1beta1.CompassService/GetType"
+	CompassService_UpdateType_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/UpdateType"
+	CompassService_DeleteType_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/DeleteType"
+	CompassService_UpsertTypeSchema_FullMethodName         = "/gotocompany.compass.v1beta1.CompassService/UpsertTypeSchema"
+	CompassService_GetTypeSchema_FullMethodName            = "/gotocompany.compass.v1beta1.CompassService/GetTypeSchema"
+	CompassService_DeleteTypeSchema_FullMethodName         = "/gotocompany.compass.v1beta1.CompassService/DeleteTypeSchema"
+	CompassService_ValidateTypeSchema_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/ValidateTypeSchema"
 	CompassService_GetAllAssets_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/GetAllAssets"
 	CompassService_GetAssetByID_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/GetAssetByID"
 	CompassService_UpsertAsset_FullMethodName              = "/gotocompany.compass.v1beta1.CompassService/UpsertAsset"
@@ -43,6 +51,7 @@ const (
 	CompassService_GetAssetStargazers_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/GetAssetStargazers"
 	CompassService_GetAssetVersionHistory_FullMethodName   = "/gotocompany.compass.v1beta1.CompassService/GetAssetVersionHistory"
 	CompassService_GetAssetByVersion_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/GetAssetByVersion"
+	CompassService_GetAssetSchemaDiff_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/GetAssetSchemaDiff"
 	CompassService_CreateAssetProbe_FullMethodName         = "/gotocompany.compass.v1beta1.CompassService/CreateAssetProbe"
 	CompassService_SyncAssets_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/SyncAssets"
 	CompassService_GetUserStarredAssets_FullMethodName     = "/gotocompany.compass.v1beta1.CompassService/GetUserStarredAssets"
@@ -55,12 +64,42 @@ const (
 	CompassService_GetTagByAssetAndTemplate_FullMethodName = "/gotocompany.compass.v1beta1.CompassService/GetTagByAssetAndTemplate"
 	CompassService_UpdateTagAsset_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/UpdateTagAsset"
 	CompassService_DeleteTagAsset_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/DeleteTagAsset"
+	CompassService_CreateColumnTag_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/CreateColumnTag"
+	CompassService_GetColumnTag_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/GetColumnTag"
+	CompassService_UpdateColumnTag_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/UpdateColumnTag"
+	CompassService_DeleteColumnTag_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/DeleteColumnTag"
+	CompassService_GetTagHistory_FullMethodName            = "/gotocompany.compass.v1beta1.CompassService/GetTagHistory"
 	CompassService_GetAllTagsByAsset_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/GetAllTagsByAsset"
 	CompassService_GetAllTagTemplates_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/GetAllTagTemplates"
 	CompassService_CreateTagTemplate_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/CreateTagTemplate"
 	CompassService_GetTagTemplate_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/GetTagTemplate"
 	CompassService_UpdateTagTemplate_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/UpdateTagTemplate"
 	CompassService_DeleteTagTemplate_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/DeleteTagTemplate"
+	CompassService_MigrateTagTemplate_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/MigrateTagTemplate"
+	CompassService_BulkTagAssets_FullMethodName            = "/gotocompany.compass.v1beta1.CompassService/BulkTagAssets"
+	CompassService_BulkUntagAssets_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/BulkUntagAssets"
+	CompassService_GetBulkTagJob_FullMethodName            = "/gotocompany.compass.v1beta1.CompassService/GetBulkTagJob"
+	CompassService_CreateSavedSearch_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/CreateSavedSearch"
+	CompassService_GetMySavedSearches_FullMethodName       = "/gotocompany.compass.v1beta1.CompassService/GetMySavedSearches"
+	CompassService_DeleteSavedSearch_FullMethodName        = "/gotocompany.compass.v1beta1.CompassService/DeleteSavedSearch"
+	CompassService_GetSavedSearchMatches_FullMethodName    = "/gotocompany.compass.v1beta1.CompassService/GetSavedSearchMatches"
+	CompassService_CreatePolicy_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/CreatePolicy"
+	CompassService_GetAllPolicies_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/GetAllPolicies"
+	CompassService_DeletePolicy_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/DeletePolicy"
+	CompassService_CreateServiceAccount_FullMethodName     = "/gotocompany.compass.v1beta1.CompassService/CreateServiceAccount"
+	CompassService_GetAllServiceAccounts_FullMethodName    = "/gotocompany.compass.v1beta1.CompassService/GetAllServiceAccounts"
+	CompassService_DeleteServiceAccount_FullMethodName     = "/gotocompany.compass.v1beta1.CompassService/DeleteServiceAccount"
+	CompassService_CreateAPIToken_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/CreateAPIToken"
+	CompassService_GetAllAPITokens_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/GetAllAPITokens"
+	CompassService_RevokeAPIToken_FullMethodName           = "/gotocompany.compass.v1beta1.CompassService/RevokeAPIToken"
+	CompassService_CreateTeam_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/CreateTeam"
+	CompassService_GetAllTeams_FullMethodName              = "/gotocompany.compass.v1beta1.CompassService/GetAllTeams"
+	CompassService_GetTeam_FullMethodName                  = "/gotocompany.compass.v1beta1.CompassService/GetTeam"
+	CompassService_UpdateTeam_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/UpdateTeam"
+	CompassService_DeleteTeam_FullMethodName               = "/gotocompany.compass.v1beta1.CompassService/DeleteTeam"
+	CompassService_GetMyProfile_FullMethodName             = "/gotocompany.compass.v1beta1.CompassService/GetMyProfile"
+	CompassService_GetMyTeamsAssets_FullMethodName         = "/gotocompany.compass.v1beta1.CompassService/GetMyTeamsAssets"
+	CompassService_ListAuditEvents_FullMethodName          = "/gotocompany.compass.v1beta1.CompassService/ListAuditEvents"
 )
 
 // CompassServiceClient is the client API for CompassService service.
@@ -85,6 +124,14 @@ type CompassServiceClient interface {
 	GetGraph(ctx context.Context, in *GetGraphRequest, opts ...grpc.CallOption) (*GetGraphResponse, error)
 	GetGraphV2(ctx context.Context, in *GetGraphV2Request, opts ...grpc.CallOption) (*GetGraphV2Response, error)
 	GetAllTypes(ctx context.Context, in *GetAllTypesRequest, opts ...grpc.CallOption) (*GetAllTypesResponse, error)
+	CreateType(ctx context.Context, in *CreateTypeRequest, opts ...grpc.CallOption) (*CreateTypeResponse, error)
+	GetType(ctx context.Context, in *GetTypeRequest, opts ...grpc.CallOption) (*GetTypeResponse, error)
+	UpdateType(ctx context.Context, in *UpdateTypeRequest, opts ...grpc.CallOption) (*UpdateTypeResponse, error)
+	DeleteType(ctx context.Context, in *DeleteTypeRequest, opts ...grpc.CallOption) (*DeleteTypeResponse, error)
+	UpsertTypeSchema(ctx context.Context, in *UpsertTypeSchemaRequest, opts ...grpc.CallOption) (*UpsertTypeSchemaResponse, error)
+	GetTypeSchema(ctx context.Context, in *GetTypeSchemaRequest, opts ...grpc.CallOption) (*GetTypeSchemaResponse, error)
+	DeleteTypeSchema(ctx context.Context, in *DeleteTypeSchemaRequest, opts ...grpc.CallOption) (*DeleteTypeSchemaResponse, error)
+	ValidateTypeSchema(ctx context.Context, in *ValidateTypeSchemaRequest, opts ...grpc.CallOption) (*ValidateTypeSchemaResponse, error)
 	GetAllAssets(ctx context.Context, in *GetAllAssetsRequest, opts ...grpc.CallOption) (*GetAllAssetsResponse, error)
 	GetAssetByID(ctx context.Context, in *GetAssetByIDRequest, opts ...grpc.CallOption) (*GetAssetByIDResponse, error)
 	UpsertAsset(ctx context.Context, in *UpsertAssetRequest, opts ...grpc.CallOption) (*UpsertAssetResponse, error)
@@ -94,6 +141,7 @@ type CompassServiceClient interface {
 	GetAssetStargazers(ctx context.Context, in *GetAssetStargazersRequest, opts ...grpc.CallOption) (*GetAssetStargazersResponse, error)
 	GetAssetVersionHistory(ctx context.Context, in *GetAssetVersionHistoryRequest, opts ...grpc.CallOption) (*GetAssetVersionHistoryResponse, error)
 	GetAssetByVersion(ctx context.Context, in *GetAssetByVersionRequest, opts ...grpc.CallOption) (*GetAssetByVersionResponse, error)
+	GetAssetSchemaDiff(ctx context.Context, in *GetAssetSchemaDiffRequest, opts ...grpc.CallOption) (*GetAssetSchemaDiffResponse, error)
 	CreateAssetProbe(ctx context.Context, in *CreateAssetProbeRequest, opts ...grpc.CallOption) (*CreateAssetProbeResponse, error)
 	SyncAssets(ctx context.Context, in *SyncAssetsRequest, opts ...grpc.CallOption) (*SyncAssetsResponse, error)
 	// Domain: User * Star
@@ -108,12 +156,43 @@ type CompassServiceClient interface {
 	GetTagByAssetAndTemplate(ctx context.Context, in *GetTagByAssetAndTemplateRequest, opts ...grpc.CallOption) (*GetTagByAssetAndTemplateResponse, error)
 	UpdateTagAsset(ctx context.Context, in *UpdateTagAssetRequest, opts ...grpc.CallOption) (*UpdateTagAssetResponse, error)
 	DeleteTagAsset(ctx context.Context, in *DeleteTagAssetRequest, opts ...grpc.CallOption) (*DeleteTagAssetResponse, error)
+	CreateColumnTag(ctx context.Context, in *CreateColumnTagRequest, opts ...grpc.CallOption) (*CreateColumnTagResponse, error)
+	GetColumnTag(ctx context.Context, in *GetColumnTagRequest, opts ...grpc.CallOption) (*GetColumnTagResponse, error)
+	UpdateColumnTag(ctx context.Context, in *UpdateColumnTagRequest, opts ...grpc.CallOption) (*UpdateColumnTagResponse, error)
+	DeleteColumnTag(ctx context.Context, in *DeleteColumnTagRequest, opts ...grpc.CallOption) (*DeleteColumnTagResponse, error)
+	GetTagHistory(ctx context.Context, in *GetTagHistoryRequest, opts ...grpc.CallOption) (*GetTagHistoryResponse, error)
 	GetAllTagsByAsset(ctx context.Context, in *GetAllTagsByAssetRequest, opts ...grpc.CallOption) (*GetAllTagsByAssetResponse, error)
 	GetAllTagTemplates(ctx context.Context, in *GetAllTagTemplatesRequest, opts ...grpc.CallOption) (*GetAllTagTemplatesResponse, error)
 	CreateTagTemplate(ctx context.Context, in *CreateTagTemplateRequest, opts ...grpc.CallOption) (*CreateTagTemplateResponse, error)
 	GetTagTemplate(ctx context.Context, in *GetTagTemplateRequest, opts ...grpc.CallOption) (*GetTagTemplateResponse, error)
 	UpdateTagTemplate(ctx context.Context, in *UpdateTagTemplateRequest, opts ...grpc.CallOption) (*UpdateTagTemplateResponse, error)
 	DeleteTagTemplate(ctx context.Context, in *DeleteTagTemplateRequest, opts ...grpc.CallOption) (*DeleteTagTemplateResponse, error)
+	MigrateTagTemplate(ctx context.Context, in *MigrateTagTemplateRequest, opts ...grpc.CallOption) (*MigrateTagTemplateResponse, error)
+	BulkTagAssets(ctx context.Context, in *BulkTagAssetsRequest, opts ...grpc.CallOption) (*BulkTagAssetsResponse, error)
+	BulkUntagAssets(ctx context.Context, in *BulkUntagAssetsRequest, opts ...grpc.CallOption) (*BulkUntagAssetsResponse, error)
+	GetBulkTagJob(ctx context.Context, in *GetBulkTagJobRequest, opts ...grpc.CallOption) (*GetBulkTagJobResponse, error)
+	// Domain: User * Saved Search
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error)
+	GetMySavedSearches(ctx context.Context, in *GetMySavedSearchesRequest, opts ...grpc.CallOption) (*GetMySavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	GetSavedSearchMatches(ctx context.Context, in *GetSavedSearchMatchesRequest, opts ...grpc.CallOption) (*GetSavedSearchMatchesResponse, error)
+	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
+	GetAllPolicies(ctx context.Context, in *GetAllPoliciesRequest, opts ...grpc.CallOption) (*GetAllPoliciesResponse, error)
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	GetAllServiceAccounts(ctx context.Context, in *GetAllServiceAccountsRequest, opts ...grpc.CallOption) (*GetAllServiceAccountsResponse, error)
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	GetAllAPITokens(ctx context.Context, in *GetAllAPITokensRequest, opts ...grpc.CallOption) (*GetAllAPITokensResponse, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	GetAllTeams(ctx context.Context, in *GetAllTeamsRequest, opts ...grpc.CallOption) (*GetAllTeamsResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*GetMyProfileResponse, error)
+	GetMyTeamsAssets(ctx context.Context, in *GetMyTeamsAssetsRequest, opts ...grpc.CallOption) (*GetMyTeamsAssetsResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type compassServiceClient struct {
@@ -259,6 +338,78 @@ func (c *compassServiceClient) GetAllTypes(ctx context.Context, in *GetAllTypesR
 	return out, nil
 }
 
+func (c *compassServiceClient) CreateType(ctx context.Context, in *CreateTypeRequest, opts ...grpc.CallOption) (*CreateTypeResponse, error) {
+	out := new(CreateTypeResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetType(ctx context.Context, in *GetTypeRequest, opts ...grpc.CallOption) (*GetTypeResponse, error) {
+	out := new(GetTypeResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) UpdateType(ctx context.Context, in *UpdateTypeRequest, opts ...grpc.CallOption) (*UpdateTypeResponse, error) {
+	out := new(UpdateTypeResponse)
+	err := c.cc.Invoke(ctx, CompassService_UpdateType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteType(ctx context.Context, in *DeleteTypeRequest, opts ...grpc.CallOption) (*DeleteTypeResponse, error) {
+	out := new(DeleteTypeResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) UpsertTypeSchema(ctx context.Context, in *UpsertTypeSchemaRequest, opts ...grpc.CallOption) (*UpsertTypeSchemaResponse, error) {
+	out := new(UpsertTypeSchemaResponse)
+	err := c.cc.Invoke(ctx, CompassService_UpsertTypeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetTypeSchema(ctx context.Context, in *GetTypeSchemaRequest, opts ...grpc.CallOption) (*GetTypeSchemaResponse, error) {
+	out := new(GetTypeSchemaResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetTypeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteTypeSchema(ctx context.Context, in *DeleteTypeSchemaRequest, opts ...grpc.CallOption) (*DeleteTypeSchemaResponse, error) {
+	out := new(DeleteTypeSchemaResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteTypeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) ValidateTypeSchema(ctx context.Context, in *ValidateTypeSchemaRequest, opts ...grpc.CallOption) (*ValidateTypeSchemaResponse, error) {
+	out := new(ValidateTypeSchemaResponse)
+	err := c.cc.Invoke(ctx, CompassService_ValidateTypeSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *compassServiceClient) GetAllAssets(ctx context.Context, in *GetAllAssetsRequest, opts ...grpc.CallOption) (*GetAllAssetsResponse, error) {
 	out := new(GetAllAssetsResponse)
 	err := c.cc.Invoke(ctx, CompassService_GetAllAssets_FullMethodName, in, out, opts...)
@@ -340,6 +491,15 @@ func (c *compassServiceClient) GetAssetByVersion(ctx context.Context, in *GetAss
 	return out, nil
 }
 
+func (c *compassServiceClient) GetAssetSchemaDiff(ctx context.Context, in *GetAssetSchemaDiffRequest, opts ...grpc.CallOption) (*GetAssetSchemaDiffResponse, error) {
+	out := new(GetAssetSchemaDiffResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetAssetSchemaDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *compassServiceClient) CreateAssetProbe(ctx context.Context, in *CreateAssetProbeRequest, opts ...grpc.CallOption) (*CreateAssetProbeResponse, error) {
 	out := new(CreateAssetProbeResponse)
 	err := c.cc.Invoke(ctx, CompassService_CreateAssetProbe_FullMethodName, in, out, opts...)
@@ -448,6 +608,51 @@ func (c *compassServiceClient) DeleteTagAsset(ctx context.Context, in *DeleteTag
 	return out, nil
 }
 
+func (c *compassServiceClient) CreateColumnTag(ctx context.Context, in *CreateColumnTagRequest, opts ...grpc.CallOption) (*CreateColumnTagResponse, error) {
+	out := new(CreateColumnTagResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateColumnTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetColumnTag(ctx context.Context, in *GetColumnTagRequest, opts ...grpc.CallOption) (*GetColumnTagResponse, error) {
+	out := new(GetColumnTagResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetColumnTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) UpdateColumnTag(ctx context.Context, in *UpdateColumnTagRequest, opts ...grpc.CallOption) (*UpdateColumnTagResponse, error) {
+	out := new(UpdateColumnTagResponse)
+	err := c.cc.Invoke(ctx, CompassService_UpdateColumnTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteColumnTag(ctx context.Context, in *DeleteColumnTagRequest, opts ...grpc.CallOption) (*DeleteColumnTagResponse, error) {
+	out := new(DeleteColumnTagResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteColumnTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetTagHistory(ctx context.Context, in *GetTagHistoryRequest, opts ...grpc.CallOption) (*GetTagHistoryResponse, error) {
+	out := new(GetTagHistoryResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetTagHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *compassServiceClient) GetAllTagsByAsset(ctx context.Context, in *GetAllTagsByAssetRequest, opts ...grpc.CallOption) (*GetAllTagsByAssetResponse, error) {
 	out := new(GetAllTagsByAssetResponse)
 	err := c.cc.Invoke(ctx, CompassService_GetAllTagsByAsset_FullMethodName, in, out, opts...)
@@ -502,6 +707,231 @@ func (c *compassServiceClient) DeleteTagTemplate(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *compassServiceClient) MigrateTagTemplate(ctx context.Context, in *MigrateTagTemplateRequest, opts ...grpc.CallOption) (*MigrateTagTemplateResponse, error) {
+	out := new(MigrateTagTemplateResponse)
+	err := c.cc.Invoke(ctx, CompassService_MigrateTagTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) BulkTagAssets(ctx context.Context, in *BulkTagAssetsRequest, opts ...grpc.CallOption) (*BulkTagAssetsResponse, error) {
+	out := new(BulkTagAssetsResponse)
+	err := c.cc.Invoke(ctx, CompassService_BulkTagAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) BulkUntagAssets(ctx context.Context, in *BulkUntagAssetsRequest, opts ...grpc.CallOption) (*BulkUntagAssetsResponse, error) {
+	out := new(BulkUntagAssetsResponse)
+	err := c.cc.Invoke(ctx, CompassService_BulkUntagAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetBulkTagJob(ctx context.Context, in *GetBulkTagJobRequest, opts ...grpc.CallOption) (*GetBulkTagJobResponse, error) {
+	out := new(GetBulkTagJobResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetBulkTagJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error) {
+	out := new(CreateSavedSearchResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetMySavedSearches(ctx context.Context, in *GetMySavedSearchesRequest, opts ...grpc.CallOption) (*GetMySavedSearchesResponse, error) {
+	out := new(GetMySavedSearchesResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetMySavedSearches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteSavedSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetSavedSearchMatches(ctx context.Context, in *GetSavedSearchMatchesRequest, opts ...grpc.CallOption) (*GetSavedSearchMatchesResponse, error) {
+	out := new(GetSavedSearchMatchesResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetSavedSearchMatches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error) {
+	out := new(CreatePolicyResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetAllPolicies(ctx context.Context, in *GetAllPoliciesRequest, opts ...grpc.CallOption) (*GetAllPoliciesResponse, error) {
+	out := new(GetAllPoliciesResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetAllPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error) {
+	out := new(DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeletePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetAllServiceAccounts(ctx context.Context, in *GetAllServiceAccountsRequest, opts ...grpc.CallOption) (*GetAllServiceAccountsResponse, error) {
+	out := new(GetAllServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetAllServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error) {
+	out := new(DeleteServiceAccountResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateAPIToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetAllAPITokens(ctx context.Context, in *GetAllAPITokensRequest, opts ...grpc.CallOption) (*GetAllAPITokensResponse, error) {
+	out := new(GetAllAPITokensResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetAllAPITokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error) {
+	out := new(RevokeAPITokenResponse)
+	err := c.cc.Invoke(ctx, CompassService_RevokeAPIToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, CompassService_CreateTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetAllTeams(ctx context.Context, in *GetAllTeamsRequest, opts ...grpc.CallOption) (*GetAllTeamsResponse, error) {
+	out := new(GetAllTeamsResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetAllTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error) {
+	out := new(UpdateTeamResponse)
+	err := c.cc.Invoke(ctx, CompassService_UpdateTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, CompassService_DeleteTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*GetMyProfileResponse, error) {
+	out := new(GetMyProfileResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetMyProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) GetMyTeamsAssets(ctx context.Context, in *GetMyTeamsAssetsRequest, opts ...grpc.CallOption) (*GetMyTeamsAssetsResponse, error) {
+	out := new(GetMyTeamsAssetsResponse)
+	err := c.cc.Invoke(ctx, CompassService_GetMyTeamsAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *compassServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, CompassService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompassServiceServer is the server API for CompassService service.
 // All implementations must embed UnimplementedCompassServiceServer
 // for forward compatibility
@@ -524,6 +954,14 @@ type CompassServiceServer interface {
 	GetGraph(context.Context, *GetGraphRequest) (*GetGraphResponse, error)
 	GetGraphV2(context.Context, *GetGraphV2Request) (*GetGraphV2Response, error)
 	GetAllTypes(context.Context, *GetAllTypesRequest) (*GetAllTypesResponse, error)
+	CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error)
+	GetType(context.Context, *GetTypeRequest) (*GetTypeResponse, error)
+	UpdateType(context.Context, *UpdateTypeRequest) (*UpdateTypeResponse, error)
+	DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error)
+	UpsertTypeSchema(context.Context, *UpsertTypeSchemaRequest) (*UpsertTypeSchemaResponse, error)
+	GetTypeSchema(context.Context, *GetTypeSchemaRequest) (*GetTypeSchemaResponse, error)
+	DeleteTypeSchema(context.Context, *DeleteTypeSchemaRequest) (*DeleteTypeSchemaResponse, error)
+	ValidateTypeSchema(context.Context, *ValidateTypeSchemaRequest) (*ValidateTypeSchemaResponse, error)
 	GetAllAssets(context.Context, *GetAllAssetsRequest) (*GetAllAssetsResponse, error)
 	GetAssetByID(context.Context, *GetAssetByIDRequest) (*GetAssetByIDResponse, error)
 	UpsertAsset(context.Context, *UpsertAssetRequest) (*UpsertAssetResponse, error)
@@ -533,6 +971,7 @@ type CompassServiceServer interface {
 	GetAssetStargazers(context.Context, *GetAssetStargazersRequest) (*GetAssetStargazersResponse, error)
 	GetAssetVersionHistory(context.Context, *GetAssetVersionHistoryRequest) (*GetAssetVersionHistoryResponse, error)
 	GetAssetByVersion(context.Context, *GetAssetByVersionRequest) (*GetAssetByVersionResponse, error)
+	GetAssetSchemaDiff(context.Context, *GetAssetSchemaDiffRequest) (*GetAssetSchemaDiffResponse, error)
 	CreateAssetProbe(context.Context, *CreateAssetProbeRequest) (*CreateAssetProbeResponse, error)
 	SyncAssets(context.Context, *SyncAssetsRequest) (*SyncAssetsResponse, error)
 	// Domain: User * Star
@@ -547,12 +986,43 @@ type CompassServiceServer interface {
 	GetTagByAssetAndTemplate(context.Context, *GetTagByAssetAndTemplateRequest) (*GetTagByAssetAndTemplateResponse, error)
 	UpdateTagAsset(context.Context, *UpdateTagAssetRequest) (*UpdateTagAssetResponse, error)
 	DeleteTagAsset(context.Context, *DeleteTagAssetRequest) (*DeleteTagAssetResponse, error)
+	CreateColumnTag(context.Context, *CreateColumnTagRequest) (*CreateColumnTagResponse, error)
+	GetColumnTag(context.Context, *GetColumnTagRequest) (*GetColumnTagResponse, error)
+	UpdateColumnTag(context.Context, *UpdateColumnTagRequest) (*UpdateColumnTagResponse, error)
+	DeleteColumnTag(context.Context, *DeleteColumnTagRequest) (*DeleteColumnTagResponse, error)
+	GetTagHistory(context.Context, *GetTagHistoryRequest) (*GetTagHistoryResponse, error)
 	GetAllTagsByAsset(context.Context, *GetAllTagsByAssetRequest) (*GetAllTagsByAssetResponse, error)
 	GetAllTagTemplates(context.Context, *GetAllTagTemplatesRequest) (*GetAllTagTemplatesResponse, error)
 	CreateTagTemplate(context.Context, *CreateTagTemplateRequest) (*CreateTagTemplateResponse, error)
 	GetTagTemplate(context.Context, *GetTagTemplateRequest) (*GetTagTemplateResponse, error)
 	UpdateTagTemplate(context.Context, *UpdateTagTemplateRequest) (*UpdateTagTemplateResponse, error)
 	DeleteTagTemplate(context.Context, *DeleteTagTemplateRequest) (*DeleteTagTemplateResponse, error)
+	MigrateTagTemplate(context.Context, *MigrateTagTemplateRequest) (*MigrateTagTemplateResponse, error)
+	BulkTagAssets(context.Context, *BulkTagAssetsRequest) (*BulkTagAssetsResponse, error)
+	BulkUntagAssets(context.Context, *BulkUntagAssetsRequest) (*BulkUntagAssetsResponse, error)
+	GetBulkTagJob(context.Context, *GetBulkTagJobRequest) (*GetBulkTagJobResponse, error)
+	// Domain: User * Saved Search
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error)
+	GetMySavedSearches(context.Context, *GetMySavedSearchesRequest) (*GetMySavedSearchesResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	GetSavedSearchMatches(context.Context, *GetSavedSearchMatchesRequest) (*GetSavedSearchMatchesResponse, error)
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
+	GetAllPolicies(context.Context, *GetAllPoliciesRequest) (*GetAllPoliciesResponse, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	GetAllServiceAccounts(context.Context, *GetAllServiceAccountsRequest) (*GetAllServiceAccountsResponse, error)
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	GetAllAPITokens(context.Context, *GetAllAPITokensRequest) (*GetAllAPITokensResponse, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	GetAllTeams(context.Context, *GetAllTeamsRequest) (*GetAllTeamsResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	GetMyProfile(context.Context, *GetMyProfileRequest) (*GetMyProfileResponse, error)
+	GetMyTeamsAssets(context.Context, *GetMyTeamsAssetsRequest) (*GetMyTeamsAssetsResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedCompassServiceServer()
 }
 
@@ -605,6 +1075,30 @@ func (UnimplementedCompassServiceServer) GetGraphV2(context.Context, *GetGraphV2
 func (UnimplementedCompassServiceServer) GetAllTypes(context.Context, *GetAllTypesRequest) (*GetAllTypesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllTypes not implemented")
 }
+func (UnimplementedCompassServiceServer) CreateType(context.Context, *CreateTypeRequest) (*CreateTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateType not implemented")
+}
+func (UnimplementedCompassServiceServer) GetType(context.Context, *GetTypeRequest) (*GetTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetType not implemented")
+}
+func (UnimplementedCompassServiceServer) UpdateType(context.Context, *UpdateTypeRequest) (*UpdateTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateType not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteType(context.Context, *DeleteTypeRequest) (*DeleteTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteType not implemented")
+}
+func (UnimplementedCompassServiceServer) UpsertTypeSchema(context.Context, *UpsertTypeSchemaRequest) (*UpsertTypeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTypeSchema not implemented")
+}
+func (UnimplementedCompassServiceServer) GetTypeSchema(context.Context, *GetTypeSchemaRequest) (*GetTypeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypeSchema not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteTypeSchema(context.Context, *DeleteTypeSchemaRequest) (*DeleteTypeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTypeSchema not implemented")
+}
+func (UnimplementedCompassServiceServer) ValidateTypeSchema(context.Context, *ValidateTypeSchemaRequest) (*ValidateTypeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTypeSchema not implemented")
+}
 func (UnimplementedCompassServiceServer) GetAllAssets(context.Context, *GetAllAssetsRequest) (*GetAllAssetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllAssets not implemented")
 }
@@ -632,6 +1126,9 @@ func (UnimplementedCompassServiceServer) GetAssetVersionHistory(context.Context,
 func (UnimplementedCompassServiceServer) GetAssetByVersion(context.Context, *GetAssetByVersionRequest) (*GetAssetByVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAssetByVersion not implemented")
 }
+func (UnimplementedCompassServiceServer) GetAssetSchemaDiff(context.Context, *GetAssetSchemaDiffRequest) (*GetAssetSchemaDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetSchemaDiff not implemented")
+}
 func (UnimplementedCompassServiceServer) CreateAssetProbe(context.Context, *CreateAssetProbeRequest) (*CreateAssetProbeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetProbe not implemented")
 }
@@ -668,6 +1165,21 @@ func (UnimplementedCompassServiceServer) UpdateTagAsset(context.Context, *Update
 func (UnimplementedCompassServiceServer) DeleteTagAsset(context.Context, *DeleteTagAssetRequest) (*DeleteTagAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagAsset not implemented")
 }
+func (UnimplementedCompassServiceServer) CreateColumnTag(context.Context, *CreateColumnTagRequest) (*CreateColumnTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateColumnTag not implemented")
+}
+func (UnimplementedCompassServiceServer) GetColumnTag(context.Context, *GetColumnTagRequest) (*GetColumnTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetColumnTag not implemented")
+}
+func (UnimplementedCompassServiceServer) UpdateColumnTag(context.Context, *UpdateColumnTagRequest) (*UpdateColumnTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateColumnTag not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteColumnTag(context.Context, *DeleteColumnTagRequest) (*DeleteColumnTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteColumnTag not implemented")
+}
+func (UnimplementedCompassServiceServer) GetTagHistory(context.Context, *GetTagHistoryRequest) (*GetTagHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagHistory not implemented")
+}
 func (UnimplementedCompassServiceServer) GetAllTagsByAsset(context.Context, *GetAllTagsByAssetRequest) (*GetAllTagsByAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllTagsByAsset not implemented")
 }
@@ -686,15 +1198,90 @@ func (UnimplementedCompassServiceServer) UpdateTagTemplate(context.Context, *Upd
 func (UnimplementedCompassServiceServer) DeleteTagTemplate(context.Context, *DeleteTagTemplateRequest) (*DeleteTagTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagTemplate not implemented")
 }
-func (UnimplementedCompassServiceServer) mustEmbedUnimplementedCompassServiceServer() {}
-
-// UnsafeCompassServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CompassServiceServer will
-// result in compilation errors.
-type UnsafeCompassServiceServer interface {
-	mustEmbedUnimplementedCompassServiceServer()
+func (UnimplementedCompassServiceServer) MigrateTagTemplate(context.Context, *MigrateTagTemplateRequest) (*MigrateTagTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTagTemplate not implemented")
 }
-
+func (UnimplementedCompassServiceServer) BulkTagAssets(context.Context, *BulkTagAssetsRequest) (*BulkTagAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkTagAssets not implemented")
+}
+func (UnimplementedCompassServiceServer) BulkUntagAssets(context.Context, *BulkUntagAssetsRequest) (*BulkUntagAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUntagAssets not implemented")
+}
+func (UnimplementedCompassServiceServer) GetBulkTagJob(context.Context, *GetBulkTagJobRequest) (*GetBulkTagJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkTagJob not implemented")
+}
+func (UnimplementedCompassServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedCompassServiceServer) GetMySavedSearches(context.Context, *GetMySavedSearchesRequest) (*GetMySavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMySavedSearches not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedCompassServiceServer) GetSavedSearchMatches(context.Context, *GetSavedSearchMatchesRequest) (*GetSavedSearchMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearchMatches not implemented")
+}
+func (UnimplementedCompassServiceServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedCompassServiceServer) GetAllPolicies(context.Context, *GetAllPoliciesRequest) (*GetAllPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPolicies not implemented")
+}
+func (UnimplementedCompassServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedCompassServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedCompassServiceServer) GetAllServiceAccounts(context.Context, *GetAllServiceAccountsRequest) (*GetAllServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllServiceAccounts not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedCompassServiceServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedCompassServiceServer) GetAllAPITokens(context.Context, *GetAllAPITokensRequest) (*GetAllAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAPITokens not implemented")
+}
+func (UnimplementedCompassServiceServer) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedCompassServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedCompassServiceServer) GetAllTeams(context.Context, *GetAllTeamsRequest) (*GetAllTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllTeams not implemented")
+}
+func (UnimplementedCompassServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedCompassServiceServer) UpdateTeam(context.Context, *UpdateTeamRequest) (*UpdateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeam not implemented")
+}
+func (UnimplementedCompassServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedCompassServiceServer) GetMyProfile(context.Context, *GetMyProfileRequest) (*GetMyProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyProfile not implemented")
+}
+func (UnimplementedCompassServiceServer) GetMyTeamsAssets(context.Context, *GetMyTeamsAssetsRequest) (*GetMyTeamsAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyTeamsAssets not implemented")
+}
+func (UnimplementedCompassServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCompassServiceServer) mustEmbedUnimplementedCompassServiceServer() {}
+
+// UnsafeCompassServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompassServiceServer will
+// result in compilation errors.
+type UnsafeCompassServiceServer interface {
+	mustEmbedUnimplementedCompassServiceServer()
+}
+
 func RegisterCompassServiceServer(s grpc.ServiceRegistrar, srv CompassServiceServer) {
 	s.RegisterService(&CompassService_ServiceDesc, srv)
 }
@@ -910,547 +1497,1249 @@ func _CompassService_SuggestAssets_Handler(srv interface{}, ctx context.Context,
 		FullMethod: CompassService_SuggestAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).SuggestAssets(ctx, req.(*SuggestAssetsRequest))
+		return srv.(CompassServiceServer).SuggestAssets(ctx, req.(*SuggestAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetGraph(ctx, req.(*GetGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetGraphV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGraphV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetGraphV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetGraphV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetGraphV2(ctx, req.(*GetGraphV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAllTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAllTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAllTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAllTypes(ctx, req.(*GetAllTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_CreateType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).CreateType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_CreateType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).CreateType(ctx, req.(*CreateTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetType(ctx, req.(*GetTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpdateType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpdateType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpdateType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpdateType(ctx, req.(*UpdateTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteType(ctx, req.(*DeleteTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpsertTypeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTypeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpsertTypeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpsertTypeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpsertTypeSchema(ctx, req.(*UpsertTypeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetTypeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetTypeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetTypeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetTypeSchema(ctx, req.(*GetTypeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteTypeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTypeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteTypeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteTypeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteTypeSchema(ctx, req.(*DeleteTypeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_ValidateTypeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTypeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).ValidateTypeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_ValidateTypeSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).ValidateTypeSchema(ctx, req.(*ValidateTypeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAllAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAllAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAllAssets(ctx, req.(*GetAllAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAssetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAssetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAssetByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAssetByID(ctx, req.(*GetAssetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpsertAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpsertAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpsertAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpsertAsset(ctx, req.(*UpsertAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpsertPatchAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPatchAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpsertPatchAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpsertPatchAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpsertPatchAsset(ctx, req.(*UpsertPatchAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteAssets(ctx, req.(*DeleteAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAssetStargazers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetStargazersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAssetStargazers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAssetStargazers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAssetStargazers(ctx, req.(*GetAssetStargazersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAssetVersionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetVersionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAssetVersionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAssetVersionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAssetVersionHistory(ctx, req.(*GetAssetVersionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAssetByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAssetByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAssetByVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAssetByVersion(ctx, req.(*GetAssetByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAssetSchemaDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetSchemaDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAssetSchemaDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAssetSchemaDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAssetSchemaDiff(ctx, req.(*GetAssetSchemaDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_CreateAssetProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).CreateAssetProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_CreateAssetProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).CreateAssetProbe(ctx, req.(*CreateAssetProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_SyncAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).SyncAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_SyncAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).SyncAssets(ctx, req.(*SyncAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetUserStarredAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStarredAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetUserStarredAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetUserStarredAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetUserStarredAssets(ctx, req.(*GetUserStarredAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetMyStarredAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyStarredAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetMyStarredAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetMyStarredAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetMyStarredAssets(ctx, req.(*GetMyStarredAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetMyStarredAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyStarredAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetMyStarredAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetMyStarredAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetMyStarredAsset(ctx, req.(*GetMyStarredAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_StarAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).StarAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_StarAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).StarAsset(ctx, req.(*StarAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UnstarAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnstarAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UnstarAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UnstarAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UnstarAsset(ctx, req.(*UnstarAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetMyDiscussions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyDiscussionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetMyDiscussions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetMyDiscussions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetMyDiscussions(ctx, req.(*GetMyDiscussionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_CreateTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).CreateTagAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_CreateTagAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).CreateTagAsset(ctx, req.(*CreateTagAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetTagByAssetAndTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagByAssetAndTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetTagByAssetAndTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetTagByAssetAndTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetTagByAssetAndTemplate(ctx, req.(*GetTagByAssetAndTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpdateTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpdateTagAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpdateTagAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpdateTagAsset(ctx, req.(*UpdateTagAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteTagAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteTagAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteTagAsset(ctx, req.(*DeleteTagAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_CreateColumnTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateColumnTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).CreateColumnTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_CreateColumnTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).CreateColumnTag(ctx, req.(*CreateColumnTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetColumnTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetColumnTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetColumnTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetColumnTag(ctx, req.(*GetColumnTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_UpdateColumnTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateColumnTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).UpdateColumnTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_UpdateColumnTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).UpdateColumnTag(ctx, req.(*UpdateColumnTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_DeleteColumnTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteColumnTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).DeleteColumnTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_DeleteColumnTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).DeleteColumnTag(ctx, req.(*DeleteColumnTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetTagHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetTagHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetTagHistory(ctx, req.(*GetTagHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompassService_GetAllTagsByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTagsByAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompassServiceServer).GetAllTagsByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompassService_GetAllTagsByAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompassServiceServer).GetAllTagsByAsset(ctx, req.(*GetAllTagsByAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGraphRequest)
+func _CompassService_GetAllTagTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTagTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetGraph(ctx, in)
+		return srv.(CompassServiceServer).GetAllTagTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetGraph_FullMethodName,
+		FullMethod: CompassService_GetAllTagTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetGraph(ctx, req.(*GetGraphRequest))
+		return srv.(CompassServiceServer).GetAllTagTemplates(ctx, req.(*GetAllTagTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetGraphV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGraphV2Request)
+func _CompassService_CreateTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetGraphV2(ctx, in)
+		return srv.(CompassServiceServer).CreateTagTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetGraphV2_FullMethodName,
+		FullMethod: CompassService_CreateTagTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetGraphV2(ctx, req.(*GetGraphV2Request))
+		return srv.(CompassServiceServer).CreateTagTemplate(ctx, req.(*CreateTagTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAllTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllTypesRequest)
+func _CompassService_GetTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAllTypes(ctx, in)
+		return srv.(CompassServiceServer).GetTagTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAllTypes_FullMethodName,
+		FullMethod: CompassService_GetTagTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAllTypes(ctx, req.(*GetAllTypesRequest))
+		return srv.(CompassServiceServer).GetTagTemplate(ctx, req.(*GetTagTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllAssetsRequest)
+func _CompassService_UpdateTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAllAssets(ctx, in)
+		return srv.(CompassServiceServer).UpdateTagTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAllAssets_FullMethodName,
+		FullMethod: CompassService_UpdateTagTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAllAssets(ctx, req.(*GetAllAssetsRequest))
+		return srv.(CompassServiceServer).UpdateTagTemplate(ctx, req.(*UpdateTagTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAssetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetByIDRequest)
+func _CompassService_DeleteTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAssetByID(ctx, in)
+		return srv.(CompassServiceServer).DeleteTagTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAssetByID_FullMethodName,
+		FullMethod: CompassService_DeleteTagTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAssetByID(ctx, req.(*GetAssetByIDRequest))
+		return srv.(CompassServiceServer).DeleteTagTemplate(ctx, req.(*DeleteTagTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_UpsertAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertAssetRequest)
+func _CompassService_MigrateTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateTagTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).UpsertAsset(ctx, in)
+		return srv.(CompassServiceServer).MigrateTagTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_UpsertAsset_FullMethodName,
+		FullMethod: CompassService_MigrateTagTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).UpsertAsset(ctx, req.(*UpsertAssetRequest))
+		return srv.(CompassServiceServer).MigrateTagTemplate(ctx, req.(*MigrateTagTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_UpsertPatchAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertPatchAssetRequest)
+func _CompassService_BulkTagAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTagAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).UpsertPatchAsset(ctx, in)
+		return srv.(CompassServiceServer).BulkTagAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_UpsertPatchAsset_FullMethodName,
+		FullMethod: CompassService_BulkTagAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).UpsertPatchAsset(ctx, req.(*UpsertPatchAssetRequest))
+		return srv.(CompassServiceServer).BulkTagAssets(ctx, req.(*BulkTagAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAssetRequest)
+func _CompassService_BulkUntagAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUntagAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).DeleteAsset(ctx, in)
+		return srv.(CompassServiceServer).BulkUntagAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_DeleteAsset_FullMethodName,
+		FullMethod: CompassService_BulkUntagAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+		return srv.(CompassServiceServer).BulkUntagAssets(ctx, req.(*BulkUntagAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_DeleteAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAssetsRequest)
+func _CompassService_GetBulkTagJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkTagJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).DeleteAssets(ctx, in)
+		return srv.(CompassServiceServer).GetBulkTagJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_DeleteAssets_FullMethodName,
+		FullMethod: CompassService_GetBulkTagJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).DeleteAssets(ctx, req.(*DeleteAssetsRequest))
+		return srv.(CompassServiceServer).GetBulkTagJob(ctx, req.(*GetBulkTagJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAssetStargazers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetStargazersRequest)
+func _CompassService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAssetStargazers(ctx, in)
+		return srv.(CompassServiceServer).CreateSavedSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAssetStargazers_FullMethodName,
+		FullMethod: CompassService_CreateSavedSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAssetStargazers(ctx, req.(*GetAssetStargazersRequest))
+		return srv.(CompassServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAssetVersionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetVersionHistoryRequest)
+func _CompassService_GetMySavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMySavedSearchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAssetVersionHistory(ctx, in)
+		return srv.(CompassServiceServer).GetMySavedSearches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAssetVersionHistory_FullMethodName,
+		FullMethod: CompassService_GetMySavedSearches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAssetVersionHistory(ctx, req.(*GetAssetVersionHistoryRequest))
+		return srv.(CompassServiceServer).GetMySavedSearches(ctx, req.(*GetMySavedSearchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAssetByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAssetByVersionRequest)
+func _CompassService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAssetByVersion(ctx, in)
+		return srv.(CompassServiceServer).DeleteSavedSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAssetByVersion_FullMethodName,
+		FullMethod: CompassService_DeleteSavedSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAssetByVersion(ctx, req.(*GetAssetByVersionRequest))
+		return srv.(CompassServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_CreateAssetProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAssetProbeRequest)
+func _CompassService_GetSavedSearchMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchMatchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).CreateAssetProbe(ctx, in)
+		return srv.(CompassServiceServer).GetSavedSearchMatches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_CreateAssetProbe_FullMethodName,
+		FullMethod: CompassService_GetSavedSearchMatches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).CreateAssetProbe(ctx, req.(*CreateAssetProbeRequest))
+		return srv.(CompassServiceServer).GetSavedSearchMatches(ctx, req.(*GetSavedSearchMatchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_SyncAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncAssetsRequest)
+func _CompassService_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).SyncAssets(ctx, in)
+		return srv.(CompassServiceServer).CreatePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_SyncAssets_FullMethodName,
+		FullMethod: CompassService_CreatePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).SyncAssets(ctx, req.(*SyncAssetsRequest))
+		return srv.(CompassServiceServer).CreatePolicy(ctx, req.(*CreatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetUserStarredAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserStarredAssetsRequest)
+func _CompassService_GetAllPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetUserStarredAssets(ctx, in)
+		return srv.(CompassServiceServer).GetAllPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetUserStarredAssets_FullMethodName,
+		FullMethod: CompassService_GetAllPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetUserStarredAssets(ctx, req.(*GetUserStarredAssetsRequest))
+		return srv.(CompassServiceServer).GetAllPolicies(ctx, req.(*GetAllPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetMyStarredAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMyStarredAssetsRequest)
+func _CompassService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetMyStarredAssets(ctx, in)
+		return srv.(CompassServiceServer).DeletePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetMyStarredAssets_FullMethodName,
+		FullMethod: CompassService_DeletePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetMyStarredAssets(ctx, req.(*GetMyStarredAssetsRequest))
+		return srv.(CompassServiceServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetMyStarredAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMyStarredAssetRequest)
+func _CompassService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetMyStarredAsset(ctx, in)
+		return srv.(CompassServiceServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetMyStarredAsset_FullMethodName,
+		FullMethod: CompassService_CreateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetMyStarredAsset(ctx, req.(*GetMyStarredAssetRequest))
+		return srv.(CompassServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_StarAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StarAssetRequest)
+func _CompassService_GetAllServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllServiceAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).StarAsset(ctx, in)
+		return srv.(CompassServiceServer).GetAllServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_StarAsset_FullMethodName,
+		FullMethod: CompassService_GetAllServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).StarAsset(ctx, req.(*StarAssetRequest))
+		return srv.(CompassServiceServer).GetAllServiceAccounts(ctx, req.(*GetAllServiceAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_UnstarAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnstarAssetRequest)
+func _CompassService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).UnstarAsset(ctx, in)
+		return srv.(CompassServiceServer).DeleteServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_UnstarAsset_FullMethodName,
+		FullMethod: CompassService_DeleteServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).UnstarAsset(ctx, req.(*UnstarAssetRequest))
+		return srv.(CompassServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetMyDiscussions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMyDiscussionsRequest)
+func _CompassService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetMyDiscussions(ctx, in)
+		return srv.(CompassServiceServer).CreateAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetMyDiscussions_FullMethodName,
+		FullMethod: CompassService_CreateAPIToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetMyDiscussions(ctx, req.(*GetMyDiscussionsRequest))
+		return srv.(CompassServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_CreateTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTagAssetRequest)
+func _CompassService_GetAllAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAPITokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).CreateTagAsset(ctx, in)
+		return srv.(CompassServiceServer).GetAllAPITokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_CreateTagAsset_FullMethodName,
+		FullMethod: CompassService_GetAllAPITokens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).CreateTagAsset(ctx, req.(*CreateTagAssetRequest))
+		return srv.(CompassServiceServer).GetAllAPITokens(ctx, req.(*GetAllAPITokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetTagByAssetAndTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTagByAssetAndTemplateRequest)
+func _CompassService_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetTagByAssetAndTemplate(ctx, in)
+		return srv.(CompassServiceServer).RevokeAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetTagByAssetAndTemplate_FullMethodName,
+		FullMethod: CompassService_RevokeAPIToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetTagByAssetAndTemplate(ctx, req.(*GetTagByAssetAndTemplateRequest))
+		return srv.(CompassServiceServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_UpdateTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTagAssetRequest)
+func _CompassService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).UpdateTagAsset(ctx, in)
+		return srv.(CompassServiceServer).CreateTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_UpdateTagAsset_FullMethodName,
+		FullMethod: CompassService_CreateTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).UpdateTagAsset(ctx, req.(*UpdateTagAssetRequest))
+		return srv.(CompassServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_DeleteTagAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTagAssetRequest)
+func _CompassService_GetAllTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTeamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).DeleteTagAsset(ctx, in)
+		return srv.(CompassServiceServer).GetAllTeams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_DeleteTagAsset_FullMethodName,
+		FullMethod: CompassService_GetAllTeams_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).DeleteTagAsset(ctx, req.(*DeleteTagAssetRequest))
+		return srv.(CompassServiceServer).GetAllTeams(ctx, req.(*GetAllTeamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAllTagsByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllTagsByAssetRequest)
+func _CompassService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAllTagsByAsset(ctx, in)
+		return srv.(CompassServiceServer).GetTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAllTagsByAsset_FullMethodName,
+		FullMethod: CompassService_GetTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAllTagsByAsset(ctx, req.(*GetAllTagsByAssetRequest))
+		return srv.(CompassServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetAllTagTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllTagTemplatesRequest)
+func _CompassService_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetAllTagTemplates(ctx, in)
+		return srv.(CompassServiceServer).UpdateTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetAllTagTemplates_FullMethodName,
+		FullMethod: CompassService_UpdateTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetAllTagTemplates(ctx, req.(*GetAllTagTemplatesRequest))
+		return srv.(CompassServiceServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_CreateTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTagTemplateRequest)
+func _CompassService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).CreateTagTemplate(ctx, in)
+		return srv.(CompassServiceServer).DeleteTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_CreateTagTemplate_FullMethodName,
+		FullMethod: CompassService_DeleteTeam_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).CreateTagTemplate(ctx, req.(*CreateTagTemplateRequest))
+		return srv.(CompassServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_GetTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTagTemplateRequest)
+func _CompassService_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).GetTagTemplate(ctx, in)
+		return srv.(CompassServiceServer).GetMyProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_GetTagTemplate_FullMethodName,
+		FullMethod: CompassService_GetMyProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).GetTagTemplate(ctx, req.(*GetTagTemplateRequest))
+		return srv.(CompassServiceServer).GetMyProfile(ctx, req.(*GetMyProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_UpdateTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTagTemplateRequest)
+func _CompassService_GetMyTeamsAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyTeamsAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).UpdateTagTemplate(ctx, in)
+		return srv.(CompassServiceServer).GetMyTeamsAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_UpdateTagTemplate_FullMethodName,
+		FullMethod: CompassService_GetMyTeamsAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).UpdateTagTemplate(ctx, req.(*UpdateTagTemplateRequest))
+		return srv.(CompassServiceServer).GetMyTeamsAssets(ctx, req.(*GetMyTeamsAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CompassService_DeleteTagTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTagTemplateRequest)
+func _CompassService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CompassServiceServer).DeleteTagTemplate(ctx, in)
+		return srv.(CompassServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CompassService_DeleteTagTemplate_FullMethodName,
+		FullMethod: CompassService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CompassServiceServer).DeleteTagTemplate(ctx, req.(*DeleteTagTemplateRequest))
+		return srv.(CompassServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1522,6 +2811,38 @@ var CompassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllTypes",
 			Handler:    _CompassService_GetAllTypes_Handler,
 		},
+		{
+			MethodName: "CreateType",
+			Handler:    _CompassService_CreateType_Handler,
+		},
+		{
+			MethodName: "GetType",
+			Handler:    _CompassService_GetType_Handler,
+		},
+		{
+			MethodName: "UpdateType",
+			Handler:    _CompassService_UpdateType_Handler,
+		},
+		{
+			MethodName: "DeleteType",
+			Handler:    _CompassService_DeleteType_Handler,
+		},
+		{
+			MethodName: "UpsertTypeSchema",
+			Handler:    _CompassService_UpsertTypeSchema_Handler,
+		},
+		{
+			MethodName: "GetTypeSchema",
+			Handler:    _CompassService_GetTypeSchema_Handler,
+		},
+		{
+			MethodName: "DeleteTypeSchema",
+			Handler:    _CompassService_DeleteTypeSchema_Handler,
+		},
+		{
+			MethodName: "ValidateTypeSchema",
+			Handler:    _CompassService_ValidateTypeSchema_Handler,
+		},
 		{
 			MethodName: "GetAllAssets",
 			Handler:    _CompassService_GetAllAssets_Handler,
@@ -1558,6 +2879,10 @@ var CompassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAssetByVersion",
 			Handler:    _CompassService_GetAssetByVersion_Handler,
 		},
+		{
+			MethodName: "GetAssetSchemaDiff",
+			Handler:    _CompassService_GetAssetSchemaDiff_Handler,
+		},
 		{
 			MethodName: "CreateAssetProbe",
 			Handler:    _CompassService_CreateAssetProbe_Handler,
@@ -1606,6 +2931,26 @@ var CompassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTagAsset",
 			Handler:    _CompassService_DeleteTagAsset_Handler,
 		},
+		{
+			MethodName: "CreateColumnTag",
+			Handler:    _CompassService_CreateColumnTag_Handler,
+		},
+		{
+			MethodName: "GetColumnTag",
+			Handler:    _CompassService_GetColumnTag_Handler,
+		},
+		{
+			MethodName: "UpdateColumnTag",
+			Handler:    _CompassService_UpdateColumnTag_Handler,
+		},
+		{
+			MethodName: "DeleteColumnTag",
+			Handler:    _CompassService_DeleteColumnTag_Handler,
+		},
+		{
+			MethodName: "GetTagHistory",
+			Handler:    _CompassService_GetTagHistory_Handler,
+		},
 		{
 			MethodName: "GetAllTagsByAsset",
 			Handler:    _CompassService_GetAllTagsByAsset_Handler,
@@ -1630,6 +2975,106 @@ var CompassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTagTemplate",
 			Handler:    _CompassService_DeleteTagTemplate_Handler,
 		},
+		{
+			MethodName: "MigrateTagTemplate",
+			Handler:    _CompassService_MigrateTagTemplate_Handler,
+		},
+		{
+			MethodName: "BulkTagAssets",
+			Handler:    _CompassService_BulkTagAssets_Handler,
+		},
+		{
+			MethodName: "BulkUntagAssets",
+			Handler:    _CompassService_BulkUntagAssets_Handler,
+		},
+		{
+			MethodName: "GetBulkTagJob",
+			Handler:    _CompassService_GetBulkTagJob_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _CompassService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetMySavedSearches",
+			Handler:    _CompassService_GetMySavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _CompassService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearchMatches",
+			Handler:    _CompassService_GetSavedSearchMatches_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _CompassService_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "GetAllPolicies",
+			Handler:    _CompassService_GetAllPolicies_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _CompassService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _CompassService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetAllServiceAccounts",
+			Handler:    _CompassService_GetAllServiceAccounts_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _CompassService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _CompassService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAllAPITokens",
+			Handler:    _CompassService_GetAllAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _CompassService_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _CompassService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetAllTeams",
+			Handler:    _CompassService_GetAllTeams_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _CompassService_GetTeam_Handler,
+		},
+		{
+			MethodName: "UpdateTeam",
+			Handler:    _CompassService_UpdateTeam_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _CompassService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "GetMyProfile",
+			Handler:    _CompassService_GetMyProfile_Handler,
+		},
+		{
+			MethodName: "GetMyTeamsAssets",
+			Handler:    _CompassService_GetMyTeamsAssets_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _CompassService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/compass/v1beta1/service.proto",